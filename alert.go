@@ -0,0 +1,110 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const alertURL = "alert"
+
+func getAlertDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	alert := Alert{}
+	return c.APIClient().QueryParamsWithFields(&alert)
+}
+
+// GetAlerts returns alerts matching the query sorted by time, nil query returns all alerts.
+// Since is sent in UTC, so the timestamp is not affected by the local zone of the caller
+func (c *ClientIMPL) GetAlerts(ctx context.Context, query *AlertQuery) ([]Alert, error) {
+	result := []Alert{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Alert
+		qp := getAlertDefaultQueryParams(c)
+		if query != nil {
+			if query.Severity != "" {
+				qp.RawArg("severity", fmt.Sprintf("eq.%s", query.Severity))
+			}
+			if query.State != "" {
+				qp.RawArg("state", fmt.Sprintf("eq.%s", query.State))
+			}
+			if !query.Since.IsZero() {
+				qp.RawArg("generated_timestamp",
+					fmt.Sprintf("gt.%s", query.Since.UTC().Format(time.RFC3339)))
+			}
+		}
+		qp.Order("generated_timestamp", "id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    alertURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetAlert query and return specific alert by id
+func (c *ClientIMPL) GetAlert(ctx context.Context, id string) (resp Alert, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    alertURL,
+			ID:          id,
+			QueryParams: getAlertDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// AcknowledgeAlert marks alert as acknowledged, acknowledged alerts stay active until cleared
+func (c *ClientIMPL) AcknowledgeAlert(ctx context.Context, alertID string) (EmptyResponse, error) {
+	acknowledged := true
+	return c.modifyAlert(ctx, &alertModify{IsAcknowledged: &acknowledged}, alertID)
+}
+
+// ClearAlert marks alert as cleared
+func (c *ClientIMPL) ClearAlert(ctx context.Context, alertID string) (EmptyResponse, error) {
+	state := AlertStateEnumCleared
+	return c.modifyAlert(ctx, &alertModify{State: &state}, alertID)
+}
+
+func (c *ClientIMPL) modifyAlert(ctx context.Context,
+	modifyParams *alertModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: alertURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
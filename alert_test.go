@@ -0,0 +1,119 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const alertMockURL = APIMockURL + alertURL
+
+var alertID = "5a7b3c9d-1e2f-4a6b-8c0d-2e4f6a8b0c1d"
+
+func TestClientIMPL_GetAlerts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var queries []url.Values
+	httpmock.RegisterResponder("GET", alertMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			queries = append(queries, query)
+			if query.Get("offset") == "0" {
+				return pageResponse(fmt.Sprintf(`[{"id": "%s", "severity": "Major", "resource_type": "volume",
+"resource_id": "%s", "description_l10n": "Volume is offline", "state": "ACTIVE",
+"generated_timestamp": "2020-05-06T10:00:00.123+00:00"}]`, alertID, volID), "0-0/2"), nil
+			}
+			return pageResponse(`[{"id": "a2", "severity": "Major", "state": "ACTIVE"}]`, "1-1/2"), nil
+		})
+	since := time.Date(2020, 5, 6, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	alerts, err := C.GetAlerts(context.Background(),
+		&AlertQuery{Severity: EventSeverityEnumMajor, Since: since})
+	assert.Nil(t, err)
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, Alert{ID: alertID, Severity: EventSeverityEnumMajor, ResourceType: "volume",
+		ResourceID: volID, Description: "Volume is offline", State: AlertStateEnumActive,
+		GeneratedTimestamp: "2020-05-06T10:00:00.123+00:00"}, alerts[0])
+	assert.Equal(t, "a2", alerts[1].ID)
+
+	assert.Len(t, queries, 2)
+	assert.Equal(t, "gt.2020-05-06T10:30:00Z", queries[0].Get("generated_timestamp"))
+	assert.Equal(t, "eq.Major", queries[0].Get("severity"))
+	assert.Equal(t, "", queries[0].Get("state"))
+	assert.Equal(t, "generated_timestamp,id", queries[0].Get("order"))
+	assert.Equal(t, "1", queries[1].Get("offset"))
+	assert.Equal(t, queries[0].Get("generated_timestamp"), queries[1].Get("generated_timestamp"))
+}
+
+func TestClientIMPL_GetAlert(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", alertMockURL, alertID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "severity": "Critical", "state": "ACTIVE"}`,
+			alertID)))
+	alert, err := C.GetAlert(context.Background(), alertID)
+	assert.Nil(t, err)
+	assert.Equal(t, alertID, alert.ID)
+	assert.Equal(t, EventSeverityEnumCritical, alert.Severity)
+	assert.Equal(t, AlertStateEnumActive, alert.State)
+}
+
+func TestClientIMPL_GetAlerts_NoQuery(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var query url.Values
+	httpmock.RegisterResponder("GET", alertMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, `[]`), nil
+		})
+	alerts, err := C.GetAlerts(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, alerts)
+	assert.Len(t, alerts, 0)
+	assert.NotContains(t, query, "generated_timestamp")
+	assert.NotContains(t, query, "severity")
+}
+
+func TestClientIMPL_AcknowledgeClearAlert(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", alertMockURL, alertID),
+		func(req *http.Request) (*http.Response, error) {
+			reqBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	_, err := C.AcknowledgeAlert(context.Background(), alertID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"is_acknowledged": true}, reqBody)
+	_, err = C.ClearAlert(context.Background(), alertID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"state": "CLEARED"}, reqBody)
+}
@@ -0,0 +1,77 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import "time"
+
+// AlertStateEnum state of the alert
+type AlertStateEnum string
+
+const (
+	// AlertStateEnumActive captures enum value "ACTIVE"
+	AlertStateEnumActive AlertStateEnum = "ACTIVE"
+	// AlertStateEnumCleared captures enum value "CLEARED"
+	AlertStateEnumCleared AlertStateEnum = "CLEARED"
+)
+
+// AlertQuery filters of GetAlerts request, unset fields don't filter alerts
+type AlertQuery struct {
+	// Only alerts of the severity are returned.
+	Severity EventSeverityEnum
+	// Only alerts in the state are returned.
+	State AlertStateEnum
+	// Only alerts generated after the time are returned.
+	Since time.Time
+}
+
+// alertModify body of alert modify request
+type alertModify struct {
+	IsAcknowledged *bool           `json:"is_acknowledged,omitempty"`
+	State          *AlertStateEnum `json:"state,omitempty"`
+}
+
+// Alert alert raised by the array
+type Alert struct {
+	// Unique identifier of the alert.
+	ID string `json:"id,omitempty"`
+	// Code of the event which raised the alert.
+	EventCode string `json:"event_code,omitempty"`
+	// Severity of the alert.
+	Severity EventSeverityEnum `json:"severity,omitempty"`
+	// Type of the resource the alert is raised for.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Unique identifier of the resource the alert is raised for.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name of the resource the alert is raised for.
+	ResourceName string `json:"resource_name,omitempty"`
+	// Localized description of the alert.
+	Description string `json:"description_l10n,omitempty"`
+	// State of the alert.
+	State AlertStateEnum `json:"state,omitempty"`
+	// Indicates whether the alert was acknowledged by user.
+	IsAcknowledged bool `json:"is_acknowledged,omitempty"`
+	// Time when the alert was raised.
+	GeneratedTimestamp string `json:"generated_timestamp,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (a *Alert) Fields() []string {
+	return []string{"id", "event_code", "severity", "resource_type", "resource_id",
+		"resource_name", "description_l10n", "state", "is_acknowledged", "generated_timestamp"}
+}
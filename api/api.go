@@ -22,10 +22,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -35,6 +37,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -44,6 +47,9 @@ var (
 
 const paginationHeader = "content-range"
 
+// requestIDHeader response header with id the array assigned to the request, support uses it to find the request in logs
+const requestIDHeader = "Dell-Emc-Request-Id"
+
 // RequestConfig provide options for the request
 type RequestConfig struct {
 	// http method Name
@@ -60,6 +66,15 @@ type RequestConfig struct {
 	Body interface{}
 }
 
+// RawBody is request body which is sent as is instead of being encoded to JSON,
+// e.g. multipart form with a file
+type RawBody struct {
+	// value of Content-Type header
+	ContentType string
+	// returns reader of the whole body, it is called for every attempt of the request
+	Open func() (io.Reader, error)
+}
+
 // RenderRequestConfig is RequestConfigRenderer implementation
 func (rc RequestConfig) RenderRequestConfig() RequestConfig {
 	return rc
@@ -88,6 +103,12 @@ type RespMeta struct {
 	Status int
 	// pagination data
 	Pagination PaginationInfo
+	// id the array assigned to the request
+	RequestID string
+	// raw value of content-range header
+	ContentRange string
+	// raw value of location header, set for asynchronous requests accepted by the array
+	Location string
 }
 
 // Client is PowerStore API client interface
@@ -101,6 +122,8 @@ type Client interface {
 	QueryParamsWithFields(provider FieldProvider) QueryParamsEncoder
 	SetCustomHTTPHeaders(headers http.Header)
 	SetLogger(logger Logger)
+	Config() Config
+	Logout(ctx context.Context) error
 }
 
 // FieldProvider provide method which return required fields list
@@ -116,42 +139,277 @@ type ClientIMPL struct {
 	password          string
 	httpClient        *http.Client
 	defaultTimeout    uint64
+	requestTimeout    time.Duration
 	requestIDKey      string
+	minTLSVersion     uint16
+	cipherSuites      []uint16
 	customHTTPHeaders http.Header
 	logger            Logger
+	retryPolicy       RetryPolicy
+	requestsPerSecond int
+	limiter           *rateLimiter
+	concurrency       concurrencyLimiter
+	maxConcurrent     int
+	customHTTPClient  bool
+	customTransport   bool
+	proxyURL          string
+	customCA          bool
+	clientCertificate bool
+	tracer            RequestTracer
+	hooks             RequestHooks
+	structuredLogger  StructuredLogger
+	spanTracer        SpanTracer
+	sessionAuth       bool
+	sessionMutex      sync.Mutex
+	session           *authSession
+	pendingLogin      *loginCall
+	settingsMutex     sync.RWMutex
+	maxIdleConns      int
+	readOnly          bool
+	dryRun            bool
+}
+
+// Options holds settings of the API client
+type Options struct {
+	// skip https cert check
+	Insecure bool
+	// default request timeout in seconds
+	DefaultTimeout uint64
+	// timeout of requests which context has no deadline, overrides DefaultTimeout when set
+	RequestTimeout time.Duration
+	// define field name in context which will be used for tracing
+	RequestIDKey string
+	// minimum TLS version accepted by the transport, zero value keeps Go default
+	MinTLSVersion uint16
+	// list of enabled TLS 1.0-1.2 cipher suites, empty value keeps Go default
+	CipherSuites []uint16
+	// number of retries of requests failed with 429, 503 or connection errors, zero disables retries
+	RetryCount int
+	// maximum time spent on retries of a single request, zero value means 30 seconds
+	RetryTimeout time.Duration
+	// backoff, retried statuses and limits of retries, overrides RetryCount and RetryTimeout when set
+	RetryPolicy *RetryPolicy
+	// maximum number of requests sent per second, retries included. Zero value means unlimited
+	RequestsPerSecond int
+	// maximum number of requests in flight, retries and login included. Zero value means unlimited
+	MaxConcurrentRequests int
+	// maximum number of idle connections to the array kept for reuse, zero value means 100.
+	// Can't be used with HTTPClient or Transport
+	MaxIdleConnsPerHost int
+	// http client used to send requests, e.g. with custom CA pool, proxy or dial timeout.
+	// Its transport is used as is, so TLS and proxy options must not be set
+	HTTPClient *http.Client
+	// transport used to send requests, e.g. an instrumented http.RoundTripper.
+	// It is used as is, so TLS and proxy options and HTTPClient must not be set
+	Transport http.RoundTripper
+	// URL of the proxy requests are sent through, empty value means proxy from environment is used
+	ProxyURL string
+	// PEM encoded CA certificates trusted instead of system roots when verifying the array certificate
+	CACertificates []byte
+	// certificates presented to the array for mutual TLS authentication
+	ClientCertificates []tls.Certificate
+	// receives every request and response, nil disables tracing
+	RequestTracer RequestTracer
+	// authenticate requests with login session instead of sending basic credentials with every request
+	SessionAuth bool
+	// callbacks invoked for every request and response
+	RequestHooks RequestHooks
+	// logger with fields, replaces default logger when set
+	StructuredLogger StructuredLogger
+	// creates client span for every REST call, nil disables spans
+	SpanTracer SpanTracer
+	// reject requests which may change state of the array with ErrReadOnly
+	ReadOnly bool
+	// log requests which may change state of the array instead of sending them
+	DryRun bool
 }
 
 // New creates and initialize API client
 func New(apiURL string, username string,
 	password string, insecure bool, defaultTimeout uint64, requestIDKey string) (*ClientIMPL, error) {
+	return NewWithOptions(apiURL, username, password, Options{
+		Insecure:       insecure,
+		DefaultTimeout: defaultTimeout,
+		RequestIDKey:   requestIDKey})
+}
+
+// NewWithOptions creates and initialize API client with provided options
+func NewWithOptions(apiURL string, username string,
+	password string, options Options) (*ClientIMPL, error) {
 	debug, _ = strconv.ParseBool(os.Getenv("GOPOWERSTORE_DEBUG"))
 	if apiURL == "" || username == "" || password == "" {
 		return nil, errors.New("API Client can't be initialized: " +
 			"Missing endpoint, username, or password param")
 	}
 
-	var client *http.Client
-	// #nosec G402
-	if insecure {
-		client = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: insecure,
-				},
-			},
-		}
-	} else {
-		client = &http.Client{}
+	client, err := buildHTTPClient(options)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy := RetryPolicy{MaxRetries: options.RetryCount, Timeout: options.RetryTimeout}
+	if options.RetryPolicy != nil {
+		retryPolicy = *options.RetryPolicy
+	}
+	if retryPolicy.Timeout <= 0 {
+		retryPolicy.Timeout = retryDefaultTimeout
+	}
+
+	requestTimeout := options.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = time.Duration(options.DefaultTimeout) * time.Second
+	}
+
+	var logger Logger = &defaultLogger{}
+	if options.StructuredLogger != nil {
+		logger = &structuredLoggerAdapter{logger: options.StructuredLogger}
 	}
 
 	return &ClientIMPL{apiURL: apiURL,
-		insecure:       insecure,
-		username:       username,
-		password:       password,
-		httpClient:     client,
-		defaultTimeout: defaultTimeout,
-		requestIDKey:   requestIDKey,
-		logger:         &defaultLogger{}}, nil
+		insecure:          options.Insecure,
+		username:          username,
+		password:          password,
+		httpClient:        client,
+		defaultTimeout:    options.DefaultTimeout,
+		requestTimeout:    requestTimeout,
+		requestIDKey:      options.RequestIDKey,
+		minTLSVersion:     options.MinTLSVersion,
+		cipherSuites:      options.CipherSuites,
+		retryPolicy:       retryPolicy,
+		requestsPerSecond: options.RequestsPerSecond,
+		customHTTPClient:  options.HTTPClient != nil,
+		customTransport:   options.Transport != nil,
+		proxyURL:          options.ProxyURL,
+		customCA:          len(options.CACertificates) > 0,
+		clientCertificate: len(options.ClientCertificates) > 0,
+		limiter:           newRateLimiter(options.RequestsPerSecond),
+		concurrency:       newConcurrencyLimiter(options.MaxConcurrentRequests),
+		maxConcurrent:     options.MaxConcurrentRequests,
+		maxIdleConns:      maxIdleConnsPerHost(options),
+		tracer:            options.RequestTracer,
+		sessionAuth:       options.SessionAuth,
+		hooks:             options.RequestHooks,
+		structuredLogger:  options.StructuredLogger,
+		spanTracer:        options.SpanTracer,
+		readOnly:          options.ReadOnly,
+		dryRun:            options.DryRun,
+		logger:            logger}, nil
+}
+
+// buildHTTPClient returns custom http client or client with custom transport if one is provided,
+// otherwise client with transport built from TLS and proxy options
+func buildHTTPClient(options Options) (*http.Client, error) {
+	tlsConfig, err := buildTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+	var proxyURL *url.URL
+	if options.ProxyURL != "" {
+		proxyURL, err = url.Parse(options.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL: %s", redactURL(options.ProxyURL))
+		}
+	}
+	if options.MaxIdleConnsPerHost < 0 {
+		return nil, fmt.Errorf("invalid maximum number of idle connections per host: %d", options.MaxIdleConnsPerHost)
+	}
+	if options.HTTPClient != nil || options.Transport != nil {
+		if options.HTTPClient != nil && options.Transport != nil {
+			return nil, errors.New("API Client can't be initialized: " +
+				"custom HTTP client and transport can't be used together")
+		}
+		if tlsConfig != nil || proxyURL != nil || options.MaxIdleConnsPerHost != 0 {
+			return nil, errors.New("API Client can't be initialized: " +
+				"TLS, proxy and connection pool options can't be applied to custom HTTP client or transport, " +
+				"configure the transport instead")
+		}
+		if options.HTTPClient != nil {
+			return options.HTTPClient, nil
+		}
+		return &http.Client{Transport: options.Transport}, nil
+	}
+	if tlsConfig == nil && proxyURL == nil {
+		return &http.Client{Transport: &pooledTransport{maxIdleConnsPerHost: options.MaxIdleConnsPerHost}}, nil
+	}
+	// start from default transport to keep its dial timeouts and proxy from environment
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	tuneTransport(transport, options.MaxIdleConnsPerHost)
+	return &http.Client{Transport: transport}, nil
+}
+
+// maxIdleConnsPerHost returns effective size of the connection pool, zero if pool of custom
+// http client or transport is used
+func maxIdleConnsPerHost(options Options) int {
+	switch {
+	case options.HTTPClient != nil || options.Transport != nil:
+		return 0
+	case options.MaxIdleConnsPerHost > 0:
+		return options.MaxIdleConnsPerHost
+	default:
+		return defaultMaxIdleConnsPerHost
+	}
+}
+
+// redactURL hides password of the URL, unparsable URL is replaced with redactedValue entirely
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redactedValue
+	}
+	return u.Redacted()
+}
+
+// buildTLSConfig returns nil if default TLS settings should be used
+func buildTLSConfig(options Options) (*tls.Config, error) {
+	if !options.Insecure && options.MinTLSVersion == 0 && len(options.CipherSuites) == 0 &&
+		len(options.CACertificates) == 0 && len(options.ClientCertificates) == 0 {
+		return nil, nil
+	}
+	switch options.MinTLSVersion {
+	case 0, tls.VersionTLS10, tls.VersionTLS11, tls.VersionTLS12, tls.VersionTLS13:
+	default:
+		return nil, fmt.Errorf("unknown minimum TLS version: 0x%04x", options.MinTLSVersion)
+	}
+	knownSuites := make(map[uint16]*tls.CipherSuite)
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		knownSuites[suite.ID] = suite
+	}
+	for _, id := range options.CipherSuites {
+		suite, ok := knownSuites[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite: 0x%04x", id)
+		}
+		usable := false
+		for _, version := range suite.SupportedVersions {
+			if version >= options.MinTLSVersion {
+				usable = true
+				break
+			}
+		}
+		if !usable {
+			return nil, fmt.Errorf("TLS cipher suite %s can't be used with minimum TLS version %s",
+				suite.Name, tls.VersionName(options.MinTLSVersion))
+		}
+	}
+	var rootCAs *x509.CertPool
+	if len(options.CACertificates) > 0 {
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(options.CACertificates) {
+			return nil, errors.New("no valid PEM encoded certificates found in CA bundle")
+		}
+	}
+	// #nosec G402
+	return &tls.Config{
+		InsecureSkipVerify: options.Insecure,
+		MinVersion:         options.MinTLSVersion,
+		CipherSuites:       options.CipherSuites,
+		RootCAs:            rootCAs,
+		Certificates:       options.ClientCertificates,
+	}, nil
 }
 
 const errorSeverity = "Error"
@@ -163,6 +421,7 @@ type apiErrorMsg struct {
 // ErrorMsg is internal error representation
 type ErrorMsg struct {
 	StatusCode int    `json:"-"`
+	RequestID  string `json:"-"`
 	ErrorCode  string `json:"code"`
 	Severity   string
 	Message    string `json:"message_l10n"`
@@ -185,31 +444,71 @@ func buildError(r *http.Response) *ErrorMsg {
 			s := buf.String()
 			errMsg = fmt.Sprintf("%s: %s", errMsg, s)
 		}
-		return &ErrorMsg{StatusCode: r.StatusCode, Severity: errorSeverity,
-			Message: errMsg}
+		return &ErrorMsg{StatusCode: r.StatusCode, RequestID: r.Header.Get(requestIDHeader),
+			Severity: errorSeverity, Message: errMsg}
 	}
 	firstErrMsg := (*apiErrorMsg.Messages)[0]
 	firstErrMsg.StatusCode = r.StatusCode
+	firstErrMsg.RequestID = r.Header.Get(requestIDHeader)
 	return &firstErrMsg
 }
 
-// SetCustomHTTPHeaders method register headers which will be sent with every request
+// SetCustomHTTPHeaders method register headers which will be sent with every request.
+// Headers are copied, so it is safe to call while requests are in flight
 func (c *ClientIMPL) SetCustomHTTPHeaders(headers http.Header) {
-	c.customHTTPHeaders = headers
+	var copied http.Header
+	if headers != nil {
+		copied = headers.Clone()
+	}
+	c.settingsMutex.Lock()
+	defer c.settingsMutex.Unlock()
+	c.customHTTPHeaders = copied
 }
 
 // SetLogger set logger for use by gopowerstore
 func (c *ClientIMPL) SetLogger(logger Logger) {
+	c.settingsMutex.Lock()
+	defer c.settingsMutex.Unlock()
 	c.logger = logger
 }
 
-// Query method do http request and reads response to provided struct
+// getCustomHTTPHeaders returns headers sent with every request, the result must not be modified
+func (c *ClientIMPL) getCustomHTTPHeaders() http.Header {
+	c.settingsMutex.RLock()
+	defer c.settingsMutex.RUnlock()
+	return c.customHTTPHeaders
+}
+
+// Logger returns logger used by the client
+func (c *ClientIMPL) Logger() Logger {
+	return c.getLogger()
+}
+
+// getLogger returns logger set for the client
+func (c *ClientIMPL) getLogger() Logger {
+	c.settingsMutex.RLock()
+	defer c.settingsMutex.RUnlock()
+	return c.logger
+}
+
+// Query method do http request and reads response to provided struct,
+// if resp implements io.Writer body of the successful response is copied to it as is
 func (c *ClientIMPL) Query(
 	ctx context.Context,
 	cfg RequestConfigRenderer,
 	resp interface{}) (RespMeta, error) {
 
 	config := cfg.RenderRequestConfig()
+	meta, err := c.queryWithSpan(ctx, config, func(ctx context.Context) (RespMeta, error) {
+		return c.query(ctx, config, resp)
+	})
+	if recorder := getRespMetaRecorder(ctx); recorder != nil {
+		recorder.record(meta)
+	}
+	return meta, err
+}
+
+func (c *ClientIMPL) query(ctx context.Context, config RequestConfig, resp interface{}) (RespMeta, error) {
 	meta := RespMeta{}
 	var cancelFuncPtr *func()
 	ctx, cancelFuncPtr = c.setupContext(ctx)
@@ -223,28 +522,54 @@ func (c *ClientIMPL) Query(
 	if err != nil {
 		return meta, err
 	}
+	if send, err := c.guardRequest(ctx, config, requestURL, traceMsg); !send {
+		return meta, err
+	}
 
-	req, err := c.prepareRequest(ctx, config.Method, requestURL, traceMsg, config.Body)
+	session, err := c.currentSession(ctx, traceMsg)
 	if err != nil {
 		return meta, err
 	}
-	r, err := c.httpClient.Do(req)
+	newRequest := func() (*http.Request, error) {
+		return c.prepareRequest(ctx, config.Method, requestURL, traceMsg, config.Body, session)
+	}
+	r, err := c.doWithRetry(ctx, traceMsg, newRequest)
+	if err == nil && session != nil && r.StatusCode == http.StatusUnauthorized {
+		// session expired or was ended on the array, login again and repeat the request once
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		r.Body.Close()
+		c.invalidateSession(session)
+		session, err = c.currentSession(ctx, traceMsg)
+		if err != nil {
+			return meta, err
+		}
+		r, err = c.doWithRetry(ctx, traceMsg, newRequest)
+	}
 	if err != nil {
 		return meta, err
 	}
 	defer r.Body.Close()
 
+	// raw content, e.g. a downloaded file, is copied to resp which implements io.Writer
+	stream, isStream := resp.(io.Writer)
 	if debug {
-		dump, _ := httputil.DumpResponse(r, true)
+		dump, _ := httputil.DumpResponse(r, !isStream)
 		replacedHeader := prepareHTTPDump(dump) // Replace sensitive parts of response headers
-		c.logger.Debug(ctx, "%sRESPONSE: %v\n", traceMsg, replacedHeader)
+		c.getLogger().Debug(ctx, "%sRESPONSE: %v\n", traceMsg, replacedHeader)
 	}
 	meta.Status = r.StatusCode
+	meta.RequestID = r.Header.Get(requestIDHeader)
+	meta.ContentRange = r.Header.Get(paginationHeader)
+	meta.Location = r.Header.Get("Location")
 	switch {
 	case resp == nil:
 		return meta, nil
 	case r.StatusCode >= 200 && r.StatusCode < 300:
 		c.updatePaginationInfoInMeta(&meta, r)
+		if isStream {
+			_, err = io.Copy(stream, r.Body)
+			return meta, err
+		}
 		err = json.NewDecoder(r.Body).Decode(resp)
 		if err == io.EOF {
 			return meta, nil
@@ -289,10 +614,21 @@ func (c *ClientIMPL) prepareRequestURL(endpoint, id string, action string,
 }
 
 func (c *ClientIMPL) prepareRequest(ctx context.Context, method, requestURL, traceMsg string,
-	body interface{}) (*http.Request, error) {
+	body interface{}, session *authSession) (*http.Request, error) {
 	var req *http.Request
 	var err error
-	if body != nil && !(reflect.ValueOf(body).Kind() == reflect.Ptr && reflect.ValueOf(body).IsNil()) {
+	rawBody, isRaw := body.(*RawBody)
+	if isRaw && rawBody != nil {
+		content, err := rawBody.Open()
+		if err != nil {
+			return nil, err
+		}
+		req, err = http.NewRequest(method, requestURL, content)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", rawBody.ContentType)
+	} else if body != nil && !(reflect.ValueOf(body).Kind() == reflect.Ptr && reflect.ValueOf(body).IsNil()) {
 		bodyJSON, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
@@ -308,15 +644,17 @@ func (c *ClientIMPL) prepareRequest(ctx context.Context, method, requestURL, tra
 		}
 	}
 	req = req.WithContext(ctx)
-	req.SetBasicAuth(c.username, c.password)
-	for key, values := range c.customHTTPHeaders {
+	c.setAuth(req, session)
+	for key, values := range c.getCustomHTTPHeaders() {
 		for _, elem := range values {
 			req.Header.Add(key, elem)
 		}
 	}
+	c.injectSpan(ctx, req)
 	if debug {
-		if requestData, err := httputil.DumpRequest(req, true); err == nil {
-			c.logger.Debug(ctx, "%sREQUEST: %s", traceMsg, prepareHTTPDump(requestData))
+		// raw body may be large, e.g. an uploaded file, so it is not dumped
+		if requestData, err := httputil.DumpRequest(req, !isRaw); err == nil {
+			c.getLogger().Debug(ctx, "%sREQUEST: %s", traceMsg, prepareHTTPDump(requestData))
 		}
 	}
 	return req, nil
@@ -334,10 +672,15 @@ func (c *ClientIMPL) setupContext(ctx context.Context) (context.Context, *func()
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	var f func()
+	if timeout := RequestTimeout(ctx); timeout > 0 {
+		// deadline of the parent context still applies if it is earlier
+		ctx, f = context.WithTimeout(ctx, timeout)
+		return ctx, &f
+	}
 	_, timeoutIsSet := ctx.Deadline()
 	if !timeoutIsSet {
-		var f func()
-		ctx, f = context.WithTimeout(ctx, time.Duration(c.defaultTimeout)*time.Second)
+		ctx, f = context.WithTimeout(ctx, c.requestTimeout)
 		return ctx, &f
 	}
 	return ctx, nil
@@ -377,8 +720,9 @@ func (c *ClientIMPL) updatePaginationInfoInMeta(meta *RespMeta, r *http.Response
 	}
 }
 
+// prepareHTTPDump hides sensitive headers and secret values of the dumped body
 func prepareHTTPDump(dump []byte) string {
-	content := replaceSensitiveHeaderInfo(dump)
+	content := string(redactBody(dump))
 	return newlineRegexp.ReplaceAllString(content, " ")
 }
 
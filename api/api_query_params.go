@@ -119,6 +119,33 @@ func (qp *QueryParams) Async(value bool) QueryParamsEncoder {
 	return qp
 }
 
+// Clone returns copy of QueryParams which can be changed without changing the original
+func (qp *QueryParams) Clone() *QueryParams {
+	clone := &QueryParams{}
+	if qp == nil {
+		return clone
+	}
+	for k, v := range qp.rawArgs {
+		clone.RawArg(k, v)
+	}
+	if qp.selectParam != nil {
+		clone.Select(*qp.selectParam...)
+	}
+	if qp.orderParam != nil {
+		clone.Order(*qp.orderParam...)
+	}
+	if qp.offsetParam != nil {
+		clone.Offset(*qp.offsetParam)
+	}
+	if qp.limitParam != nil {
+		clone.Limit(*qp.limitParam)
+	}
+	if qp.asyncParam != nil {
+		clone.Async(*qp.asyncParam)
+	}
+	return clone
+}
+
 // Encode encodes the values into ``URL encoded'' form
 // ("bar=baz&foo=quux") sorted by key.
 func (qp *QueryParams) Encode() string {
@@ -72,6 +72,15 @@ func TestQueryParamsOverride(t *testing.T) {
 	assert.Contains(t, qp.Encode(), "async=true")
 }
 
+func TestQueryParams_Clone(t *testing.T) {
+	qp := QueryParams{}
+	qp.Select("foo").Order("bar").Limit(10).RawArg("spam", "eq.ham")
+	clone := qp.Clone()
+	clone.Select("baz").Order("foo").Limit(20).RawArg("spam", "eq.eggs").RawArg("ham", "eq.spam")
+	assert.Equal(t, "limit=10&order=bar&select=foo&spam=eq.ham", qp.Encode())
+	assert.Equal(t, "ham=eq.spam&limit=20&order=bar%2Cfoo&select=foo%2Cbaz&spam=eq.eggs", clone.Encode())
+}
+
 func TestQueryParamsChaining(t *testing.T) {
 	qp := QueryParams{}
 	qp.Async(true).Select("foo", "bar")
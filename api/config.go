@@ -0,0 +1,142 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"crypto/tls"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+const redactedValue = "******"
+
+var sensitiveHeaderNameRegexp = regexp.MustCompile(`(?i)auth|token|cookie|secret|passw|key|session`)
+
+// Config holds snapshot of effective client settings. Credentials are never included.
+// The client doesn't negotiate REST API version and sends Go default User-Agent,
+// so neither is part of the snapshot
+type Config struct {
+	// PowerStore REST API endpoint with password redacted
+	APIURL string
+	// user used for authentication, password is omitted
+	Username string
+	// https cert check is skipped
+	Insecure bool
+	// default request timeout in seconds
+	DefaultTimeout uint64
+	// effective timeout of requests which context has no deadline
+	RequestTimeout time.Duration
+	// field name in context which is used for tracing
+	RequestIDKey string
+	// minimum TLS version name, empty when Go default is used
+	MinTLSVersion string
+	// enabled TLS cipher suites names, empty when Go default is used
+	CipherSuites []string
+	// headers sent with every request, values of headers which may contain secrets are redacted
+	CustomHTTPHeaders http.Header
+	// requests and responses are dumped to log
+	Debug bool
+	// number of retries of failed requests
+	RetryCount int
+	// maximum time spent on retries of a single request
+	RetryTimeout time.Duration
+	// maximum number of requests sent per second, zero when unlimited
+	RequestsPerSecond int
+	// maximum number of requests in flight, zero when unlimited
+	MaxConcurrentRequests int
+	// maximum number of idle connections to the array kept for reuse, zero when custom http client or transport is used
+	MaxIdleConnsPerHost int
+	// requests are sent with http client provided by the caller
+	CustomHTTPClient bool
+	// requests are sent with transport provided by the caller
+	CustomTransport bool
+	// proxy requests are sent through with password redacted, empty when proxy from environment is used
+	ProxyURL string
+	// array certificate is verified against CA bundle provided by the caller
+	CustomCA bool
+	// client certificate is presented to the array
+	ClientCertificate bool
+	// requests and responses are reported to request tracer
+	Tracing bool
+	// requests are authenticated with login session
+	SessionAuth bool
+	// request hooks are set
+	RequestHooks bool
+	// messages are logged with structured logger
+	StructuredLogging bool
+	// client span is created for every REST call
+	SpanTracing bool
+	// requests which may change state of the array are rejected
+	ReadOnly bool
+	// requests which may change state of the array are logged instead of being sent
+	DryRun bool
+}
+
+// Config returns snapshot of effective client settings with secrets redacted
+func (c *ClientIMPL) Config() Config {
+	cfg := Config{
+		APIURL:                redactURL(c.apiURL),
+		Username:              c.username,
+		Insecure:              c.insecure,
+		DefaultTimeout:        c.defaultTimeout,
+		RequestTimeout:        c.requestTimeout,
+		RequestIDKey:          c.requestIDKey,
+		Debug:                 debug,
+		RetryCount:            c.retryPolicy.MaxRetries,
+		RetryTimeout:          c.retryPolicy.Timeout,
+		RequestsPerSecond:     c.requestsPerSecond,
+		MaxConcurrentRequests: c.maxConcurrent,
+		MaxIdleConnsPerHost:   c.maxIdleConns,
+		CustomHTTPClient:      c.customHTTPClient,
+		CustomTransport:       c.customTransport,
+		CustomCA:              c.customCA,
+		ClientCertificate:     c.clientCertificate,
+		Tracing:               c.tracer != nil,
+		SessionAuth:           c.sessionAuth,
+		RequestHooks:          c.hooks.OnRequest != nil || c.hooks.OnResponse != nil,
+		StructuredLogging:     c.structuredLogger != nil,
+		SpanTracing:           c.spanTracer != nil,
+		ReadOnly:              c.readOnly,
+		DryRun:                c.dryRun,
+	}
+	if c.proxyURL != "" {
+		cfg.ProxyURL = redactURL(c.proxyURL)
+	}
+	if c.minTLSVersion != 0 {
+		cfg.MinTLSVersion = tls.VersionName(c.minTLSVersion)
+	}
+	for _, id := range c.cipherSuites {
+		cfg.CipherSuites = append(cfg.CipherSuites, tls.CipherSuiteName(id))
+	}
+	if headers := c.getCustomHTTPHeaders(); headers != nil {
+		cfg.CustomHTTPHeaders = make(http.Header, len(headers))
+		for key, values := range headers {
+			copied := make([]string, len(values))
+			for i, v := range values {
+				if sensitiveHeaderNameRegexp.MatchString(key) {
+					v = redactedValue
+				}
+				copied[i] = v
+			}
+			cfg.CustomHTTPHeaders[key] = copied
+		}
+	}
+	return cfg
+}
@@ -20,6 +20,8 @@ package api
 
 import (
 	"context"
+	"sync"
+	"time"
 )
 
 // Traceable interface provide ability to set and read tracing info to/from context
@@ -41,3 +43,50 @@ func (c *ClientIMPL) TraceID(ctx context.Context) string {
 	}
 	return r
 }
+
+type requestTimeoutContextKey struct{}
+
+// WithRequestTimeout returns copy of the context which limits every request made with it to timeout.
+// Unlike the context deadline the timeout is applied to each request separately, retries and
+// session re-login included. Effective limit is the earliest of the timeout and the context deadline.
+// Non-positive timeout is ignored
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	if timeout <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, requestTimeoutContextKey{}, timeout)
+}
+
+// RequestTimeout returns per-request timeout set by WithRequestTimeout, zero if there is none
+func RequestTimeout(ctx context.Context) time.Duration {
+	timeout, _ := ctx.Value(requestTimeoutContextKey{}).(time.Duration)
+	return timeout
+}
+
+type respMetaContextKey struct{}
+
+// respMetaRecorder guards meta which may be written by parallel requests of a single call,
+// e.g. batch operations and parallel reads of paginated lists share the context
+type respMetaRecorder struct {
+	mu   sync.Mutex
+	meta *RespMeta
+}
+
+func (r *respMetaRecorder) record(meta RespMeta) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*r.meta = meta
+}
+
+// WithRespMeta returns copy of the context which records metadata of the response to meta,
+// e.g. request id needed by support, when the request is made by a method which doesn't return it.
+// Each request made with the context overwrites meta, so the last response wins. Methods which
+// send requests in parallel may finish them in any order, meta must be read only after the method returned
+func WithRespMeta(ctx context.Context, meta *RespMeta) context.Context {
+	return context.WithValue(ctx, respMetaContextKey{}, &respMetaRecorder{meta: meta})
+}
+
+func getRespMetaRecorder(ctx context.Context) *respMetaRecorder {
+	recorder, _ := ctx.Value(respMetaContextKey{}).(*respMetaRecorder)
+	return recorder
+}
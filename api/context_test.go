@@ -22,6 +22,7 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestContextTraceId(t *testing.T) {
@@ -39,3 +40,44 @@ func TestContextTraceIdEmpty(t *testing.T) {
 	fromContext := c.TraceID(ctx)
 	assert.Equal(t, "", fromContext)
 }
+
+func TestWithRequestTimeout(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, time.Duration(0), RequestTimeout(ctx))
+	assert.Equal(t, ctx, WithRequestTimeout(ctx, 0))
+	ctx = WithRequestTimeout(ctx, time.Second)
+	assert.Equal(t, time.Second, RequestTimeout(ctx))
+}
+
+func TestClientIMPL_setupContext(t *testing.T) {
+	c := ClientIMPL{requestTimeout: time.Hour}
+	parent, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// deadline of the context is kept
+	ctx, f := c.setupContext(parent)
+	assert.Nil(t, f)
+	assert.Equal(t, parent, ctx)
+
+	// per-request timeout is applied even if the context has a later deadline
+	ctx, f = c.setupContext(WithRequestTimeout(parent, time.Second))
+	assert.NotNil(t, f)
+	defer (*f)()
+	deadline, _ := ctx.Deadline()
+	assert.True(t, time.Until(deadline) <= time.Second)
+
+	// earlier deadline of the context wins
+	ctx, f = c.setupContext(WithRequestTimeout(parent, 2*time.Hour))
+	assert.NotNil(t, f)
+	defer (*f)()
+	deadline, _ = ctx.Deadline()
+	parentDeadline, _ := parent.Deadline()
+	assert.Equal(t, parentDeadline, deadline)
+
+	// client timeout is used for the context without deadline
+	ctx, f = c.setupContext(context.Background())
+	assert.NotNil(t, f)
+	defer (*f)()
+	deadline, _ = ctx.Deadline()
+	assert.True(t, time.Until(deadline) > time.Minute)
+}
@@ -37,4 +37,28 @@ const (
 	VolumeAttachedToHost = "0xE0A080020001"
 	// InstanceWasNotFound - Instance was not found on array
 	InstanceWasNotFound = "0xE04040020009"
+	// VolumeGroupNameAlreadyUseErrorCode - volume group already exists
+	VolumeGroupNameAlreadyUseErrorCode = "0xE0A0E0010008"
+	// VolumeSizeIsNotSupportedErrorCode - requested volume size is invalid, e.g. smaller than current size
+	VolumeSizeIsNotSupportedErrorCode = "0xE0A08001001A"
+	// VolumeAlreadyAttachedErrorCode - volume already attached to host
+	VolumeAlreadyAttachedErrorCode = "0xE0A01001001D"
+	// SnapshotRuleIsInUseErrorCode - snapshot rule is used by protection policy
+	SnapshotRuleIsInUseErrorCode = "0xE0A090010013"
+	// SnapshotIsNotOfVolumeErrorCode - snapshot doesn't belong to the volume
+	SnapshotIsNotOfVolumeErrorCode = "0xE0A080030005"
+	// VolumeIsNotCloneErrorCode - operation is allowed only for clone volumes
+	VolumeIsNotCloneErrorCode = "0xE0A080030007"
+	// FilesystemHasExportsErrorCode - file system has NFS exports or SMB shares
+	FilesystemHasExportsErrorCode = "0xE0800F01000D"
+	// ReplicationSessionInvalidStateErrorCode - operation is not allowed in current replication session state
+	ReplicationSessionInvalidStateErrorCode = "0xE02010050014"
+	// MetricsNotAvailableErrorCode - metrics for requested interval were not collected yet
+	MetricsNotAvailableErrorCode = "0xE04040030001"
+	// HostAlreadyInHostGroupErrorCode - host is already a member of another host group
+	HostAlreadyInHostGroupErrorCode = "0xE0A030010007"
+	// HostNameAlreadyUseErrorCode - host already exists
+	HostNameAlreadyUseErrorCode = "0xE0A01001000C"
+	// NFSExportNameAlreadyUseErrorCode - NFS export already exists
+	NFSExportNameAlreadyUseErrorCode = "0xE08010080449"
 )
@@ -0,0 +1,84 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned for requests which may change state of the array when client is read-only
+var ErrReadOnly = errors.New("request rejected by read-only client")
+
+// readActions POST actions which only read data, so they are allowed for read-only client
+var readActions = map[string]bool{
+	"metrics/generate": true,
+}
+
+// isReadRequest reports whether the request doesn't change state of the array
+func isReadRequest(config RequestConfig) bool {
+	switch config.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		return readActions[config.Endpoint+"/"+config.Action]
+	}
+	return false
+}
+
+// guardRequest checks read-only and dry-run settings, false is returned if the request must not be sent
+func (c *ClientIMPL) guardRequest(ctx context.Context, config RequestConfig,
+	requestURL, traceMsg string) (bool, error) {
+	if isReadRequest(config) {
+		return true, nil
+	}
+	if c.readOnly {
+		return false, fmt.Errorf("%w: %s %s", ErrReadOnly, config.Method, requestURL)
+	}
+	if c.dryRun {
+		msg := fmt.Sprintf("%sDRY RUN: %s %s", traceMsg, config.Method, requestURL)
+		if body := dryRunBody(config.Body); body != "" {
+			msg += " " + body
+		}
+		c.getLogger().Info(ctx, "%s", msg)
+		return false, nil
+	}
+	return true, nil
+}
+
+// dryRunBody returns body of the request as it would be sent with secret values redacted
+func dryRunBody(body interface{}) string {
+	if body == nil {
+		return ""
+	}
+	if raw, ok := body.(*RawBody); ok {
+		if raw == nil {
+			return ""
+		}
+		return fmt.Sprintf("<%s body>", raw.ContentType)
+	}
+	content, err := json.Marshal(body)
+	if err != nil || string(content) == "null" {
+		return ""
+	}
+	return string(redactBody(content))
+}
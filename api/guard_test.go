@@ -0,0 +1,96 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIMPL_Query_ReadOnly(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`[{"name": "Foo"}]`))
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{DefaultTimeout: 10, ReadOnly: true})
+	assert.Nil(t, err)
+	assert.True(t, c.Config().ReadOnly)
+
+	ctx := context.Background()
+	var resp []testResp
+	_, err = c.Query(ctx, RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+	assert.Nil(t, err)
+	_, err = c.Query(ctx, RequestConfig{Method: "POST", Endpoint: "metrics", Action: "generate",
+		Body: map[string]string{"entity": "space_metrics_by_cluster"}}, &resp)
+	assert.Nil(t, err)
+	for _, method := range []string{"POST", "PATCH", "DELETE"} {
+		_, err = c.Query(ctx, RequestConfig{Method: method, Endpoint: "volume", ID: "1"}, &resp)
+		assert.True(t, errors.Is(err, ErrReadOnly))
+		assert.Contains(t, err.Error(), method+" "+server.URL+"/volume/1")
+	}
+	assert.Equal(t, []string{"GET /volume", "POST /metrics/generate"}, requests)
+}
+
+func TestClientIMPL_Query_DryRun(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	logger := &capturingLogger{}
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{DefaultTimeout: 10,
+		DryRun: true, StructuredLogger: logger})
+	assert.Nil(t, err)
+	assert.True(t, c.Config().DryRun)
+
+	ctx := c.SetTraceID(context.Background(), "trace-1")
+	resp := &testResp{}
+	_, err = c.Query(ctx, RequestConfig{Method: "POST", Endpoint: "local_user",
+		Body: map[string]string{"name": "audit", "password": "secret"}}, resp)
+	assert.Nil(t, err)
+	assert.Empty(t, resp.Name)
+	_, err = c.Query(ctx, RequestConfig{Method: "DELETE", Endpoint: "volume", ID: "1"}, resp)
+	assert.Nil(t, err)
+	_, err = c.Query(ctx, RequestConfig{Method: "GET", Endpoint: "volume", ID: "1"}, resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Foo", resp.Name)
+	assert.Equal(t, []string{"GET /volume/1"}, requests)
+
+	// the sent GET request is logged at debug level after dry run messages
+	assert.Len(t, logger.records, 3)
+	assert.Equal(t, "info", logger.records[0].level)
+	assert.Equal(t, `[trace-1] DRY RUN: POST `+server.URL+`/local_user {"name":"audit","password":"******"}`,
+		logger.records[0].msg)
+	assert.Equal(t, "[trace-1] DRY RUN: DELETE "+server.URL+"/volume/1", logger.records[1].msg)
+}
+
+func TestClientIMPL_Query_ReadOnlyOverridesDryRun(t *testing.T) {
+	c, err := NewWithOptions("https://foo", "admin", "password", Options{ReadOnly: true, DryRun: true})
+	assert.Nil(t, err)
+	_, err = c.Query(context.Background(), RequestConfig{Method: "DELETE", Endpoint: "volume", ID: "1"}, nil)
+	assert.True(t, errors.Is(err, ErrReadOnly))
+}
@@ -0,0 +1,93 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestInfo describes request sent to the array
+type RequestInfo struct {
+	Method  string
+	URL     string
+	TraceID string
+	// zero for the first attempt, increased for every retry
+	Attempt int
+}
+
+// ResponseInfo describes response of the array or transport error of the request
+type ResponseInfo struct {
+	RequestInfo
+	// http status, zero if no response was received
+	Status int
+	// time between sending the request and receiving response headers
+	Latency time.Duration
+	Err     error
+}
+
+// RequestHooks callbacks invoked synchronously for every request, retries included.
+// Unlike RequestTracer they don't receive bodies, so they are cheap enough to collect latency metrics.
+// nil callbacks are skipped
+type RequestHooks struct {
+	OnRequest  func(ctx context.Context, info RequestInfo)
+	OnResponse func(ctx context.Context, info ResponseInfo)
+}
+
+func (c *ClientIMPL) requestInfo(ctx context.Context, req *http.Request, attempt int) RequestInfo {
+	return RequestInfo{Method: req.Method, URL: req.URL.String(), TraceID: c.TraceID(ctx), Attempt: attempt}
+}
+
+func (c *ClientIMPL) notifyRequest(ctx context.Context, info RequestInfo) {
+	if c.hooks.OnRequest != nil {
+		c.hooks.OnRequest(ctx, info)
+	}
+}
+
+// notifyResponse passes response to the hook and logs it with structured logger
+func (c *ClientIMPL) notifyResponse(ctx context.Context, info RequestInfo,
+	r *http.Response, err error, latency time.Duration) {
+	if c.hooks.OnResponse == nil && c.structuredLogger == nil {
+		return
+	}
+	resp := ResponseInfo{RequestInfo: info, Latency: latency, Err: err}
+	if r != nil {
+		resp.Status = r.StatusCode
+	}
+	if c.hooks.OnResponse != nil {
+		c.hooks.OnResponse(ctx, resp)
+	}
+	if c.structuredLogger != nil {
+		fields := []LogField{
+			{Key: "method", Value: info.Method},
+			{Key: "url", Value: info.URL},
+			{Key: "status", Value: resp.Status},
+			{Key: "latency", Value: latency},
+			{Key: "attempt", Value: info.Attempt},
+		}
+		if info.TraceID != "" {
+			fields = append(fields, LogField{Key: "trace_id", Value: info.TraceID})
+		}
+		if err != nil {
+			fields = append(fields, LogField{Key: "error", Value: err.Error()})
+		}
+		c.structuredLogger.Debug(ctx, "request completed", fields...)
+	}
+}
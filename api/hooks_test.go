@@ -0,0 +1,132 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type logRecord struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+type capturingLogger struct {
+	records []logRecord
+}
+
+func (l *capturingLogger) add(level, msg string, fields []LogField) {
+	record := logRecord{level: level, msg: msg, fields: map[string]interface{}{}}
+	for _, f := range fields {
+		record.fields[f.Key] = f.Value
+	}
+	l.records = append(l.records, record)
+}
+
+func (l *capturingLogger) Debug(ctx context.Context, msg string, fields ...LogField) {
+	l.add("debug", msg, fields)
+}
+
+func (l *capturingLogger) Info(ctx context.Context, msg string, fields ...LogField) {
+	l.add("info", msg, fields)
+}
+
+func (l *capturingLogger) Error(ctx context.Context, msg string, fields ...LogField) {
+	l.add("error", msg, fields)
+}
+
+func TestClientIMPL_Query_RequestHooks(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	retryBaseDelay = time.Millisecond
+	var requests []RequestInfo
+	var responses []ResponseInfo
+	logger := &capturingLogger{}
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RequestIDKey:   "requestid",
+		RetryCount:     1,
+		RetryTimeout:   time.Minute,
+		RequestHooks: RequestHooks{
+			OnRequest: func(ctx context.Context, info RequestInfo) {
+				requests = append(requests, info)
+			},
+			OnResponse: func(ctx context.Context, info ResponseInfo) {
+				responses = append(responses, info)
+			}},
+		StructuredLogger: logger})
+	assert.Nil(t, err)
+	assert.True(t, c.Config().RequestHooks)
+	assert.True(t, c.Config().StructuredLogging)
+
+	ctx := c.SetTraceID(context.Background(), "trace-1")
+	_, err = c.Query(ctx, RequestConfig{Method: "GET", Endpoint: "volume", ID: "1"}, &testResp{})
+	assert.Nil(t, err)
+
+	assert.Len(t, requests, 2)
+	assert.Equal(t, RequestInfo{Method: "GET", URL: server.URL + "/volume/1", TraceID: "trace-1", Attempt: 1},
+		requests[1])
+	assert.Len(t, responses, 2)
+	assert.Equal(t, http.StatusServiceUnavailable, responses[0].Status)
+	assert.Equal(t, http.StatusOK, responses[1].Status)
+	assert.Equal(t, 1, responses[1].Attempt)
+	assert.True(t, responses[1].Latency > 0)
+
+	var completed []logRecord
+	for _, r := range logger.records {
+		if r.msg == "request completed" {
+			completed = append(completed, r)
+		}
+	}
+	assert.Len(t, completed, 2)
+	assert.Equal(t, "debug", completed[1].level)
+	assert.Equal(t, http.StatusOK, completed[1].fields["status"])
+	assert.Equal(t, "trace-1", completed[1].fields["trace_id"])
+	assert.Equal(t, server.URL+"/volume/1", completed[1].fields["url"])
+	// retry message of the client is passed to structured logger as formatted text
+	assert.Contains(t, logger.records[1].msg, "retrying request after status 503")
+}
+
+func TestClientIMPL_Query_NoHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{DefaultTimeout: 10})
+	assert.Nil(t, err)
+	assert.False(t, c.Config().RequestHooks)
+	assert.False(t, c.Config().StructuredLogging)
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.Nil(t, err)
+}
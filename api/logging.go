@@ -20,6 +20,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"log"
 )
 
@@ -45,3 +46,35 @@ func (dl *defaultLogger) Debug(ctx context.Context, format string, args ...inter
 func (dl *defaultLogger) Error(ctx context.Context, format string, args ...interface{}) {
 	log.Printf(format, args...)
 }
+
+// LogField key-value pair attached to structured log record
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// StructuredLogger interface for loggers with fields, e.g. adapters of zap or logrus.
+// When it is set every response is logged at debug level with method, url, status,
+// latency and trace id fields, other messages are passed as formatted text without fields
+type StructuredLogger interface {
+	Debug(ctx context.Context, msg string, fields ...LogField)
+	Info(ctx context.Context, msg string, fields ...LogField)
+	Error(ctx context.Context, msg string, fields ...LogField)
+}
+
+// structuredLoggerAdapter passes messages of Logger interface to StructuredLogger
+type structuredLoggerAdapter struct {
+	logger StructuredLogger
+}
+
+func (a *structuredLoggerAdapter) Info(ctx context.Context, format string, args ...interface{}) {
+	a.logger.Info(ctx, fmt.Sprintf(format, args...))
+}
+
+func (a *structuredLoggerAdapter) Debug(ctx context.Context, format string, args ...interface{}) {
+	a.logger.Debug(ctx, fmt.Sprintf(format, args...))
+}
+
+func (a *structuredLoggerAdapter) Error(ctx context.Context, format string, args ...interface{}) {
+	a.logger.Error(ctx, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,115 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket with capacity of one token, so outbound requests are evenly
+// spaced and never exceed configured rate. nil rateLimiter doesn't limit requests
+type rateLimiter struct {
+	mu sync.Mutex
+	// time needed to refill one token
+	interval time.Duration
+	// time when next token becomes available
+	next time.Time
+}
+
+// newRateLimiter returns nil if requestsPerSecond is zero or negative
+func newRateLimiter(requestsPerSecond int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Second / time.Duration(requestsPerSecond)}
+}
+
+// wait blocks until token is available, an error is returned without waiting
+// if the token can't be obtained before ctx deadline
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	if deadline, ok := ctx.Deadline(); ok && at.After(deadline) {
+		l.mu.Unlock()
+		return context.DeadlineExceeded
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// concurrencyLimiter is a semaphore which bounds number of requests in flight.
+// nil concurrencyLimiter doesn't limit requests
+type concurrencyLimiter chan struct{}
+
+// newConcurrencyLimiter returns nil if maxConcurrent is zero or negative
+func newConcurrencyLimiter(maxConcurrent int) concurrencyLimiter {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+	return make(concurrencyLimiter, maxConcurrent)
+}
+
+// acquire blocks until a slot is free or ctx is done, returned func frees the slot
+func (l concurrencyLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	select {
+	case l <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() { once.Do(func() { <-l }) }, nil
+}
+
+// releasingBody frees slot of concurrencyLimiter when response body is closed,
+// so the request stays in flight until its response is read
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,178 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIMPL_Query_RequestLimit(t *testing.T) {
+	var mu sync.Mutex
+	var calls []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, time.Now())
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	requestsPerSecond := 20
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout:    10,
+		RequestsPerSecond: requestsPerSecond})
+	assert.Nil(t, err)
+	assert.Equal(t, requestsPerSecond, c.Config().RequestsPerSecond)
+
+	requests := 2*requestsPerSecond + 1
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, nil)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, calls, requests)
+	first, last := calls[0], calls[0]
+	for _, ts := range calls {
+		if ts.Before(first) {
+			first = ts
+		}
+		if ts.After(last) {
+			last = ts
+		}
+	}
+	observed := float64(requests-1) / last.Sub(first).Seconds()
+	assert.True(t, observed <= float64(requestsPerSecond)*1.05,
+		"observed rate %.2f exceeds limit %d", observed, requestsPerSecond)
+}
+
+func TestClientIMPL_Query_RequestLimitRetry(t *testing.T) {
+	var mu sync.Mutex
+	var calls []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, time.Now())
+		first := len(calls) == 1
+		mu.Unlock()
+		if first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout:    10,
+		RetryCount:        1,
+		RetryTimeout:      time.Minute,
+		RequestsPerSecond: 5})
+	assert.Nil(t, err)
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, nil)
+	assert.Nil(t, err)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, calls, 2)
+	assert.True(t, calls[1].Sub(calls[0]) >= 190*time.Millisecond)
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	var l *rateLimiter
+	assert.Nil(t, newRateLimiter(0))
+	assert.Nil(t, l.wait(context.Background()))
+
+	l = newRateLimiter(1)
+	assert.Nil(t, l.wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.Equal(t, context.DeadlineExceeded, l.wait(ctx))
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+}
+
+func TestClientIMPL_Query_MaxConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout:        10,
+		MaxConcurrentRequests: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c.Config().MaxConcurrentRequests)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, nil)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, maxInFlight)
+}
+
+func TestConcurrencyLimiter_acquire(t *testing.T) {
+	var unlimited concurrencyLimiter
+	release, err := unlimited.acquire(context.Background())
+	assert.Nil(t, err)
+	release()
+
+	l := newConcurrencyLimiter(1)
+	release, err = l.acquire(context.Background())
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// slot is freed only once
+	release()
+	release()
+	release, err = l.acquire(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, l, 1)
+	release()
+}
@@ -0,0 +1,242 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// base delay before the first retry, doubled for every next attempt
+var retryBaseDelay = 500 * time.Millisecond
+
+// upper bound of delay between two attempts
+var retryMaxDelay = 10 * time.Second
+
+// maximum time spent on retries of a single request if the policy sets none
+var retryDefaultTimeout = 30 * time.Second
+
+// RetryPolicy controls retries of failed requests. Zero values of timeout, delays and statuses keep defaults
+type RetryPolicy struct {
+	// maximum number of retries of a single request, zero disables retries
+	MaxRetries int
+	// maximum time spent on retries of a single request, 30 seconds if not set
+	Timeout time.Duration
+	// delay before the first retry, doubled for every next retry
+	BaseDelay time.Duration
+	// upper bound of delay between two attempts
+	MaxDelay time.Duration
+	// use exact backoff delays instead of delays randomly chosen between half and full backoff value
+	DisableJitter bool
+	// statuses of responses which are retried, 429 and 503 if empty.
+	// Requests which got no response are retried if the connection failed, or if the request
+	// timed out and is idempotent. Certificate and TLS errors are never retried
+	RetryOnStatus []int
+}
+
+// isRetryable returns true if request may be safely sent again:
+// the response status is retried by the policy or the request failed with retryable error
+func (p *RetryPolicy) isRetryable(req *http.Request, r *http.Response, err error) bool {
+	if err != nil {
+		return isRetryableError(req, err)
+	}
+	if len(p.RetryOnStatus) == 0 {
+		return isThrottled(r)
+	}
+	for _, status := range p.RetryOnStatus {
+		if r.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableError returns true if the request got no response because the connection to the array
+// couldn't be established or was reset, or because idempotent request timed out. Requests which may
+// have been processed by the array, e.g. POST which timed out, and TLS failures are not retried
+func isRetryableError(req *http.Request, err error) bool {
+	if isTLSError(err) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// isTLSError returns true if the error is caused by certificate verification or TLS protocol failure,
+// which repeats on every attempt
+func isTLSError(err error) bool {
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalidErr x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	return errors.As(err, &unknownAuthorityErr) || errors.As(err, &hostnameErr) ||
+		errors.As(err, &certInvalidErr) || errors.As(err, &recordHeaderErr)
+}
+
+// isIdempotent returns true if repeating the request with the method has the same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// delay returns backoff delay for given attempt
+func (p *RetryPolicy) delay(attempt int) time.Duration {
+	base, max := p.BaseDelay, p.MaxDelay
+	if base <= 0 {
+		base = retryBaseDelay
+	}
+	if max <= 0 {
+		max = retryMaxDelay
+	}
+	return backoffDelay(attempt, base, max, !p.DisableJitter)
+}
+
+// isThrottled returns true if the array explicitly asked to repeat the request later
+func isThrottled(r *http.Response) bool {
+	return r.StatusCode == http.StatusTooManyRequests || r.StatusCode == http.StatusServiceUnavailable
+}
+
+// retryAfter returns delay requested by Retry-After header of throttle response,
+// the header holds either number of seconds or HTTP date
+func retryAfter(r *http.Response) (time.Duration, bool) {
+	if r == nil || !isThrottled(r) {
+		return 0, false
+	}
+	value := r.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// retryDelay returns default exponential backoff delay with jitter for given attempt
+func retryDelay(attempt int) time.Duration {
+	return backoffDelay(attempt, retryBaseDelay, retryMaxDelay, true)
+}
+
+// backoffDelay returns exponential backoff delay for given attempt, with jitter
+// the delay is randomly chosen between half and full backoff value
+func backoffDelay(attempt int, base, max time.Duration, jitter bool) time.Duration {
+	delay := max
+	if attempt < 30 {
+		if d := base << uint(attempt); d > 0 && d < max {
+			delay = d
+		}
+	}
+	if !jitter {
+		return delay
+	}
+	half := int64(delay / 2)
+	if half <= 0 {
+		return delay
+	}
+	// #nosec G404
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// doWithRetry sends request built by newRequest and repeats it while the failure is retryable,
+// retry count and retry timeout are not exceeded and ctx is not done. Delay requested by
+// Retry-After header of throttle response is used instead of the backoff delay.
+// Every attempt waits for the rate limiter and is reported to the request tracer
+func (c *ClientIMPL) doWithRetry(ctx context.Context, traceMsg string,
+	newRequest func() (*http.Request, error)) (*http.Response, error) {
+	retryDeadline := time.Now().Add(c.retryPolicy.Timeout)
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+		release, err := c.concurrency.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		info := c.requestInfo(ctx, req, attempt)
+		c.notifyRequest(ctx, info)
+		c.traceRequest(ctx, req)
+		start := time.Now()
+		r, err := c.httpClient.Do(req)
+		c.notifyResponse(ctx, info, r, err, time.Since(start))
+		c.traceResponse(ctx, r, err)
+		if err != nil {
+			release()
+		} else {
+			r.Body = &releasingBody{ReadCloser: r.Body, release: release}
+		}
+		if attempt >= c.retryPolicy.MaxRetries || ctx.Err() != nil || !c.retryPolicy.isRetryable(req, r, err) {
+			return r, err
+		}
+		delay, ok := retryAfter(r)
+		if !ok {
+			delay = c.retryPolicy.delay(attempt)
+		}
+		if time.Now().Add(delay).After(retryDeadline) {
+			return r, err
+		}
+		if err == nil {
+			c.getLogger().Debug(ctx, "%sretrying request after status %d, attempt %d", traceMsg, r.StatusCode, attempt+1)
+			_, _ = io.Copy(ioutil.Discard, r.Body)
+			r.Body.Close()
+		} else {
+			c.getLogger().Debug(ctx, "%sretrying request after error: %s, attempt %d", traceMsg, err.Error(), attempt+1)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,333 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestClient(t *testing.T, apiURL string, retryCount int) *ClientIMPL {
+	retryBaseDelay = time.Millisecond
+	c, err := NewWithOptions(apiURL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RetryCount:     retryCount,
+		RetryTimeout:   time.Minute})
+	assert.Nil(t, err)
+	return c
+}
+
+func TestClientIMPL_Query_RetryUnavailable(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	c := newRetryTestClient(t, server.URL, 3)
+	body := struct{ Name string }{Name: "Foo"}
+	resp := &testResp{}
+	_, err := c.Query(context.Background(), RequestConfig{Method: "POST", Endpoint: "volume", Body: &body}, resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Foo", resp.Name)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClientIMPL_Query_RetryCountExceeded(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	c := newRetryTestClient(t, server.URL, 2)
+	_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.(*ErrorMsg).StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClientIMPL_Query_NoRetryOnError(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	c := newRetryTestClient(t, server.URL, 3)
+	_, err := c.Query(context.Background(), RequestConfig{Method: "POST", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClientIMPL_Query_RetryNoResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	calls := 0
+	httpmock.RegisterResponder("GET", "https://foo.com/api/rest/volume",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+			}
+			return httpmock.NewStringResponse(200, `{"name": "Foo"}`), nil
+		})
+	c := newRetryTestClient(t, "https://foo.com/api/rest", 3)
+	_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClientIMPL_Query_NoRetryOnTLSError(t *testing.T) {
+	var conns int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+	// certificate of the server isn't trusted
+	c := newRetryTestClient(t, server.URL, 3)
+	_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&conns))
+}
+
+func TestClientIMPL_Query_RetryTimeoutIdempotent(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// response of the first request is late
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	retryBaseDelay = time.Millisecond
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RetryCount:     3,
+		RetryTimeout:   time.Minute,
+		HTTPClient:     &http.Client{Timeout: 50 * time.Millisecond}})
+	assert.Nil(t, err)
+
+	// POST may have been processed by the array, it is sent once
+	_, err = c.Query(context.Background(), RequestConfig{Method: "POST", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	atomic.StoreInt32(&calls, 0)
+	resp := &testResp{}
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Foo", resp.Name)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func Test_isRetryableError(t *testing.T) {
+	get, _ := http.NewRequest(http.MethodGet, "https://foo.com", nil)
+	post, _ := http.NewRequest(http.MethodPost, "https://foo.com", nil)
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	assert.True(t, isRetryableError(post, refused))
+	assert.True(t, isRetryableError(post, fmt.Errorf("request failed: %w", refused)))
+	assert.False(t, isRetryableError(get, x509.UnknownAuthorityError{}))
+	assert.False(t, isRetryableError(get, fmt.Errorf("request failed: %w", x509.HostnameError{})))
+	assert.False(t, isRetryableError(get, tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}))
+	assert.False(t, isRetryableError(get, errors.New("unexpected failure")))
+}
+
+func TestClientIMPL_Query_RetryContextDeadline(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	c := newRetryTestClient(t, server.URL, 100)
+	retryBaseDelay = 50 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Millisecond)
+	defer cancel()
+	_, err := c.Query(ctx, RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.True(t, calls < 5)
+}
+
+func Test_retryDelay(t *testing.T) {
+	retryBaseDelay = 100 * time.Millisecond
+	for attempt := 0; attempt < 40; attempt++ {
+		d := retryDelay(attempt)
+		assert.True(t, d <= retryMaxDelay)
+		assert.True(t, d >= retryMaxDelay/2 || d >= (retryBaseDelay<<uint(attempt))/2)
+	}
+}
+
+func TestClientIMPL_Query_RetryPolicy(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RetryCount:     5,
+		RetryPolicy: &RetryPolicy{
+			MaxRetries:    3,
+			Timeout:       time.Minute,
+			BaseDelay:     time.Millisecond,
+			RetryOnStatus: []int{http.StatusInternalServerError}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, c.Config().RetryCount)
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	// 503 is not retried when the policy lists retried statuses
+	assert.Equal(t, http.StatusServiceUnavailable, err.(*ErrorMsg).StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClientIMPL_Query_RetryPolicyDefaultTimeout(t *testing.T) {
+	defer func(delay time.Duration) { retryBaseDelay = delay }(retryBaseDelay)
+	retryBaseDelay = time.Millisecond
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	// policy without timeout keeps the default one
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RetryPolicy:    &RetryPolicy{MaxRetries: 3}})
+	assert.Nil(t, err)
+	assert.Equal(t, retryDefaultTimeout, c.Config().RetryTimeout)
+	resp := &testResp{}
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Foo", resp.Name)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClientIMPL_Query_RetryAfter(t *testing.T) {
+	calls := 0
+	retryAfterValue := "0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", retryAfterValue)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RetryPolicy: &RetryPolicy{
+			MaxRetries: 3,
+			Timeout:    10 * time.Second,
+			BaseDelay:  time.Minute}})
+	assert.Nil(t, err)
+	start := time.Now()
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+	// delay requested by the array replaces the backoff delay
+	assert.True(t, time.Since(start) < time.Second)
+
+	// requested delay exceeds retry timeout, the throttle response is returned
+	calls = 0
+	retryAfterValue = "60"
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.(*ErrorMsg).StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func Test_retryAfter(t *testing.T) {
+	newResponse := func(status int, value string) *http.Response {
+		r := &http.Response{StatusCode: status, Header: http.Header{}}
+		if value != "" {
+			r.Header.Set("Retry-After", value)
+		}
+		return r
+	}
+	d, ok := retryAfter(newResponse(http.StatusServiceUnavailable, "5"))
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+	d, ok = retryAfter(newResponse(http.StatusTooManyRequests,
+		time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)))
+	assert.True(t, ok)
+	assert.True(t, d > 59*time.Minute)
+	_, ok = retryAfter(newResponse(http.StatusTooManyRequests, ""))
+	assert.False(t, ok)
+	_, ok = retryAfter(newResponse(http.StatusTooManyRequests, "soon"))
+	assert.False(t, ok)
+	_, ok = retryAfter(newResponse(http.StatusInternalServerError, "5"))
+	assert.False(t, ok)
+	_, ok = retryAfter(nil)
+	assert.False(t, ok)
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, DisableJitter: true}
+	assert.Equal(t, 100*time.Millisecond, p.delay(0))
+	assert.Equal(t, 400*time.Millisecond, p.delay(2))
+	assert.Equal(t, time.Second, p.delay(10))
+	assert.Equal(t, time.Second, p.delay(100))
+	p.DisableJitter = false
+	for attempt := 0; attempt < 5; attempt++ {
+		d := p.delay(attempt)
+		assert.True(t, d >= (p.BaseDelay<<uint(attempt))/2 || d >= p.MaxDelay/2)
+		assert.True(t, d <= p.MaxDelay)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	loginSessionURL    = "login_session"
+	logoutURL          = "logout"
+	sessionTokenHeader = "DELL-EMC-TOKEN"
+	sessionCookieName  = "auth_cookie"
+)
+
+// authSession login session of the client, requests of the session are authorized
+// by the session cookie and the CSRF token instead of basic credentials
+type authSession struct {
+	token  string
+	cookie *http.Cookie
+}
+
+// setAuth adds session credentials to the request, basic credentials are used without session
+func (c *ClientIMPL) setAuth(req *http.Request, session *authSession) {
+	if session == nil {
+		req.SetBasicAuth(c.username, c.password)
+		return
+	}
+	req.Header.Set(sessionTokenHeader, session.token)
+	req.AddCookie(session.cookie)
+}
+
+// loginCall login in progress, requests which need a session while it runs wait for its result
+type loginCall struct {
+	done    chan struct{}
+	session *authSession
+	err     error
+}
+
+// currentSession returns session shared by all requests of the client, the session is
+// created on first use. nil is returned if session authentication is disabled.
+// Only one login runs at a time, other requests wait for it until their context is done
+func (c *ClientIMPL) currentSession(ctx context.Context, traceMsg string) (*authSession, error) {
+	if !c.sessionAuth {
+		return nil, nil
+	}
+	for {
+		c.sessionMutex.Lock()
+		if c.session != nil {
+			session := c.session
+			c.sessionMutex.Unlock()
+			return session, nil
+		}
+		if call := c.pendingLogin; call != nil {
+			c.sessionMutex.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if call.err == nil {
+				return call.session, nil
+			}
+			if isContextErr(call.err) && ctx.Err() == nil {
+				// login was interrupted by the context of another request, try again
+				continue
+			}
+			return nil, call.err
+		}
+		call := &loginCall{done: make(chan struct{})}
+		c.pendingLogin = call
+		c.sessionMutex.Unlock()
+
+		call.session, call.err = c.login(ctx, traceMsg)
+		c.sessionMutex.Lock()
+		if call.err == nil {
+			c.session = call.session
+		}
+		c.pendingLogin = nil
+		c.sessionMutex.Unlock()
+		close(call.done)
+		return call.session, call.err
+	}
+}
+
+// isContextErr checks if err is caused by cancelled or expired context
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// invalidateSession forgets the session if it is still the current one,
+// next request creates a new session
+func (c *ClientIMPL) invalidateSession(session *authSession) {
+	c.sessionMutex.Lock()
+	defer c.sessionMutex.Unlock()
+	if c.session == session {
+		c.session = nil
+	}
+}
+
+// login creates new session using basic credentials
+func (c *ClientIMPL) login(ctx context.Context, traceMsg string) (*authSession, error) {
+	requestURL, err := c.prepareRequestURL(loginSessionURL, "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.doWithRetry(ctx, traceMsg, func() (*http.Request, error) {
+		return c.prepareRequest(ctx, http.MethodGet, requestURL, traceMsg, nil, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	if r.StatusCode < 200 || r.StatusCode >= 300 {
+		return nil, buildError(r)
+	}
+	_, _ = io.Copy(ioutil.Discard, r.Body)
+	session := &authSession{token: r.Header.Get(sessionTokenHeader)}
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == sessionCookieName {
+			session.cookie = &http.Cookie{Name: cookie.Name, Value: cookie.Value}
+		}
+	}
+	if session.token == "" || session.cookie == nil {
+		return nil, errors.New("login session response has no session token or cookie")
+	}
+	c.getLogger().Debug(ctx, "%slogin session created", traceMsg)
+	return session, nil
+}
+
+// Logout ends the session of the client on the array. Next request creates a new session,
+// so Logout is usually called once the client is not needed anymore.
+// It does nothing if session authentication is disabled or no request was sent yet
+func (c *ClientIMPL) Logout(ctx context.Context) error {
+	c.sessionMutex.Lock()
+	session := c.session
+	c.session = nil
+	c.sessionMutex.Unlock()
+	if session == nil {
+		return nil
+	}
+	var cancelFuncPtr *func()
+	ctx, cancelFuncPtr = c.setupContext(ctx)
+	if cancelFuncPtr != nil {
+		defer (*cancelFuncPtr)()
+	}
+	traceMsg := c.prepareTraceMsg(ctx)
+	requestURL, err := c.prepareRequestURL(logoutURL, "", "", nil)
+	if err != nil {
+		return err
+	}
+	r, err := c.doWithRetry(ctx, traceMsg, func() (*http.Request, error) {
+		return c.prepareRequest(ctx, http.MethodPost, requestURL, traceMsg, nil, session)
+	})
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+	if r.StatusCode < 200 || r.StatusCode >= 300 {
+		return buildError(r)
+	}
+	return nil
+}
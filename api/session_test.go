@@ -0,0 +1,219 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sessionServer emulates login session handling of the array
+type sessionServer struct {
+	mu       sync.Mutex
+	sessions int
+	active   string
+	logins   int
+	basic    int
+	logouts  int
+}
+
+func (s *sessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, _, ok := r.BasicAuth(); ok {
+		s.basic++
+	}
+	switch r.URL.Path {
+	case "/" + loginSessionURL:
+		if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.logins++
+		s.sessions++
+		s.active = fmt.Sprintf("session-%d", s.sessions)
+		w.Header().Set(sessionTokenHeader, "token-"+s.active)
+		http.SetCookie(w, &http.Cookie{Name: sessionCookieName, Value: s.active, Path: "/"})
+		_, _ = w.Write([]byte(`[{"id": "admin"}]`))
+		return
+	}
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil || cookie.Value != s.active || r.Header.Get(sessionTokenHeader) != "token-"+s.active {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Path == "/"+logoutURL {
+		s.logouts++
+		s.active = ""
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	_, _ = w.Write([]byte(`{"name": "Foo"}`))
+}
+
+func (s *sessionServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.active = ""
+}
+
+func newSessionTestClient(t *testing.T, apiURL, password string) *ClientIMPL {
+	c, err := NewWithOptions(apiURL, "admin", password, Options{
+		DefaultTimeout: 10,
+		SessionAuth:    true})
+	assert.Nil(t, err)
+	return c
+}
+
+func TestClientIMPL_Query_SessionAuth(t *testing.T) {
+	array := &sessionServer{}
+	server := httptest.NewServer(array)
+	defer server.Close()
+	c := newSessionTestClient(t, server.URL, "password")
+	assert.True(t, c.Config().SessionAuth)
+
+	var resp testResp
+	for i := 0; i < 3; i++ {
+		_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+		assert.Nil(t, err)
+		assert.Equal(t, "Foo", resp.Name)
+	}
+	assert.Equal(t, 1, array.logins)
+	// basic credentials are sent only to login
+	assert.Equal(t, 1, array.basic)
+
+	// expired session is replaced transparently
+	array.expire()
+	_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, array.logins)
+
+	assert.Nil(t, c.Logout(context.Background()))
+	assert.Equal(t, 1, array.logouts)
+	// second logout has no session to end
+	assert.Nil(t, c.Logout(context.Background()))
+	assert.Equal(t, 1, array.logouts)
+
+	// client logs in again after logout
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, array.logins)
+}
+
+func TestClientIMPL_Query_SessionAuthParallel(t *testing.T) {
+	array := &sessionServer{}
+	server := httptest.NewServer(array)
+	defer server.Close()
+	c := newSessionTestClient(t, server.URL, "password")
+
+	query := func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var resp testResp
+				_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+				assert.Nil(t, err)
+			}()
+		}
+		wg.Wait()
+	}
+	// requests started together share a single login
+	query()
+	assert.Equal(t, 1, array.logins)
+	// and a single login replaces the expired session
+	array.expire()
+	query()
+	assert.Equal(t, 2, array.logins)
+}
+
+func TestClientIMPL_Query_SessionAuthLoginCancelled(t *testing.T) {
+	release := make(chan struct{})
+	array := &sessionServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+loginSessionURL {
+			<-release
+		}
+		array.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	c := newSessionTestClient(t, server.URL, "password")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := c.Query(ctx, RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+		first <- err
+	}()
+	// wait for the login of the first request
+	for {
+		c.sessionMutex.Lock()
+		pending := c.pendingLogin != nil
+		c.sessionMutex.Unlock()
+		if pending {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	second := make(chan error)
+	go func() {
+		_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+		second <- err
+	}()
+	// request waiting for login of another request which was cancelled logs in itself
+	cancel()
+	assert.NotNil(t, <-first)
+	close(release)
+	assert.Nil(t, <-second)
+}
+
+func TestClientIMPL_Query_SessionAuthLoginFailed(t *testing.T) {
+	array := &sessionServer{}
+	server := httptest.NewServer(array)
+	defer server.Close()
+	c := newSessionTestClient(t, server.URL, "wrong")
+	_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	apiErr, ok := err.(*ErrorMsg)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, 0, array.logins)
+}
+
+func TestClientIMPL_Query_BasicAuth(t *testing.T) {
+	array := &sessionServer{}
+	server := httptest.NewServer(array)
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{DefaultTimeout: 10})
+	assert.Nil(t, err)
+	// session endpoints are not used without session authentication
+	assert.Nil(t, c.Logout(context.Background()))
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, array.logins)
+	assert.Equal(t, 1, array.basic)
+}
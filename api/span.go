@@ -0,0 +1,110 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// SpanAttribute key-value attribute of the span
+type SpanAttribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span is a client span of a single REST call, adapter of OpenTelemetry trace.Span
+type Span interface {
+	SetAttributes(attrs ...SpanAttribute)
+	RecordError(err error)
+	End()
+}
+
+// SpanTracer creates spans of REST calls, usually an adapter of OpenTelemetry tracer and propagator.
+// The client depends only on this interface, so OpenTelemetry is linked only by applications
+// which configure it
+type SpanTracer interface {
+	// StartSpan starts client span, returned context carries the span
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+	// Inject adds propagation headers of the span carried by ctx to the request, e.g. traceparent
+	Inject(ctx context.Context, header http.Header)
+}
+
+// span attribute keys
+const (
+	SpanAttributeResourceType = "powerstore.resource_type"
+	SpanAttributeAction       = "powerstore.action"
+	SpanAttributeArray        = "powerstore.array"
+	SpanAttributeTraceID      = "powerstore.trace_id"
+	SpanAttributeHTTPMethod   = "http.method"
+	SpanAttributeHTTPStatus   = "http.status_code"
+)
+
+// spanName returns name of the span, e.g. "POST volume/clone". Ids are not included to keep
+// span names low-cardinality
+func spanName(config RequestConfig) string {
+	name := config.Endpoint
+	if config.Action != "" {
+		name = path.Join(name, config.Action)
+	}
+	return config.Method + " " + name
+}
+
+// queryWithSpan wraps query of the REST call with span when span tracer is set
+func (c *ClientIMPL) queryWithSpan(ctx context.Context, config RequestConfig,
+	query func(ctx context.Context) (RespMeta, error)) (RespMeta, error) {
+	if c.spanTracer == nil {
+		return query(ctx)
+	}
+	ctx, span := c.spanTracer.StartSpan(ctx, spanName(config))
+	defer span.End()
+	action := config.Action
+	if action == "" {
+		action = config.Method
+	}
+	attrs := []SpanAttribute{
+		{Key: SpanAttributeResourceType, Value: config.Endpoint},
+		{Key: SpanAttributeAction, Value: action},
+		{Key: SpanAttributeHTTPMethod, Value: config.Method},
+	}
+	if u, err := url.Parse(c.apiURL); err == nil {
+		attrs = append(attrs, SpanAttribute{Key: SpanAttributeArray, Value: u.Host})
+	}
+	if traceID := c.TraceID(ctx); traceID != "" {
+		attrs = append(attrs, SpanAttribute{Key: SpanAttributeTraceID, Value: traceID})
+	}
+	span.SetAttributes(attrs...)
+	meta, err := query(ctx)
+	if meta.Status != 0 {
+		span.SetAttributes(SpanAttribute{Key: SpanAttributeHTTPStatus, Value: meta.Status})
+	}
+	if err != nil {
+		span.RecordError(err)
+	}
+	return meta, err
+}
+
+// injectSpan adds propagation headers of the span to the request
+func (c *ClientIMPL) injectSpan(ctx context.Context, req *http.Request) {
+	if c.spanTracer != nil {
+		c.spanTracer.Inject(ctx, req.Header)
+	}
+}
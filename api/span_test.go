@@ -0,0 +1,116 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type spanKey struct{}
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...SpanAttribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+type testSpanTracer struct {
+	spans []*testSpan
+}
+
+func (t *testSpanTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (t *testSpanTracer) Inject(ctx context.Context, header http.Header) {
+	if span, ok := ctx.Value(spanKey{}).(*testSpan); ok {
+		header.Set("traceparent", span.name)
+	}
+}
+
+func TestClientIMPL_Query_SpanTracer(t *testing.T) {
+	var traceparent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = append(traceparent, r.Header.Get("traceparent"))
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"messages": [{"code": "0xE04040010005", "severity": "Error"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	tracer := &testSpanTracer{}
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{
+		DefaultTimeout: 10,
+		RequestIDKey:   "requestid",
+		SpanTracer:     tracer})
+	assert.Nil(t, err)
+	assert.True(t, c.Config().SpanTracing)
+
+	ctx := c.SetTraceID(context.Background(), "trace-1")
+	_, err = c.Query(ctx, RequestConfig{Method: "POST", Endpoint: "volume", ID: "1", Action: "clone"}, &testResp{})
+	assert.Nil(t, err)
+	_, err = c.Query(ctx, RequestConfig{Method: "DELETE", Endpoint: "volume", ID: "1"}, &testResp{})
+	assert.NotNil(t, err)
+
+	u, _ := url.Parse(server.URL)
+	assert.Len(t, tracer.spans, 2)
+	span := tracer.spans[0]
+	assert.Equal(t, "POST volume/clone", span.name)
+	assert.True(t, span.ended)
+	assert.Nil(t, span.err)
+	assert.Equal(t, map[string]interface{}{
+		SpanAttributeResourceType: "volume",
+		SpanAttributeAction:       "clone",
+		SpanAttributeHTTPMethod:   "POST",
+		SpanAttributeHTTPStatus:   http.StatusOK,
+		SpanAttributeArray:        u.Host,
+		SpanAttributeTraceID:      "trace-1"}, span.attrs)
+
+	span = tracer.spans[1]
+	assert.Equal(t, "DELETE volume", span.name)
+	assert.Equal(t, "DELETE", span.attrs[SpanAttributeAction])
+	assert.Equal(t, http.StatusNotFound, span.attrs[SpanAttributeHTTPStatus])
+	assert.NotNil(t, span.err)
+	assert.Equal(t, []string{"POST volume/clone", "DELETE volume"}, traceparent)
+}
@@ -0,0 +1,97 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// traceBodyLimit maximum number of bytes of the response body passed to the tracer
+const traceBodyLimit = 1 << 20
+
+// RequestTracer receives every request sent to the array and every response or transport error,
+// retries included. Callbacks are invoked synchronously, ctx carries the trace id set by SetTraceID.
+// Credentials are never passed to the tracer, secret values in bodies are redacted
+type RequestTracer interface {
+	OnRequest(ctx context.Context, method, url string, body []byte)
+	OnResponse(ctx context.Context, status int, body []byte, err error)
+}
+
+// matches string values of json fields which hold passwords, secrets, tokens and keys, e.g. private_key
+var sensitiveBodyFieldRegexp = regexp.MustCompile(
+	`("[^"]*(?i:passw|secret|token|key)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// redactBody hides values of secret json fields and sensitive header values in body
+func redactBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	content := sensitiveBodyFieldRegexp.ReplaceAll(body, []byte(`$1"`+redactedValue+`"`))
+	return []byte(replaceSensitiveHeaderInfo(content))
+}
+
+func (c *ClientIMPL) traceRequest(ctx context.Context, req *http.Request) {
+	if c.tracer == nil {
+		return
+	}
+	var body []byte
+	if req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			body, _ = ioutil.ReadAll(rc)
+			rc.Close()
+		}
+	}
+	c.tracer.OnRequest(ctx, req.Method, req.URL.String(), redactBody(body))
+}
+
+// traceResponse reports response to the tracer. Only JSON and text bodies are reported and at most
+// traceBodyLimit bytes of them are buffered, the caller still reads the whole body, so downloads stay streamed
+func (c *ClientIMPL) traceResponse(ctx context.Context, r *http.Response, err error) {
+	if c.tracer == nil {
+		return
+	}
+	if err != nil {
+		c.tracer.OnResponse(ctx, 0, nil, err)
+		return
+	}
+	if !isTextContent(r.Header.Get("Content-Type")) {
+		c.tracer.OnResponse(ctx, r.StatusCode, nil, nil)
+		return
+	}
+	body, readErr := ioutil.ReadAll(io.LimitReader(r.Body, traceBodyLimit))
+	r.Body = &tracedBody{Reader: io.MultiReader(bytes.NewReader(body), r.Body), Closer: r.Body}
+	c.tracer.OnResponse(ctx, r.StatusCode, redactBody(body), readErr)
+}
+
+// isTextContent checks if body of the content type is JSON or text, unknown type is treated as text
+func isTextContent(contentType string) bool {
+	return contentType == "" || strings.Contains(contentType, "json") || strings.HasPrefix(contentType, "text/")
+}
+
+// tracedBody response body which part was already read for the tracer
+type tracedBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,70 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"net/http"
+	"sync"
+)
+
+// defaultMaxIdleConnsPerHost number of idle connections to the array kept open for reuse.
+// http.DefaultTransport keeps only 2, so parallel requests over it mostly open
+// new connections and pay for a new TLS handshake
+const defaultMaxIdleConnsPerHost = 100
+
+// tuneTransport sizes connection pool of the transport for many parallel requests to a single host
+func tuneTransport(transport *http.Transport, maxIdleConnsPerHost int) {
+	if maxIdleConnsPerHost <= 0 {
+		maxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	if transport.MaxIdleConns != 0 && transport.MaxIdleConns < maxIdleConnsPerHost {
+		transport.MaxIdleConns = maxIdleConnsPerHost
+	}
+}
+
+// pooledTransport sends requests through a tuned clone of http.DefaultTransport, the clone is
+// created on first request and shared by all requests of the client. If http.DefaultTransport
+// was replaced with another http.RoundTripper, e.g. an instrumented one, requests are sent
+// through the replacement as before
+type pooledTransport struct {
+	maxIdleConnsPerHost int
+	once                sync.Once
+	transport           *http.Transport
+}
+
+func (t *pooledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	t.once.Do(func() {
+		t.transport = base.Clone()
+		tuneTransport(t.transport, t.maxIdleConnsPerHost)
+	})
+	return t.transport.RoundTrip(req)
+}
+
+// CloseIdleConnections closes connections of the pool which are not in use
+func (t *pooledTransport) CloseIdleConnections() {
+	t.once.Do(func() {})
+	if t.transport != nil {
+		t.transport.CloseIdleConnections()
+	}
+}
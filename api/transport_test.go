@@ -0,0 +1,197 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countConnections starts TLS server which counts connections opened by clients
+func countConnections() (*httptest.Server, *int64) {
+	var conns int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&conns, 1)
+		}
+	}
+	server.StartTLS()
+	return server, &conns
+}
+
+func TestBuildHTTPClient_ConnectionPool(t *testing.T) {
+	client, err := buildHTTPClient(Options{})
+	assert.Nil(t, err)
+	_, ok := client.Transport.(*pooledTransport)
+	assert.True(t, ok)
+
+	client, err = buildHTTPClient(Options{Insecure: true, MaxIdleConnsPerHost: 300})
+	assert.Nil(t, err)
+	transport := client.Transport.(*http.Transport)
+	assert.Equal(t, 300, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 300, transport.MaxIdleConns)
+
+	client, err = buildHTTPClient(Options{Insecure: true})
+	assert.Nil(t, err)
+	assert.Equal(t, defaultMaxIdleConnsPerHost, client.Transport.(*http.Transport).MaxIdleConnsPerHost)
+
+	_, err = buildHTTPClient(Options{MaxIdleConnsPerHost: -1})
+	assert.NotNil(t, err)
+	_, err = buildHTTPClient(Options{MaxIdleConnsPerHost: 10, HTTPClient: &http.Client{}})
+	assert.NotNil(t, err)
+
+	c, err := NewWithOptions("https://foo", "admin", "password", Options{})
+	assert.Nil(t, err)
+	assert.Equal(t, defaultMaxIdleConnsPerHost, c.Config().MaxIdleConnsPerHost)
+	c, err = NewWithOptions("https://foo", "admin", "password", Options{Transport: &http.Transport{}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, c.Config().MaxIdleConnsPerHost)
+}
+
+func TestPooledTransport_DefaultTransportReplaced(t *testing.T) {
+	var called int64
+	original := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt64(&called, 1)
+		return original.RoundTrip(req)
+	})
+	defer func() { http.DefaultTransport = original }()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{DefaultTimeout: 10})
+	assert.Nil(t, err)
+	var resp testResp
+	_, err = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+	assert.Nil(t, err)
+	assert.Equal(t, "Foo", resp.Name)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&called))
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientIMPL_Query_ParallelConnectionReuse(t *testing.T) {
+	server, conns := countConnections()
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{Insecure: true, DefaultTimeout: 10})
+	assert.Nil(t, err)
+
+	const workers = 20
+	var wg sync.WaitGroup
+	var failed int64
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				var resp testResp
+				_, err := c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &resp)
+				if err != nil {
+					atomic.AddInt64(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(0), failed)
+	// idle connections are reused instead of new handshakes, a few extra connections may be opened
+	// by dials which lost the race to a released connection. With pool of http.DefaultTransport
+	// this is close to the number of requests
+	assert.True(t, atomic.LoadInt64(conns) <= 2*workers)
+}
+
+func TestClientIMPL_SetCustomHTTPHeaders_Parallel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "Foo"}`))
+	}))
+	defer server.Close()
+	c, err := NewWithOptions(server.URL, "admin", "password", Options{DefaultTimeout: 10})
+	assert.Nil(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _ = c.Query(context.Background(), RequestConfig{Method: "GET", Endpoint: "volume"}, &testResp{})
+		}()
+		go func() {
+			defer wg.Done()
+			c.SetCustomHTTPHeaders(http.Header{"X-Client": []string{"csi"}})
+			c.SetLogger(&defaultLogger{})
+		}()
+	}
+	wg.Wait()
+	// headers are copied, changes of the caller are not seen by the client
+	headers := http.Header{}
+	headers.Set("X-Client", "foo")
+	c.SetCustomHTTPHeaders(headers)
+	headers.Set("X-Client", "bar")
+	assert.Equal(t, "foo", c.Config().CustomHTTPHeaders.Get("X-Client"))
+}
+
+func BenchmarkClientIMPL_Query_Parallel(b *testing.B) {
+	server, conns := countConnections()
+	defer server.Close()
+	for _, bc := range []struct {
+		name    string
+		maxIdle int
+	}{
+		{"DefaultPool", 0},
+		// pool size of http.DefaultTransport
+		{"TwoIdleConns", 2},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			c, err := NewWithOptions(server.URL, "admin", "password",
+				Options{Insecure: true, DefaultTimeout: 10, MaxIdleConnsPerHost: bc.maxIdle})
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer c.httpClient.CloseIdleConnections()
+			atomic.StoreInt64(conns, 0)
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					var resp testResp
+					if _, err := c.Query(context.Background(),
+						RequestConfig{Method: "GET", Endpoint: "volume", ID: "1"}, &resp); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+			b.ReportMetric(float64(atomic.LoadInt64(conns)), "conns")
+		})
+	}
+}
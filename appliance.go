@@ -21,9 +21,80 @@ package gopowerstore
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	applianceURL            = "appliance"
+	applianceListCmaViewURL = "appliance_list_cma_view"
 )
 
-const applianceListCmaViewURL = "appliance_list_cma_view"
+func getApplianceDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	appliance := ApplianceInstance{}
+	return c.APIClient().QueryParamsWithFields(&appliance)
+}
+
+// GetAppliances returns a list of appliances of the cluster
+func (c *ClientIMPL) GetAppliances(ctx context.Context) ([]ApplianceInstance, error) {
+	key := cacheKey(applianceURL, "list")
+	if cached, ok := c.cache.get(key); ok {
+		return append([]ApplianceInstance{}, cached.([]ApplianceInstance)...), nil
+	}
+	generation := c.cache.generation()
+	result := []ApplianceInstance{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []ApplianceInstance
+		qp := getApplianceDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    applianceURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	if err == nil {
+		c.cache.set(key, append([]ApplianceInstance{}, result...), generation)
+	}
+	return result, err
+}
+
+// GetApplianceByName query and return specific appliance by name
+func (c *ClientIMPL) GetApplianceByName(ctx context.Context, name string) (resp ApplianceInstance, err error) {
+	key := cacheKey(applianceURL, "name", name)
+	if cached, ok := c.cache.get(key); ok {
+		return cached.(ApplianceInstance), nil
+	}
+	generation := c.cache.generation()
+	var applianceList []ApplianceInstance
+	qp := getApplianceDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    applianceURL,
+			QueryParams: qp},
+		&applianceList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(applianceList) != 1 {
+		return resp, notExistError()
+	}
+	c.cache.set(key, applianceList[0], generation)
+	return applianceList[0], nil
+}
 
 // GetApplianceListCMA return a list of Appliance
 func (c *ClientIMPL) GetApplianceListCMA(ctx context.Context) (resp []Appliance, err error) {
@@ -47,6 +118,49 @@ func (c *ClientIMPL) GetApplianceListCMA(ctx context.Context) (resp []Appliance,
 	return
 }
 
+// GetCapacityByAppliance returns usable, used and free physical space of every appliance of the cluster
+func (c *ClientIMPL) GetCapacityByAppliance(ctx context.Context) ([]ApplianceCapacity, error) {
+	appliances, err := c.GetApplianceListCMA(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ApplianceCapacity, 0, len(appliances))
+	for _, a := range appliances {
+		capacity := ApplianceCapacity{
+			ApplianceID:   a.ID,
+			Name:          a.Name,
+			PhysicalTotal: a.LastPhysicalTotalSpace,
+			PhysicalUsed:  a.LastPhysicalUsedSpace}
+		if free := a.LastPhysicalTotalSpace - a.LastPhysicalUsedSpace; free > 0 {
+			capacity.PhysicalFree = free
+		}
+		result = append(result, capacity)
+	}
+	return result, nil
+}
+
+// GetApplianceMostFreeSpace returns capacity of the appliance with the most free physical space,
+// e.g. to place a new volume on it with VolumeCreate.ApplianceID. Ties are resolved by appliance id,
+// so the same appliance is returned for the same capacity of the cluster
+func (c *ClientIMPL) GetApplianceMostFreeSpace(ctx context.Context) (ApplianceCapacity, error) {
+	capacities, err := c.GetCapacityByAppliance(ctx)
+	if err != nil {
+		return ApplianceCapacity{}, err
+	}
+	var best *ApplianceCapacity
+	for i := range capacities {
+		capacity := &capacities[i]
+		if best == nil || capacity.PhysicalFree > best.PhysicalFree ||
+			(capacity.PhysicalFree == best.PhysicalFree && capacity.ApplianceID < best.ApplianceID) {
+			best = capacity
+		}
+	}
+	if best == nil {
+		return ApplianceCapacity{}, errors.New("can't get appliance list")
+	}
+	return *best, nil
+}
+
 // GetCapacity return capacity of first appliance
 func (c *ClientIMPL) GetCapacity(ctx context.Context) (int64, error) {
 	var resp []Appliance
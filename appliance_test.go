@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
 )
 
@@ -98,10 +99,90 @@ func TestClientIMPL_GetCapacity_NotFoundAppliance(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 	respData := fmt.Sprintf(`[]`)
-	httpmock.RegisterResponder("GET", APIMockURL+`/appliance_list_cma_view`,
+	httpmock.RegisterResponder("GET", APIMockURL+`appliance_list_cma_view`,
 		httpmock.NewStringResponder(200, respData))
 
 	resp, err := C.GetCapacity(context.Background())
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(0), resp)
 }
+
+func TestClientIMPL_GetCapacityByAppliance(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := `[{"id": "A1", "name": "Appliance-1", "last_physical_total_space": 1000, "last_physical_used_space": 400},
+{"id": "A2", "name": "Appliance-2", "last_physical_total_space": 1000, "last_physical_used_space": 1200}]`
+	httpmock.RegisterResponder("GET", applianceMockURL,
+		httpmock.NewStringResponder(200, respData))
+
+	resp, err := C.GetCapacityByAppliance(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []ApplianceCapacity{
+		{ApplianceID: "A1", Name: "Appliance-1", PhysicalTotal: 1000, PhysicalUsed: 400, PhysicalFree: 600},
+		{ApplianceID: "A2", Name: "Appliance-2", PhysicalTotal: 1000, PhysicalUsed: 1200, PhysicalFree: 0}}, resp)
+}
+
+func TestClientIMPL_GetApplianceMostFreeSpace(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := `[{"id": "A3", "name": "Appliance-3", "last_physical_total_space": 1000, "last_physical_used_space": 100},
+{"id": "A1", "name": "Appliance-1", "last_physical_total_space": 1000, "last_physical_used_space": 400},
+{"id": "A2", "name": "Appliance-2", "last_physical_total_space": 2000, "last_physical_used_space": 1100}]`
+	httpmock.RegisterResponder("GET", applianceMockURL,
+		httpmock.NewStringResponder(200, respData))
+
+	// A2 and A3 have the same free space, appliance with lower id is picked
+	resp, err := C.GetApplianceMostFreeSpace(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, ApplianceCapacity{ApplianceID: "A2", Name: "Appliance-2", PhysicalTotal: 2000,
+		PhysicalUsed: 1100, PhysicalFree: 900}, resp)
+
+	httpmock.RegisterResponder("GET", applianceMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetApplianceMostFreeSpace(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetAppliances(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", APIMockURL+applianceURL,
+		httpmock.NewStringResponder(200, `[{"id": "A1", "name": "appliance-1", "model": "PowerStore 1000T"},
+{"id": "A2", "name": "appliance-2"}]`))
+	appliances, err := C.GetAppliances(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, appliances, 2)
+	assert.Equal(t, "PowerStore 1000T", appliances[0].Model)
+}
+
+func TestClientIMPL_GetAppliances_Pagination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", APIMockURL+applianceURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("offset") == "0" {
+				return pageResponse(`[{"id": "A1"}]`, "0-0/2"), nil
+			}
+			return pageResponse(`[{"id": "A2"}]`, "1-1/2"), nil
+		})
+	appliances, err := C.GetAppliances(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, appliances, 2)
+	assert.Equal(t, "A2", appliances[1].ID)
+}
+
+func TestClientIMPL_GetApplianceByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", APIMockURL+applianceURL,
+		httpmock.NewStringResponder(200, `[{"id": "A1", "name": "appliance-1"}]`))
+	appliance, err := C.GetApplianceByName(context.Background(), "appliance-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "A1", appliance.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", APIMockURL+applianceURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetApplianceByName(context.Background(), "appliance-1")
+	assert.NotNil(t, err)
+}
@@ -61,3 +61,36 @@ func (h *Appliance) Fields() []string {
 	return []string{"id", "name", "ip_address", "appliance_type",
 		"mode", "last_physical_total_space", "last_physical_used_space"}
 }
+
+// ApplianceCapacity physical space of the appliance in bytes
+type ApplianceCapacity struct {
+	// Unique identifier of the appliance.
+	ApplianceID string
+	// Name of the appliance.
+	Name string
+	// Usable physical space of the appliance.
+	PhysicalTotal int64
+	// Used physical space of the appliance.
+	PhysicalUsed int64
+	// Free physical space of the appliance, zero if used space exceeds usable one.
+	PhysicalFree int64
+}
+
+// ApplianceInstance details about appliance of the cluster
+type ApplianceInstance struct {
+	// Unique identifier of the appliance.
+	ID string `json:"id,omitempty"`
+	// Name of the appliance.
+	Name string `json:"name,omitempty"`
+	// Service tag of the appliance.
+	ServiceTag string `json:"service_tag,omitempty"`
+	// Express service code of the appliance.
+	ExpressServiceCode string `json:"express_service_code,omitempty"`
+	// Model of the appliance.
+	Model string `json:"model,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (a *ApplianceInstance) Fields() []string {
+	return []string{"id", "name", "service_tag", "express_service_code", "model"}
+}
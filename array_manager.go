@@ -0,0 +1,224 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownArray array is not registered in ArrayManager
+var ErrUnknownArray = errors.New("array is not registered")
+
+// ArrayManager holds clients of multiple arrays keyed by global id, arrays may also be
+// looked up by management address. It is safe for concurrent use
+type ArrayManager struct {
+	mu sync.RWMutex
+	// clients by array global id
+	arrays map[string]Client
+	// global ids by management address
+	addresses map[string]string
+	// global id of the array used for legacy volume handles
+	defaultID string
+}
+
+// NewArrayManager returns empty ArrayManager
+func NewArrayManager() *ArrayManager {
+	return &ArrayManager{
+		arrays:    make(map[string]Client),
+		addresses: make(map[string]string)}
+}
+
+// AddArray registers client of the array with the global id and optional management address.
+// The first registered array becomes the default one
+func (m *ArrayManager) AddArray(globalID, managementAddress string, client Client) error {
+	if globalID == "" || client == nil {
+		return errors.New("array global id and client are required")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.arrays[globalID]; ok {
+		return fmt.Errorf("array %s is already registered", globalID)
+	}
+	if id, ok := m.addresses[managementAddress]; ok && managementAddress != "" {
+		return fmt.Errorf("management address %s is already used by array %s", managementAddress, id)
+	}
+	m.arrays[globalID] = client
+	if managementAddress != "" {
+		m.addresses[managementAddress] = globalID
+	}
+	if m.defaultID == "" {
+		m.defaultID = globalID
+	}
+	return nil
+}
+
+// DiscoverArray reads global id and management address of the array from the cluster
+// info and registers the client with them, global id of the array is returned
+func (m *ArrayManager) DiscoverArray(ctx context.Context, client Client) (string, error) {
+	cluster, err := client.GetCluster(ctx)
+	if err != nil {
+		return "", err
+	}
+	return cluster.GlobalID, m.AddArray(cluster.GlobalID, cluster.ManagementAddress, client)
+}
+
+// RemoveArray unregisters the array, default array is unset if it is removed
+func (m *ArrayManager) RemoveArray(globalID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.arrays, globalID)
+	for address, id := range m.addresses {
+		if id == globalID {
+			delete(m.addresses, address)
+		}
+	}
+	if m.defaultID == globalID {
+		m.defaultID = ""
+	}
+}
+
+// SetDefaultArray sets array used for volume handles without array id,
+// the array may be identified by global id or management address
+func (m *ArrayManager) SetDefaultArray(idOrAddress string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id, ok := m.resolve(idOrAddress)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownArray, idOrAddress)
+	}
+	m.defaultID = id
+	return nil
+}
+
+// Array returns global id and client of the array identified by global id or management address
+func (m *ArrayManager) Array(idOrAddress string) (string, Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	id, ok := m.resolve(idOrAddress)
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnknownArray, idOrAddress)
+	}
+	return id, m.arrays[id], nil
+}
+
+// DefaultArray returns global id and client of the default array
+func (m *ArrayManager) DefaultArray() (string, Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.defaultID == "" {
+		return "", nil, fmt.Errorf("%w: default array is not set", ErrUnknownArray)
+	}
+	return m.defaultID, m.arrays[m.defaultID], nil
+}
+
+// ArrayIDs returns sorted global ids of registered arrays
+func (m *ArrayManager) ArrayIDs() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ids := make([]string, 0, len(m.arrays))
+	for id := range m.arrays {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ArrayForVolumeHandle returns parsed handle and client of the array which owns the volume,
+// legacy handles without array id are routed to the default array
+func (m *ArrayManager) ArrayForVolumeHandle(handle string) (VolumeHandle, Client, error) {
+	h, err := ParseVolumeHandle(handle)
+	if err != nil {
+		return h, nil, err
+	}
+	var client Client
+	if h.ArrayID == "" {
+		h.ArrayID, client, err = m.DefaultArray()
+	} else {
+		_, client, err = m.Array(h.ArrayID)
+	}
+	return h, client, err
+}
+
+// ForEachArray calls fn for every registered array in parallel and returns errors by array global id,
+// arrays for which fn succeeded are not included
+func (m *ArrayManager) ForEachArray(ctx context.Context,
+	fn func(ctx context.Context, arrayID string, client Client) error) map[string]error {
+	ids := m.ArrayIDs()
+	clients := make([]Client, len(ids))
+	m.mu.RLock()
+	for i, id := range ids {
+		clients[i] = m.arrays[id]
+	}
+	m.mu.RUnlock()
+	errs := runBatch(ctx, len(ids), len(ids), func(i int) error {
+		return fn(ctx, ids[i], clients[i])
+	})
+	result := make(map[string]error)
+	for i, err := range errs {
+		if err != nil {
+			result[ids[i]] = err
+		}
+	}
+	return result
+}
+
+// FindVolumeByName looks for the volume on all arrays. Error which matches ErrNotFound is returned
+// if no array has the volume, other errors are returned only if the volume isn't found on any array.
+// Names are unique within an array only, so an error is returned if several arrays have the volume
+func (m *ArrayManager) FindVolumeByName(ctx context.Context, name string) (ArrayVolume, error) {
+	var mu sync.Mutex
+	var found []ArrayVolume
+	errs := m.ForEachArray(ctx, func(ctx context.Context, arrayID string, client Client) error {
+		vol, err := client.GetVolumeByName(ctx, name)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		found = append(found, ArrayVolume{ArrayID: arrayID, Volume: vol})
+		mu.Unlock()
+		return nil
+	})
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+	default:
+		sort.Slice(found, func(i, j int) bool { return found[i].ArrayID < found[j].ArrayID })
+		return ArrayVolume{}, fmt.Errorf("volume %s exists on arrays %s and %s", name, found[0].ArrayID, found[1].ArrayID)
+	}
+	for _, id := range m.ArrayIDs() {
+		if err, ok := errs[id]; ok && !errors.Is(err, ErrNotFound) {
+			return ArrayVolume{}, err
+		}
+	}
+	return ArrayVolume{}, NewVolumeIsNotExistError()
+}
+
+// resolve returns global id of the array identified by global id or management address, m.mu must be held
+func (m *ArrayManager) resolve(idOrAddress string) (string, bool) {
+	if _, ok := m.arrays[idOrAddress]; ok {
+		return idOrAddress, true
+	}
+	id, ok := m.addresses[idOrAddress]
+	return id, ok
+}
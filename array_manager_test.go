@@ -0,0 +1,148 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	arrayID         = "PS4ebb8d4e8488"
+	arrayID2        = "PS99a6b7c4ad10"
+	array2MockURL   = "https://mock-server-2/api/rest/"
+	arrayManagement = "10.0.0.1"
+)
+
+func newTestArrayManager(t *testing.T) *ArrayManager {
+	c2, err := NewClientWithArgs(array2MockURL, "admin", "Password", NewClientOptions())
+	assert.Nil(t, err)
+	m := NewArrayManager()
+	assert.Nil(t, m.AddArray(arrayID, arrayManagement, C))
+	assert.Nil(t, m.AddArray(arrayID2, "", c2))
+	return m
+}
+
+func TestParseVolumeHandle(t *testing.T) {
+	h, err := ParseVolumeHandle(fmt.Sprintf("%s/%s/scsi", volID, arrayID))
+	assert.Nil(t, err)
+	assert.Equal(t, VolumeHandle{ID: volID, ArrayID: arrayID, Protocol: "scsi"}, h)
+	assert.Equal(t, fmt.Sprintf("%s/%s/scsi", volID, arrayID), h.String())
+
+	h, err = ParseVolumeHandle(volID)
+	assert.Nil(t, err)
+	assert.Equal(t, VolumeHandle{ID: volID}, h)
+	assert.Equal(t, volID, h.String())
+
+	for _, handle := range []string{"", "/" + arrayID, volID + "//scsi", volID + "/a/b/c"} {
+		_, err = ParseVolumeHandle(handle)
+		assert.NotNil(t, err, handle)
+	}
+}
+
+func TestArrayManager_Array(t *testing.T) {
+	m := newTestArrayManager(t)
+	assert.Equal(t, []string{arrayID, arrayID2}, m.ArrayIDs())
+	assert.NotNil(t, m.AddArray(arrayID, "", C))
+	assert.NotNil(t, m.AddArray("PS0000", arrayManagement, C))
+
+	id, c, err := m.Array(arrayManagement)
+	assert.Nil(t, err)
+	assert.Equal(t, arrayID, id)
+	assert.Equal(t, C, c)
+
+	_, _, err = m.Array("PS0000")
+	assert.True(t, errors.Is(err, ErrUnknownArray))
+
+	// the first registered array is the default
+	id, _, err = m.DefaultArray()
+	assert.Nil(t, err)
+	assert.Equal(t, arrayID, id)
+	assert.Nil(t, m.SetDefaultArray(arrayID2))
+	h, _, err := m.ArrayForVolumeHandle(volID)
+	assert.Nil(t, err)
+	assert.Equal(t, arrayID2, h.ArrayID)
+
+	h, c, err = m.ArrayForVolumeHandle(fmt.Sprintf("%s/%s/nfs", volID, arrayID))
+	assert.Nil(t, err)
+	assert.Equal(t, C, c)
+	assert.Equal(t, volID, h.ID)
+
+	m.RemoveArray(arrayID2)
+	_, _, err = m.ArrayForVolumeHandle(volID)
+	assert.True(t, errors.Is(err, ErrUnknownArray))
+	_, _, err = m.Array(arrayManagement)
+	assert.Nil(t, err)
+	m.RemoveArray(arrayID)
+	_, _, err = m.Array(arrayManagement)
+	assert.NotNil(t, err)
+}
+
+func TestArrayManager_DiscoverArray(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", clusterMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "0", "global_id": "%s", "management_address": "%s"}]`,
+			arrayID, arrayManagement)))
+	m := NewArrayManager()
+	id, err := m.DiscoverArray(context.Background(), C)
+	assert.Nil(t, err)
+	assert.Equal(t, arrayID, id)
+	_, c, err := m.Array(arrayManagement)
+	assert.Nil(t, err)
+	assert.Equal(t, C, c)
+}
+
+func TestArrayManager_FindVolumeByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	m := newTestArrayManager(t)
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", array2MockURL+volumeURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "vol"}]`, volID)))
+	found, err := m.FindVolumeByName(context.Background(), "vol")
+	assert.Nil(t, err)
+	assert.Equal(t, arrayID2, found.ArrayID)
+	assert.Equal(t, volID, found.Volume.ID)
+
+	// volume exists on both arrays
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "vol"}]`, volID2)))
+	_, err = m.FindVolumeByName(context.Background(), "vol")
+	assert.NotNil(t, err)
+
+	// error of an array is returned if the volume isn't found elsewhere
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(500, `{"messages": [{"code": "0xE0000000", "severity": "Error"}]}`))
+	httpmock.RegisterResponder("GET", array2MockURL+volumeURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = m.FindVolumeByName(context.Background(), "vol")
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrNotFound))
+
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = m.FindVolumeByName(context.Background(), "vol")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,74 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"fmt"
+	"strings"
+)
+
+// volumeHandleSeparator separates parts of the volume handle
+const volumeHandleSeparator = "/"
+
+// VolumeHandle identifies volume in multi-array deployment, it has form of
+// <volume id>/<array global id>/<protocol>, e.g. as used by CSI PowerStore driver.
+// Legacy handles hold volume id only
+type VolumeHandle struct {
+	// Unique identifier of the volume.
+	ID string
+	// Global unique identifier of the array, empty for legacy handles.
+	ArrayID string
+	// Protocol the volume is attached with, e.g. scsi or nfs, may be empty.
+	Protocol string
+}
+
+// ParseVolumeHandle splits handle into volume id, array global id and protocol
+func ParseVolumeHandle(handle string) (VolumeHandle, error) {
+	parts := strings.Split(handle, volumeHandleSeparator)
+	if len(parts) > 3 || parts[0] == "" || (len(parts) > 1 && parts[1] == "") {
+		return VolumeHandle{}, fmt.Errorf("invalid volume handle: %q", handle)
+	}
+	result := VolumeHandle{ID: parts[0]}
+	if len(parts) > 1 {
+		result.ArrayID = parts[1]
+	}
+	if len(parts) > 2 {
+		result.Protocol = parts[2]
+	}
+	return result, nil
+}
+
+// String returns handle in <volume id>/<array global id>/<protocol> form, empty parts are omitted
+func (h VolumeHandle) String() string {
+	parts := []string{h.ID}
+	if h.ArrayID != "" {
+		parts = append(parts, h.ArrayID)
+		if h.Protocol != "" {
+			parts = append(parts, h.Protocol)
+		}
+	}
+	return strings.Join(parts, volumeHandleSeparator)
+}
+
+// ArrayVolume volume found by ArrayManager together with the array it belongs to
+type ArrayVolume struct {
+	// Global unique identifier of the array.
+	ArrayID string
+	Volume  Volume
+}
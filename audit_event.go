@@ -0,0 +1,82 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const auditEventURL = "audit_event"
+
+func getAuditEventDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	event := AuditEvent{}
+	return c.APIClient().QueryParamsWithFields(&event)
+}
+
+// mappingRequestBody holds volume id of attach/detach request
+type mappingRequestBody struct {
+	VolumeID string `json:"volume_id"`
+}
+
+// GetVolumeMappingHistory returns successful attach and detach events of the volume sorted by time.
+// History is reconstructed from the audit log, so events older than the array audit log
+// retention are not returned
+func (c *ClientIMPL) GetVolumeMappingHistory(ctx context.Context, volID string) (resp []VolumeMappingEvent, err error) {
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []AuditEvent
+		qp := getAuditEventDefaultQueryParams(c)
+		qp.RawArg("resource_type", fmt.Sprintf("in.(%s,%s)", ResourceTypeEnumHost, ResourceTypeEnumHostGroup))
+		qp.RawArg("resource_action", fmt.Sprintf("in.(%s,%s)",
+			VolumeMappingActionEnumAttach, VolumeMappingActionEnumDetach))
+		qp.RawArg("success", "is.true")
+		qp.Order("timestamp")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    auditEventURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			for _, e := range page {
+				var body mappingRequestBody
+				if json.Unmarshal(e.RequestBody, &body) != nil || body.VolumeID != volID {
+					continue
+				}
+				resp = append(resp, VolumeMappingEvent{
+					Timestamp:  e.Timestamp,
+					Action:     VolumeMappingActionEnum(e.ResourceAction),
+					TargetType: e.ResourceType,
+					TargetID:   e.ResourceID,
+					TargetName: e.ResourceName,
+					Username:   e.Username,
+				})
+			}
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
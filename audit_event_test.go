@@ -0,0 +1,52 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const auditEventMockURL = APIMockURL + auditEventURL
+
+func TestClientIMPL_GetVolumeMappingHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[
+{"timestamp": "2020-05-06T10:00:00Z", "resource_type": "host", "resource_id": "%s", "resource_name": "node1",
+ "resource_action": "attach", "username": "admin", "request_body": {"volume_id": "%s"}},
+{"timestamp": "2020-05-06T11:00:00Z", "resource_type": "host", "resource_id": "%s", "resource_name": "node1",
+ "resource_action": "attach", "username": "admin", "request_body": {"volume_id": "%s"}},
+{"timestamp": "2020-05-06T12:00:00Z", "resource_type": "host", "resource_id": "%s", "resource_name": "node1",
+ "resource_action": "detach", "username": "csi", "request_body": {"volume_id": "%s"}}]`,
+		hostID, volID, hostID, volID2, hostID, volID)
+	httpmock.RegisterResponder("GET", auditEventMockURL,
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetVolumeMappingHistory(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, VolumeMappingActionEnumAttach, resp[0].Action)
+	assert.Equal(t, ResourceTypeEnumHost, resp[0].TargetType)
+	assert.Equal(t, hostID, resp[0].TargetID)
+	assert.Equal(t, VolumeMappingActionEnumDetach, resp[1].Action)
+	assert.Equal(t, "csi", resp[1].Username)
+}
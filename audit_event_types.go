@@ -0,0 +1,89 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import "encoding/json"
+
+// AuditEventTypeEnum type of audit event
+type AuditEventTypeEnum string
+
+const (
+	// AuditEventTypeEnumAuthentication captures enum value "Authentication"
+	AuditEventTypeEnumAuthentication AuditEventTypeEnum = "Authentication"
+	// AuditEventTypeEnumConfig captures enum value "Config"
+	AuditEventTypeEnumConfig AuditEventTypeEnum = "Config"
+	// AuditEventTypeEnumSystem captures enum value "System"
+	AuditEventTypeEnumSystem AuditEventTypeEnum = "System"
+)
+
+// AuditEvent audit log record of configuration change
+type AuditEvent struct {
+	// Unique identifier of the audit event.
+	ID string `json:"id,omitempty"`
+	// Type of the audit event.
+	Type AuditEventTypeEnum `json:"type,omitempty"`
+	// Time when the event occurred.
+	Timestamp string `json:"timestamp,omitempty"`
+	// Type of the resource the event refers to.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Unique identifier of the resource the event refers to.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name of the resource the event refers to.
+	ResourceName string `json:"resource_name,omitempty"`
+	// Action performed on the resource, for example create, modify or attach.
+	ResourceAction string `json:"resource_action,omitempty"`
+	// Name of the user who performed the action.
+	Username string `json:"username,omitempty"`
+	// Indicates whether the action succeeded.
+	Success bool `json:"success,omitempty"`
+	// Body of the request which performed the action, sensitive values are masked.
+	RequestBody json.RawMessage `json:"request_body,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (e *AuditEvent) Fields() []string {
+	return []string{"id", "type", "timestamp", "resource_type", "resource_id",
+		"resource_name", "resource_action", "username", "success", "request_body"}
+}
+
+// VolumeMappingActionEnum mapping change action
+type VolumeMappingActionEnum string
+
+const (
+	// VolumeMappingActionEnumAttach - volume was mapped to host or host group
+	VolumeMappingActionEnumAttach VolumeMappingActionEnum = "attach"
+	// VolumeMappingActionEnumDetach - volume was unmapped from host or host group
+	VolumeMappingActionEnumDetach VolumeMappingActionEnum = "detach"
+)
+
+// VolumeMappingEvent single mapping change of the volume
+type VolumeMappingEvent struct {
+	// Time when the mapping was changed.
+	Timestamp string
+	// Mapping change action.
+	Action VolumeMappingActionEnum
+	// Type of the mapping target, host or host_group.
+	TargetType ResourceTypeEnum
+	// Unique identifier of the host or host group.
+	TargetID string
+	// Name of the host or host group.
+	TargetName string
+	// Name of the user who changed the mapping.
+	Username string
+}
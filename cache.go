@@ -0,0 +1,171 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+// lookupCache keeps results of slow-changing lookups for ttl, nil cache caches nothing.
+// Generation is incremented by every clear, so lookups which started before it don't store stale values
+type lookupCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	gen     uint64
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &lookupCache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+func cacheKey(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+func (lc *lookupCache) get(key string) (interface{}, bool) {
+	if lc == nil {
+		return nil, false
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	entry, ok := lc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(lc.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// generation returns current generation of the cache, it must be read before the lookup is sent
+func (lc *lookupCache) generation() uint64 {
+	if lc == nil {
+		return 0
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return lc.gen
+}
+
+// set stores result of the lookup started at generation, the result is dropped
+// if the cache was cleared since then
+func (lc *lookupCache) set(key string, value interface{}, generation uint64) {
+	if lc == nil {
+		return
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if generation != lc.gen {
+		return
+	}
+	lc.entries[key] = cacheEntry{value: value, expires: time.Now().Add(lc.ttl)}
+}
+
+func (lc *lookupCache) clear() {
+	if lc == nil {
+		return
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.entries = make(map[string]cacheEntry)
+	lc.gen++
+}
+
+// copyVolume returns volume which shares no maps with v, cached values are copied both ways
+// so callers can't change entries of the cache
+func copyVolume(v Volume) Volume {
+	if v.Metadata != nil {
+		metadata := make(map[string]string, len(v.Metadata))
+		for key, value := range v.Metadata {
+			metadata[key] = value
+		}
+		v.Metadata = metadata
+	}
+	return v
+}
+
+// copyHost returns host which shares no slices with h
+func copyHost(h Host) Host {
+	if h.Initiators != nil {
+		initiators := make([]InitiatorInstance, len(h.Initiators))
+		for i, initiator := range h.Initiators {
+			if initiator.ActiveSessions != nil {
+				initiator.ActiveSessions = append([]ActiveSessionInstance{}, initiator.ActiveSessions...)
+			}
+			initiators[i] = initiator
+		}
+		h.Initiators = initiators
+	}
+	return h
+}
+
+// copyHosts returns deep copy of hosts
+func copyHosts(hosts []Host) []Host {
+	if hosts == nil {
+		return nil
+	}
+	result := make([]Host, len(hosts))
+	for i, h := range hosts {
+		result[i] = copyHost(h)
+	}
+	return result
+}
+
+// cachingAPIClient drops cached lookups on every request which may change the array state,
+// both before the request and after it. Lookups which were in flight during the request
+// don't restore stale values, because the generation of the cache is changed
+type cachingAPIClient struct {
+	api.Client
+	cache *lookupCache
+}
+
+// Query method do http request and reads response to provided struct
+func (cc *cachingAPIClient) Query(ctx context.Context,
+	cfg api.RequestConfigRenderer, resp interface{}) (api.RespMeta, error) {
+	if cfg.RenderRequestConfig().Method == http.MethodGet {
+		return cc.Client.Query(ctx, cfg, resp)
+	}
+	cc.cache.clear()
+	defer cc.cache.clear()
+	return cc.Client.Query(ctx, cfg, resp)
+}
+
+// InvalidateCache drops all cached lookups and detected array version,
+// use it when the array is known to be changed by another client
+func (c *ClientIMPL) InvalidateCache() {
+	c.cache.clear()
+	c.version.set(SoftwareVersion{})
+}
@@ -0,0 +1,172 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newCachingClient(t *testing.T, ttl time.Duration) Client {
+	client, err := NewClientWithArgs(APIMockURL, "admin", "Password", NewClientOptions().SetCacheTTL(ttl))
+	assert.Nil(t, err)
+	return client
+}
+
+func TestClientIMPL_Cache_GetVolumeByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	client := newCachingClient(t, time.Minute)
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "vol"}]`, volID)))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(204, ""))
+
+	for i := 0; i < 3; i++ {
+		vol, err := client.GetVolumeByName(context.Background(), "vol")
+		assert.Nil(t, err)
+		assert.Equal(t, volID, vol.ID)
+	}
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+volumeMockURL])
+
+	// any change made by the client drops cached lookups
+	_, err := client.DeleteVolume(context.Background(), nil, volID2)
+	assert.Nil(t, err)
+	_, err = client.GetVolumeByName(context.Background(), "vol")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["GET "+volumeMockURL])
+
+	client.InvalidateCache()
+	_, err = client.GetVolumeByName(context.Background(), "vol")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, httpmock.GetCallCountInfo()["GET "+volumeMockURL])
+}
+
+func TestClientIMPL_Cache_TTL(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	client := newCachingClient(t, 50*time.Millisecond)
+	httpmock.RegisterResponder("GET", hostMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "%s"}]`, hostID, hostID2)))
+
+	hosts, err := client.GetHosts(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, hosts, 2)
+	// callers own returned slice
+	hosts[0] = Host{}
+	hosts, err = client.GetHosts(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, hostID, hosts[0].ID)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+hostMockURL])
+
+	time.Sleep(100 * time.Millisecond)
+	_, err = client.GetHosts(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["GET "+hostMockURL])
+}
+
+func TestClientIMPL_Cache_NotFoundIsNotCached(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	client := newCachingClient(t, time.Minute)
+	httpmock.RegisterResponder("GET", hostMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	for i := 0; i < 2; i++ {
+		_, err := client.GetHostByName(context.Background(), "host")
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["GET "+hostMockURL])
+}
+
+func TestClientIMPL_Cache_Disabled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "vol"}]`, volID)))
+	for i := 0; i < 2; i++ {
+		_, err := C.GetVolumeByName(context.Background(), "vol")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["GET "+volumeMockURL])
+	C.InvalidateCache()
+}
+
+func TestClientIMPL_Cache_StaleLookup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	client := newCachingClient(t, time.Minute)
+	impl := client.(*ClientIMPL)
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			// volume is changed by the client while the lookup is in flight
+			_, err := client.DeleteVolume(context.Background(), nil, volID2)
+			assert.Nil(t, err)
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "vol"}]`, volID)), nil
+		})
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(204, ""))
+	_, err := client.GetVolumeByName(context.Background(), "vol")
+	assert.Nil(t, err)
+	_, ok := impl.cache.get(cacheKey(volumeURL, "name", "vol"))
+	assert.False(t, ok)
+}
+
+func TestClientIMPL_Cache_CopiesValues(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	client := newCachingClient(t, time.Minute)
+	httpmock.RegisterResponder("GET", hostMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "host",
+"host_initiators": [{"port_name": "iqn.1994-05.com.redhat:1", "active_sessions": [{"node_id": "N1"}]}]}]`, hostID)))
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "vol", "metadata": {"k": "v"}}]`, volID)))
+
+	for _, lookup := range []func() (Host, error){
+		func() (Host, error) { return client.GetHostByName(context.Background(), "host") },
+		func() (Host, error) {
+			hosts, err := client.GetHosts(context.Background())
+			if err != nil {
+				return Host{}, err
+			}
+			return hosts[0], nil
+		},
+	} {
+		host, err := lookup()
+		assert.Nil(t, err)
+		host.Initiators[0].PortName = "changed"
+		host.Initiators[0].ActiveSessions[0].NodeID = "changed"
+		host, err = lookup()
+		assert.Nil(t, err)
+		assert.Equal(t, "iqn.1994-05.com.redhat:1", host.Initiators[0].PortName)
+		assert.Equal(t, "N1", host.Initiators[0].ActiveSessions[0].NodeID)
+	}
+
+	vol, err := client.GetVolumeByName(context.Background(), "vol")
+	assert.Nil(t, err)
+	vol.Metadata["k"] = "changed"
+	vol, err = client.GetVolumeByName(context.Background(), "vol")
+	assert.Nil(t, err)
+	assert.Equal(t, "v", vol.Metadata["k"])
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+volumeMockURL])
+}
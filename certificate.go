@@ -0,0 +1,196 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	x509CertificateURL = "x509_certificate"
+	securityConfigURL  = "security_config"
+)
+
+func getX509CertificateDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	cert := X509Certificate{}
+	return c.APIClient().QueryParamsWithFields(&cert)
+}
+
+func getSecurityConfigDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	config := SecurityConfig{}
+	return c.APIClient().QueryParamsWithFields(&config)
+}
+
+// GetX509Certificate query and return specific certificate by id
+func (c *ClientIMPL) GetX509Certificate(ctx context.Context, id string) (resp X509Certificate, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    x509CertificateURL,
+			ID:          id,
+			QueryParams: getX509CertificateDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetX509Certificates returns all certificates of the cluster and certificates trusted by it
+func (c *ClientIMPL) GetX509Certificates(ctx context.Context) ([]X509Certificate, error) {
+	return c.getX509Certificates(ctx, nil)
+}
+
+// GetX509CertificatesByService returns certificates used by the service
+func (c *ClientIMPL) GetX509CertificatesByService(ctx context.Context,
+	service X509CertificateServiceEnum) ([]X509Certificate, error) {
+	return c.getX509Certificates(ctx, map[string]string{"service": "eq." + string(service)})
+}
+
+func (c *ClientIMPL) getX509Certificates(ctx context.Context, filters map[string]string) ([]X509Certificate, error) {
+	result := []X509Certificate{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []X509Certificate
+		qp := getX509CertificateDefaultQueryParams(c)
+		for k, v := range filters {
+			qp.RawArg(k, v)
+		}
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    x509CertificateURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateX509Certificate imports certificate to the cluster
+func (c *ClientIMPL) CreateX509Certificate(ctx context.Context,
+	createParams *X509CertificateCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: x509CertificateURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ImportManagementCertificate imports server certificate signed by the customer CA and puts it in use
+// by REST API and UI, keyPassphrase may be empty if the private key is not encrypted
+func (c *ClientIMPL) ImportManagementCertificate(ctx context.Context,
+	certificatePEM, privateKeyPEM, keyPassphrase string) (resp CreateResponse, err error) {
+	isCurrent := true
+	createParams := &X509CertificateCreate{
+		Type:        X509CertificateTypeEnumServer,
+		Service:     X509CertificateServiceEnumManagementHTTP,
+		Certificate: &certificatePEM,
+		PrivateKey:  &privateKeyPEM,
+		IsCurrent:   &isCurrent,
+	}
+	if keyPassphrase != "" {
+		createParams.Passphrase = &keyPassphrase
+	}
+	return c.CreateX509Certificate(ctx, createParams)
+}
+
+// ModifyX509Certificate modifies existing certificate
+func (c *ClientIMPL) ModifyX509Certificate(ctx context.Context,
+	modifyParams *X509CertificateModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: x509CertificateURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteX509Certificate deletes certificate, certificates in use can't be deleted
+func (c *ClientIMPL) DeleteX509Certificate(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: x509CertificateURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ExchangeX509Certificates exchanges certificates with the remote system, so both clusters trust each other.
+// Replication pairing with CreateRemoteSystem requires the exchange to be done first
+func (c *ClientIMPL) ExchangeX509Certificates(ctx context.Context,
+	exchangeParams *X509CertificateExchange) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: x509CertificateURL,
+			Action:   "exchange",
+			Body:     exchangeParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetSecurityConfig returns security configuration of the cluster
+func (c *ClientIMPL) GetSecurityConfig(ctx context.Context) (resp SecurityConfig, err error) {
+	var configList []SecurityConfig
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    securityConfigURL,
+			QueryParams: getSecurityConfigDefaultQueryParams(c)},
+		&configList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(configList) != 1 {
+		return resp, notExistError()
+	}
+	return configList[0], nil
+}
+
+// ModifySecurityConfig modifies security configuration of the cluster, e.g. disables old TLS versions
+func (c *ClientIMPL) ModifySecurityConfig(ctx context.Context,
+	modifyParams *SecurityConfigModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: securityConfigURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
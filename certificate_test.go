@@ -0,0 +1,172 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	x509CertificateMockURL = APIMockURL + x509CertificateURL
+	securityConfigMockURL  = APIMockURL + securityConfigURL
+)
+
+var certificateID = "4b1f5c8e-5d6a-4a0c-9a52-3f1f6e3c2b7d"
+
+func TestClientIMPL_GetX509Certificate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "type": "Server", "service": "Management_HTTP", "is_current": true,
+"members": [{"subject": "CN=powerstore", "depth": 0}]}`, certificateID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", x509CertificateMockURL, certificateID),
+		httpmock.NewStringResponder(200, respData))
+	cert, err := C.GetX509Certificate(context.Background(), certificateID)
+	assert.Nil(t, err)
+	assert.Equal(t, X509CertificateServiceEnumManagementHTTP, cert.Service)
+	assert.True(t, cert.IsCurrent)
+	assert.Equal(t, "CN=powerstore", cert.Members[0].Subject)
+}
+
+func TestClientIMPL_GetX509Certificates(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var serviceFilter string
+	httpmock.RegisterResponder("GET", x509CertificateMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			serviceFilter = req.URL.Query().Get("service")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "%s", "service": "Replication_HTTP"}]`, certificateID)), nil
+		})
+	certs, err := C.GetX509Certificates(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, certs, 1)
+	assert.Empty(t, serviceFilter)
+
+	certs, err = C.GetX509CertificatesByService(context.Background(), X509CertificateServiceEnumReplicationHTTP)
+	assert.Nil(t, err)
+	assert.Len(t, certs, 1)
+	assert.Equal(t, "eq.Replication_HTTP", serviceFilter)
+}
+
+func TestClientIMPL_ImportManagementCertificate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", x509CertificateMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, certificateID)), nil
+		})
+	resp, err := C.ImportManagementCertificate(context.Background(), "cert-pem", "key-pem", "")
+	assert.Nil(t, err)
+	assert.Equal(t, certificateID, resp.ID)
+	assert.Equal(t, "Server", reqBody["type"])
+	assert.Equal(t, "Management_HTTP", reqBody["service"])
+	assert.Equal(t, "cert-pem", reqBody["certificate"])
+	assert.Equal(t, "key-pem", reqBody["private_key"])
+	assert.Equal(t, true, reqBody["is_current"])
+	assert.NotContains(t, reqBody, "passphrase")
+}
+
+func TestClientIMPL_ModifyX509Certificate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", x509CertificateMockURL, certificateID),
+		httpmock.NewStringResponder(204, ""))
+	isCurrent := true
+	_, err := C.ModifyX509Certificate(context.Background(), &X509CertificateModify{IsCurrent: &isCurrent}, certificateID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_DeleteX509Certificate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", x509CertificateMockURL, certificateID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteX509Certificate(context.Background(), certificateID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_ExchangeX509Certificates(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", x509CertificateMockURL+"/exchange",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	address := "10.0.0.20"
+	username := "admin"
+	password := "Password123!"
+	_, err := C.ExchangeX509Certificates(context.Background(), &X509CertificateExchange{
+		Service:  X509CertificateServiceEnumReplicationHTTP,
+		Address:  &address,
+		Username: &username,
+		Password: &password,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Replication_HTTP", reqBody["service"])
+	assert.Equal(t, address, reqBody["address"])
+	assert.NotContains(t, reqBody, "port")
+}
+
+func TestClientIMPL_GetSecurityConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", securityConfigMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "1", "protocol_mode": "TLSv1_2"}]`))
+	config, err := C.GetSecurityConfig(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "1", config.ID)
+	assert.Equal(t, SecurityProtocolModeEnumTLSv12, config.ProtocolMode)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", securityConfigMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetSecurityConfig(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_ModifySecurityConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", securityConfigMockURL+"/1",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	mode := SecurityProtocolModeEnumTLSv12
+	_, err := C.ModifySecurityConfig(context.Background(), &SecurityConfigModify{ProtocolMode: &mode}, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "TLSv1_2", reqBody["protocol_mode"])
+}
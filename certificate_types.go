@@ -0,0 +1,172 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// X509CertificateServiceEnum service the certificate is used by
+type X509CertificateServiceEnum string
+
+const (
+	// X509CertificateServiceEnumManagementHTTP captures enum value "Management_HTTP", REST API and UI
+	X509CertificateServiceEnumManagementHTTP X509CertificateServiceEnum = "Management_HTTP"
+	// X509CertificateServiceEnumReplicationHTTP captures enum value "Replication_HTTP"
+	X509CertificateServiceEnumReplicationHTTP X509CertificateServiceEnum = "Replication_HTTP"
+	// X509CertificateServiceEnumVASAHTTP captures enum value "VASA_HTTP"
+	X509CertificateServiceEnumVASAHTTP X509CertificateServiceEnum = "VASA_HTTP"
+	// X509CertificateServiceEnumImportHTTP captures enum value "Import_HTTP"
+	X509CertificateServiceEnumImportHTTP X509CertificateServiceEnum = "Import_HTTP"
+	// X509CertificateServiceEnumLDAPHTTP captures enum value "LDAP_HTTP"
+	X509CertificateServiceEnumLDAPHTTP X509CertificateServiceEnum = "LDAP_HTTP"
+	// X509CertificateServiceEnumSyslogHTTP captures enum value "Syslog_HTTP"
+	X509CertificateServiceEnumSyslogHTTP X509CertificateServiceEnum = "Syslog_HTTP"
+)
+
+// X509CertificateTypeEnum type of the certificate
+type X509CertificateTypeEnum string
+
+const (
+	// X509CertificateTypeEnumServer captures enum value "Server"
+	X509CertificateTypeEnumServer X509CertificateTypeEnum = "Server"
+	// X509CertificateTypeEnumClient captures enum value "Client"
+	X509CertificateTypeEnumClient X509CertificateTypeEnum = "Client"
+	// X509CertificateTypeEnumCA captures enum value "CA"
+	X509CertificateTypeEnumCA X509CertificateTypeEnum = "CA"
+	// X509CertificateTypeEnumTrustedCA captures enum value "TrustedCA"
+	X509CertificateTypeEnumTrustedCA X509CertificateTypeEnum = "TrustedCA"
+)
+
+// X509CertificateMember certificate of the chain
+type X509CertificateMember struct {
+	// Subject of the certificate.
+	Subject string `json:"subject,omitempty"`
+	// Issuer of the certificate.
+	Issuer string `json:"issuer,omitempty"`
+	// Serial number of the certificate.
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Start of the certificate validity period.
+	ValidFrom string `json:"valid_from,omitempty"`
+	// End of the certificate validity period.
+	ValidTo string `json:"valid_to,omitempty"`
+	// Thumbprint of the certificate.
+	Thumbprint string `json:"thumbprint,omitempty"`
+	// Subject alternative names of the certificate.
+	SubjectAlternativeNames []string `json:"subject_alternative_names,omitempty"`
+	// Position of the certificate in the chain, 0 is the leaf.
+	Depth int `json:"depth,omitempty"`
+	// Certificate in PEM format.
+	Certificate string `json:"certificate,omitempty"`
+}
+
+// X509Certificate x509 certificate of the cluster or certificate trusted by it
+type X509Certificate struct {
+	// Unique identifier of the certificate.
+	ID string `json:"id,omitempty"`
+	// Type of the certificate.
+	Type X509CertificateTypeEnum `json:"type,omitempty"`
+	// Service the certificate is used by.
+	Service X509CertificateServiceEnum `json:"service,omitempty"`
+	// Scope of the certificate, e.g. address of the remote system for replication certificates.
+	Scope string `json:"scope,omitempty"`
+	// Indicates whether the certificate is in use by the service.
+	IsCurrent bool `json:"is_current,omitempty"`
+	// Indicates whether the certificate is valid.
+	IsValid bool `json:"is_valid,omitempty"`
+	// Certificates of the chain.
+	Members []X509CertificateMember `json:"members,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (c *X509Certificate) Fields() []string {
+	return []string{"id", "type", "service", "scope", "is_current", "is_valid", "members"}
+}
+
+// X509CertificateCreate import certificate request
+type X509CertificateCreate struct {
+	// Type of the certificate.
+	Type X509CertificateTypeEnum `json:"type"`
+	// Service the certificate is used by.
+	Service X509CertificateServiceEnum `json:"service"`
+	// Scope of the certificate.
+	Scope *string `json:"scope,omitempty"`
+	// Certificate chain in PEM format.
+	Certificate *string `json:"certificate"`
+	// Private key of the certificate in PEM format, required for Server and Client certificates.
+	PrivateKey *string `json:"private_key,omitempty"`
+	// Passphrase of the encrypted private key.
+	Passphrase *string `json:"passphrase,omitempty"`
+	// Indicates whether the certificate is put in use by the service right away.
+	IsCurrent *bool `json:"is_current,omitempty"`
+}
+
+// X509CertificateModify modify certificate request, unset fields are not changed
+type X509CertificateModify struct {
+	// Indicates whether the certificate is in use by the service.
+	IsCurrent *bool `json:"is_current,omitempty"`
+	// Certificate chain in PEM format which replaces the current one.
+	Certificate *string `json:"certificate,omitempty"`
+}
+
+// X509CertificateExchange request for exchanging certificates with the remote system,
+// both clusters trust each other afterwards, it is required before replication pairing
+type X509CertificateExchange struct {
+	// Service the certificates are exchanged for.
+	Service X509CertificateServiceEnum `json:"service"`
+	// Management address of the remote system.
+	Address *string `json:"address"`
+	// Management port of the remote system.
+	Port *int32 `json:"port,omitempty"`
+	// Username of the remote system administrator.
+	Username *string `json:"username"`
+	// Password of the remote system administrator.
+	Password *string `json:"password"`
+	// Timeout of the exchange in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
+// SecurityProtocolModeEnum minimal version of TLS accepted by the cluster
+type SecurityProtocolModeEnum string
+
+const (
+	// SecurityProtocolModeEnumTLSv10 captures enum value "TLSv1_0"
+	SecurityProtocolModeEnumTLSv10 SecurityProtocolModeEnum = "TLSv1_0"
+	// SecurityProtocolModeEnumTLSv11 captures enum value "TLSv1_1"
+	SecurityProtocolModeEnumTLSv11 SecurityProtocolModeEnum = "TLSv1_1"
+	// SecurityProtocolModeEnumTLSv12 captures enum value "TLSv1_2"
+	SecurityProtocolModeEnumTLSv12 SecurityProtocolModeEnum = "TLSv1_2"
+)
+
+// SecurityConfig security settings of the cluster
+type SecurityConfig struct {
+	// Unique identifier of the security configuration.
+	ID string `json:"id,omitempty"`
+	// Minimal version of TLS accepted by the cluster.
+	ProtocolMode SecurityProtocolModeEnum `json:"protocol_mode,omitempty"`
+	// Last update time of the security configuration.
+	LastUpdateTimestamp string `json:"last_update_timestamp,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *SecurityConfig) Fields() []string {
+	return []string{"id", "protocol_mode", "last_update_timestamp"}
+}
+
+// SecurityConfigModify modify security configuration request
+type SecurityConfigModify struct {
+	// Minimal version of TLS accepted by the cluster.
+	ProtocolMode *SecurityProtocolModeEnum `json:"protocol_mode"`
+}
@@ -20,9 +20,11 @@ package gopowerstore
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/dell/gopowerstore/api"
 )
@@ -38,32 +40,265 @@ const (
 	paginationDefaultPageSize = 1000
 )
 
-// Client defines gopowerstore client interface
+//go:generate mockgen -source client.go -package mock -destination mock/client_mock.go
+
+// Client defines gopowerstore client interface, mock.MockClient implements it for unit tests
 type Client interface {
 	APIClient() api.Client
+	Do(ctx context.Context, cfg RequestConfig, resp interface{}) (api.RespMeta, error)
+	InvalidateCache()
 	SetTraceID(ctx context.Context, value string) context.Context
 	SetCustomHTTPHeaders(headers http.Header)
+	Config() ClientConfig
+	Logout(ctx context.Context) error
 	GetVolume(ctx context.Context, id string) (Volume, error)
 	GetVolumeByName(ctx context.Context, name string) (Volume, error)
 	GetVolumes(ctx context.Context) ([]Volume, error)
+	GetVolumesFiltered(ctx context.Context, filter *QueryFilter) ([]Volume, error)
+	GetSnapshotsFiltered(ctx context.Context, filter *QueryFilter) ([]Volume, error)
+	GetVolumesWithPagination(ctx context.Context, offset, limit int) ([]Volume, int, error)
+	GetVolumesExceedingLogicalUsed(ctx context.Context, thresholdPercent float64) ([]Volume, error)
 	CreateVolume(ctx context.Context, createParams *VolumeCreate) (CreateResponse, error)
+	EnsureVolume(ctx context.Context, createParams *VolumeCreate) (Volume, error)
 	DeleteVolume(ctx context.Context, deleteParams *VolumeDelete, id string) (EmptyResponse, error)
 	GetHost(ctx context.Context, id string) (Host, error)
 	GetHostByName(ctx context.Context, name string) (Host, error)
 	GetHosts(ctx context.Context) ([]Host, error)
 	CreateHost(ctx context.Context, createParams *HostCreate) (CreateResponse, error)
+	EnsureHost(ctx context.Context, createParams *HostCreate) (Host, error)
 	DeleteHost(ctx context.Context, deleteParams *HostDelete, id string) (EmptyResponse, error)
 	ModifyHost(ctx context.Context, modifyParams *HostModify, id string) (CreateResponse, error)
+	AddHostInitiators(ctx context.Context, hostID string, initiators []InitiatorCreateModify) (EmptyResponse, error)
+	RemoveHostInitiators(ctx context.Context, hostID string, portNames []string) (EmptyResponse, error)
+	GetHostInitiatorPaths(ctx context.Context, hostID string) ([]InitiatorPath, error)
+	GetHostConnectivity(ctx context.Context, hostID string) (HostConnectivity, error)
+	GetTargetPortInitiatorPaths(ctx context.Context, targetPortName string) ([]InitiatorPath, error)
+	SetHostInitiatorsChap(ctx context.Context, hostID string, credentials []UpdateInitiatorInHost) (EmptyResponse, error)
+	GetHostGroup(ctx context.Context, id string) (HostGroup, error)
+	GetHostGroupByName(ctx context.Context, name string) (HostGroup, error)
+	GetHostGroups(ctx context.Context) ([]HostGroup, error)
+	CreateHostGroup(ctx context.Context, createParams *HostGroupCreate) (CreateResponse, error)
+	ModifyHostGroup(ctx context.Context, modifyParams *HostGroupModify, id string) (EmptyResponse, error)
+	DeleteHostGroup(ctx context.Context, id string) (EmptyResponse, error)
+	AttachVolumeToHostGroup(ctx context.Context, hostGroupID string,
+		attachParams *HostVolumeAttach) (EmptyResponse, error)
+	DetachVolumeFromHostGroup(ctx context.Context, hostGroupID string,
+		detachParams *HostVolumeDetach) (EmptyResponse, error)
 	GetHostVolumeMappings(ctx context.Context) (resp []HostVolumeMapping, err error)
 	GetHostVolumeMapping(ctx context.Context, id string) (resp HostVolumeMapping, err error)
 	GetHostVolumeMappingByVolumeID(ctx context.Context, volumeID string) (resp []HostVolumeMapping, err error)
+	GetVolumeMappingHistory(ctx context.Context, volID string) ([]VolumeMappingEvent, error)
+	GetEventsSince(ctx context.Context, cursor string) ([]Event, string, error)
+	GetEvents(ctx context.Context, query *EventQuery) ([]Event, error)
+	GetAlerts(ctx context.Context, query *AlertQuery) ([]Alert, error)
+	GetAlert(ctx context.Context, id string) (Alert, error)
+	AcknowledgeAlert(ctx context.Context, alertID string) (EmptyResponse, error)
+	ClearAlert(ctx context.Context, alertID string) (EmptyResponse, error)
+	GetEffectiveHostAccess(ctx context.Context, volID string) ([]HostAccess, error)
 	AttachVolumeToHost(ctx context.Context, hostID string, attachParams *HostVolumeAttach) (resp EmptyResponse, err error)
 	DetachVolumeFromHost(ctx context.Context, hostID string, detachParams *HostVolumeDetach) (resp EmptyResponse, err error)
 	GetStorageISCSITargetAddresses(ctx context.Context) ([]IPPoolAddress, error)
+	GetISCSITargetIQNs(ctx context.Context) ([]string, error)
+	GetStorageNVMeTCPTargetAddresses(ctx context.Context) ([]IPPoolAddress, error)
+	GetIPPoolAddresses(ctx context.Context, purpose IPPurposeTypeEnum) ([]IPPoolAddress, error)
+	GetManagementIPs(ctx context.Context) (ManagementIPs, error)
+	GetNVMeSubsystem(ctx context.Context) ([]NVMeSubsystem, error)
+	GetNVMeNamespaces(ctx context.Context, filter api.QueryParamsEncoder) ([]NVMeNamespace, error)
+	GetStorageContainer(ctx context.Context, id string) (StorageContainer, error)
+	GetStorageContainerByName(ctx context.Context, name string) (StorageContainer, error)
+	GetStorageContainers(ctx context.Context) ([]StorageContainer, error)
+	CreateStorageContainer(ctx context.Context, createParams *StorageContainerCreate) (CreateResponse, error)
+	ModifyStorageContainer(ctx context.Context,
+		modifyParams *StorageContainerModify, id string) (EmptyResponse, error)
+	DeleteStorageContainer(ctx context.Context, id string) (EmptyResponse, error)
+	GetVirtualVolume(ctx context.Context, id string) (VirtualVolume, error)
+	GetVirtualVolumeByName(ctx context.Context, name string) (VirtualVolume, error)
+	GetVirtualVolumes(ctx context.Context) ([]VirtualVolume, error)
+	GetVirtualVolumeSnapshots(ctx context.Context, vvolID string) ([]VirtualVolume, error)
+	GetVirtualVolumesByVirtualMachineUUID(ctx context.Context, instanceUUID string) ([]VirtualVolume, error)
+	GetHostVirtualVolumeMappings(ctx context.Context) ([]HostVirtualVolumeMapping, error)
+	GetHostVirtualVolumeMappingsByHostID(ctx context.Context, hostID string) ([]HostVirtualVolumeMapping, error)
+	GetHostVirtualVolumeMappingsByVirtualVolumeID(ctx context.Context,
+		vvolID string) ([]HostVirtualVolumeMapping, error)
+	GetVirtualMachine(ctx context.Context, id string) (VirtualMachine, error)
+	GetVirtualMachineByInstanceUUID(ctx context.Context, instanceUUID string) (VirtualMachine, error)
+	GetVirtualMachines(ctx context.Context) ([]VirtualMachine, error)
+	GetRemoteSystem(ctx context.Context, id string) (RemoteSystem, error)
+	GetRemoteSystems(ctx context.Context) ([]RemoteSystem, error)
+	GetRemoteSystemByName(ctx context.Context, name string) (RemoteSystem, error)
+	GetRemoteSystemByMgmtAddress(ctx context.Context, address string) (RemoteSystem, error)
+	CreateRemoteSystem(ctx context.Context, createParams *RemoteSystemCreate) (CreateResponse, error)
+	ModifyRemoteSystem(ctx context.Context, modifyParams *RemoteSystemModify, id string) (EmptyResponse, error)
+	DeleteRemoteSystem(ctx context.Context, id string) (EmptyResponse, error)
+	VerifyRemoteSystem(ctx context.Context, id string) (EmptyResponse, error)
+	GetImportSession(ctx context.Context, id string) (ImportSession, error)
+	GetImportSessions(ctx context.Context) ([]ImportSession, error)
+	GetImportSessionsByRemoteSystemID(ctx context.Context, remoteSystemID string) ([]ImportSession, error)
+	CreateImportSession(ctx context.Context, createParams *ImportSessionCreate) (CreateResponse, error)
+	ModifyImportSession(ctx context.Context, modifyParams *ImportSessionModify, id string) (EmptyResponse, error)
+	DeleteImportSession(ctx context.Context, id string) (EmptyResponse, error)
+	CutoverImportSession(ctx context.Context, id string) (JobResponse, error)
+	CancelImportSession(ctx context.Context, id string, cancelParams *ImportSessionCancel) (JobResponse, error)
+	PauseImportSession(ctx context.Context, id string) (JobResponse, error)
+	ResumeImportSession(ctx context.Context, id string) (JobResponse, error)
+	CleanupImportSession(ctx context.Context, id string) (JobResponse, error)
+	MigrateVolume(ctx context.Context, volID, applianceID string) (CreateResponse, error)
+	GetMigrationSession(ctx context.Context, id string) (MigrationSession, error)
+	GetMigrationSessions(ctx context.Context) ([]MigrationSession, error)
+	GetMigrationSessionsByResourceID(ctx context.Context, resourceID string) ([]MigrationSession, error)
+	CutoverMigrationSession(ctx context.Context, id string) (JobResponse, error)
+	CancelMigrationSession(ctx context.Context, id string, deleteParams *MigrationSessionDelete) (JobResponse, error)
+	GetMigrationRecommendation(ctx context.Context, id string) (MigrationRecommendation, error)
+	GetMigrationRecommendations(ctx context.Context) ([]MigrationRecommendation, error)
+	GenerateMigrationRecommendation(ctx context.Context,
+		generateParams *MigrationRecommendationGenerate) (CreateResponse, error)
+	CreateMigrationSessionsFromRecommendation(ctx context.Context, id string) ([]CreateResponse, error)
+	StartMigrationSessionsFromRecommendation(ctx context.Context, id string) (JobResponse, error)
+	GetReplicationSession(ctx context.Context, id string) (ReplicationSession, error)
+	GetReplicationSessionByLocalResourceID(ctx context.Context, resourceID string) (ReplicationSession, error)
+	FailoverReplicationSession(ctx context.Context, id string,
+		failoverParams *ReplicationSessionFailover) (JobResponse, error)
+	ReprotectReplicationSession(ctx context.Context, id string) (JobResponse, error)
+	ResumeReplicationSession(ctx context.Context, id string) (JobResponse, error)
+	PauseReplicationSession(ctx context.Context, id string) (JobResponse, error)
+	SyncReplicationSession(ctx context.Context, id string) (JobResponse, error)
+	GetVolumeGroupReplicationSession(ctx context.Context, groupID string) (ReplicationSession, error)
+	FailoverVolumeGroup(ctx context.Context, groupID string,
+		failoverParams *ReplicationSessionFailover) (JobResponse, error)
+	ReprotectVolumeGroup(ctx context.Context, groupID string) (JobResponse, error)
+	StartReplicationSessionFailoverTest(ctx context.Context, id string,
+		testParams *ReplicationSessionFailoverTest) (JobResponse, error)
+	StopReplicationSessionFailoverTest(ctx context.Context, id string) (JobResponse, error)
+	RunReplicationSessionFailoverTest(ctx context.Context, id string,
+		testParams *ReplicationSessionFailoverTest, test func(ctx context.Context) error) error
+	GetNASServers(ctx context.Context) ([]NAS, error)
+	GetNAS(ctx context.Context, id string) (NAS, error)
+	GetNASByName(ctx context.Context, name string) (NAS, error)
+	GetFS(ctx context.Context, id string) (FileSystem, error)
+	GetFSByName(ctx context.Context, name string) (FileSystem, error)
+	CreateFS(ctx context.Context, createParams *FsCreate) (CreateResponse, error)
+	ModifyFS(ctx context.Context, modifyParams *FsModify, id string) (FileSystem, error)
+	DeleteFS(ctx context.Context, id string) (EmptyResponse, error)
+	CloneFS(ctx context.Context, cloneParams *FsClone, sourceFsID string) (CreateResponse, error)
+	CreateFsSnapshot(ctx context.Context, createParams *FsSnapshotCreate, fsID string) (CreateResponse, error)
+	CreateFsProtocolSnapshot(ctx context.Context, createParams *FsSnapshotCreate, fsID string) (CreateResponse, error)
+	GetFsSnapshotAccessPaths(ctx context.Context, snapID string) ([]FsSnapshotAccessPath, error)
+	GetFsSnapshots(ctx context.Context) ([]FileSystem, error)
+	CreateFsFromSnapshot(ctx context.Context, createParams *FsClone, snapID string) (CreateResponse, error)
+	GetFsSnapshotsByFSID(ctx context.Context, fsID string) ([]FileSystem, error)
+	DeleteFsSnapshot(ctx context.Context, snapID string) (EmptyResponse, error)
+	RestoreFsSnapshot(ctx context.Context, snapID string, restoreParams *FsSnapshotRestore) (CreateResponse, error)
+	GetTreeQuota(ctx context.Context, id string) (TreeQuota, error)
+	GetTreeQuotasByFSID(ctx context.Context, fsID string) ([]TreeQuota, error)
+	CreateTreeQuota(ctx context.Context, createParams *TreeQuotaCreate) (CreateResponse, error)
+	ModifyTreeQuota(ctx context.Context, modifyParams *TreeQuotaModify, id string) (EmptyResponse, error)
+	DeleteTreeQuota(ctx context.Context, id string) (EmptyResponse, error)
+	GetUserQuota(ctx context.Context, id string) (UserQuota, error)
+	GetUserQuotasByFSID(ctx context.Context, fsID string) ([]UserQuota, error)
+	CreateUserQuota(ctx context.Context, createParams *UserQuotaCreate) (CreateResponse, error)
+	ModifyUserQuota(ctx context.Context, modifyParams *UserQuotaModify, id string) (EmptyResponse, error)
+	GetNFSExport(ctx context.Context, id string) (NFSExport, error)
+	GetNFSExportByName(ctx context.Context, name string) (NFSExport, error)
+	GetNFSExportByFileSystemID(ctx context.Context, fsID string) (NFSExport, error)
+	GetNFSExportsByFileSystemID(ctx context.Context, fsID string) ([]NFSExport, error)
+	CreateNFSExport(ctx context.Context, createParams *NFSExportCreate) (CreateResponse, error)
+	CreateNFSExportOnFsSnapshot(ctx context.Context, createParams *NFSExportCreate, snapID string) (CreateResponse, error)
+	EnsureNFSExport(ctx context.Context, createParams *NFSExportCreate) (NFSExport, error)
+	ModifyNFSExport(ctx context.Context, modifyParams *NFSExportModify, id string) (EmptyResponse, error)
+	DeleteNFSExport(ctx context.Context, id string) (EmptyResponse, error)
+	GetSMBShare(ctx context.Context, id string) (SMBShare, error)
+	GetSMBShareByName(ctx context.Context, name string) (SMBShare, error)
+	GetSMBSharesByFileSystemID(ctx context.Context, fsID string) ([]SMBShare, error)
+	CreateSMBShare(ctx context.Context, createParams *SMBShareCreate) (CreateResponse, error)
+	ModifySMBShare(ctx context.Context, modifyParams *SMBShareModify, id string) (EmptyResponse, error)
+	DeleteSMBShare(ctx context.Context, id string) (EmptyResponse, error)
+	GetLDAPConfig(ctx context.Context, nasServerID string) (FileLDAP, error)
+	CreateLDAPConfig(ctx context.Context, createParams *FileLDAPCreate) (CreateResponse, error)
+	ModifyLDAPConfig(ctx context.Context, modifyParams *FileLDAPModify, id string) (EmptyResponse, error)
+	VerifyLDAPConfig(ctx context.Context, id string) (EmptyResponse, error)
+	GetADConfig(ctx context.Context, nasServerID string) (SMBServer, error)
+	CreateADConfig(ctx context.Context, createParams *SMBServerCreate) (CreateResponse, error)
+	ModifyADConfig(ctx context.Context, modifyParams *SMBServerModify, id string) (EmptyResponse, error)
+	JoinADDomain(ctx context.Context, joinParams *SMBServerJoin, id string) (EmptyResponse, error)
+	DeleteADConfig(ctx context.Context, id string) (EmptyResponse, error)
+	GetNFSServer(ctx context.Context, id string) (NFSServer, error)
+	GetNFSServerByNASServerID(ctx context.Context, nasServerID string) (NFSServer, error)
+	CreateNFSServer(ctx context.Context, createParams *NFSServerCreate) (CreateResponse, error)
+	ModifyNFSServer(ctx context.Context, modifyParams *NFSServerModify, id string) (EmptyResponse, error)
+	DeleteNFSServer(ctx context.Context, id string) (EmptyResponse, error)
+	GetFileInterface(ctx context.Context, id string) (FileInterface, error)
+	GetFileInterfacesByNASServerID(ctx context.Context, nasServerID string) ([]FileInterface, error)
+	CreateFileInterface(ctx context.Context, createParams *FileInterfaceCreate) (CreateResponse, error)
+	ModifyFileInterface(ctx context.Context, modifyParams *FileInterfaceModify, id string) (EmptyResponse, error)
+	DeleteFileInterface(ctx context.Context, id string) (EmptyResponse, error)
+	GetCluster(ctx context.Context) (Cluster, error)
+	GetX509Certificate(ctx context.Context, id string) (X509Certificate, error)
+	GetX509Certificates(ctx context.Context) ([]X509Certificate, error)
+	GetX509CertificatesByService(ctx context.Context, service X509CertificateServiceEnum) ([]X509Certificate, error)
+	CreateX509Certificate(ctx context.Context, createParams *X509CertificateCreate) (CreateResponse, error)
+	ImportManagementCertificate(ctx context.Context, certificatePEM, privateKeyPEM, keyPassphrase string) (CreateResponse, error)
+	ModifyX509Certificate(ctx context.Context, modifyParams *X509CertificateModify, id string) (EmptyResponse, error)
+	DeleteX509Certificate(ctx context.Context, id string) (EmptyResponse, error)
+	ExchangeX509Certificates(ctx context.Context, exchangeParams *X509CertificateExchange) (EmptyResponse, error)
+	GetLocalUser(ctx context.Context, id string) (LocalUser, error)
+	GetLocalUserByName(ctx context.Context, name string) (LocalUser, error)
+	GetLocalUsers(ctx context.Context) ([]LocalUser, error)
+	CreateLocalUser(ctx context.Context, createParams *LocalUserCreate) (CreateResponse, error)
+	ModifyLocalUser(ctx context.Context, modifyParams *LocalUserModify, id string) (EmptyResponse, error)
+	DeleteLocalUser(ctx context.Context, id string) (EmptyResponse, error)
+	GetRole(ctx context.Context, id string) (Role, error)
+	GetRoleByName(ctx context.Context, name string) (Role, error)
+	GetRoles(ctx context.Context) ([]Role, error)
+	GetLDAPDomain(ctx context.Context, id string) (LDAPDomain, error)
+	GetLDAPDomains(ctx context.Context) ([]LDAPDomain, error)
+	CreateLDAPDomain(ctx context.Context, createParams *LDAPDomainCreate) (CreateResponse, error)
+	ModifyLDAPDomain(ctx context.Context, modifyParams *LDAPDomainModify, id string) (EmptyResponse, error)
+	DeleteLDAPDomain(ctx context.Context, id string) (EmptyResponse, error)
+	VerifyLDAPDomain(ctx context.Context, id string) (EmptyResponse, error)
+	GetLDAPAccount(ctx context.Context, id string) (LDAPAccount, error)
+	GetLDAPAccounts(ctx context.Context) ([]LDAPAccount, error)
+	CreateLDAPAccount(ctx context.Context, createParams *LDAPAccountCreate) (CreateResponse, error)
+	ModifyLDAPAccount(ctx context.Context, modifyParams *LDAPAccountModify, id string) (EmptyResponse, error)
+	DeleteLDAPAccount(ctx context.Context, id string) (EmptyResponse, error)
+	GetNetwork(ctx context.Context, id string) (Network, error)
+	GetNetworks(ctx context.Context) ([]Network, error)
+	GetNetworksByType(ctx context.Context, networkType NetworkTypeEnum) ([]Network, error)
+	ModifyNetwork(ctx context.Context, modifyParams *NetworkModify, id string) (EmptyResponse, error)
+	GetDNS(ctx context.Context) (DNS, error)
+	ModifyDNS(ctx context.Context, addresses []string, id string) (EmptyResponse, error)
+	GetNTP(ctx context.Context) (NTP, error)
+	ModifyNTP(ctx context.Context, addresses []string, id string) (EmptyResponse, error)
+	GetSMTPConfig(ctx context.Context) (SMTPConfig, error)
+	ModifySMTPConfig(ctx context.Context, modifyParams *SMTPConfigModify, id string) (EmptyResponse, error)
+	TestSMTPConfig(ctx context.Context, id, recipientEmail string) (EmptyResponse, error)
+	GetSecurityConfig(ctx context.Context) (SecurityConfig, error)
+	ModifySecurityConfig(ctx context.Context, modifyParams *SecurityConfigModify, id string) (EmptyResponse, error)
+	ModifyCluster(ctx context.Context, modifyParams *ClusterModify, id string) (EmptyResponse, error)
+	GetSoftwareInstalled(ctx context.Context) ([]SoftwareInstalled, error)
+	GetSoftwarePackage(ctx context.Context, id string) (SoftwarePackage, error)
+	GetSoftwarePackages(ctx context.Context) ([]SoftwarePackage, error)
+	UploadSoftwarePackage(ctx context.Context, fileName string, content io.ReadSeeker) (CreateResponse, error)
+	DeleteSoftwarePackage(ctx context.Context, id string) (EmptyResponse, error)
+	RunSoftwarePackageHealthCheck(ctx context.Context, id string,
+		checkParams *SoftwarePackageHealthCheck) (JobResponse, error)
+	InstallSoftwarePackage(ctx context.Context, id string, installParams *SoftwarePackageInstall) (JobResponse, error)
+	GetSupportMaterial(ctx context.Context, id string) (SupportMaterial, error)
+	GetSupportMaterials(ctx context.Context) ([]SupportMaterial, error)
+	CollectSupportMaterial(ctx context.Context, collectParams *SupportMaterialCollect) (JobResponse, error)
+	DeleteSupportMaterial(ctx context.Context, id string) (EmptyResponse, error)
+	DownloadSupportMaterial(ctx context.Context, id string) (io.ReadCloser, error)
+	GetSoftwareVersion(ctx context.Context) (string, error)
+	GetSoftwareMajorMinorVersion(ctx context.Context) (SoftwareVersion, error)
+	GetCapabilities(ctx context.Context) (Capabilities, error)
+	GetAppliances(ctx context.Context) ([]ApplianceInstance, error)
+	GetApplianceByName(ctx context.Context, name string) (ApplianceInstance, error)
 	GetApplianceListCMA(ctx context.Context) ([]Appliance, error)
 	GetCapacity(ctx context.Context) (int64, error)
+	GetCapacityByAppliance(ctx context.Context) ([]ApplianceCapacity, error)
+	GetApplianceMostFreeSpace(ctx context.Context) (ApplianceCapacity, error)
 	GetFCPorts(ctx context.Context) (resp []FcPort, err error)
 	GetFCPort(ctx context.Context, id string) (resp FcPort, err error)
+	GetFCTargetPorts(ctx context.Context) ([]FcPort, error)
 	SetLogger(logger Logger)
 	CreateSnapshot(ctx context.Context, createSnapParams *SnapshotCreate, id string) (resp CreateResponse, err error)
 	DeleteSnapshot(ctx context.Context, deleteParams *VolumeDelete, id string) (EmptyResponse, error)
@@ -71,11 +306,106 @@ type Client interface {
 	GetSnapshots(ctx context.Context) ([]Volume, error)
 	GetSnapshot(ctx context.Context, snapID string) (Volume, error)
 	CreateVolumeFromSnapshot(ctx context.Context, createParams *VolumeClone, snapID string) (CreateResponse, error)
+	CloneVolume(ctx context.Context, cloneParams *VolumeClone, sourceVolID string) (CreateResponse, error)
+	ConfigureMetroVolume(ctx context.Context, volID string, config *MetroConfig) (MetroSessionResponse, error)
+	EndMetroVolume(ctx context.Context, volID string, options *EndMetroVolumeOptions) (EmptyResponse, error)
+	GetMetroSessionByVolumeID(ctx context.Context, volID string) (ReplicationSession, error)
+	NewVolumeIterator(ctx context.Context, pageSize int) *VolumeIterator
+	NewSnapshotIterator(ctx context.Context, pageSize int) *VolumeIterator
+	RefreshVolume(ctx context.Context, volID string, refreshParams *VolumeRefresh) (JobResponse, error)
+	RestoreVolumeFromSnapshot(ctx context.Context, volID string, restoreParams *VolumeRestore) (EmptyResponse, error)
+	RestoreVolumeFromSnapshotAsync(ctx context.Context, volID string, restoreParams *VolumeRestore) (JobResponse, error)
+	GetTopSpaceConsumers(ctx context.Context, n int) ([]Volume, error)
+	GetVolumeByMetadata(ctx context.Context, key, value string) ([]Volume, error)
+	GetUnmanagedVolumes(ctx context.Context, metadataKey string) ([]Volume, error)
+	DeleteSnapshotsOlderThan(ctx context.Context, cutoff time.Time, filter api.QueryParamsEncoder) []error
+	DeleteSnapshotsByVolumeID(ctx context.Context, volID string) (DeleteResult, error)
+	CreateVolumes(ctx context.Context, createParams []VolumeCreate, concurrency int) []BatchResult
+	DeleteVolumes(ctx context.Context, ids []string, concurrency int) []BatchResult
+	ModifyVolume(ctx context.Context, modifyParams *VolumeModify, volID string) (EmptyResponse, error)
+	ModifySnapshot(ctx context.Context, modifyParams *SnapshotModify, snapID string) (EmptyResponse, error)
+	GetSnapshotRule(ctx context.Context, id string) (SnapshotRule, error)
+	CreateSnapshotRule(ctx context.Context, createParams *SnapshotRuleCreate) (CreateResponse, error)
+	DeleteSnapshotRule(ctx context.Context, id string) (EmptyResponse, error)
+	GetIoLimitPolicy(ctx context.Context, id string) (IoLimitPolicy, error)
+	GetIoLimitPolicyByName(ctx context.Context, name string) (IoLimitPolicy, error)
+	CreateIoLimitPolicy(ctx context.Context, createParams *IoLimitPolicyCreate) (CreateResponse, error)
+	GetIoLimitPolicies(ctx context.Context) ([]IoLimitPolicy, error)
+	ModifyIoLimitPolicy(ctx context.Context, modifyParams *IoLimitPolicyModify, id string) (EmptyResponse, error)
+	DeleteIoLimitPolicy(ctx context.Context, id string) (EmptyResponse, error)
+	GetPerformancePolicy(ctx context.Context, id string) (PerformancePolicy, error)
+	GetPerformancePolicies(ctx context.Context) ([]PerformancePolicy, error)
+	GetProtectionPolicy(ctx context.Context, id string) (ProtectionPolicy, error)
+	GetProtectionPolicyByName(ctx context.Context, name string) (ProtectionPolicy, error)
+	CreateProtectionPolicy(ctx context.Context, createParams *ProtectionPolicyCreate) (CreateResponse, error)
+	ModifyProtectionPolicy(ctx context.Context, modifyParams *ProtectionPolicyModify, id string) (EmptyResponse, error)
+	DeleteProtectionPolicy(ctx context.Context, id string) (EmptyResponse, error)
+	GetReplicationRule(ctx context.Context, id string) (ReplicationRule, error)
+	GetReplicationRuleByName(ctx context.Context, name string) (ReplicationRule, error)
+	CreateReplicationRule(ctx context.Context, createParams *ReplicationRuleCreate) (CreateResponse, error)
+	ModifyReplicationRule(ctx context.Context, modifyParams *ReplicationRuleModify, id string) (EmptyResponse, error)
+	DeleteReplicationRule(ctx context.Context, id string) (EmptyResponse, error)
+	ModifySnapshotRule(ctx context.Context, modifyParams *SnapshotRuleModify, id string) (EmptyResponse, error)
+	GetSnapshotCreationRate(ctx context.Context, interval MetricsIntervalEnum) (CreationRate, error)
+	GetVolumeCreationRate(ctx context.Context, interval MetricsIntervalEnum) (CreationRate, error)
+	GetVolumePerformanceMetrics(ctx context.Context, request *MetricsRequest) ([]VolumePerformanceMetric, error)
+	GetVolumeSpaceMetrics(ctx context.Context, volID string, interval MetricsIntervalEnum) ([]VolumeSpaceMetric, error)
+	PerformanceMetricsByVolume(ctx context.Context, volID string,
+		interval MetricsIntervalEnum) ([]VolumePerformanceMetric, error)
+	PerformanceMetricsByAppliance(ctx context.Context, applianceID string,
+		interval MetricsIntervalEnum) ([]AppliancePerformanceMetric, error)
+	PerformanceMetricsByNode(ctx context.Context, nodeID string,
+		interval MetricsIntervalEnum) ([]NodePerformanceMetric, error)
+	SpaceMetricsByVolume(ctx context.Context, volID string, interval MetricsIntervalEnum) ([]VolumeSpaceMetric, error)
+	SpaceMetricsByCluster(ctx context.Context, clusterID string,
+		interval MetricsIntervalEnum) ([]ClusterSpaceMetric, error)
+	WearMetricsByDrive(ctx context.Context, driveID string, interval MetricsIntervalEnum) ([]DriveWearMetric, error)
+	GetHardware(ctx context.Context, id string) (Hardware, error)
+	GetHardwareList(ctx context.Context) ([]Hardware, error)
+	GetHardwareByType(ctx context.Context, hwType HardwareTypeEnum) ([]Hardware, error)
+	GetFailedHardware(ctx context.Context) ([]Hardware, error)
+	GetLatencyHistogramByVolume(ctx context.Context, volID string, interval MetricsIntervalEnum) (LatencyHistogram, error)
+	GetLatencyHistogramByAppliance(ctx context.Context, applianceID string,
+		interval MetricsIntervalEnum) (LatencyHistogram, error)
+	GetLatencyHistogramByNode(ctx context.Context, nodeID string, interval MetricsIntervalEnum) (LatencyHistogram, error)
+	GetCapacityForecast(ctx context.Context, applianceID string) (CapacityForecast, error)
+	GetReplicationThroughput(ctx context.Context, remoteSystemID string,
+		interval MetricsIntervalEnum) (ReplicationThroughput, error)
+	GetLicenses(ctx context.Context) ([]License, error)
+	GetJob(ctx context.Context, id string) (Job, error)
+	WatchJobs(ctx context.Context, jobIDs []string) <-chan JobResult
+	WatchVolumes(ctx context.Context, interval time.Duration, filter *QueryFilter) (<-chan VolumeEvent, error)
+	WatchHosts(ctx context.Context, interval time.Duration) (<-chan HostEvent, error)
+	WaitForJob(ctx context.Context, id string) (Job, error)
+	WaitForJobCompletion(ctx context.Context, id string, pollInterval time.Duration) (Job, error)
+	GetVolumeGroup(ctx context.Context, id string) (VolumeGroup, error)
+	GetVolumeGroupByName(ctx context.Context, name string) (VolumeGroup, error)
+	GetVolumeGroups(ctx context.Context) ([]VolumeGroup, error)
+	GetVolumeGroupsByVolumeID(ctx context.Context, volID string) ([]VolumeGroup, error)
+	CreateVolumeGroup(ctx context.Context, createParams *VolumeGroupCreate) (CreateResponse, error)
+	ModifyVolumeGroup(ctx context.Context, modifyParams *VolumeGroupModify, id string) (EmptyResponse, error)
+	DeleteVolumeGroup(ctx context.Context, id string) (EmptyResponse, error)
+	AddMembersToVolumeGroup(ctx context.Context, groupID string, volumeIDs []string) (EmptyResponse, error)
+	RemoveMembersFromVolumeGroup(ctx context.Context, groupID string, volumeIDs []string) (EmptyResponse, error)
+	GetVolumeGroupSnapshots(ctx context.Context, groupID string) ([]VolumeGroup, error)
+	RestoreVolumeGroupFromSnapshot(ctx context.Context, groupID string, restoreParams *VolumeGroupRestore) (EmptyResponse, error)
+	CreateVolumeGroupSnapshot(ctx context.Context, groupID string,
+		createParams *VolumeGroupSnapshotCreate) (CreateResponse, error)
+	CloneVolumeGroup(ctx context.Context, groupID string, cloneParams *VolumeGroupClone) (JobResponse, error)
+	RefreshVolumeGroup(ctx context.Context, groupID string, refreshParams *VolumeGroupRefresh) (JobResponse, error)
+	GetVolumeGroupCloneMapping(ctx context.Context, cloneGroupID string) (map[string]string, error)
+	DescribeDeletion(ctx context.Context, resourceType ResourceTypeEnum, id string) (DeletionImpact, error)
 }
 
 // ClientIMPL provides basic API client implementation
 type ClientIMPL struct {
 	API api.Client
+	// reject volume creation without protection policy
+	requireProtectionPolicy bool
+	// results of slow-changing lookups, nil if caching is disabled
+	cache *lookupCache
+	// detected version of the array
+	version *versionCache
 }
 
 // SetTraceID method allows to set tracing ID to context which will be used in log messages
@@ -88,6 +418,35 @@ func (c *ClientIMPL) SetCustomHTTPHeaders(headers http.Header) {
 	c.API.SetCustomHTTPHeaders(headers)
 }
 
+// WithRequestTimeout returns copy of the context which limits every request made with it to timeout,
+// while deadline of the context itself bounds the whole operation, e.g. WaitForJob or paginated reads
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return api.WithRequestTimeout(ctx, timeout)
+}
+
+// RespMeta holds metadata of the response: http status, pagination and request id assigned by the array
+type RespMeta = api.RespMeta
+
+// WithRespMeta returns copy of the context which records metadata of the last response to meta,
+// request id included, so it may be passed to support when a call fails
+func WithRespMeta(ctx context.Context, meta *RespMeta) context.Context {
+	return api.WithRespMeta(ctx, meta)
+}
+
+// ClientConfig is a snapshot of effective client settings, credentials are omitted
+type ClientConfig api.Config
+
+// Config returns effective client configuration with secrets redacted
+func (c *ClientIMPL) Config() ClientConfig {
+	return ClientConfig(c.API.Config())
+}
+
+// Logout ends login session of the client, it does nothing if session authentication is disabled.
+// Client may still be used after Logout, next request creates a new session
+func (c *ClientIMPL) Logout(ctx context.Context) error {
+	return WrapErr(c.API.Logout(ctx))
+}
+
 // Logger is interface required for gopowerstore custom logger
 type Logger api.Logger
 
@@ -96,11 +455,62 @@ func (c *ClientIMPL) SetLogger(logger Logger) {
 	c.API.SetLogger(api.Logger(logger))
 }
 
+// RequestTracer is interface of hook which receives every request sent to the array and its response
+type RequestTracer api.RequestTracer
+
+// RetryPolicy controls retries of failed requests, see ClientOptions.SetRetryPolicy
+type RetryPolicy api.RetryPolicy
+
+// StructuredLogger is interface of logger with fields, see ClientOptions.SetStructuredLogger
+type StructuredLogger api.StructuredLogger
+
+// LogField is key-value pair passed to StructuredLogger.
+// Aliases of api types let callers implement loggers and hooks without importing the api package
+type LogField = api.LogField
+
+// RequestHooks are callbacks invoked for every request and response, see ClientOptions.SetRequestHooks
+type RequestHooks = api.RequestHooks
+
+// RequestInfo describes request passed to RequestHooks
+type RequestInfo = api.RequestInfo
+
+// ResponseInfo describes response passed to RequestHooks
+type ResponseInfo = api.ResponseInfo
+
+// SpanTracer creates client spans of REST calls, see ClientOptions.SetSpanTracer
+type SpanTracer api.SpanTracer
+
+// Span is client span returned by SpanTracer
+type Span = api.Span
+
+// SpanAttribute is key-value attribute of the Span
+type SpanAttribute = api.SpanAttribute
+
 // APIClient method returns powerstore API client may be useful for doing raw API requests
 func (c *ClientIMPL) APIClient() api.Client {
 	return c.API
 }
 
+// Do performs raw request to the endpoint which is not wrapped by the client and decodes the response to resp,
+// the request is authenticated, retried and traced as any other and error responses are returned as APIError
+func (c *ClientIMPL) Do(ctx context.Context, cfg RequestConfig, resp interface{}) (api.RespMeta, error) {
+	meta, err := c.APIClient().Query(ctx, cfg, resp)
+	return meta, WrapErr(err)
+}
+
+// copyFilter returns query params with the caller's filter args, so default args added by the method
+// don't change the filter. Filters which are not *api.QueryParams can't be copied and are used as is
+func copyFilter(c Client, filter api.QueryParamsEncoder) api.QueryParamsEncoder {
+	switch qp := filter.(type) {
+	case nil:
+		return c.APIClient().QueryParams()
+	case *api.QueryParams:
+		return qp.Clone()
+	default:
+		return filter
+	}
+}
+
 // method allow to read paginated data from backend
 func (c *ClientIMPL) readPaginatedData(f func(int) (api.RespMeta, error)) error {
 	var err error
@@ -118,14 +528,15 @@ func (c *ClientIMPL) readPaginatedData(f func(int) (api.RespMeta, error)) error
 			meta, err = f(nextOffset)
 			err = WrapErr(err)
 			if err != nil {
-				apiError, ok := err.(*APIError)
-				if !ok {
-					return err
-				}
-				if apiError.BadRange() {
+				apiError, ok := err.(APIError)
+				if ok && apiError.BadRange() {
 					// could happen if some instances was deleted during pagination
 					break
 				}
+				return err
+			}
+			if !meta.Pagination.IsPaginate {
+				break
 			}
 		}
 	}
@@ -155,11 +566,39 @@ func NewClient() (Client, error) {
 func NewClientWithArgs(
 	apiURL string,
 	username, password string, options *ClientOptions) (Client, error) {
-	client, err := api.New(apiURL, username, password,
-		options.Insecure(), options.DefaultTimeout(), options.RequestIDKey())
+	client, err := api.NewWithOptions(apiURL, username, password, api.Options{
+		Insecure:              options.Insecure(),
+		DefaultTimeout:        options.DefaultTimeout(),
+		RequestTimeout:        options.RequestTimeout(),
+		RequestIDKey:          options.RequestIDKey(),
+		MinTLSVersion:         options.MinTLSVersion(),
+		CipherSuites:          options.CipherSuites(),
+		RetryCount:            options.RetryCount(),
+		RetryTimeout:          options.RetryTimeout(),
+		RequestsPerSecond:     options.RequestLimit(),
+		MaxConcurrentRequests: options.MaxConcurrentRequests(),
+		MaxIdleConnsPerHost:   options.MaxIdleConnsPerHost(),
+		HTTPClient:            options.HTTPClient(),
+		Transport:             options.Transport(),
+		ProxyURL:              options.ProxyURL(),
+		CACertificates:        options.CACertificates(),
+		ClientCertificates:    options.ClientCertificates(),
+		RequestTracer:         api.RequestTracer(options.RequestTracer()),
+		SessionAuth:           options.SessionAuth(),
+		RetryPolicy:           (*api.RetryPolicy)(options.RetryPolicy()),
+		RequestHooks:          options.RequestHooks(),
+		StructuredLogger:      api.StructuredLogger(options.StructuredLogger()),
+		SpanTracer:            api.SpanTracer(options.SpanTracer()),
+		ReadOnly:              options.ReadOnly(),
+		DryRun:                options.DryRun()})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ClientIMPL{client}, nil
+	c := &ClientIMPL{API: client, requireProtectionPolicy: options.RequireProtectionPolicy(),
+		version: &versionCache{}}
+	if c.cache = newLookupCache(options.CacheTTL()); c.cache != nil {
+		c.API = &cachingAPIClient{Client: client, cache: c.cache}
+	}
+	return c, nil
 }
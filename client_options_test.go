@@ -19,8 +19,14 @@
 package gopowerstore
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestClientOptions_Insecure(t *testing.T) {
@@ -36,8 +42,181 @@ func TestClientOptions_DefaultTimeout(t *testing.T) {
 	assert.Equal(t, value, co.DefaultTimeout())
 }
 
+func TestClientOptions_WithDefaultTimeout(t *testing.T) {
+	co := NewClientOptions()
+	assert.Equal(t, clientOptionsDefaultTimeout*time.Second, co.RequestTimeout())
+	co.SetDefaultTimeout(10)
+	assert.Equal(t, 10*time.Second, co.RequestTimeout())
+	co.WithDefaultTimeout(500 * time.Millisecond)
+	assert.Equal(t, 500*time.Millisecond, co.RequestTimeout())
+}
+
 func TestClientOptions_RequestIDKey(t *testing.T) {
 	co := NewClientOptions()
 	co.SetRequestIDKey("foobar")
 	assert.Equal(t, "foobar", co.RequestIDKey())
 }
+
+func TestClientOptions_TLS(t *testing.T) {
+	co := NewClientOptions()
+	assert.Equal(t, uint16(0), co.MinTLSVersion())
+	assert.Nil(t, co.CipherSuites())
+	suites := []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384}
+	co.SetMinTLSVersion(tls.VersionTLS12).SetCipherSuites(suites)
+	assert.Equal(t, uint16(tls.VersionTLS12), co.MinTLSVersion())
+	assert.Equal(t, suites, co.CipherSuites())
+}
+
+func TestClientOptions_RequireProtectionPolicy(t *testing.T) {
+	co := NewClientOptions()
+	assert.False(t, co.RequireProtectionPolicy())
+	co.SetRequireProtectionPolicy(true)
+	assert.True(t, co.RequireProtectionPolicy())
+}
+
+func TestClientOptions_Retry(t *testing.T) {
+	co := NewClientOptions()
+	assert.Equal(t, clientOptionsDefaultRetryCount, co.RetryCount())
+	assert.Equal(t, clientOptionsDefaultRetryTimeout, co.RetryTimeout())
+	co.SetRetryCount(0).SetRetryTimeout(time.Minute)
+	assert.Equal(t, 0, co.RetryCount())
+	assert.Equal(t, time.Minute, co.RetryTimeout())
+}
+
+func TestClientOptions_RequestLimit(t *testing.T) {
+	co := NewClientOptions()
+	assert.Equal(t, 0, co.RequestLimit())
+	co.SetRequestLimit(10)
+	assert.Equal(t, 10, co.RequestLimit())
+}
+
+func TestClientOptions_MaxIdleConnsPerHost(t *testing.T) {
+	co := NewClientOptions()
+	assert.Equal(t, 0, co.MaxIdleConnsPerHost())
+	co.SetMaxIdleConnsPerHost(200)
+	assert.Equal(t, 200, co.MaxIdleConnsPerHost())
+}
+
+func TestClientOptions_RequestTracer(t *testing.T) {
+	co := NewClientOptions()
+	assert.Nil(t, co.RequestTracer())
+	tracer := &testRequestTracer{}
+	co.SetRequestTracer(tracer)
+	assert.Equal(t, tracer, co.RequestTracer())
+}
+
+type testRequestTracer struct{}
+
+func (t *testRequestTracer) OnRequest(ctx context.Context, method, url string, body []byte) {}
+
+func (t *testRequestTracer) OnResponse(ctx context.Context, status int, body []byte, err error) {}
+
+func TestClientOptions_SessionAuth(t *testing.T) {
+	co := NewClientOptions()
+	assert.False(t, co.SessionAuth())
+	co.SetSessionAuth(true)
+	assert.True(t, co.SessionAuth())
+	c, err := NewClientWithArgs("https://127.0.0.1/api/rest", "admin", "password", co)
+	assert.Nil(t, err)
+	assert.True(t, c.Config().SessionAuth)
+	// no request was sent, so there is no session to end
+	assert.Nil(t, c.Logout(context.Background()))
+}
+
+func TestClientOptions_RetryPolicy(t *testing.T) {
+	co := NewClientOptions()
+	assert.Nil(t, co.RetryPolicy())
+	co.SetRetryCount(5).SetRetryPolicy(RetryPolicy{MaxRetries: 2, Timeout: time.Second})
+	assert.Equal(t, 2, co.RetryPolicy().MaxRetries)
+	c, err := NewClientWithArgs("https://127.0.0.1/api/rest", "admin", "password", co)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, c.Config().RetryCount)
+	assert.Equal(t, time.Second, c.Config().RetryTimeout)
+}
+
+type testStructuredLogger struct {
+	messages []string
+}
+
+func (l *testStructuredLogger) Debug(ctx context.Context, msg string, fields ...LogField) {
+	l.messages = append(l.messages, msg)
+}
+
+func (l *testStructuredLogger) Info(ctx context.Context, msg string, fields ...LogField) {
+	l.messages = append(l.messages, msg)
+}
+
+func (l *testStructuredLogger) Error(ctx context.Context, msg string, fields ...LogField) {
+	l.messages = append(l.messages, msg)
+}
+
+func TestClientOptions_RequestHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "A1"}`))
+	}))
+	defer server.Close()
+	var latency time.Duration
+	logger := &testStructuredLogger{}
+	co := NewClientOptions().SetStructuredLogger(logger).SetRequestHooks(RequestHooks{
+		OnResponse: func(ctx context.Context, info ResponseInfo) {
+			latency = info.Latency
+		}})
+	assert.Equal(t, logger, co.StructuredLogger())
+	c, err := NewClientWithArgs(server.URL, "admin", "password", co)
+	assert.Nil(t, err)
+	_, err = c.GetVolume(context.Background(), "A1")
+	assert.Nil(t, err)
+	assert.True(t, latency > 0)
+	assert.Equal(t, []string{"request completed"}, logger.messages)
+}
+
+type testSpanTracer struct {
+	names []string
+}
+
+func (t *testSpanTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	t.names = append(t.names, name)
+	return ctx, testSpan{}
+}
+
+func (t *testSpanTracer) Inject(ctx context.Context, header http.Header) {}
+
+type testSpan struct{}
+
+func (testSpan) SetAttributes(attrs ...SpanAttribute) {}
+
+func (testSpan) RecordError(err error) {}
+
+func (testSpan) End() {}
+
+func TestClientOptions_SpanTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "A1"}`))
+	}))
+	defer server.Close()
+	co := NewClientOptions()
+	assert.Nil(t, co.SpanTracer())
+	tracer := &testSpanTracer{}
+	co.SetSpanTracer(tracer)
+	assert.Equal(t, tracer, co.SpanTracer())
+	c, err := NewClientWithArgs(server.URL, "admin", "password", co)
+	assert.Nil(t, err)
+	_, err = c.GetVolume(context.Background(), "A1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET volume"}, tracer.names)
+}
+
+func TestClientOptions_ReadOnlyDryRun(t *testing.T) {
+	co := NewClientOptions()
+	assert.False(t, co.ReadOnly())
+	assert.False(t, co.DryRun())
+	co.SetReadOnly(true).SetDryRun(true)
+	assert.True(t, co.ReadOnly())
+	assert.True(t, co.DryRun())
+
+	c, err := NewClientWithArgs("https://foo", "admin", "password", NewClientOptions().SetReadOnly(true))
+	assert.Nil(t, err)
+	assert.True(t, c.Config().ReadOnly)
+	_, err = c.DeleteVolume(context.Background(), nil, "A1")
+	assert.True(t, errors.Is(err, ErrReadOnly))
+}
@@ -20,9 +20,17 @@ package gopowerstore
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"github.com/dell/gopowerstore/api"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 )
 
 var C Client
@@ -42,6 +50,13 @@ func init() {
 	initClient()
 }
 
+// pageResponse returns 206 Partial Content response with the content-range header
+func pageResponse(body, contentRange string) *http.Response {
+	resp := httpmock.NewStringResponse(http.StatusPartialContent, body)
+	resp.Header.Set("Content-Range", contentRange)
+	return resp
+}
+
 func TestNewClient(t *testing.T) {
 	os.Setenv(InsecureEnv, "true")
 	os.Setenv(APIURLEnv, "api")
@@ -60,3 +75,179 @@ func TestClientIMPL_SetTraceID(t *testing.T) {
 	ctx = C.SetTraceID(ctx, "123")
 	assert.Equal(t, "123", ctx.Value(clientOptionsDefaultRequestIDKey))
 }
+
+func TestNewClientWithArgs_TLS(t *testing.T) {
+	options := NewClientOptions()
+	options.SetMinTLSVersion(tls.VersionTLS13)
+	options.SetCipherSuites([]uint16{tls.TLS_RSA_WITH_AES_128_CBC_SHA})
+	_, err := NewClientWithArgs(APIMockURL, "admin", "Password", options)
+	assert.NotNil(t, err)
+}
+
+func TestNewClientWithArgs_SelfSignedCert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": "0", "name": "cluster"}]`))
+	}))
+	defer server.Close()
+	apiURL := server.URL + "/api/rest/"
+
+	newClient := func(options *ClientOptions) Client {
+		c, err := NewClientWithArgs(apiURL, "admin", "Password", options)
+		assert.Nil(t, err)
+		return c
+	}
+	_, err := newClient(NewClientOptions()).GetCluster(context.Background())
+	assert.NotNil(t, err)
+	_, err = newClient(NewClientOptions().SetInsecure(true)).GetCluster(context.Background())
+	assert.Nil(t, err)
+
+	// server.Client trusts the self-signed cert
+	c := newClient(NewClientOptions().SetHTTPClient(server.Client()))
+	assert.True(t, c.APIClient().Config().CustomHTTPClient)
+	_, err = c.GetCluster(context.Background())
+	assert.Nil(t, err)
+
+	_, err = NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetHTTPClient(server.Client()).SetInsecure(true))
+	assert.NotNil(t, err)
+}
+
+func TestNewClientWithArgs_CustomCAAndClientCert(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": "0", "name": "cluster"}]`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+	apiURL := server.URL + "/api/rest/"
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	// array certificate is trusted, but client certificate is missing
+	c, err := NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetCACertificates(caPEM))
+	assert.Nil(t, err)
+	_, err = c.GetCluster(context.Background())
+	assert.NotNil(t, err)
+
+	c, err = NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetCACertificates(caPEM).
+			SetClientCertificates(server.TLS.Certificates))
+	assert.Nil(t, err)
+	_, err = c.GetCluster(context.Background())
+	assert.Nil(t, err)
+	cfg := c.APIClient().Config()
+	assert.True(t, cfg.CustomCA)
+	assert.True(t, cfg.ClientCertificate)
+
+	_, err = NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetCACertificates([]byte("not a certificate")))
+	assert.NotNil(t, err)
+}
+
+func TestNewClientWithArgs_Transport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": "0", "name": "cluster"}]`))
+	}))
+	defer server.Close()
+	apiURL := server.URL + "/api/rest/"
+
+	c, err := NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetTransport(server.Client().Transport))
+	assert.Nil(t, err)
+	assert.True(t, c.APIClient().Config().CustomTransport)
+	_, err = c.GetCluster(context.Background())
+	assert.Nil(t, err)
+
+	_, err = NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetTransport(server.Client().Transport).SetProxyURL("http://proxy:3128"))
+	assert.NotNil(t, err)
+	_, err = NewClientWithArgs(apiURL, "admin", "Password",
+		NewClientOptions().SetTransport(server.Client().Transport).SetHTTPClient(server.Client()))
+	assert.NotNil(t, err)
+}
+
+func TestNewClientWithArgs_DefaultTimeout(t *testing.T) {
+	// server never responds until the client gives up
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	c, err := NewClientWithArgs(server.URL+"/api/rest/", "admin", "Password",
+		NewClientOptions().WithDefaultTimeout(100*time.Millisecond))
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_, err = c.GetCluster(context.Background())
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.True(t, time.Since(start) < 5*time.Second)
+
+	// deadline of the caller is kept
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.GetCluster(ctx)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestClientIMPL_Config(t *testing.T) {
+	cfg := C.Config()
+	assert.Equal(t, APIMockURL, cfg.APIURL)
+	assert.Equal(t, "admin", cfg.Username)
+	assert.Equal(t, uint64(clientOptionsDefaultTimeout), cfg.DefaultTimeout)
+	assert.Equal(t, clientOptionsDefaultTimeout*time.Second, cfg.RequestTimeout)
+	assert.Equal(t, clientOptionsDefaultRequestIDKey, cfg.RequestIDKey)
+	assert.Equal(t, clientOptionsDefaultRetryCount, cfg.RetryCount)
+	assert.Equal(t, clientOptionsDefaultRetryTimeout, cfg.RetryTimeout)
+	assert.Equal(t, 0, cfg.RequestsPerSecond)
+}
+
+func TestClientIMPL_Do(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", APIMockURL+"smtp_config/0",
+		httpmock.NewStringResponder(200, `{"id": "0", "address": "smtp.example.com", "port": 25}`))
+	var smtp struct {
+		ID      string `json:"id"`
+		Address string `json:"address"`
+		Port    int    `json:"port"`
+	}
+	meta, err := C.Do(context.Background(),
+		RequestConfig{Method: "GET", Endpoint: "smtp_config", ID: "0"}, &smtp)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, meta.Status)
+	assert.Equal(t, "smtp.example.com", smtp.Address)
+	assert.Equal(t, 25, smtp.Port)
+
+	httpmock.RegisterResponder("GET", APIMockURL+"smtp_config/1",
+		httpmock.NewStringResponder(404, `{"messages": [{"code": "0xE04040010005", "severity": "Error"}]}`))
+	_, err = C.Do(context.Background(),
+		RequestConfig{Method: "GET", Endpoint: "smtp_config", ID: "1"}, &smtp)
+	assert.NotNil(t, err)
+	apiError, ok := err.(APIError)
+	assert.True(t, ok)
+	assert.True(t, apiError.NotFound())
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClientIMPL_readPaginatedData(t *testing.T) {
+	c := C.(*ClientIMPL)
+	var offsets []int
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		offsets = append(offsets, offset)
+		if offset == 4 {
+			// instances were deleted during pagination
+			return api.RespMeta{}, &api.ErrorMsg{StatusCode: http.StatusRequestedRangeNotSatisfiable}
+		}
+		return api.RespMeta{Pagination: api.PaginationInfo{
+			First: offset, Last: offset + 1, Total: 6, IsPaginate: true}}, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2, 4}, offsets)
+
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		if offset > 0 {
+			return api.RespMeta{}, &api.ErrorMsg{StatusCode: http.StatusInternalServerError}
+		}
+		return api.RespMeta{Pagination: api.PaginationInfo{Last: 1, Total: 6, IsPaginate: true}}, nil
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	clusterURL           = "cluster"
+	softwareInstalledURL = "software_installed"
+)
+
+// GetCluster returns the cluster the client is connected to
+func (c *ClientIMPL) GetCluster(ctx context.Context) (resp Cluster, err error) {
+	var clusterList []Cluster
+	cluster := Cluster{}
+	qp := c.APIClient().QueryParamsWithFields(&cluster)
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    clusterURL,
+			QueryParams: qp},
+		&clusterList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(clusterList) == 0 {
+		return resp, errors.New("can't get cluster")
+	}
+	return clusterList[0], nil
+}
+
+// ModifyCluster modifies the cluster, e.g. enables CHAP authentication of iSCSI connections
+func (c *ClientIMPL) ModifyCluster(ctx context.Context,
+	modifyParams *ClusterModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: clusterURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetSoftwareInstalled returns software installed on the cluster and on every appliance
+func (c *ClientIMPL) GetSoftwareInstalled(ctx context.Context) ([]SoftwareInstalled, error) {
+	result := []SoftwareInstalled{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []SoftwareInstalled
+		software := SoftwareInstalled{}
+		qp := c.APIClient().QueryParamsWithFields(&software)
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    softwareInstalledURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetSoftwareVersion returns PowerStore OS release version of the cluster, e.g. "2.0.0.0"
+func (c *ClientIMPL) GetSoftwareVersion(ctx context.Context) (string, error) {
+	software, err := c.GetSoftwareInstalled(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, s := range software {
+		if s.IsCluster && s.ReleaseVersion != "" {
+			return s.ReleaseVersion, nil
+		}
+	}
+	return "", errors.New("can't get cluster software version")
+}
@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	clusterMockURL           = APIMockURL + clusterURL
+	softwareInstalledMockURL = APIMockURL + softwareInstalledURL
+)
+
+func TestClientIMPL_GetCluster(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", clusterMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "0", "name": "PS-cluster", "management_address": "10.0.0.10",
+"state": "Configured", "system_time": "2020-05-06T10:00:00.000Z"}]`))
+	cluster, err := C.GetCluster(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "PS-cluster", cluster.Name)
+	assert.Equal(t, "10.0.0.10", cluster.ManagementAddress)
+	assert.Equal(t, ClusterStateEnumConfigured, cluster.State)
+	assert.Equal(t, "2020-05-06T10:00:00.000Z", cluster.SystemTime)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", clusterMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetCluster(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetClusterNVMeAndChap(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	nqn := "nqn.1988-11.com.dell:powerstore:00:a1b2c3d4e5f6A1B2C3D4"
+	httpmock.RegisterResponder("GET", clusterMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "0", "nvm_subsystem_nqn": "%s",
+"chap_mode": "Mutual"}]`, nqn)))
+	cluster, err := C.GetCluster(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, nqn, cluster.NVMeSubsystemNQN)
+	assert.Equal(t, ChapModeEnumMutual, cluster.ChapMode)
+}
+
+func TestClientIMPL_ModifyCluster(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", clusterMockURL, "0"),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	mode := ChapModeEnumSingle
+	_, err := C.ModifyCluster(context.Background(), &ClusterModify{ChapMode: &mode}, "0")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"chap_mode": "Single"}, reqBody)
+}
+
+func TestClientIMPL_GetSoftwareVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", softwareInstalledMockURL,
+		httpmock.NewStringResponder(200, `[
+{"id": "1", "is_cluster": false, "release_version": "2.0.1.0", "appliance_id": "A1"},
+{"id": "2", "is_cluster": true, "release_version": "2.0.1.0", "build_version": "2.0.1.0.5.003"}]`))
+	software, err := C.GetSoftwareInstalled(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, software, 2)
+	assert.Equal(t, "A1", software[0].ApplianceID)
+
+	version, err := C.GetSoftwareVersion(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "2.0.1.0", version)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", softwareInstalledMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "1", "is_cluster": false, "release_version": "2.0.1.0"}]`))
+	_, err = C.GetSoftwareVersion(context.Background())
+	assert.NotNil(t, err)
+}
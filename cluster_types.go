@@ -0,0 +1,110 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// ClusterStateEnum state of the cluster
+type ClusterStateEnum string
+
+const (
+	// ClusterStateEnumConfigurable captures enum value "Configurable"
+	ClusterStateEnumConfigurable ClusterStateEnum = "Configurable"
+	// ClusterStateEnumUnconfigured captures enum value "Unconfigured"
+	ClusterStateEnumUnconfigured ClusterStateEnum = "Unconfigured"
+	// ClusterStateEnumConfiguring captures enum value "Configuring"
+	ClusterStateEnumConfiguring ClusterStateEnum = "Configuring"
+	// ClusterStateEnumConfigured captures enum value "Configured"
+	ClusterStateEnumConfigured ClusterStateEnum = "Configured"
+	// ClusterStateEnumExpanding captures enum value "Expanding"
+	ClusterStateEnumExpanding ClusterStateEnum = "Expanding"
+	// ClusterStateEnumRemoving captures enum value "Removing"
+	ClusterStateEnumRemoving ClusterStateEnum = "Removing"
+	// ClusterStateEnumClusteringFailed captures enum value "Clustering_Failed"
+	ClusterStateEnumClusteringFailed ClusterStateEnum = "Clustering_Failed"
+)
+
+// Cluster details about the cluster
+type Cluster struct {
+	// Unique identifier of the cluster.
+	ID string `json:"id,omitempty"`
+	// Name of the cluster.
+	Name string `json:"name,omitempty"`
+	// Global unique identifier of the cluster.
+	GlobalID string `json:"global_id,omitempty"`
+	// Floating management IP address of the cluster.
+	ManagementAddress string `json:"management_address,omitempty"`
+	// State of the cluster.
+	State ClusterStateEnum `json:"state,omitempty"`
+	// Current time of the cluster.
+	SystemTime string `json:"system_time,omitempty"`
+	// Unique identifier of the primary appliance.
+	PrimaryApplianceID string `json:"primary_appliance_id,omitempty"`
+	// NVMe qualified name of the cluster subsystem, hosts use it to connect over NVMe-oF.
+	NVMeSubsystemNQN string `json:"nvm_subsystem_nqn,omitempty"`
+	// CHAP authentication mode of iSCSI connections.
+	ChapMode ChapModeEnum `json:"chap_mode,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (c *Cluster) Fields() []string {
+	return []string{"id", "name", "global_id", "management_address", "state",
+		"system_time", "primary_appliance_id", "nvm_subsystem_nqn", "chap_mode"}
+}
+
+// ChapModeEnum CHAP authentication mode of iSCSI connections
+type ChapModeEnum string
+
+const (
+	// ChapModeEnumDisabled captures enum value "Disabled"
+	ChapModeEnumDisabled ChapModeEnum = "Disabled"
+	// ChapModeEnumSingle captures enum value "Single", the array authenticates initiators
+	ChapModeEnumSingle ChapModeEnum = "Single"
+	// ChapModeEnumMutual captures enum value "Mutual", initiators authenticate the array as well
+	ChapModeEnumMutual ChapModeEnum = "Mutual"
+)
+
+// ClusterModify modify cluster request, unset fields are not changed
+type ClusterModify struct {
+	// Name of the cluster.
+	Name *string `json:"name,omitempty"`
+	// CHAP authentication mode of iSCSI connections. Before the mode is enabled every iSCSI
+	// initiator must have CHAP credentials of the mode set.
+	ChapMode *ChapModeEnum `json:"chap_mode,omitempty"`
+}
+
+// SoftwareInstalled software package installed on the cluster or appliance
+type SoftwareInstalled struct {
+	// Unique identifier of the installed software.
+	ID string `json:"id,omitempty"`
+	// Indicates whether the entry describes cluster-wide software rather than single appliance.
+	IsCluster bool `json:"is_cluster,omitempty"`
+	// Release version, e.g. "2.0.0.0".
+	ReleaseVersion string `json:"release_version,omitempty"`
+	// Build version of the release.
+	BuildVersion string `json:"build_version,omitempty"`
+	// Release timestamp.
+	ReleaseTimestamp string `json:"release_timestamp,omitempty"`
+	// Unique identifier of the appliance, empty for cluster-wide entry.
+	ApplianceID string `json:"appliance_id,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *SoftwareInstalled) Fields() []string {
+	return []string{"id", "is_cluster", "release_version", "build_version",
+		"release_timestamp", "appliance_id"}
+}
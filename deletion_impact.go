@@ -0,0 +1,154 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+// dependencyQuery describes how to find objects of one type which depend on the resource
+type dependencyQuery struct {
+	resourceType ResourceTypeEnum
+	endpoint     string
+	fields       []string
+	filters      map[string]string
+	blocking     bool
+}
+
+// DescribeDeletion reports objects which block or will be affected by deletion of the resource.
+// Supported resource types are volume, file_system and host. Nothing is deleted.
+func (c *ClientIMPL) DescribeDeletion(ctx context.Context,
+	resourceType ResourceTypeEnum, id string) (resp DeletionImpact, err error) {
+	resp.ResourceType = resourceType
+	resp.ID = id
+	var queries []dependencyQuery
+	switch resourceType {
+	case ResourceTypeEnumVolume:
+		queries = []dependencyQuery{
+			{ResourceTypeEnumHostVolumeMapping, hostMappingURL, []string{"id"},
+				map[string]string{"volume_id": fmt.Sprintf("eq.%s", id)}, true},
+			{ResourceTypeEnumReplicationSession, replicationSessionURL, []string{"id"},
+				map[string]string{"local_resource_id": fmt.Sprintf("eq.%s", id)}, true},
+			{ResourceTypeEnumSnapshot, volumeURL, []string{"id", "name"},
+				map[string]string{"protection_data->>source_id": fmt.Sprintf("eq.%s", id),
+					"type": fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot)}, false},
+			{ResourceTypeEnumClone, volumeURL, []string{"id", "name"},
+				map[string]string{"protection_data->>parent_id": fmt.Sprintf("eq.%s", id),
+					"type": fmt.Sprintf("eq.%s", VolumeTypeEnumClone)}, false},
+		}
+		var groups []DeletionDependency
+		groups, err = c.getVolumeGroupDependencies(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+		resp.Dependencies = append(resp.Dependencies, groups...)
+	case ResourceTypeEnumHost:
+		queries = []dependencyQuery{
+			{ResourceTypeEnumHostVolumeMapping, hostMappingURL, []string{"id"},
+				map[string]string{"host_id": fmt.Sprintf("eq.%s", id)}, true},
+		}
+		var host Host
+		host, err = c.GetHost(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+		if host.HostGroupID != "" {
+			resp.Dependencies = append(resp.Dependencies, DeletionDependency{
+				ResourceType: ResourceTypeEnumHostGroup, ID: host.HostGroupID, Blocking: true})
+		}
+	case ResourceTypeEnumFileSystem:
+		queries = []dependencyQuery{
+			{ResourceTypeEnumNFSExport, nfsURL, []string{"id", "name"},
+				map[string]string{"file_system_id": fmt.Sprintf("eq.%s", id)}, true},
+			{ResourceTypeEnumSMBShare, "smb_share", []string{"id", "name"},
+				map[string]string{"file_system_id": fmt.Sprintf("eq.%s", id)}, true},
+			{ResourceTypeEnumReplicationSession, replicationSessionURL, []string{"id"},
+				map[string]string{"local_resource_id": fmt.Sprintf("eq.%s", id)}, true},
+			{ResourceTypeEnumSnapshot, fsURL, []string{"id", "name"},
+				map[string]string{"parent_id": fmt.Sprintf("eq.%s", id),
+					"filesystem_type": "eq.Snapshot"}, false},
+		}
+	default:
+		return resp, fmt.Errorf("deletion impact is not supported for resource type: %s", resourceType)
+	}
+	for _, q := range queries {
+		var deps []DeletionDependency
+		deps, err = c.getDependencies(ctx, q)
+		if err != nil {
+			return resp, err
+		}
+		resp.Dependencies = append(resp.Dependencies, deps...)
+	}
+	return resp, nil
+}
+
+func (c *ClientIMPL) getDependencies(ctx context.Context, q dependencyQuery) (resp []DeletionDependency, err error) {
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []resourceRef
+		qp := c.APIClient().QueryParams().Select(q.fields...)
+		for k, v := range q.filters {
+			qp.RawArg(k, v)
+		}
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    q.endpoint,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			for _, r := range page {
+				resp = append(resp, DeletionDependency{
+					ResourceType: q.resourceType, ID: r.ID, Name: r.Name, Blocking: q.blocking})
+			}
+		}
+		return meta, err
+	})
+	return resp, err
+}
+
+func (c *ClientIMPL) getVolumeGroupDependencies(ctx context.Context, volID string) ([]DeletionDependency, error) {
+	var vol struct {
+		VolumeGroups []resourceRef `json:"volume_groups"`
+	}
+	_, err := c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			ID:          volID,
+			QueryParams: c.APIClient().QueryParams().Select("volume_groups(id,name)")},
+		&vol)
+	err = WrapErr(err)
+	if err != nil {
+		return nil, err
+	}
+	var resp []DeletionDependency
+	for _, g := range vol.VolumeGroups {
+		resp = append(resp, DeletionDependency{
+			ResourceType: ResourceTypeEnumVolumeGroup, ID: g.ID, Name: g.Name, Blocking: true})
+	}
+	return resp, nil
+}
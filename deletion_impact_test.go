@@ -0,0 +1,75 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestClientIMPL_DescribeDeletion_Volume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	groupID := volumeGroupID
+	snapID := "a9e4d7c1-72e8-4de2-8b0a-3d8b1c2e8f10"
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"volume_groups": [{"id": "%s", "name": "vg"}]}`, groupID)))
+	httpmock.RegisterResponder("GET", hostMappingMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}]`, hostID)))
+	httpmock.RegisterResponder("GET", APIMockURL+"replication_session",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("type") == "eq.Snapshot" {
+				return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "snap"}]`, snapID)), nil
+			}
+			return httpmock.NewStringResponse(200, `[]`), nil
+		})
+
+	resp, err := C.DescribeDeletion(context.Background(), ResourceTypeEnumVolume, volID)
+	assert.Nil(t, err)
+	assert.True(t, resp.Blocked())
+	assert.Len(t, resp.Dependencies, 3)
+	assert.Equal(t, DeletionDependency{ResourceTypeEnumVolumeGroup, groupID, "vg", true}, resp.Dependencies[0])
+	assert.Equal(t, DeletionDependency{ResourceTypeEnumHostVolumeMapping, hostID, "", true}, resp.Dependencies[1])
+	assert.Equal(t, DeletionDependency{ResourceTypeEnumSnapshot, snapID, "snap", false}, resp.Dependencies[2])
+}
+
+func TestClientIMPL_DescribeDeletion_Host(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", hostMockURL, hostID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s"}`, hostID)))
+	httpmock.RegisterResponder("GET", hostMappingMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+
+	resp, err := C.DescribeDeletion(context.Background(), ResourceTypeEnumHost, hostID)
+	assert.Nil(t, err)
+	assert.False(t, resp.Blocked())
+	assert.Empty(t, resp.Dependencies)
+}
+
+func TestClientIMPL_DescribeDeletion_Unsupported(t *testing.T) {
+	_, err := C.DescribeDeletion(context.Background(), ResourceTypeEnumSnapshot, volID)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,58 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// DeletionDependency object which depends on the resource planned for deletion
+type DeletionDependency struct {
+	// Type of the dependent object.
+	ResourceType ResourceTypeEnum
+	// Unique identifier of the dependent object.
+	ID string
+	// Name of the dependent object, empty for objects without name.
+	Name string
+	// Indicates that the array will reject deletion until the dependency is removed.
+	// Non blocking dependencies are removed or detached together with the resource.
+	Blocking bool
+}
+
+// DeletionImpact report about objects affected by deletion of the resource
+type DeletionImpact struct {
+	// Type of the resource planned for deletion.
+	ResourceType ResourceTypeEnum
+	// Unique identifier of the resource planned for deletion.
+	ID string
+	// Objects which block deletion or will be affected by it.
+	Dependencies []DeletionDependency
+}
+
+// Blocked returns true if at least one dependency blocks deletion
+func (di *DeletionImpact) Blocked() bool {
+	for _, d := range di.Dependencies {
+		if d.Blocking {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceRef holds identity of any PowerStore object
+type resourceRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
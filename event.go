@@ -0,0 +1,150 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const eventURL = "event"
+
+func getEventDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	event := Event{}
+	return c.APIClient().QueryParamsWithFields(&event)
+}
+
+func decodeEventCursor(cursor string) (result eventCursor, err error) {
+	if cursor == "" {
+		return result, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(data, &result)
+	}
+	if err != nil {
+		return result, fmt.Errorf("invalid event cursor: %s", err.Error())
+	}
+	return result, nil
+}
+
+func encodeEventCursor(cursor eventCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// GetEvents returns events of the event log matching the query sorted by time, nil query returns all
+// retained events. Since is sent in UTC, so the timestamp is not affected by the local zone of the caller
+func (c *ClientIMPL) GetEvents(ctx context.Context, query *EventQuery) ([]Event, error) {
+	result := []Event{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Event
+		qp := getEventDefaultQueryParams(c)
+		if query != nil {
+			if query.Severity != "" {
+				qp.RawArg("severity", fmt.Sprintf("eq.%s", query.Severity))
+			}
+			if query.ResourceType != "" {
+				qp.RawArg("resource_type", fmt.Sprintf("eq.%s", query.ResourceType))
+			}
+			if query.ResourceID != "" {
+				qp.RawArg("resource_id", fmt.Sprintf("eq.%s", query.ResourceID))
+			}
+			if !query.Since.IsZero() {
+				qp.RawArg("generated_timestamp",
+					fmt.Sprintf("gt.%s", query.Since.UTC().Format(time.RFC3339)))
+			}
+		}
+		qp.Order("generated_timestamp", "id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    eventURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetEventsSince returns events generated after the position described by cursor, sorted by time,
+// and the cursor to pass on the next call. Empty cursor returns all retained events.
+// PowerStore has no native event sequence number, so the cursor holds the timestamp of the latest
+// returned event together with ids of events sharing that timestamp. Events are requested starting
+// from that timestamp inclusive and already returned ones are dropped, so events generated in the
+// same instant as the previous poll are not lost. Cursor is opaque and doesn't depend on array version
+func (c *ClientIMPL) GetEventsSince(ctx context.Context, cursor string) (resp []Event, next string, err error) {
+	position, err := decodeEventCursor(cursor)
+	if err != nil {
+		return resp, cursor, err
+	}
+	seen := make(map[string]bool, len(position.IDs))
+	for _, id := range position.IDs {
+		seen[id] = true
+	}
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Event
+		qp := getEventDefaultQueryParams(c)
+		if position.Timestamp != "" {
+			qp.RawArg("generated_timestamp", fmt.Sprintf("gte.%s", position.Timestamp))
+		}
+		qp.Order("generated_timestamp", "id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    eventURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	if err != nil {
+		return nil, cursor, WrapErr(err)
+	}
+	var events []Event
+	for _, e := range resp {
+		if e.GeneratedTimestamp == position.Timestamp && seen[e.ID] {
+			continue
+		}
+		events = append(events, e)
+		if e.GeneratedTimestamp != position.Timestamp {
+			position = eventCursor{Timestamp: e.GeneratedTimestamp}
+		}
+		position.IDs = append(position.IDs, e.ID)
+	}
+	return events, encodeEventCursor(position), nil
+}
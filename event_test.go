@@ -0,0 +1,97 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const eventMockURL = APIMockURL + eventURL
+
+func TestClientIMPL_GetEvents(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var query url.Values
+	httpmock.RegisterResponder("GET", eventMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, `[{"id": "e1", "severity": "Minor", "resource_type": "volume"}]`), nil
+		})
+	since := time.Date(2020, 5, 6, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	events, err := C.GetEvents(context.Background(),
+		&EventQuery{Severity: EventSeverityEnumMinor, ResourceType: "volume", Since: since})
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "e1", events[0].ID)
+	assert.Equal(t, "eq.Minor", query.Get("severity"))
+	assert.Equal(t, "eq.volume", query.Get("resource_type"))
+	assert.Equal(t, "", query.Get("resource_id"))
+	assert.Equal(t, "gt.2020-05-06T10:30:00Z", query.Get("generated_timestamp"))
+
+	events, err = C.GetEvents(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "", query.Get("severity"))
+}
+
+func TestClientIMPL_GetEventsSince(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := `[{"id": "e1", "generated_timestamp": "2020-05-06T10:00:00Z"},
+{"id": "e2", "generated_timestamp": "2020-05-06T10:05:00Z"},
+{"id": "e3", "generated_timestamp": "2020-05-06T10:05:00Z"}]`
+	var timestampFilter string
+	httpmock.RegisterResponder("GET", eventMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			timestampFilter = req.URL.Query().Get("generated_timestamp")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+
+	events, cursor, err := C.GetEventsSince(context.Background(), "")
+	assert.Nil(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, "", timestampFilter)
+	assert.NotEmpty(t, cursor)
+
+	respData = `[{"id": "e2", "generated_timestamp": "2020-05-06T10:05:00Z"},
+{"id": "e3", "generated_timestamp": "2020-05-06T10:05:00Z"},
+{"id": "e4", "generated_timestamp": "2020-05-06T10:05:00Z"}]`
+	events, cursor, err = C.GetEventsSince(context.Background(), cursor)
+	assert.Nil(t, err)
+	assert.Equal(t, "gte.2020-05-06T10:05:00Z", timestampFilter)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "e4", events[0].ID)
+
+	respData = `[{"id": "e2", "generated_timestamp": "2020-05-06T10:05:00Z"},
+{"id": "e3", "generated_timestamp": "2020-05-06T10:05:00Z"},
+{"id": "e4", "generated_timestamp": "2020-05-06T10:05:00Z"}]`
+	events, next, err := C.GetEventsSince(context.Background(), cursor)
+	assert.Nil(t, err)
+	assert.Len(t, events, 0)
+	assert.Equal(t, cursor, next)
+
+	_, _, err = C.GetEventsSince(context.Background(), "not a cursor")
+	assert.NotNil(t, err)
+}
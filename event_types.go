@@ -0,0 +1,81 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import "time"
+
+// EventSeverityEnum severity of the event
+type EventSeverityEnum string
+
+const (
+	// EventSeverityEnumInfo captures enum value "Info"
+	EventSeverityEnumInfo EventSeverityEnum = "Info"
+	// EventSeverityEnumMinor captures enum value "Minor"
+	EventSeverityEnumMinor EventSeverityEnum = "Minor"
+	// EventSeverityEnumMajor captures enum value "Major"
+	EventSeverityEnumMajor EventSeverityEnum = "Major"
+	// EventSeverityEnumCritical captures enum value "Critical"
+	EventSeverityEnumCritical EventSeverityEnum = "Critical"
+)
+
+// Event system event generated by the array
+type Event struct {
+	// Unique identifier of the event.
+	ID string `json:"id,omitempty"`
+	// Code of the event.
+	EventCode string `json:"event_code,omitempty"`
+	// Severity of the event.
+	Severity EventSeverityEnum `json:"severity,omitempty"`
+	// Type of the resource which generated the event.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Unique identifier of the resource which generated the event.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Name of the resource which generated the event.
+	ResourceName string `json:"resource_name,omitempty"`
+	// Time when the event was generated.
+	GeneratedTimestamp string `json:"generated_timestamp,omitempty"`
+	// Localized description of the event.
+	DescriptionL10n string `json:"description_l10n,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (e *Event) Fields() []string {
+	return []string{"id", "event_code", "severity", "resource_type", "resource_id",
+		"resource_name", "generated_timestamp", "description_l10n"}
+}
+
+// EventQuery filters of GetEvents, unset fields don't filter
+type EventQuery struct {
+	// Only events of the severity are returned.
+	Severity EventSeverityEnum
+	// Only events generated by resources of the type are returned, e.g. volume.
+	ResourceType ResourceTypeEnum
+	// Only events generated by the resource are returned.
+	ResourceID string
+	// Only events generated after the time are returned.
+	Since time.Time
+}
+
+// eventCursor position in the event stream
+type eventCursor struct {
+	// Timestamp of the latest seen event.
+	Timestamp string `json:"t"`
+	// Ids of seen events generated at Timestamp.
+	IDs []string `json:"ids"`
+}
@@ -0,0 +1,270 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package fake
+
+import (
+	"net/http"
+
+	"github.com/dell/gopowerstore"
+	"github.com/dell/gopowerstore/api"
+)
+
+func (s *Server) serveHost(r *request) *response {
+	switch {
+	case r.id == "" && r.Method == http.MethodGet:
+		return s.listHosts(r)
+	case r.id == "" && r.Method == http.MethodPost:
+		return s.createHost(r)
+	case r.id == "":
+		return methodNotAllowed(r)
+	}
+	host, ok := s.hosts[r.id]
+	if !ok {
+		return errorResponse(http.StatusNotFound, api.InvalidInstance, "host %s doesn't exist", r.id)
+	}
+	switch {
+	case r.action == "" && r.Method == http.MethodGet:
+		return jsonResponse(http.StatusOK, host)
+	case r.action == "" && r.Method == http.MethodPatch:
+		return s.modifyHost(r, host)
+	case r.action == "" && r.Method == http.MethodDelete:
+		return s.deleteHost(host)
+	case r.action == "attach" && r.Method == http.MethodPost:
+		return s.attachVolume(r, host)
+	case r.action == "detach" && r.Method == http.MethodPost:
+		return s.detachVolume(r, host)
+	}
+	return methodNotAllowed(r)
+}
+
+func (s *Server) listHosts(r *request) *response {
+	items := make([]interface{}, 0, len(s.hosts))
+	for _, v := range s.hosts {
+		items = append(items, v)
+	}
+	return listResponse(r, items)
+}
+
+// initiatorOwner returns id of the host which has initiator with the port name
+func (s *Server) initiatorOwner(portName string) string {
+	for _, h := range s.hosts {
+		for _, i := range h.Initiators {
+			if i.PortName == portName {
+				return h.ID
+			}
+		}
+	}
+	return ""
+}
+
+func (s *Server) addInitiators(host *gopowerstore.Host, initiators []gopowerstore.InitiatorCreateModify) *response {
+	for _, i := range initiators {
+		if i.PortName == nil || i.PortType == nil {
+			return errorResponse(http.StatusBadRequest, "", "port_name and port_type of initiator are required")
+		}
+		if owner := s.initiatorOwner(*i.PortName); owner != "" {
+			return errorResponse(http.StatusUnprocessableEntity, "",
+				"initiator %s is already used by host %s", *i.PortName, owner)
+		}
+	}
+	for _, i := range initiators {
+		initiator := gopowerstore.InitiatorInstance{PortName: *i.PortName, PortType: *i.PortType}
+		if i.ChapSingleUsername != nil {
+			initiator.ChapSingleUsername = *i.ChapSingleUsername
+		}
+		if i.ChapMutualUsername != nil {
+			initiator.ChapMutualUsername = *i.ChapMutualUsername
+		}
+		host.Initiators = append(host.Initiators, initiator)
+	}
+	return nil
+}
+
+func (s *Server) createHost(r *request) *response {
+	var params gopowerstore.HostCreate
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.Name == nil || *params.Name == "" || params.OsType == nil || params.Initiators == nil {
+		return errorResponse(http.StatusBadRequest, "", "name, os_type and initiators are required")
+	}
+	for _, h := range s.hosts {
+		if h.Name == *params.Name {
+			return errorResponse(http.StatusUnprocessableEntity, "", "host name %s is already in use", *params.Name)
+		}
+	}
+	host := &gopowerstore.Host{ID: newID(), Name: *params.Name, OsType: *params.OsType,
+		Initiators: []gopowerstore.InitiatorInstance{}}
+	if params.Description != nil {
+		host.Description = *params.Description
+	}
+	if params.Type != nil {
+		host.Type = *params.Type
+	}
+	if resp := s.addInitiators(host, *params.Initiators); resp != nil {
+		return resp
+	}
+	s.hosts[host.ID] = host
+	return createdResponse(host.ID)
+}
+
+func (s *Server) modifyHost(r *request, host *gopowerstore.Host) *response {
+	var params gopowerstore.HostModify
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.RemoveInitiators != nil {
+		for _, portName := range *params.RemoveInitiators {
+			if s.initiatorOwner(portName) != host.ID {
+				return errorResponse(http.StatusBadRequest, "",
+					"initiator %s doesn't belong to host %s", portName, host.ID)
+			}
+		}
+		removed := map[string]bool{}
+		for _, portName := range *params.RemoveInitiators {
+			removed[portName] = true
+		}
+		initiators := []gopowerstore.InitiatorInstance{}
+		for _, i := range host.Initiators {
+			if !removed[i.PortName] {
+				initiators = append(initiators, i)
+			}
+		}
+		host.Initiators = initiators
+	}
+	if params.AddInitiators != nil {
+		if resp := s.addInitiators(host, *params.AddInitiators); resp != nil {
+			return resp
+		}
+	}
+	if params.ModifyInitiators != nil {
+		for _, m := range *params.ModifyInitiators {
+			for i := range host.Initiators {
+				if m.PortName == nil || host.Initiators[i].PortName != *m.PortName {
+					continue
+				}
+				if m.ChapSingleUsername != nil {
+					host.Initiators[i].ChapSingleUsername = *m.ChapSingleUsername
+				}
+				if m.ChapMutualUsername != nil {
+					host.Initiators[i].ChapMutualUsername = *m.ChapMutualUsername
+				}
+			}
+		}
+	}
+	if params.Name != nil {
+		host.Name = *params.Name
+	}
+	if params.Description != nil {
+		host.Description = *params.Description
+	}
+	return noContentResponse()
+}
+
+func (s *Server) deleteHost(host *gopowerstore.Host) *response {
+	for _, m := range s.mappings {
+		if m.HostID == host.ID {
+			return errorResponse(http.StatusUnprocessableEntity, "",
+				"host %s has attached volumes and can't be deleted", host.ID)
+		}
+	}
+	delete(s.hosts, host.ID)
+	return noContentResponse()
+}
+
+func (s *Server) attachVolume(r *request, host *gopowerstore.Host) *response {
+	var params gopowerstore.HostVolumeAttach
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.VolumeID == nil {
+		return errorResponse(http.StatusBadRequest, "", "volume_id is required")
+	}
+	if _, ok := s.volumes[*params.VolumeID]; !ok {
+		return volumeNotFound(*params.VolumeID)
+	}
+	luns := map[int64]bool{}
+	for _, m := range s.mappings {
+		if m.HostID != host.ID {
+			continue
+		}
+		if m.VolumeID == *params.VolumeID {
+			return errorResponse(http.StatusUnprocessableEntity, api.VolumeAlreadyAttachedErrorCode,
+				"volume %s is already attached to host %s", m.VolumeID, host.ID)
+		}
+		luns[m.LogicalUnitNumber] = true
+	}
+	var lun int64
+	if params.LogicalUnitNumber != nil {
+		lun = *params.LogicalUnitNumber
+		if luns[lun] {
+			return errorResponse(http.StatusUnprocessableEntity, "",
+				"logical unit number %d is already used on host %s", lun, host.ID)
+		}
+	} else {
+		for luns[lun] {
+			lun++
+		}
+	}
+	mapping := &gopowerstore.HostVolumeMapping{
+		ID:                newID(),
+		ApplianceID:       defaultApplianceID,
+		HostID:            host.ID,
+		HostType:          host.Type,
+		VolumeID:          *params.VolumeID,
+		LogicalUnitNumber: lun}
+	s.mappings[mapping.ID] = mapping
+	return noContentResponse()
+}
+
+func (s *Server) detachVolume(r *request, host *gopowerstore.Host) *response {
+	var params gopowerstore.HostVolumeDetach
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.VolumeID == nil {
+		return errorResponse(http.StatusBadRequest, "", "volume_id is required")
+	}
+	for id, m := range s.mappings {
+		if m.HostID == host.ID && m.VolumeID == *params.VolumeID {
+			delete(s.mappings, id)
+			return noContentResponse()
+		}
+	}
+	return errorResponse(http.StatusBadRequest, api.HostIsNotAttachedToVolumeErrorCode,
+		"host %s is not attached to volume %s", host.ID, *params.VolumeID)
+}
+
+func (s *Server) serveHostVolumeMapping(r *request) *response {
+	if r.Method != http.MethodGet || r.action != "" {
+		return methodNotAllowed(r)
+	}
+	if r.id != "" {
+		m, ok := s.mappings[r.id]
+		if !ok {
+			return errorResponse(http.StatusNotFound, api.InvalidInstance, "host volume mapping %s doesn't exist", r.id)
+		}
+		return jsonResponse(http.StatusOK, m)
+	}
+	items := make([]interface{}, 0, len(s.mappings))
+	for _, v := range s.mappings {
+		items = append(items, v)
+	}
+	return listResponse(r, items)
+}
@@ -0,0 +1,69 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/dell/gopowerstore"
+	"github.com/dell/gopowerstore/api"
+)
+
+// startJob records result of the asynchronous operation as finished job, operations of the fake
+// server complete immediately, so the job is returned in terminal state on the first poll
+func (s *Server) startJob(resourceType, action, resourceID string, result *response) *response {
+	job := &gopowerstore.Job{
+		ID:                 newID(),
+		ResourceType:       gopowerstore.ResourceTypeEnum(resourceType),
+		ResourceAction:     action,
+		ResourceID:         resourceID,
+		State:              gopowerstore.JobStateEnumCompleted,
+		StartTime:          now(),
+		EndTime:            now(),
+		ProgressPercentage: 100,
+		ResponseStatus:     strconv.Itoa(result.status)}
+	if result.status >= 300 {
+		job.State = gopowerstore.JobStateEnumFailed
+	}
+	if result.body != nil {
+		job.ResponseBody, _ = json.Marshal(result.body)
+	}
+	s.jobs[job.ID] = job
+	return jsonResponse(http.StatusAccepted, gopowerstore.JobResponse{ID: job.ID})
+}
+
+func (s *Server) serveJob(r *request) *response {
+	if r.Method != http.MethodGet || r.action != "" {
+		return methodNotAllowed(r)
+	}
+	if r.id != "" {
+		job, ok := s.jobs[r.id]
+		if !ok {
+			return errorResponse(http.StatusNotFound, api.InvalidInstance, "job %s doesn't exist", r.id)
+		}
+		return jsonResponse(http.StatusOK, job)
+	}
+	items := make([]interface{}, 0, len(s.jobs))
+	for _, v := range s.jobs {
+		items = append(items, v)
+	}
+	return listResponse(r, items)
+}
@@ -0,0 +1,199 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+// query params which are not filters
+var reservedParams = map[string]bool{"select": true, "order": true, "offset": true, "limit": true, "is_async": true}
+
+// document is json representation of the resource used for filtering and ordering
+type document map[string]interface{}
+
+func toDocument(v interface{}) document {
+	data, _ := json.Marshal(v)
+	doc := document{}
+	_ = json.Unmarshal(data, &doc)
+	return doc
+}
+
+// lookup returns value of the field, nested fields are addressed as protection_data->>source_id
+func (d document) lookup(key string) (string, bool) {
+	var value interface{} = map[string]interface{}(d)
+	for _, part := range strings.Split(key, "->>") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if value, ok = m[part]; !ok || value == nil {
+			return "", false
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		data, _ := json.Marshal(v)
+		return string(data), true
+	}
+}
+
+// listResponse filters, orders and paginates resources according to the query of the request
+func listResponse(r *request, items []interface{}) *response {
+	query := r.URL.Query()
+	docs := make([]document, 0, len(items))
+	for _, item := range items {
+		doc := toDocument(item)
+		ok, err := matchesQuery(doc, query)
+		if err != nil {
+			return errorResponse(http.StatusBadRequest, "", "%s", err)
+		}
+		if ok {
+			docs = append(docs, doc)
+		}
+	}
+	// map iteration order is random, so instances are ordered by id unless other order is requested
+	sortDocuments(docs, "id")
+	if order := query.Get("order"); order != "" {
+		sortDocuments(docs, order)
+	}
+
+	total := len(docs)
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	if offset < 0 || (offset > 0 && offset >= total) {
+		return errorResponse(http.StatusRequestedRangeNotSatisfiable, api.BadRangeCode,
+			"offset %d is out of range of %d instances", offset, total)
+	}
+	end := total
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit >= 0 && offset+limit < total {
+		end = offset + limit
+	}
+	page := docs[offset:end]
+	if offset == 0 && end == total {
+		return jsonResponse(http.StatusOK, page)
+	}
+	r.w.Header().Set("Content-Range", fmt.Sprintf("%d-%d/%d", offset, end-1, total))
+	return jsonResponse(http.StatusPartialContent, page)
+}
+
+func matchesQuery(doc document, query url.Values) (bool, error) {
+	for key, exprs := range query {
+		if reservedParams[key] {
+			continue
+		}
+		for _, expr := range exprs {
+			ok, err := matches(doc, key, expr)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// matches evaluates filter expression, e.g. eq.Snapshot, not.eq.Snapshot, in.(a,b), is.null or ilike.*prod*
+func matches(doc document, key, expr string) (bool, error) {
+	negate := strings.HasPrefix(expr, "not.")
+	expr = strings.TrimPrefix(expr, "not.")
+	sep := strings.Index(expr, ".")
+	if sep < 0 {
+		return false, fmt.Errorf("invalid filter %s=%s", key, expr)
+	}
+	op, arg := expr[:sep], expr[sep+1:]
+	value, present := doc.lookup(key)
+	var result bool
+	switch op {
+	case "eq":
+		result = present && value == arg
+	case "neq":
+		result = !present || value != arg
+	case "gt", "gte", "lt", "lte":
+		if present {
+			c := compare(value, arg)
+			result = (op == "gt" && c > 0) || (op == "gte" && c >= 0) ||
+				(op == "lt" && c < 0) || (op == "lte" && c <= 0)
+		}
+	case "in":
+		for _, v := range strings.Split(strings.Trim(arg, "()"), ",") {
+			if present && value == v {
+				result = true
+				break
+			}
+		}
+	case "is":
+		if arg != "null" {
+			return false, fmt.Errorf("invalid filter %s=%s", key, expr)
+		}
+		result = !present
+	case "like", "ilike":
+		pattern := "^" + strings.Replace(regexp.QuoteMeta(arg), `\*`, ".*", -1) + "$"
+		if op == "ilike" {
+			pattern = "(?i)" + pattern
+		}
+		result = present && regexp.MustCompile(pattern).MatchString(value)
+	default:
+		return false, fmt.Errorf("unknown operator %s of filter %s", op, key)
+	}
+	return result != negate, nil
+}
+
+// compare compares values as numbers when both are numeric, as strings otherwise
+func compare(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	switch {
+	case errA == nil && errB == nil && fa < fb:
+		return -1
+	case errA == nil && errB == nil && fa > fb:
+		return 1
+	case errA == nil && errB == nil:
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+// sortDocuments orders documents by comma separated list of fields with optional .asc or .desc suffix
+func sortDocuments(docs []document, order string) {
+	fields := strings.Split(order, ",")
+	sort.SliceStable(docs, func(i, j int) bool {
+		for _, field := range fields {
+			desc := strings.HasSuffix(field, ".desc")
+			key := strings.TrimSuffix(strings.TrimSuffix(field, ".desc"), ".asc")
+			a, _ := docs[i].lookup(key)
+			b, _ := docs[j].lookup(key)
+			if c := compare(a, b); c != 0 {
+				return (c < 0) != desc
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,249 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package fake provides in-memory PowerStore REST API server for tests of gopowerstore consumers.
+// Server implements stateful subset of the API: volumes, snapshots, clones, hosts,
+// host volume mappings and jobs, with error codes returned by the real array
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/dell/gopowerstore"
+)
+
+// credentials accepted by the server
+const (
+	DefaultUsername = "admin"
+	DefaultPassword = "Password123!"
+)
+
+const (
+	apiPrefix          = "/api/rest/"
+	sessionTokenHeader = "DELL-EMC-TOKEN"
+	sessionCookieName  = "auth_cookie"
+	defaultApplianceID = "A1"
+)
+
+// Server is in-memory PowerStore REST API server started on local address
+type Server struct {
+	*httptest.Server
+	// credentials of basic authentication, login_session returns session cookie for them
+	Username string
+	Password string
+
+	mu       sync.Mutex
+	volumes  map[string]*gopowerstore.Volume
+	hosts    map[string]*gopowerstore.Host
+	mappings map[string]*gopowerstore.HostVolumeMapping
+	jobs     map[string]*gopowerstore.Job
+	sessions map[string]bool
+}
+
+// NewServer starts new server with empty state, it must be stopped with Close
+func NewServer() *Server {
+	s := &Server{Username: DefaultUsername, Password: DefaultPassword}
+	s.Reset()
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// APIURL returns url of the REST API which can be passed to gopowerstore.NewClientWithArgs
+func (s *Server) APIURL() string {
+	return s.URL + strings.TrimSuffix(apiPrefix, "/")
+}
+
+// NewClient returns client connected to the server
+func (s *Server) NewClient() (gopowerstore.Client, error) {
+	return gopowerstore.NewClientWithArgs(s.APIURL(), s.Username, s.Password,
+		gopowerstore.NewClientOptions().SetDefaultTimeout(10))
+}
+
+// Reset removes all resources, sessions and jobs
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.volumes = map[string]*gopowerstore.Volume{}
+	s.hosts = map[string]*gopowerstore.Host{}
+	s.mappings = map[string]*gopowerstore.HostVolumeMapping{}
+	s.jobs = map[string]*gopowerstore.Job{}
+	s.sessions = map[string]bool{}
+}
+
+// request parsed request to the resource
+type request struct {
+	*http.Request
+	w      http.ResponseWriter
+	id     string
+	action string
+}
+
+// decode reads json body of the request, empty body leaves v unchanged
+func (r *request) decode(v interface{}) *response {
+	if r.Body == nil || r.ContentLength == 0 {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errorResponse(http.StatusBadRequest, "", "invalid request body: %s", err)
+	}
+	return nil
+}
+
+// async returns true if request is sent with is_async=true
+func (r *request) async() bool {
+	return r.URL.Query().Get("is_async") == "true"
+}
+
+// response status and body of the request
+type response struct {
+	status int
+	body   interface{}
+}
+
+type errorMessage struct {
+	Code      string   `json:"code,omitempty"`
+	Severity  string   `json:"severity"`
+	Message   string   `json:"message_l10n"`
+	Arguments []string `json:"arguments,omitempty"`
+}
+
+type errorBody struct {
+	Messages []errorMessage `json:"messages"`
+}
+
+func errorResponse(status int, code string, format string, args ...interface{}) *response {
+	return &response{status: status, body: errorBody{Messages: []errorMessage{{
+		Code: code, Severity: "Error", Message: fmt.Sprintf(format, args...)}}}}
+}
+
+func jsonResponse(status int, body interface{}) *response {
+	return &response{status: status, body: body}
+}
+
+func createdResponse(id string) *response {
+	return jsonResponse(http.StatusCreated, gopowerstore.CreateResponse{ID: id})
+}
+
+func noContentResponse() *response {
+	return &response{status: http.StatusNoContent}
+}
+
+func methodNotAllowed(r *request) *response {
+	return errorResponse(http.StatusMethodNotAllowed, "", "method %s is not supported for %s", r.Method, r.URL.Path)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeResponse(w, errorResponse(http.StatusNotFound, "", "unknown path %s", r.URL.Path))
+		return
+	}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, apiPrefix), "/", 3)
+	req := &request{Request: r, w: w}
+	if len(parts) > 1 {
+		req.id = parts[1]
+	}
+	if len(parts) > 2 {
+		req.action = parts[2]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if parts[0] == "login_session" {
+		writeResponse(w, s.login(req))
+		return
+	}
+	if !s.authorized(r) {
+		writeResponse(w, errorResponse(http.StatusUnauthorized, "", "authentication required"))
+		return
+	}
+	var resp *response
+	switch parts[0] {
+	case "logout":
+		resp = s.logout(req)
+	case "volume":
+		resp = s.serveVolume(req)
+	case "host":
+		resp = s.serveHost(req)
+	case "host_volume_mapping":
+		resp = s.serveHostVolumeMapping(req)
+	case "job":
+		resp = s.serveJob(req)
+	default:
+		resp = errorResponse(http.StatusNotFound, "", "resource type %s is not supported by fake server", parts[0])
+	}
+	writeResponse(w, resp)
+}
+
+func writeResponse(w http.ResponseWriter, resp *response) {
+	if resp.body == nil {
+		w.WriteHeader(resp.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	_ = json.NewEncoder(w).Encode(resp.body)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if cookie, err := r.Cookie(sessionCookieName); err == nil && s.sessions[cookie.Value] {
+		return true
+	}
+	username, password, ok := r.BasicAuth()
+	return ok && username == s.Username && password == s.Password
+}
+
+func (s *Server) login(r *request) *response {
+	if r.Method != http.MethodGet {
+		return methodNotAllowed(r)
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok || username != s.Username || password != s.Password {
+		return errorResponse(http.StatusUnauthorized, "", "invalid credentials")
+	}
+	cookie := newID()
+	s.sessions[cookie] = true
+	r.w.Header().Set(sessionTokenHeader, newID())
+	http.SetCookie(r.w, &http.Cookie{Name: sessionCookieName, Value: cookie, Path: "/"})
+	return jsonResponse(http.StatusOK, []map[string]string{{"id": newID()}})
+}
+
+func (s *Server) logout(r *request) *response {
+	if r.Method != http.MethodPost {
+		return methodNotAllowed(r)
+	}
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		delete(s.sessions, cookie.Value)
+	}
+	return noContentResponse()
+}
+
+// newID returns random UUID in the format used by the array
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
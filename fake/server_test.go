@@ -0,0 +1,193 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T) (*Server, gopowerstore.Client) {
+	s := NewServer()
+	c, err := s.NewClient()
+	assert.Nil(t, err)
+	return s, c
+}
+
+func createVolume(t *testing.T, c gopowerstore.Client, name string) string {
+	size := int64(1048576)
+	resp, err := c.CreateVolume(context.Background(), &gopowerstore.VolumeCreate{Name: &name, Size: &size})
+	assert.Nil(t, err)
+	return resp.ID
+}
+
+func TestServer_Volumes(t *testing.T) {
+	s, c := newTestClient(t)
+	defer s.Close()
+	ctx := context.Background()
+	volID := createVolume(t, c, "vol1")
+	vol, err := c.GetVolume(ctx, volID)
+	assert.Nil(t, err)
+	assert.Equal(t, "vol1", vol.Name)
+	assert.Equal(t, gopowerstore.VolumeTypeEnumPrimary, vol.Type)
+	assert.NotEmpty(t, vol.Wwn)
+
+	snapName := "snap1"
+	snap, err := c.CreateSnapshot(ctx, &gopowerstore.SnapshotCreate{Name: &snapName}, volID)
+	assert.Nil(t, err)
+	vols, err := c.GetVolumes(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, vols, 1)
+	snaps, err := c.GetSnapshotsByVolumeID(ctx, volID)
+	assert.Nil(t, err)
+	assert.Len(t, snaps, 1)
+	assert.Equal(t, snap.ID, snaps[0].ID)
+
+	size := int64(1024)
+	_, err = c.ModifyVolume(ctx, &gopowerstore.VolumeModify{Size: &size}, volID)
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.VolumeSizeIsNotSupported())
+
+	job, err := c.RestoreVolumeFromSnapshotAsync(ctx, volID, &gopowerstore.VolumeRestore{SnapshotID: &snap.ID})
+	assert.Nil(t, err)
+	_, err = c.WaitForJob(ctx, job.ID)
+	assert.Nil(t, err)
+	vol, err = c.GetVolume(ctx, volID)
+	assert.Nil(t, err)
+	assert.Equal(t, snap.ID, vol.ProtectionData.SourceID)
+
+	_, err = c.DeleteVolume(ctx, nil, volID)
+	assert.Nil(t, err)
+	_, err = c.GetSnapshot(ctx, snap.ID)
+	assert.NotNil(t, err)
+	apiError = err.(gopowerstore.APIError)
+	assert.True(t, apiError.VolumeIsNotExist())
+}
+
+func TestServer_Pagination(t *testing.T) {
+	s, c := newTestClient(t)
+	defer s.Close()
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		createVolume(t, c, fmt.Sprintf("vol%d", 4-i))
+	}
+	page, total, err := c.GetVolumesWithPagination(ctx, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, total)
+	assert.Len(t, page, 2)
+	assert.Equal(t, "vol1", page[0].Name)
+	assert.Equal(t, "vol2", page[1].Name)
+
+	_, _, err = c.GetVolumesWithPagination(ctx, 5, 2)
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.BadRange())
+}
+
+func TestServer_Filters(t *testing.T) {
+	s, c := newTestClient(t)
+	defer s.Close()
+	ctx := context.Background()
+	createVolume(t, c, "prod-db")
+	createVolume(t, c, "PROD-web")
+	createVolume(t, c, "test-db")
+	vols, err := c.GetVolumesFiltered(ctx, gopowerstore.NewQueryFilter().
+		Where("name", gopowerstore.FilterOperatorEnumIlike, "prod*").OrderBy("name.desc"))
+	assert.Nil(t, err)
+	assert.Len(t, vols, 2)
+	assert.Equal(t, "prod-db", vols[0].Name)
+	assert.Equal(t, "PROD-web", vols[1].Name)
+
+	vols, err = c.GetVolumesFiltered(ctx, gopowerstore.NewQueryFilter().
+		Where("name", gopowerstore.FilterOperatorEnumIn, "test-db", "prod-db"))
+	assert.Nil(t, err)
+	assert.Len(t, vols, 2)
+}
+
+func TestServer_HostMappings(t *testing.T) {
+	s, c := newTestClient(t)
+	defer s.Close()
+	ctx := context.Background()
+	volID := createVolume(t, c, "vol")
+	name := "host"
+	osType := gopowerstore.OSTypeEnumLinux
+	portName := "iqn.1994-05.com.redhat:host"
+	portType := gopowerstore.InitiatorProtocolTypeEnumISCSI
+	initiators := []gopowerstore.InitiatorCreateModify{{PortName: &portName, PortType: &portType}}
+	host, err := c.CreateHost(ctx, &gopowerstore.HostCreate{Name: &name, OsType: &osType, Initiators: &initiators})
+	assert.Nil(t, err)
+	otherName := "other"
+	_, err = c.CreateHost(ctx, &gopowerstore.HostCreate{Name: &otherName, OsType: &osType, Initiators: &initiators})
+	assert.NotNil(t, err)
+
+	_, err = c.AttachVolumeToHost(ctx, host.ID, &gopowerstore.HostVolumeAttach{VolumeID: &volID})
+	assert.Nil(t, err)
+	mappings, err := c.GetHostVolumeMappingByVolumeID(ctx, volID)
+	assert.Nil(t, err)
+	assert.Len(t, mappings, 1)
+	assert.Equal(t, host.ID, mappings[0].HostID)
+	assert.Equal(t, int64(0), mappings[0].LogicalUnitNumber)
+
+	_, err = c.DeleteHost(ctx, nil, host.ID)
+	assert.NotNil(t, err)
+	_, err = c.DetachVolumeFromHost(ctx, host.ID, &gopowerstore.HostVolumeDetach{VolumeID: &volID})
+	assert.Nil(t, err)
+	_, err = c.DeleteHost(ctx, nil, host.ID)
+	assert.Nil(t, err)
+	_, err = c.GetHost(ctx, host.ID)
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.HostIsNotExist())
+}
+
+func TestServer_Auth(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	c, err := gopowerstore.NewClientWithArgs(s.APIURL(), s.Username, "wrong", gopowerstore.NewClientOptions())
+	assert.Nil(t, err)
+	_, err = c.GetVolumes(ctx)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, err.(gopowerstore.APIError).StatusCode)
+
+	c, err = gopowerstore.NewClientWithArgs(s.APIURL(), s.Username, s.Password,
+		gopowerstore.NewClientOptions().SetSessionAuth(true))
+	assert.Nil(t, err)
+	_, err = c.GetVolumes(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, s.sessions, 1)
+	assert.Nil(t, c.Logout(ctx))
+	assert.Empty(t, s.sessions)
+}
+
+func TestServer_Reset(t *testing.T) {
+	s, c := newTestClient(t)
+	defer s.Close()
+	createVolume(t, c, "vol")
+	s.Reset()
+	vols, err := c.GetVolumes(context.Background())
+	assert.Nil(t, err)
+	assert.Empty(t, vols)
+}
@@ -0,0 +1,375 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package fake
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dell/gopowerstore"
+	"github.com/dell/gopowerstore/api"
+)
+
+func (s *Server) serveVolume(r *request) *response {
+	switch {
+	case r.id == "" && r.Method == http.MethodGet:
+		return s.listVolumes(r)
+	case r.id == "" && r.Method == http.MethodPost:
+		return s.createVolume(r)
+	case r.id == "":
+		return methodNotAllowed(r)
+	}
+	vol, ok := s.volumes[r.id]
+	if !ok {
+		return volumeNotFound(r.id)
+	}
+	switch {
+	case r.action == "" && r.Method == http.MethodGet:
+		return jsonResponse(http.StatusOK, vol)
+	case r.action == "" && r.Method == http.MethodPatch:
+		return s.modifyVolume(r, vol)
+	case r.action == "" && r.Method == http.MethodDelete:
+		return s.deleteVolume(vol)
+	case r.Method != http.MethodPost:
+		return methodNotAllowed(r)
+	}
+	switch r.action {
+	case "snapshot":
+		return s.createSnapshot(r, vol)
+	case "clone":
+		return s.cloneVolume(r, vol)
+	case "restore":
+		return s.restoreVolume(r, vol)
+	case "refresh":
+		return s.refreshVolume(r, vol)
+	}
+	return errorResponse(http.StatusNotFound, "", "volume action %s is not supported by fake server", r.action)
+}
+
+func volumeNotFound(id string) *response {
+	return errorResponse(http.StatusNotFound, api.UnknownVolumeErrorCode, "volume %s doesn't exist", id)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func (s *Server) listVolumes(r *request) *response {
+	items := make([]interface{}, 0, len(s.volumes))
+	for _, v := range s.volumes {
+		items = append(items, v)
+	}
+	return listResponse(r, items)
+}
+
+// nameInUse returns true if volume or clone with the name exists, snapshot names are unique per volume
+func (s *Server) nameInUse(name string) bool {
+	for _, v := range s.volumes {
+		if v.Type != gopowerstore.VolumeTypeEnumSnapshot && v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) snapshotNameInUse(volID, name string) bool {
+	for _, v := range s.volumes {
+		if v.Type == gopowerstore.VolumeTypeEnumSnapshot && v.ProtectionData.SourceID == volID && v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) isMapped(volID string) bool {
+	for _, m := range s.mappings {
+		if m.VolumeID == volID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) addVolume(vol *gopowerstore.Volume) {
+	vol.State = gopowerstore.VolumeStateEnumReady
+	if vol.StorageType == "" {
+		vol.StorageType = gopowerstore.StorageTypeEnumBlock
+	}
+	vol.Wwn = "naa.68ccf098" + wwnSuffix(vol.ID)
+	s.volumes[vol.ID] = vol
+}
+
+// wwnSuffix returns 24 hex digits of the id used as unique part of wwn
+func wwnSuffix(id string) string {
+	hex := make([]byte, 0, 24)
+	for i := 0; i < len(id) && len(hex) < 24; i++ {
+		if id[i] != '-' {
+			hex = append(hex, id[i])
+		}
+	}
+	return string(hex)
+}
+
+func (s *Server) createVolume(r *request) *response {
+	var params gopowerstore.VolumeCreate
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.Name == nil || *params.Name == "" {
+		return errorResponse(http.StatusBadRequest, "", "name is required")
+	}
+	if params.Size == nil || *params.Size <= 0 {
+		return errorResponse(http.StatusBadRequest, api.VolumeSizeIsNotSupportedErrorCode, "size is required")
+	}
+	if s.nameInUse(*params.Name) {
+		return errorResponse(http.StatusUnprocessableEntity, api.VolumeNameAlreadyUseErrorCode,
+			"volume name %s is already in use", *params.Name)
+	}
+	id := newID()
+	vol := &gopowerstore.Volume{
+		ID:   id,
+		Name: *params.Name,
+		Size: *params.Size,
+		Type: gopowerstore.VolumeTypeEnumPrimary,
+		ProtectionData: gopowerstore.ProtectionData{
+			FamilyID: id, CreatorType: gopowerstore.StorageCreatorTypeEnumUser}}
+	if params.StorageType != nil {
+		vol.StorageType = *params.StorageType
+	}
+	if params.Metadata != nil {
+		vol.Metadata = *params.Metadata
+	}
+	if params.ProtectionPolicyID != nil {
+		vol.ProtectionPolicyID = *params.ProtectionPolicyID
+	}
+	s.addVolume(vol)
+	return createdResponse(id)
+}
+
+func (s *Server) modifyVolume(r *request, vol *gopowerstore.Volume) *response {
+	var params struct {
+		gopowerstore.VolumeModify
+		ExpirationTimestamp *string `json:"expiration_timestamp,omitempty"`
+	}
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.Name != nil && *params.Name != vol.Name {
+		inUse := s.nameInUse(*params.Name)
+		if vol.Type == gopowerstore.VolumeTypeEnumSnapshot {
+			inUse = s.snapshotNameInUse(vol.ProtectionData.SourceID, *params.Name)
+		}
+		if inUse {
+			return errorResponse(http.StatusUnprocessableEntity, api.VolumeNameAlreadyUseErrorCode,
+				"name %s is already in use", *params.Name)
+		}
+	}
+	if params.Size != nil {
+		if vol.Type == gopowerstore.VolumeTypeEnumSnapshot || *params.Size < vol.Size {
+			return errorResponse(http.StatusUnprocessableEntity, api.VolumeSizeIsNotSupportedErrorCode,
+				"size %d is not supported, volume can only be expanded", *params.Size)
+		}
+		vol.Size = *params.Size
+	}
+	if params.Name != nil {
+		vol.Name = *params.Name
+	}
+	if params.Description != nil {
+		vol.Description = *params.Description
+	}
+	if params.Metadata != nil {
+		vol.Metadata = *params.Metadata
+	}
+	if params.ProtectionPolicyID != nil {
+		vol.ProtectionPolicyID = *params.ProtectionPolicyID
+	}
+	if params.ExpirationTimestamp != nil {
+		vol.ProtectionData.ExpirationTimestamp = *params.ExpirationTimestamp
+	}
+	return noContentResponse()
+}
+
+// deleteVolume deletes the volume together with its snapshots, attached volume can't be deleted
+func (s *Server) deleteVolume(vol *gopowerstore.Volume) *response {
+	if s.isMapped(vol.ID) {
+		return errorResponse(http.StatusUnprocessableEntity, api.VolumeAttachedToHost,
+			"volume %s is attached to host", vol.ID)
+	}
+	for id, v := range s.volumes {
+		if v.Type == gopowerstore.VolumeTypeEnumSnapshot && v.ProtectionData.SourceID == vol.ID {
+			delete(s.volumes, id)
+		}
+	}
+	delete(s.volumes, vol.ID)
+	return noContentResponse()
+}
+
+func (s *Server) snapshot(vol *gopowerstore.Volume, name, description string) *gopowerstore.Volume {
+	if name == "" {
+		name = vol.Name + "." + now()
+	}
+	snap := &gopowerstore.Volume{
+		ID:          newID(),
+		Name:        name,
+		Description: description,
+		Size:        vol.Size,
+		StorageType: vol.StorageType,
+		Type:        gopowerstore.VolumeTypeEnumSnapshot,
+		ProtectionData: gopowerstore.ProtectionData{
+			SourceID:        vol.ID,
+			ParentID:        vol.ID,
+			FamilyID:        vol.ProtectionData.FamilyID,
+			SourceTimestamp: now(),
+			CreatorType:     gopowerstore.StorageCreatorTypeEnumUser}}
+	s.addVolume(snap)
+	return snap
+}
+
+func (s *Server) createSnapshot(r *request, vol *gopowerstore.Volume) *response {
+	var params gopowerstore.SnapshotCreate
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	var name, description string
+	if params.Name != nil {
+		name = *params.Name
+	}
+	if params.Description != nil {
+		description = *params.Description
+	}
+	if name != "" && s.snapshotNameInUse(vol.ID, name) {
+		return errorResponse(http.StatusBadRequest, api.SnapshotNameAlreadyUseErrorCode,
+			"snapshot name %s is already in use", name)
+	}
+	return createdResponse(s.snapshot(vol, name, description).ID)
+}
+
+// cloneVolume creates clone of the volume or snapshot, it is used both by clone and create from snapshot
+func (s *Server) cloneVolume(r *request, source *gopowerstore.Volume) *response {
+	var params gopowerstore.VolumeClone
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	if params.Name == nil || *params.Name == "" {
+		return errorResponse(http.StatusBadRequest, "", "name is required")
+	}
+	if s.nameInUse(*params.Name) {
+		return errorResponse(http.StatusUnprocessableEntity, api.VolumeNameAlreadyUseErrorCode,
+			"volume name %s is already in use", *params.Name)
+	}
+	id := newID()
+	clone := &gopowerstore.Volume{
+		ID:          id,
+		Name:        *params.Name,
+		Size:        source.Size,
+		StorageType: source.StorageType,
+		Type:        gopowerstore.VolumeTypeEnumClone,
+		ProtectionData: gopowerstore.ProtectionData{
+			SourceID:        source.ID,
+			ParentID:        source.ID,
+			FamilyID:        id,
+			SourceTimestamp: now(),
+			CreatorType:     gopowerstore.StorageCreatorTypeEnumUser}}
+	if params.Description != nil {
+		clone.Description = *params.Description
+	}
+	if params.ProtectionPolicyID != nil {
+		clone.ProtectionPolicyID = *params.ProtectionPolicyID
+	}
+	s.addVolume(clone)
+	return createdResponse(id)
+}
+
+// restoreVolume restores volume from its snapshot, with is_async result is reported by the job
+func (s *Server) restoreVolume(r *request, vol *gopowerstore.Volume) *response {
+	var params gopowerstore.VolumeRestore
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	resp := func() *response {
+		if params.SnapshotID == nil {
+			return errorResponse(http.StatusBadRequest, "", "from_snap_id is required")
+		}
+		snap, ok := s.volumes[*params.SnapshotID]
+		if !ok {
+			return volumeNotFound(*params.SnapshotID)
+		}
+		if snap.Type != gopowerstore.VolumeTypeEnumSnapshot || snap.ProtectionData.SourceID != vol.ID {
+			return errorResponse(http.StatusUnprocessableEntity, api.SnapshotIsNotOfVolumeErrorCode,
+				"snapshot %s doesn't belong to volume %s", snap.ID, vol.ID)
+		}
+		if params.CreateBackupSnap != nil && *params.CreateBackupSnap {
+			var name, description string
+			if params.BackupSnapProfile != nil && params.BackupSnapProfile.Name != nil {
+				name = *params.BackupSnapProfile.Name
+			}
+			if params.BackupSnapProfile != nil && params.BackupSnapProfile.Description != nil {
+				description = *params.BackupSnapProfile.Description
+			}
+			s.snapshot(vol, name, description)
+		}
+		vol.Size = snap.Size
+		vol.ProtectionData.SourceID = snap.ID
+		vol.ProtectionData.SourceTimestamp = snap.ProtectionData.SourceTimestamp
+		return noContentResponse()
+	}()
+	if r.async() {
+		return s.startJob("volume", "restore", vol.ID, resp)
+	}
+	return resp
+}
+
+// refreshVolume refreshes clone from another volume or snapshot, with is_async result is reported by the job
+func (s *Server) refreshVolume(r *request, vol *gopowerstore.Volume) *response {
+	var params gopowerstore.VolumeRefresh
+	if resp := r.decode(&params); resp != nil {
+		return resp
+	}
+	resp := func() *response {
+		if params.FromObjectID == nil {
+			return errorResponse(http.StatusBadRequest, "", "from_object_id is required")
+		}
+		source, ok := s.volumes[*params.FromObjectID]
+		if !ok {
+			return volumeNotFound(*params.FromObjectID)
+		}
+		if vol.Type != gopowerstore.VolumeTypeEnumClone {
+			return errorResponse(http.StatusUnprocessableEntity, api.VolumeIsNotCloneErrorCode,
+				"volume %s is not a clone and can't be refreshed", vol.ID)
+		}
+		if params.CreateBackupSnap != nil && *params.CreateBackupSnap {
+			var name, description string
+			if params.BackupSnapName != nil {
+				name = *params.BackupSnapName
+			}
+			if params.BackupSnapDescription != nil {
+				description = *params.BackupSnapDescription
+			}
+			s.snapshot(vol, name, description)
+		}
+		vol.Size = source.Size
+		vol.ProtectionData.SourceID = source.ID
+		vol.ProtectionData.SourceTimestamp = now()
+		return noContentResponse()
+	}()
+	if r.async() {
+		return s.startJob("volume", "refresh", vol.ID, resp)
+	}
+	return resp
+}
@@ -67,3 +67,39 @@ func (c *ClientIMPL) GetFCPort(ctx context.Context, id string) (resp FcPort, err
 		&resp)
 	return resp, WrapErr(err)
 }
+
+// GetFCTargetPorts returns fc ports which link is up and which have WWN assigned,
+// i.e. ports hosts can log in to
+func (c *ClientIMPL) GetFCTargetPorts(ctx context.Context) ([]FcPort, error) {
+	var ports []FcPort
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []FcPort
+		qp := getFCPortDefaultQueryParams(c)
+		qp.RawArg("is_link_up", "eq.true")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		qp.Order("id")
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    apiFCPortURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			ports = append(ports, page...)
+		}
+		return meta, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := []FcPort{}
+	for _, p := range ports {
+		if p.IsLinkUp && p.Wwn != "" {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
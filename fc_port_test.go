@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
 )
 
@@ -45,6 +46,28 @@ func TestClientIMPL_GetFCPorts(t *testing.T) {
 	assert.Equal(t, fcPortID, ports[0].ID)
 }
 
+func TestClientIMPL_GetFCTargetPorts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "is_link_up": true, "wwn": "58:cc:f0:90:4d:20:1a:7b",
+"wwn_node": "58:cc:f0:90:4d:a0:1a:7b"},
+{"id": "%s", "is_link_up": false, "wwn": "58:cc:f0:90:4d:21:1a:7b"},
+{"id": "p3", "is_link_up": true}]`, fcPortID, fcPortID2)
+	var linkFilter string
+	httpmock.RegisterResponder("GET", fcPortMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			linkFilter = req.URL.Query().Get("is_link_up")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	ports, err := C.GetFCTargetPorts(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.true", linkFilter)
+	assert.Len(t, ports, 1)
+	assert.Equal(t, fcPortID, ports[0].ID)
+	assert.Equal(t, "58:cc:f0:90:4d:20:1a:7b", ports[0].Wwn)
+	assert.Equal(t, "58:cc:f0:90:4d:a0:1a:7b", ports[0].WwnNode)
+}
+
 func TestClientIMPL_GetFCPort(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
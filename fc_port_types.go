@@ -48,6 +48,8 @@ type FcPort struct {
 	SupportedSpeeds []FcPortSpeedEnum `json:"supported_speeds"`
 	// World Wide Name (WWN) of the port.
 	Wwn string `json:"wwn,omitempty"`
+	// World Wide Name (WWN) of the node the port belongs to.
+	WwnNode string `json:"wwn_node,omitempty"`
 }
 
 // FcPortSpeedEnum Possible Fibre Channel port speeds. For the current_speed attribute, these values show the current transmission speed on the port.
@@ -90,5 +92,5 @@ type FcPortModify struct {
 func (h *FcPort) Fields() []string {
 	return []string{"appliance_id", "current_speed", "id",
 		"io_module_id", "is_link_up", "name", "node_id", "partner_id",
-		"port_index", "requested_speed", "sfp_id", "supported_speeds", "wwn"}
+		"port_index", "requested_speed", "sfp_id", "supported_speeds", "wwn", "wwn_node"}
 }
@@ -0,0 +1,111 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const fileInterfaceURL = "file_interface"
+
+func getFileInterfaceDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	fi := FileInterface{}
+	return c.APIClient().QueryParamsWithFields(&fi)
+}
+
+// GetFileInterface query and return specific file interface by id
+func (c *ClientIMPL) GetFileInterface(ctx context.Context, id string) (resp FileInterface, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    fileInterfaceURL,
+			ID:          id,
+			QueryParams: getFileInterfaceDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetFileInterfacesByNASServerID returns file interfaces of the NAS server
+func (c *ClientIMPL) GetFileInterfacesByNASServerID(ctx context.Context,
+	nasServerID string) ([]FileInterface, error) {
+	result := []FileInterface{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []FileInterface
+		qp := getFileInterfaceDefaultQueryParams(c)
+		qp.RawArg("nas_server_id", fmt.Sprintf("eq.%s", nasServerID))
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    fileInterfaceURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateFileInterface creates new network interface of NAS server
+func (c *ClientIMPL) CreateFileInterface(ctx context.Context,
+	createParams *FileInterfaceCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fileInterfaceURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyFileInterface modifies existing file interface
+func (c *ClientIMPL) ModifyFileInterface(ctx context.Context,
+	modifyParams *FileInterfaceModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: fileInterfaceURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteFileInterface deletes existing file interface
+func (c *ClientIMPL) DeleteFileInterface(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: fileInterfaceURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,104 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	fileInterfaceMockURL = APIMockURL + fileInterfaceURL
+	fileInterfaceID      = "5f3ec7ea-0a46-2d8f-6b63-cee0fbdc981e"
+)
+
+func TestClientIMPL_GetFileInterface(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "ip_address": "10.0.0.10", "prefix_length": 24, "vlan_id": 100}`,
+		fileInterfaceID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fileInterfaceMockURL, fileInterfaceID),
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetFileInterface(context.Background(), fileInterfaceID)
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.10", resp.IPAddress)
+	assert.Equal(t, int32(24), resp.PrefixLength)
+	assert.Equal(t, int32(100), resp.VlanID)
+}
+
+func TestClientIMPL_GetFileInterfacesByNASServerID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var nasFilter string
+	httpmock.RegisterResponder("GET", fileInterfaceMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			nasFilter = req.URL.Query().Get("nas_server_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, fileInterfaceID)), nil
+		})
+	resp, err := C.GetFileInterfacesByNASServerID(context.Background(), nasServerID)
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, fmt.Sprintf("eq.%s", nasServerID), nasFilter)
+}
+
+func TestClientIMPL_CreateFileInterface(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fileInterfaceMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, fileInterfaceID)), nil
+		})
+	gateway := "10.0.0.1"
+	vlan := int32(100)
+	resp, err := C.CreateFileInterface(context.Background(), &FileInterfaceCreate{
+		NasServerID:  nasServerID,
+		IPAddress:    "10.0.0.10",
+		PrefixLength: 24,
+		Gateway:      &gateway,
+		VlanID:       &vlan,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, fileInterfaceID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"nas_server_id": nasServerID, "ip_address": "10.0.0.10",
+		"prefix_length": float64(24), "gateway": gateway, "vlan_id": float64(100)}, reqBody)
+}
+
+func TestClientIMPL_ModifyDeleteFileInterface(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", fileInterfaceMockURL, fileInterfaceID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", fileInterfaceMockURL, fileInterfaceID),
+		httpmock.NewStringResponder(204, ""))
+	disabled := true
+	_, err := C.ModifyFileInterface(context.Background(), &FileInterfaceModify{IsDisabled: &disabled},
+		fileInterfaceID)
+	assert.Nil(t, err)
+	_, err = C.DeleteFileInterface(context.Background(), fileInterfaceID)
+	assert.Nil(t, err)
+}
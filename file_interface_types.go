@@ -0,0 +1,95 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// FileInterfaceRoleEnum role of the file interface
+type FileInterfaceRoleEnum string
+
+const (
+	// FileInterfaceRoleEnumProduction captures enum value "Production"
+	FileInterfaceRoleEnumProduction FileInterfaceRoleEnum = "Production"
+	// FileInterfaceRoleEnumBackup captures enum value "Backup"
+	FileInterfaceRoleEnumBackup FileInterfaceRoleEnum = "Backup"
+)
+
+// FileInterfaceCreate create file interface params
+type FileInterfaceCreate struct {
+	// Unique identifier of the NAS server the interface is created on.
+	NasServerID string `json:"nas_server_id"`
+	// IPv4 or IPv6 address of the interface.
+	IPAddress string `json:"ip_address"`
+	// Prefix length of the interface address, e.g. 24.
+	PrefixLength int32 `json:"prefix_length"`
+	// Default gateway of the interface.
+	Gateway *string `json:"gateway,omitempty"`
+	// VLAN of the interface, untagged traffic is used if not set.
+	VlanID *int32 `json:"vlan_id,omitempty"`
+	// Unique identifier of the IP port the interface is bound to, selected by the array if not set.
+	IPPortID *string `json:"ip_port_id,omitempty"`
+	// Role of the interface, Production is used by default.
+	Role *FileInterfaceRoleEnum `json:"role,omitempty"`
+	// Indicates whether the interface is disabled.
+	IsDisabled *bool `json:"is_disabled,omitempty"`
+}
+
+// FileInterfaceModify modify file interface params, unset fields are not changed
+type FileInterfaceModify struct {
+	// IPv4 or IPv6 address of the interface.
+	IPAddress *string `json:"ip_address,omitempty"`
+	// Prefix length of the interface address.
+	PrefixLength *int32 `json:"prefix_length,omitempty"`
+	// Default gateway of the interface.
+	Gateway *string `json:"gateway,omitempty"`
+	// VLAN of the interface.
+	VlanID *int32 `json:"vlan_id,omitempty"`
+	// Unique identifier of the IP port the interface is bound to.
+	IPPortID *string `json:"ip_port_id,omitempty"`
+	// Indicates whether the interface is disabled.
+	IsDisabled *bool `json:"is_disabled,omitempty"`
+}
+
+// FileInterface network interface of NAS server
+type FileInterface struct {
+	// Unique identifier of the file interface.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the NAS server the interface belongs to.
+	NasServerID string `json:"nas_server_id,omitempty"`
+	// Name of the file interface.
+	Name string `json:"name,omitempty"`
+	// IP address of the file interface.
+	IPAddress string `json:"ip_address,omitempty"`
+	// Prefix length of the interface address.
+	PrefixLength int32 `json:"prefix_length,omitempty"`
+	// Default gateway of the interface.
+	Gateway string `json:"gateway,omitempty"`
+	// VLAN of the interface, 0 for untagged.
+	VlanID int32 `json:"vlan_id,omitempty"`
+	// Unique identifier of the IP port the interface is bound to.
+	IPPortID string `json:"ip_port_id,omitempty"`
+	// Role of the interface.
+	Role FileInterfaceRoleEnum `json:"role,omitempty"`
+	// Indicates whether the interface is disabled.
+	IsDisabled bool `json:"is_disabled,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (f *FileInterface) Fields() []string {
+	return []string{"id", "nas_server_id", "name", "ip_address", "prefix_length", "gateway",
+		"vlan_id", "ip_port_id", "role", "is_disabled"}
+}
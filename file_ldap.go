@@ -0,0 +1,96 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const fileLDAPURL = "file_ldap"
+
+func getFileLDAPDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	ldap := FileLDAP{}
+	return c.APIClient().QueryParamsWithFields(&ldap)
+}
+
+// GetLDAPConfig returns LDAP configuration of NAS server
+func (c *ClientIMPL) GetLDAPConfig(ctx context.Context, nasServerID string) (resp FileLDAP, err error) {
+	var ldapList []FileLDAP
+	qp := getFileLDAPDefaultQueryParams(c)
+	qp.RawArg("nas_server_id", fmt.Sprintf("eq.%s", nasServerID))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    fileLDAPURL,
+			QueryParams: qp},
+		&ldapList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(ldapList) != 1 {
+		return resp, notExistError()
+	}
+	return ldapList[0], nil
+}
+
+// CreateLDAPConfig configures LDAP for NAS server
+func (c *ClientIMPL) CreateLDAPConfig(ctx context.Context,
+	createParams *FileLDAPCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fileLDAPURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyLDAPConfig modifies existing LDAP configuration
+func (c *ClientIMPL) ModifyLDAPConfig(ctx context.Context,
+	modifyParams *FileLDAPModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: fileLDAPURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// VerifyLDAPConfig checks that LDAP servers are reachable and bind with configured credentials succeeds.
+// Error is returned if verification fails
+func (c *ClientIMPL) VerifyLDAPConfig(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fileLDAPURL,
+			ID:       id,
+			Action:   "verify"},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,99 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	fileLDAPMockURL = APIMockURL + fileLDAPURL
+	nasServerID     = "5e8d8e8e-671b-336f-db4e-cee0fbdc981e"
+	ldapID          = "5e8d8e9a-8152-d7c6-8bed-cee0fbdc981e"
+)
+
+func TestClientIMPL_GetLDAPConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "nas_server_id": "%s", "protocol": "LDAPS"}]`, ldapID, nasServerID)
+	httpmock.RegisterResponder("GET", fileLDAPMockURL,
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetLDAPConfig(context.Background(), nasServerID)
+	assert.Nil(t, err)
+	assert.Equal(t, ldapID, resp.ID)
+	assert.Equal(t, FileLDAPProtocolEnumLDAPS, resp.Protocol)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", fileLDAPMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetLDAPConfig(context.Background(), nasServerID)
+	assert.NotNil(t, err)
+	apiErr := err.(APIError)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestClientIMPL_CreateLDAPConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fileLDAPMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, ldapID)), nil
+		})
+	createParams := FileLDAPCreate{
+		NasServerID:        nasServerID,
+		AuthorityName:      "example.com",
+		BaseDN:             "dc=example,dc=com",
+		Addresses:          []string{"10.0.0.1"},
+		AuthenticationType: FileLDAPAuthenticationTypeEnumAnonymous,
+	}
+	resp, err := C.CreateLDAPConfig(context.Background(), &createParams)
+	assert.Nil(t, err)
+	assert.Equal(t, ldapID, resp.ID)
+	assert.Equal(t, nasServerID, reqBody["nas_server_id"])
+	assert.NotContains(t, reqBody, "bind_password")
+}
+
+func TestClientIMPL_ModifyLDAPConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", fileLDAPMockURL, ldapID),
+		httpmock.NewStringResponder(204, ""))
+	port := int32(636)
+	_, err := C.ModifyLDAPConfig(context.Background(), &FileLDAPModify{Port: &port}, ldapID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_VerifyLDAPConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/verify", fileLDAPMockURL, ldapID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.VerifyLDAPConfig(context.Background(), ldapID)
+	assert.Nil(t, err)
+}
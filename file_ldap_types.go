@@ -0,0 +1,126 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// FileLDAPProtocolEnum protocol used to connect to LDAP server
+type FileLDAPProtocolEnum string
+
+const (
+	// FileLDAPProtocolEnumLDAP captures enum value "LDAP"
+	FileLDAPProtocolEnumLDAP FileLDAPProtocolEnum = "LDAP"
+	// FileLDAPProtocolEnumLDAPS captures enum value "LDAPS"
+	FileLDAPProtocolEnumLDAPS FileLDAPProtocolEnum = "LDAPS"
+)
+
+// FileLDAPAuthenticationTypeEnum type of LDAP server authentication
+type FileLDAPAuthenticationTypeEnum string
+
+const (
+	// FileLDAPAuthenticationTypeEnumAnonymous captures enum value "Anonymous"
+	FileLDAPAuthenticationTypeEnumAnonymous FileLDAPAuthenticationTypeEnum = "Anonymous"
+	// FileLDAPAuthenticationTypeEnumSimple captures enum value "Simple"
+	FileLDAPAuthenticationTypeEnumSimple FileLDAPAuthenticationTypeEnum = "Simple"
+	// FileLDAPAuthenticationTypeEnumKerberos captures enum value "Kerberos"
+	FileLDAPAuthenticationTypeEnumKerberos FileLDAPAuthenticationTypeEnum = "Kerberos"
+)
+
+// FileLDAPCreate LDAP configuration create params
+type FileLDAPCreate struct {
+	// Unique identifier of the NAS server.
+	NasServerID string `json:"nas_server_id"`
+	// Name of the LDAP authority.
+	AuthorityName string `json:"authority_name"`
+	// Base DN of the LDAP directory.
+	BaseDN string `json:"base_dn"`
+	// IP addresses or FQDNs of LDAP servers.
+	Addresses []string `json:"addresses,omitempty"`
+	// LDAP server port.
+	Port *int32 `json:"port,omitempty"`
+	// Protocol used to connect to LDAP servers.
+	Protocol FileLDAPProtocolEnum `json:"protocol,omitempty"`
+	// Type of authentication.
+	AuthenticationType FileLDAPAuthenticationTypeEnum `json:"authentication_type,omitempty"`
+	// Bind DN used for Simple authentication.
+	BindUser *string `json:"bind_user,omitempty"`
+	// Password of the bind user. This value is not queriable.
+	BindPassword *string `json:"bind_password,omitempty"`
+	// Indicates whether SMB server account is used for Kerberos authentication.
+	IsSmbAccountUsed *bool `json:"is_smb_account_used,omitempty"`
+	// Indicates whether LDAP server certificate must be verified.
+	IsVerifyServerCertificate *bool `json:"is_verify_server_certificate,omitempty"`
+}
+
+// FileLDAPModify LDAP configuration modify params
+type FileLDAPModify struct {
+	// Name of the LDAP authority.
+	AuthorityName *string `json:"authority_name,omitempty"`
+	// Base DN of the LDAP directory.
+	BaseDN *string `json:"base_dn,omitempty"`
+	// IP addresses or FQDNs of LDAP servers.
+	Addresses *[]string `json:"addresses,omitempty"`
+	// LDAP server port.
+	Port *int32 `json:"port,omitempty"`
+	// Protocol used to connect to LDAP servers.
+	Protocol *FileLDAPProtocolEnum `json:"protocol,omitempty"`
+	// Type of authentication.
+	AuthenticationType *FileLDAPAuthenticationTypeEnum `json:"authentication_type,omitempty"`
+	// Bind DN used for Simple authentication.
+	BindUser *string `json:"bind_user,omitempty"`
+	// Password of the bind user. This value is not queriable.
+	BindPassword *string `json:"bind_password,omitempty"`
+	// Indicates whether SMB server account is used for Kerberos authentication.
+	IsSmbAccountUsed *bool `json:"is_smb_account_used,omitempty"`
+	// Indicates whether LDAP server certificate must be verified.
+	IsVerifyServerCertificate *bool `json:"is_verify_server_certificate,omitempty"`
+}
+
+// FileLDAP LDAP configuration of NAS server
+type FileLDAP struct {
+	// Unique identifier of the LDAP configuration.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the NAS server.
+	NasServerID string `json:"nas_server_id,omitempty"`
+	// Name of the LDAP authority.
+	AuthorityName string `json:"authority_name,omitempty"`
+	// Base DN of the LDAP directory.
+	BaseDN string `json:"base_dn,omitempty"`
+	// IP addresses or FQDNs of LDAP servers.
+	Addresses []string `json:"addresses,omitempty"`
+	// LDAP server port.
+	Port int32 `json:"port,omitempty"`
+	// Protocol used to connect to LDAP servers.
+	Protocol FileLDAPProtocolEnum `json:"protocol,omitempty"`
+	// Type of authentication.
+	AuthenticationType FileLDAPAuthenticationTypeEnum `json:"authentication_type,omitempty"`
+	// Bind DN used for Simple authentication.
+	BindUser string `json:"bind_user,omitempty"`
+	// Indicates whether SMB server account is used for Kerberos authentication.
+	IsSmbAccountUsed bool `json:"is_smb_account_used,omitempty"`
+	// Indicates whether LDAP server certificate must be verified.
+	IsVerifyServerCertificate bool `json:"is_verify_server_certificate,omitempty"`
+	// Indicates whether LDAP server certificate is uploaded.
+	IsCertificateUploaded bool `json:"is_certificate_uploaded,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (l *FileLDAP) Fields() []string {
+	return []string{"id", "nas_server_id", "authority_name", "base_dn", "addresses",
+		"port", "protocol", "authentication_type", "bind_user", "is_smb_account_used",
+		"is_verify_server_certificate", "is_certificate_uploaded"}
+}
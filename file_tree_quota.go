@@ -0,0 +1,110 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const fileTreeQuotaURL = "file_tree_quota"
+
+func getTreeQuotaDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	quota := TreeQuota{}
+	return c.APIClient().QueryParamsWithFields(&quota)
+}
+
+// GetTreeQuota query and return specific tree quota by id
+func (c *ClientIMPL) GetTreeQuota(ctx context.Context, id string) (resp TreeQuota, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    fileTreeQuotaURL,
+			ID:          id,
+			QueryParams: getTreeQuotaDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetTreeQuotasByFSID returns tree quotas of the file system
+func (c *ClientIMPL) GetTreeQuotasByFSID(ctx context.Context, fsID string) ([]TreeQuota, error) {
+	result := []TreeQuota{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []TreeQuota
+		qp := getTreeQuotaDefaultQueryParams(c)
+		qp.RawArg("file_system_id", fmt.Sprintf("eq.%s", fsID))
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    fileTreeQuotaURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateTreeQuota creates quota of the file system directory
+func (c *ClientIMPL) CreateTreeQuota(ctx context.Context,
+	createParams *TreeQuotaCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fileTreeQuotaURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyTreeQuota modifies limits of existing tree quota
+func (c *ClientIMPL) ModifyTreeQuota(ctx context.Context,
+	modifyParams *TreeQuotaModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: fileTreeQuotaURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteTreeQuota deletes existing tree quota, the directory and its data are not deleted
+func (c *ClientIMPL) DeleteTreeQuota(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: fileTreeQuotaURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,106 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	treeQuotaMockURL = APIMockURL + fileTreeQuotaURL
+	treeQuotaID      = "00000004-5e8d-8e8e-671b-336fdb4ecee0"
+)
+
+func TestClientIMPL_GetTreeQuota(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "path": "/home", "hard_limit": 10737418240,
+"soft_limit": 8589934592, "remaining_grace_period": 86400, "state": "Soft_Exceeded"}`, treeQuotaID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", treeQuotaMockURL, treeQuotaID),
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetTreeQuota(context.Background(), treeQuotaID)
+	assert.Nil(t, err)
+	assert.Equal(t, "/home", resp.Path)
+	assert.Equal(t, int64(10737418240), resp.HardLimit)
+	assert.Equal(t, int64(86400), resp.RemainingGracePeriod)
+	assert.Equal(t, FileQuotaStateEnumSoftExceeded, resp.State)
+}
+
+func TestClientIMPL_GetTreeQuotasByFSID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var fsFilter string
+	httpmock.RegisterResponder("GET", treeQuotaMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			fsFilter = req.URL.Query().Get("file_system_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, treeQuotaID)), nil
+		})
+	resp, err := C.GetTreeQuotasByFSID(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, fmt.Sprintf("eq.%s", fsID), fsFilter)
+}
+
+func TestClientIMPL_CreateTreeQuota(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", treeQuotaMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, treeQuotaID)), nil
+		})
+	hard := int64(1073741824)
+	resp, err := C.CreateTreeQuota(context.Background(),
+		&TreeQuotaCreate{FileSystemID: fsID, Path: "/tenant1", HardLimit: &hard})
+	assert.Nil(t, err)
+	assert.Equal(t, treeQuotaID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"file_system_id": fsID, "path": "/tenant1",
+		"hard_limit": float64(hard)}, reqBody)
+}
+
+func TestClientIMPL_ModifyDeleteTreeQuota(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", treeQuotaMockURL, treeQuotaID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", treeQuotaMockURL, treeQuotaID),
+		httpmock.NewStringResponder(204, ""))
+	// zero removes the limit and must be sent
+	noLimit := int64(0)
+	_, err := C.ModifyTreeQuota(context.Background(), &TreeQuotaModify{SoftLimit: &noLimit}, treeQuotaID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"soft_limit": float64(0)}, reqBody)
+	_, err = C.DeleteTreeQuota(context.Background(), treeQuotaID)
+	assert.Nil(t, err)
+}
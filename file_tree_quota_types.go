@@ -0,0 +1,91 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// FileQuotaStateEnum state of the file system quota
+type FileQuotaStateEnum string
+
+const (
+	// FileQuotaStateEnumOk captures enum value "Ok"
+	FileQuotaStateEnumOk FileQuotaStateEnum = "Ok"
+	// FileQuotaStateEnumSoftExceeded captures enum value "Soft_Exceeded"
+	FileQuotaStateEnumSoftExceeded FileQuotaStateEnum = "Soft_Exceeded"
+	// FileQuotaStateEnumSoftExceededAndExpired captures enum value "Soft_Exceeded_And_Expired"
+	FileQuotaStateEnumSoftExceededAndExpired FileQuotaStateEnum = "Soft_Exceeded_And_Expired"
+	// FileQuotaStateEnumHardReached captures enum value "Hard_Reached"
+	FileQuotaStateEnumHardReached FileQuotaStateEnum = "Hard_Reached"
+)
+
+// TreeQuotaCreate create tree quota params
+type TreeQuotaCreate struct {
+	// Unique identifier of the file system.
+	FileSystemID string `json:"file_system_id"`
+	// Path of the quota directory relative to the file system root, e.g. /home/user1.
+	Path string `json:"path"`
+	// Description of the tree quota.
+	Description *string `json:"description,omitempty"`
+	// Hard limit in bytes, writes are rejected once it is reached. 0 means no limit.
+	HardLimit *int64 `json:"hard_limit,omitempty"`
+	// Soft limit in bytes, writes are allowed until the grace period expires. 0 means no limit.
+	SoftLimit *int64 `json:"soft_limit,omitempty"`
+	// Indicates whether user quotas are enforced within the tree.
+	IsUserQuotasEnforced *bool `json:"is_user_quotas_enforced,omitempty"`
+}
+
+// TreeQuotaModify modify tree quota params, unset fields are not changed
+type TreeQuotaModify struct {
+	// Description of the tree quota.
+	Description *string `json:"description,omitempty"`
+	// Hard limit in bytes, 0 removes the limit.
+	HardLimit *int64 `json:"hard_limit,omitempty"`
+	// Soft limit in bytes, 0 removes the limit.
+	SoftLimit *int64 `json:"soft_limit,omitempty"`
+	// Indicates whether user quotas are enforced within the tree.
+	IsUserQuotasEnforced *bool `json:"is_user_quotas_enforced,omitempty"`
+}
+
+// TreeQuota quota of the directory tree of the file system
+type TreeQuota struct {
+	// Unique identifier of the tree quota.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the file system.
+	FileSystemID string `json:"file_system_id,omitempty"`
+	// Path of the quota directory relative to the file system root.
+	Path string `json:"path,omitempty"`
+	// Description of the tree quota.
+	Description string `json:"description,omitempty"`
+	// Hard limit in bytes, 0 means no limit.
+	HardLimit int64 `json:"hard_limit,omitempty"`
+	// Soft limit in bytes, 0 means no limit.
+	SoftLimit int64 `json:"soft_limit,omitempty"`
+	// Remaining grace period in seconds after the soft limit is exceeded, -1 if the grace period is not running.
+	RemainingGracePeriod int64 `json:"remaining_grace_period,omitempty"`
+	// Space used in the tree in bytes.
+	SizeUsed int64 `json:"size_used,omitempty"`
+	// State of the tree quota.
+	State FileQuotaStateEnum `json:"state,omitempty"`
+	// Indicates whether user quotas are enforced within the tree.
+	IsUserQuotasEnforced bool `json:"is_user_quotas_enforced,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (q *TreeQuota) Fields() []string {
+	return []string{"id", "file_system_id", "path", "description", "hard_limit", "soft_limit",
+		"remaining_grace_period", "size_used", "state", "is_user_quotas_enforced"}
+}
@@ -0,0 +1,99 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const fileUserQuotaURL = "file_user_quota"
+
+func getUserQuotaDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	quota := UserQuota{}
+	return c.APIClient().QueryParamsWithFields(&quota)
+}
+
+// GetUserQuota query and return specific user quota by id
+func (c *ClientIMPL) GetUserQuota(ctx context.Context, id string) (resp UserQuota, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    fileUserQuotaURL,
+			ID:          id,
+			QueryParams: getUserQuotaDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetUserQuotasByFSID returns user quotas of the file system, including user quotas within its tree quotas
+func (c *ClientIMPL) GetUserQuotasByFSID(ctx context.Context, fsID string) ([]UserQuota, error) {
+	result := []UserQuota{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []UserQuota
+		qp := getUserQuotaDefaultQueryParams(c)
+		qp.RawArg("file_system_id", fmt.Sprintf("eq.%s", fsID))
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    fileUserQuotaURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateUserQuota creates quota of the user on the file system or within the tree quota
+func (c *ClientIMPL) CreateUserQuota(ctx context.Context,
+	createParams *UserQuotaCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fileUserQuotaURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyUserQuota modifies limits of existing user quota. User quotas can't be deleted,
+// set both limits to 0 to remove the quota
+func (c *ClientIMPL) ModifyUserQuota(ctx context.Context,
+	modifyParams *UserQuotaModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: fileUserQuotaURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
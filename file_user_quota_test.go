@@ -0,0 +1,97 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	userQuotaMockURL = APIMockURL + fileUserQuotaURL
+	userQuotaID      = "00000005-5e8d-8e8e-671b-336fdb4ecee0"
+)
+
+func TestClientIMPL_GetUserQuota(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "uid": 1001, "tree_quota_id": "%s", "size_used": 4096,
+"state": "Ok"}`, userQuotaID, treeQuotaID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", userQuotaMockURL, userQuotaID),
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetUserQuota(context.Background(), userQuotaID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1001), resp.UID)
+	assert.Equal(t, treeQuotaID, resp.TreeQuotaID)
+	assert.Equal(t, FileQuotaStateEnumOk, resp.State)
+}
+
+func TestClientIMPL_GetUserQuotasByFSID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var fsFilter string
+	httpmock.RegisterResponder("GET", userQuotaMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			fsFilter = req.URL.Query().Get("file_system_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, userQuotaID)), nil
+		})
+	resp, err := C.GetUserQuotasByFSID(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, fmt.Sprintf("eq.%s", fsID), fsFilter)
+}
+
+func TestClientIMPL_CreateModifyUserQuota(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	decode := func(status int, body string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			reqBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(status, body), nil
+		}
+	}
+	httpmock.RegisterResponder("POST", userQuotaMockURL,
+		decode(201, fmt.Sprintf(`{"id": "%s"}`, userQuotaID)))
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", userQuotaMockURL, userQuotaID),
+		decode(204, ""))
+
+	treeID := treeQuotaID
+	uid := int64(1001)
+	soft := int64(536870912)
+	resp, err := C.CreateUserQuota(context.Background(),
+		&UserQuotaCreate{FileSystemID: fsID, TreeQuotaID: &treeID, UID: &uid, SoftLimit: &soft})
+	assert.Nil(t, err)
+	assert.Equal(t, userQuotaID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"file_system_id": fsID, "tree_quota_id": treeQuotaID,
+		"uid": float64(uid), "soft_limit": float64(soft)}, reqBody)
+
+	hard := int64(1073741824)
+	_, err = C.ModifyUserQuota(context.Background(), &UserQuotaModify{HardLimit: &hard}, userQuotaID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"hard_limit": float64(hard)}, reqBody)
+}
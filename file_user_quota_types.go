@@ -0,0 +1,82 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// UserQuotaCreate create user quota params, the user is identified by one of Uid, UnixName,
+// WindowsName or WindowsSid
+type UserQuotaCreate struct {
+	// Unique identifier of the file system.
+	FileSystemID string `json:"file_system_id"`
+	// Unique identifier of the tree quota, quota applies to the whole file system if not set.
+	TreeQuotaID *string `json:"tree_quota_id,omitempty"`
+	// Unix user identifier.
+	UID *int64 `json:"uid,omitempty"`
+	// Unix user name.
+	UnixName *string `json:"unix_name,omitempty"`
+	// Windows user name in DOMAIN\user format.
+	WindowsName *string `json:"windows_name,omitempty"`
+	// Windows security identifier of the user.
+	WindowsSid *string `json:"windows_sid,omitempty"`
+	// Hard limit in bytes, writes are rejected once it is reached. 0 means no limit.
+	HardLimit *int64 `json:"hard_limit,omitempty"`
+	// Soft limit in bytes, writes are allowed until the grace period expires. 0 means no limit.
+	SoftLimit *int64 `json:"soft_limit,omitempty"`
+}
+
+// UserQuotaModify modify user quota params, unset fields are not changed
+type UserQuotaModify struct {
+	// Hard limit in bytes, 0 removes the limit.
+	HardLimit *int64 `json:"hard_limit,omitempty"`
+	// Soft limit in bytes, 0 removes the limit.
+	SoftLimit *int64 `json:"soft_limit,omitempty"`
+}
+
+// UserQuota quota of the user on the file system or within the tree quota
+type UserQuota struct {
+	// Unique identifier of the user quota.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the file system.
+	FileSystemID string `json:"file_system_id,omitempty"`
+	// Unique identifier of the tree quota, empty for file system wide quotas.
+	TreeQuotaID string `json:"tree_quota_id,omitempty"`
+	// Unix user identifier.
+	UID int64 `json:"uid,omitempty"`
+	// Unix user name.
+	UnixName string `json:"unix_name,omitempty"`
+	// Windows user name.
+	WindowsName string `json:"windows_name,omitempty"`
+	// Windows security identifier of the user.
+	WindowsSid string `json:"windows_sid,omitempty"`
+	// Hard limit in bytes, 0 means no limit.
+	HardLimit int64 `json:"hard_limit,omitempty"`
+	// Soft limit in bytes, 0 means no limit.
+	SoftLimit int64 `json:"soft_limit,omitempty"`
+	// Remaining grace period in seconds after the soft limit is exceeded, -1 if the grace period is not running.
+	RemainingGracePeriod int64 `json:"remaining_grace_period,omitempty"`
+	// Space used by the user in bytes.
+	SizeUsed int64 `json:"size_used,omitempty"`
+	// State of the user quota.
+	State FileQuotaStateEnum `json:"state,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (q *UserQuota) Fields() []string {
+	return []string{"id", "file_system_id", "tree_quota_id", "uid", "unix_name", "windows_name",
+		"windows_sid", "hard_limit", "soft_limit", "remaining_grace_period", "size_used", "state"}
+}
@@ -0,0 +1,306 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const fsURL = "file_system"
+
+func getFSDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	fs := FileSystem{}
+	return c.APIClient().QueryParamsWithFields(&fs)
+}
+
+// GetFS query and return specific file system by id
+func (c *ClientIMPL) GetFS(ctx context.Context, id string) (resp FileSystem, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    fsURL,
+			ID:          id,
+			QueryParams: getFSDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetFSByName query and return specific file system by name
+func (c *ClientIMPL) GetFSByName(ctx context.Context, name string) (resp FileSystem, err error) {
+	var fsList []FileSystem
+	qp := getFSDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	qp.RawArg("filesystem_type", fmt.Sprintf("eq.%s", FileSystemTypeEnumPrimary))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    fsURL,
+			QueryParams: qp},
+		&fsList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(fsList) != 1 {
+		return resp, notExistError()
+	}
+	return fsList[0], nil
+}
+
+// CreateFS creates new file system.
+// File-Level Retention can't be enabled for VMware file systems, such request is rejected client-side
+func (c *ClientIMPL) CreateFS(ctx context.Context, createParams *FsCreate) (resp CreateResponse, err error) {
+	if createParams.FlrAttributes != nil && createParams.FlrAttributes.Mode != FLRModeEnumNone &&
+		createParams.ConfigType == FileSystemConfigTypeEnumVMware {
+		return resp, fmt.Errorf("file-level retention is not supported for %s file systems",
+			createParams.ConfigType)
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fsURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyFS modifies existing file system and returns its updated state.
+// Decreasing Size requires AllowShrink and is rejected if the new size is below used space
+func (c *ClientIMPL) ModifyFS(ctx context.Context,
+	modifyParams *FsModify, id string) (resp FileSystem, err error) {
+	if modifyParams.Size != nil {
+		current, err := c.GetFS(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+		if *modifyParams.Size < current.SizeTotal {
+			if !modifyParams.AllowShrink {
+				return resp, fmt.Errorf("can't shrink file system %s from %d to %d bytes: AllowShrink is not set",
+					id, current.SizeTotal, *modifyParams.Size)
+			}
+			if *modifyParams.Size < current.SizeUsed {
+				return resp, fmt.Errorf("can't shrink file system %s to %d bytes: %d bytes are used",
+					id, *modifyParams.Size, current.SizeUsed)
+			}
+		}
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: fsURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	if err = WrapErr(err); err != nil {
+		return resp, err
+	}
+	return c.GetFS(ctx, id)
+}
+
+// DeleteFS deletes existing file system, file system with NFS exports or SMB shares can't be deleted
+func (c *ClientIMPL) DeleteFS(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: fsURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CloneFS creates a thin clone of the file system or file system snapshot.
+// The clone is created on the NAS server of the source and shares its data until either of them is modified
+func (c *ClientIMPL) CloneFS(ctx context.Context,
+	cloneParams *FsClone, sourceFsID string) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fsURL,
+			ID:       sourceFsID,
+			Action:   "clone",
+			Body:     cloneParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CreateFsSnapshot creates snapshot of the file system
+func (c *ClientIMPL) CreateFsSnapshot(ctx context.Context,
+	createParams *FsSnapshotCreate, fsID string) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fsURL,
+			ID:       fsID,
+			Action:   "snapshot",
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetFsSnapshotsByFSID returns snapshots of the file system
+func (c *ClientIMPL) GetFsSnapshotsByFSID(ctx context.Context, fsID string) ([]FileSystem, error) {
+	result := []FileSystem{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []FileSystem
+		qp := getFSDefaultQueryParams(c)
+		qp.RawArg("parent_id", fmt.Sprintf("eq.%s", fsID))
+		qp.RawArg("filesystem_type", fmt.Sprintf("eq.%s", FileSystemTypeEnumSnapshot))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    fsURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetFsSnapshots returns snapshots of all file systems
+func (c *ClientIMPL) GetFsSnapshots(ctx context.Context) ([]FileSystem, error) {
+	result := []FileSystem{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []FileSystem
+		qp := getFSDefaultQueryParams(c)
+		qp.RawArg("filesystem_type", fmt.Sprintf("eq.%s", FileSystemTypeEnumSnapshot))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    fsURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateFsProtocolSnapshot creates snapshot of the file system with Protocol access type, so NFS exports
+// and SMB shares can be created on the snapshot itself, e.g. to mount it read-only on a backup host
+func (c *ClientIMPL) CreateFsProtocolSnapshot(ctx context.Context,
+	createParams *FsSnapshotCreate, fsID string) (resp CreateResponse, err error) {
+	params := FsSnapshotCreate{}
+	if createParams != nil {
+		params = *createParams
+	}
+	accessType := FileSystemSnapshotAccessTypeEnumProtocol
+	params.AccessType = &accessType
+	return c.CreateFsSnapshot(ctx, &params, fsID)
+}
+
+// GetFsSnapshotAccessPaths returns paths the snapshot content is read from. Snapshot with Snapshot access type
+// is available in .snapshot directory of every export and share of the parent file system, snapshot with
+// Protocol access type is available through its own exports and shares. Empty slice is returned if there is none
+func (c *ClientIMPL) GetFsSnapshotAccessPaths(ctx context.Context, snapID string) ([]FsSnapshotAccessPath, error) {
+	snap, err := c.GetFS(ctx, snapID)
+	if err != nil {
+		return nil, err
+	}
+	if snap.FilesystemType != FileSystemTypeEnumSnapshot {
+		return nil, fmt.Errorf("file system %s is not a snapshot", snapID)
+	}
+	exportedFsID := snapID
+	snapPath := "/"
+	if snap.AccessType != FileSystemSnapshotAccessTypeEnumProtocol {
+		exportedFsID = snap.ParentID
+		snapPath = "/.snapshot/" + snap.Name
+	}
+	exports, err := c.GetNFSExportsByFileSystemID(ctx, exportedFsID)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := c.GetSMBSharesByFileSystemID(ctx, exportedFsID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]FsSnapshotAccessPath, 0, len(exports)+len(shares))
+	for _, export := range exports {
+		result = append(result, FsSnapshotAccessPath{Protocol: FsSnapshotAccessProtocolEnumNFS,
+			ExportID: export.ID, ExportName: export.Name, Path: snapPath})
+	}
+	for _, share := range shares {
+		result = append(result, FsSnapshotAccessPath{Protocol: FsSnapshotAccessProtocolEnumSMB,
+			ExportID: share.ID, ExportName: share.Name, Path: snapPath})
+	}
+	return result, nil
+}
+
+// CreateFsFromSnapshot creates a new file system from the snapshot, id of a primary file system is rejected client-side
+func (c *ClientIMPL) CreateFsFromSnapshot(ctx context.Context,
+	createParams *FsClone, snapID string) (resp CreateResponse, err error) {
+	snap, err := c.GetFS(ctx, snapID)
+	if err != nil {
+		return resp, err
+	}
+	if snap.FilesystemType != FileSystemTypeEnumSnapshot {
+		return resp, fmt.Errorf("file system %s is not a snapshot", snapID)
+	}
+	return c.CloneFS(ctx, createParams, snapID)
+}
+
+// DeleteFsSnapshot deletes file system snapshot, id of a primary file system is rejected client-side
+func (c *ClientIMPL) DeleteFsSnapshot(ctx context.Context, snapID string) (resp EmptyResponse, err error) {
+	snap, err := c.GetFS(ctx, snapID)
+	if err != nil {
+		return resp, err
+	}
+	if snap.FilesystemType != FileSystemTypeEnumSnapshot {
+		return resp, fmt.Errorf("file system %s is not a snapshot", snapID)
+	}
+	return c.DeleteFS(ctx, snapID)
+}
+
+// RestoreFsSnapshot restores the parent file system in place from the snapshot.
+// If CopyName is set a backup snapshot of the current file system state is created and its id is returned
+func (c *ClientIMPL) RestoreFsSnapshot(ctx context.Context,
+	snapID string, restoreParams *FsSnapshotRestore) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: fsURL,
+			ID:       snapID,
+			Action:   "restore",
+			Body:     restoreParams},
+		&resp)
+	return resp, WrapErr(err)
+}
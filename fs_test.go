@@ -0,0 +1,346 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const fsMockURL = APIMockURL + fsURL
+
+var fsID = "5e8d8e8e-671b-336f-db4e-cee0fbdc981e"
+var fsSnapID = "6a2c4e1f-8b3d-447a-9c5e-d1f0a2b3c4d5"
+
+func TestClientIMPL_GetFS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "size_total": 8589934592, "size_used": 1610612736,
+"flr_attributes": {"mode": "Enterprise", "default_retention": "1M"}}`, fsID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		httpmock.NewStringResponder(200, respData))
+	fs, err := C.GetFS(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, fsID, fs.ID)
+	assert.Equal(t, FLRModeEnumEnterprise, fs.FlrAttributes.Mode)
+	assert.Equal(t, int64(8589934592), fs.SizeTotal)
+}
+
+func TestClientIMPL_GetFSByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var nameFilter string
+	httpmock.RegisterResponder("GET", fsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			nameFilter = req.URL.Query().Get("name")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "fs1"}]`, fsID)), nil
+		})
+	fs, err := C.GetFSByName(context.Background(), "fs1")
+	assert.Nil(t, err)
+	assert.Equal(t, fsID, fs.ID)
+	assert.Equal(t, "eq.fs1", nameFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", fsMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetFSByName(context.Background(), "fs1")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_DeleteFS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteFS(context.Background(), fsID)
+	assert.Nil(t, err)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		httpmock.NewStringResponder(422, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`, FilesystemHasExportsErrorCode)))
+	_, err = C.DeleteFS(context.Background(), fsID)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.FilesystemHasExports())
+}
+
+func TestClientIMPL_ModifyFS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	size := int64(8589934592)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`{"id": "%s", "size_total": %d, "size_used": 1610612736}`, fsID, size)), nil
+		})
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			size = int64(reqBody["size_total"].(float64))
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+
+	newSize := int64(17179869184)
+	fs, err := C.ModifyFS(context.Background(), &FsModify{Size: &newSize}, fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, newSize, fs.SizeTotal)
+	assert.NotContains(t, reqBody, "AllowShrink")
+
+	shrinkSize := int64(4294967296)
+	_, err = C.ModifyFS(context.Background(), &FsModify{Size: &shrinkSize}, fsID)
+	assert.NotNil(t, err)
+
+	belowUsed := int64(1073741824)
+	_, err = C.ModifyFS(context.Background(), &FsModify{Size: &belowUsed, AllowShrink: true}, fsID)
+	assert.NotNil(t, err)
+
+	fs, err = C.ModifyFS(context.Background(), &FsModify{Size: &shrinkSize, AllowShrink: true}, fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, shrinkSize, fs.SizeTotal)
+}
+
+func TestClientIMPL_CreateFS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, fsID)), nil
+		})
+	createParams := FsCreate{
+		Name:        "records",
+		NasServerID: nasServerID,
+		Size:        8589934592,
+		FlrAttributes: &FlrAttributes{
+			Mode:             FLRModeEnumCompliance,
+			DefaultRetention: "1Y",
+			MaximumRetention: "infinite",
+		},
+	}
+	resp, err := C.CreateFS(context.Background(), &createParams)
+	assert.Nil(t, err)
+	assert.Equal(t, fsID, resp.ID)
+	flr := reqBody["flr_attributes"].(map[string]interface{})
+	assert.Equal(t, "Compliance", flr["mode"])
+	assert.Equal(t, "1Y", flr["default_retention"])
+
+	createParams.ConfigType = FileSystemConfigTypeEnumVMware
+	_, err = C.CreateFS(context.Background(), &createParams)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_CloneFS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/clone", fsMockURL, fsID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, `{"id": "clone1"}`), nil
+		})
+	name := "fs_clone"
+	policy := "policy1"
+	resp, err := C.CloneFS(context.Background(), &FsClone{Name: &name, ProtectionPolicyID: &policy}, fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, "clone1", resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "protection_policy_id": policy}, reqBody)
+}
+
+func TestClientIMPL_CreateFsSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/snapshot", fsMockURL, fsID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, `{"id": "snap1"}`), nil
+		})
+	name := "fs_snap"
+	accessType := FileSystemSnapshotAccessTypeEnumProtocol
+	resp, err := C.CreateFsSnapshot(context.Background(),
+		&FsSnapshotCreate{Name: &name, AccessType: &accessType}, fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, "snap1", resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "access_type": "Protocol"}, reqBody)
+}
+
+func TestClientIMPL_GetFsSnapshotsByFSID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var parentFilter, typeFilter string
+	httpmock.RegisterResponder("GET", fsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			parentFilter = req.URL.Query().Get("parent_id")
+			typeFilter = req.URL.Query().Get("filesystem_type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(
+				`[{"id": "snap1", "filesystem_type": "Snapshot", "parent_id": "%s"}]`, fsID)), nil
+		})
+	snaps, err := C.GetFsSnapshotsByFSID(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Len(t, snaps, 1)
+	assert.Equal(t, fsID, snaps[0].ParentID)
+	assert.Equal(t, "eq."+fsID, parentFilter)
+	assert.Equal(t, "eq.Snapshot", typeFilter)
+}
+
+func TestClientIMPL_GetFsSnapshots(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter string
+	httpmock.RegisterResponder("GET", fsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("filesystem_type")
+			return httpmock.NewStringResponse(200,
+				`[{"id": "snap1", "filesystem_type": "Snapshot"}, {"id": "snap2", "filesystem_type": "Snapshot"}]`), nil
+		})
+	snaps, err := C.GetFsSnapshots(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, snaps, 2)
+	assert.Equal(t, "eq.Snapshot", typeFilter)
+}
+
+func TestClientIMPL_CreateFsFromSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fsMockURL+"/snap1",
+		httpmock.NewStringResponder(200, `{"id": "snap1", "filesystem_type": "Snapshot"}`))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "filesystem_type": "Primary"}`, fsID)))
+	httpmock.RegisterResponder("POST", fsMockURL+"/snap1/clone",
+		httpmock.NewStringResponder(201, `{"id": "fs2"}`))
+	name := "fs_from_snap"
+	resp, err := C.CreateFsFromSnapshot(context.Background(), &FsClone{Name: &name}, "snap1")
+	assert.Nil(t, err)
+	assert.Equal(t, "fs2", resp.ID)
+
+	_, err = C.CreateFsFromSnapshot(context.Background(), &FsClone{Name: &name}, fsID)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+fsMockURL+"/snap1/clone"])
+}
+
+func TestClientIMPL_DeleteFsSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fsMockURL+"/snap1",
+		httpmock.NewStringResponder(200, `{"id": "snap1", "filesystem_type": "Snapshot"}`))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "filesystem_type": "Primary"}`, fsID)))
+	httpmock.RegisterResponder("DELETE", fsMockURL+"/snap1",
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteFsSnapshot(context.Background(), "snap1")
+	assert.Nil(t, err)
+
+	// primary file system is never deleted
+	_, err = C.DeleteFsSnapshot(context.Background(), fsID)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE "+fsMockURL+"/snap1"])
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_RestoreFsSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fsMockURL+"/snap1/restore",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, `{"id": "backup1"}`), nil
+		})
+	copyName := "fs_backup"
+	resp, err := C.RestoreFsSnapshot(context.Background(), "snap1", &FsSnapshotRestore{CopyName: &copyName})
+	assert.Nil(t, err)
+	assert.Equal(t, "backup1", resp.ID)
+	assert.Equal(t, copyName, reqBody["copy_name"])
+}
+
+func TestClientIMPL_CreateFsProtocolSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/snapshot", fsMockURL, fsID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, fsSnapID)), nil
+		})
+	name := "backup"
+	resp, err := C.CreateFsProtocolSnapshot(context.Background(), &FsSnapshotCreate{Name: &name}, fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, fsSnapID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": "backup", "access_type": "Protocol"}, reqBody)
+}
+
+func TestClientIMPL_GetFsSnapshotAccessPaths(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var exportFilter, shareFilter string
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			exportFilter = req.URL.Query().Get("file_system_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "export1"}]`, nfsID)), nil
+		})
+	httpmock.RegisterResponder("GET", smbShareMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			shareFilter = req.URL.Query().Get("file_system_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "share1"}]`, smbShareID)), nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsSnapID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "name": "daily", "filesystem_type": "Snapshot",
+"parent_id": "%s", "access_type": "Snapshot"}`, fsSnapID, fsID)))
+
+	paths, err := C.GetFsSnapshotAccessPaths(context.Background(), fsSnapID)
+	assert.Nil(t, err)
+	assert.Equal(t, "eq."+fsID, exportFilter)
+	assert.Equal(t, "eq."+fsID, shareFilter)
+	assert.Equal(t, []FsSnapshotAccessPath{
+		{Protocol: FsSnapshotAccessProtocolEnumNFS, ExportID: nfsID, ExportName: "export1", Path: "/.snapshot/daily"},
+		{Protocol: FsSnapshotAccessProtocolEnumSMB, ExportID: smbShareID, ExportName: "share1", Path: "/.snapshot/daily"},
+	}, paths)
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsSnapID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "name": "daily", "filesystem_type": "Snapshot",
+"parent_id": "%s", "access_type": "Protocol"}`, fsSnapID, fsID)))
+	paths, err = C.GetFsSnapshotAccessPaths(context.Background(), fsSnapID)
+	assert.Nil(t, err)
+	assert.Equal(t, "eq."+fsSnapID, exportFilter)
+	assert.Equal(t, "/", paths[0].Path)
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "filesystem_type": "Primary"}`, fsID)))
+	_, err = C.GetFsSnapshotAccessPaths(context.Background(), fsID)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,206 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// FileSystemConfigTypeEnum file system configuration type
+type FileSystemConfigTypeEnum string
+
+const (
+	// FileSystemConfigTypeEnumGeneral - general purpose file system
+	FileSystemConfigTypeEnumGeneral FileSystemConfigTypeEnum = "General"
+	// FileSystemConfigTypeEnumVMware - file system used as VMware NFS datastore
+	FileSystemConfigTypeEnumVMware FileSystemConfigTypeEnum = "VMware"
+)
+
+// FileSystemTypeEnum type of file system
+type FileSystemTypeEnum string
+
+const (
+	// FileSystemTypeEnumPrimary - normal file system or clone
+	FileSystemTypeEnumPrimary FileSystemTypeEnum = "Primary"
+	// FileSystemTypeEnumSnapshot - snapshot of a file system
+	FileSystemTypeEnumSnapshot FileSystemTypeEnum = "Snapshot"
+)
+
+// FileSystemSnapshotAccessTypeEnum the way file system snapshot is accessed by protocol clients
+type FileSystemSnapshotAccessTypeEnum string
+
+const (
+	// FileSystemSnapshotAccessTypeEnumSnapshot - snapshot is accessed through .snapshot directory of the file system
+	FileSystemSnapshotAccessTypeEnumSnapshot FileSystemSnapshotAccessTypeEnum = "Snapshot"
+	// FileSystemSnapshotAccessTypeEnumProtocol - snapshot is shared with its own NFS exports or SMB shares
+	FileSystemSnapshotAccessTypeEnumProtocol FileSystemSnapshotAccessTypeEnum = "Protocol"
+)
+
+// FLRModeEnum File-Level Retention mode
+type FLRModeEnum string
+
+const (
+	// FLRModeEnumNone - File-Level Retention is disabled
+	FLRModeEnumNone FLRModeEnum = "None"
+	// FLRModeEnumEnterprise - locked files can be deleted by administrator with the file system
+	FLRModeEnumEnterprise FLRModeEnum = "Enterprise"
+	// FLRModeEnumCompliance - locked files can't be deleted before retention expires
+	FLRModeEnumCompliance FLRModeEnum = "Compliance"
+)
+
+// FlrAttributes File-Level Retention (WORM) settings of file system.
+// Retention periods are in PowerStore format, e.g. "1D", "6M", "5Y" or "infinite".
+// Retention of particular file is set by protocol clients through file last access time,
+// PowerStore doesn't provide REST API for it
+type FlrAttributes struct {
+	// File-Level Retention mode. Can be set on file system creation only.
+	Mode FLRModeEnum `json:"mode,omitempty"`
+	// Shortest retention period allowed for files.
+	MinimumRetention string `json:"minimum_retention,omitempty"`
+	// Retention period applied to files locked without explicit retention.
+	DefaultRetention string `json:"default_retention,omitempty"`
+	// Longest retention period allowed for files.
+	MaximumRetention string `json:"maximum_retention,omitempty"`
+	// Indicates whether files are locked automatically after policy interval.
+	AutoLock bool `json:"auto_lock,omitempty"`
+	// Indicates whether locked files are deleted automatically when retention expires.
+	AutoDelete bool `json:"auto_delete,omitempty"`
+}
+
+// FlrAttributesModify File-Level Retention settings modify params
+type FlrAttributesModify struct {
+	// Shortest retention period allowed for files.
+	MinimumRetention *string `json:"minimum_retention,omitempty"`
+	// Retention period applied to files locked without explicit retention.
+	DefaultRetention *string `json:"default_retention,omitempty"`
+	// Longest retention period allowed for files.
+	MaximumRetention *string `json:"maximum_retention,omitempty"`
+	// Indicates whether files are locked automatically after policy interval.
+	AutoLock *bool `json:"auto_lock,omitempty"`
+	// Indicates whether locked files are deleted automatically when retention expires.
+	AutoDelete *bool `json:"auto_delete,omitempty"`
+}
+
+// FsCreate create file system params
+type FsCreate struct {
+	// Name of the file system.
+	Name string `json:"name"`
+	// File system description.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the NAS server the file system is created on.
+	NasServerID string `json:"nas_server_id"`
+	// Total size of the file system in bytes.
+	Size int64 `json:"size_total"`
+	// File system configuration type, General is used by default.
+	ConfigType FileSystemConfigTypeEnum `json:"config_type,omitempty"`
+	// File-Level Retention settings, supported for General file systems only.
+	FlrAttributes *FlrAttributes `json:"flr_attributes,omitempty"`
+}
+
+// FsModify modify file system params
+type FsModify struct {
+	// New total size of the file system in bytes.
+	Size *int64 `json:"size_total,omitempty"`
+	// File system description.
+	Description *string `json:"description,omitempty"`
+	// File-Level Retention settings.
+	FlrAttributes *FlrAttributesModify `json:"flr_attributes,omitempty"`
+	// AllowShrink must be set to decrease file system size. It is not sent to the array,
+	// ModifyFS rejects shrinking without it and never shrinks below used space.
+	AllowShrink bool `json:"-"`
+}
+
+// FsSnapshotCreate create file system snapshot params
+type FsSnapshotCreate struct {
+	// Name of the snapshot, generated by the array if not set.
+	Name *string `json:"name,omitempty"`
+	// Snapshot description.
+	Description *string `json:"description,omitempty"`
+	// Expiration timestamp of the snapshot, snapshot never expires if not set.
+	ExpirationTimestamp *string `json:"expiration_timestamp,omitempty"`
+	// Snapshot access type, Snapshot is used by default.
+	AccessType *FileSystemSnapshotAccessTypeEnum `json:"access_type,omitempty"`
+}
+
+// FsSnapshotRestore restore file system from snapshot params
+type FsSnapshotRestore struct {
+	// Name of the backup snapshot of the file system created before restore, backup is not created if not set.
+	CopyName *string `json:"copy_name,omitempty"`
+}
+
+// FsClone clone file system params
+type FsClone struct {
+	// Name of the clone.
+	Name *string `json:"name"`
+	// Clone description.
+	Description *string `json:"description,omitempty"`
+	// Unique identifier of the protection policy assigned to the clone.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+}
+
+// FileSystem file system instance
+type FileSystem struct {
+	// Unique identifier of the file system.
+	ID string `json:"id,omitempty"`
+	// Name of the file system.
+	Name string `json:"name,omitempty"`
+	// File system description.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the NAS server the file system belongs to.
+	NasServerID string `json:"nas_server_id,omitempty"`
+	// Total size of the file system in bytes.
+	SizeTotal int64 `json:"size_total,omitempty"`
+	// Used space of the file system in bytes.
+	SizeUsed int64 `json:"size_used,omitempty"`
+	// File system configuration type.
+	ConfigType FileSystemConfigTypeEnum `json:"config_type,omitempty"`
+	// File-Level Retention settings.
+	FlrAttributes FlrAttributes `json:"flr_attributes,omitempty"`
+	// Type of the file system.
+	FilesystemType FileSystemTypeEnum `json:"filesystem_type,omitempty"`
+	// Unique identifier of the file system the snapshot was taken from, empty for primary file systems.
+	ParentID string `json:"parent_id,omitempty"`
+	// Access type of the snapshot, empty for primary file systems.
+	AccessType FileSystemSnapshotAccessTypeEnum `json:"access_type,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (fs *FileSystem) Fields() []string {
+	return []string{"id", "name", "description", "nas_server_id", "size_total", "size_used",
+		"config_type", "flr_attributes", "filesystem_type", "parent_id", "access_type"}
+}
+
+// FsSnapshotAccessProtocolEnum protocol the snapshot is accessed with
+type FsSnapshotAccessProtocolEnum string
+
+const (
+	// FsSnapshotAccessProtocolEnumNFS snapshot is accessed through NFS export
+	FsSnapshotAccessProtocolEnumNFS FsSnapshotAccessProtocolEnum = "NFS"
+	// FsSnapshotAccessProtocolEnumSMB snapshot is accessed through SMB share
+	FsSnapshotAccessProtocolEnumSMB FsSnapshotAccessProtocolEnum = "SMB"
+)
+
+// FsSnapshotAccessPath path protocol clients read the snapshot content from
+type FsSnapshotAccessPath struct {
+	// Protocol of the export or share.
+	Protocol FsSnapshotAccessProtocolEnum
+	// Unique identifier of the NFS export or SMB share.
+	ExportID string
+	// Name of the NFS export or SMB share.
+	ExportName string
+	// Path of the snapshot content relative to the export or share, e.g. "/.snapshot/daily" for snapshots
+	// accessed through parent file system, "/" for snapshots with own exports.
+	Path string
+}
@@ -19,8 +19,11 @@
 package gopowerstore
 
 import (
+	"errors"
 	"github.com/dell/gopowerstore/api"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -42,6 +45,73 @@ const (
 	VolumeAttachedToHost = api.VolumeAttachedToHost
 	// InstanceWasNotFound - Instance was not found on array
 	InstanceWasNotFound = api.InstanceWasNotFound
+	// VolumeGroupNameAlreadyUseErrorCode indicates non unique volume group name
+	VolumeGroupNameAlreadyUseErrorCode = api.VolumeGroupNameAlreadyUseErrorCode
+	// VolumeSizeIsNotSupportedErrorCode - requested volume size is invalid
+	VolumeSizeIsNotSupportedErrorCode = api.VolumeSizeIsNotSupportedErrorCode
+	// VolumeAlreadyAttachedErrorCode - volume already attached to host
+	VolumeAlreadyAttachedErrorCode = api.VolumeAlreadyAttachedErrorCode
+	// SnapshotRuleIsInUseErrorCode - snapshot rule is used by protection policy
+	SnapshotRuleIsInUseErrorCode = api.SnapshotRuleIsInUseErrorCode
+	// SnapshotIsNotOfVolumeErrorCode - snapshot doesn't belong to the volume
+	SnapshotIsNotOfVolumeErrorCode = api.SnapshotIsNotOfVolumeErrorCode
+	// VolumeIsNotCloneErrorCode - operation is allowed only for clone volumes
+	VolumeIsNotCloneErrorCode = api.VolumeIsNotCloneErrorCode
+	// FilesystemHasExportsErrorCode - file system has NFS exports or SMB shares
+	FilesystemHasExportsErrorCode = api.FilesystemHasExportsErrorCode
+	// ReplicationSessionInvalidStateErrorCode - operation is not allowed in current replication session state
+	ReplicationSessionInvalidStateErrorCode = api.ReplicationSessionInvalidStateErrorCode
+	// MetricsNotAvailableErrorCode - metrics for requested interval were not collected yet
+	MetricsNotAvailableErrorCode = api.MetricsNotAvailableErrorCode
+	// HostAlreadyInHostGroupErrorCode - host is already a member of another host group
+	HostAlreadyInHostGroupErrorCode = api.HostAlreadyInHostGroupErrorCode
+	// HostNameAlreadyUseErrorCode indicates non unique host name
+	HostNameAlreadyUseErrorCode = api.HostNameAlreadyUseErrorCode
+	// NFSExportNameAlreadyUseErrorCode indicates non unique NFS export name
+	NFSExportNameAlreadyUseErrorCode = api.NFSExportNameAlreadyUseErrorCode
+)
+
+// ResourceTypeEnum Type of PowerStore resource.
+// Like other enums of the package it is a string type, so values unknown to the client are decoded as is.
+type ResourceTypeEnum string
+
+const (
+	// ResourceTypeEnumVolume captures enum value "volume"
+	ResourceTypeEnumVolume ResourceTypeEnum = "volume"
+	// ResourceTypeEnumSnapshot captures enum value "snapshot"
+	ResourceTypeEnumSnapshot ResourceTypeEnum = "snapshot"
+	// ResourceTypeEnumClone captures enum value "clone"
+	ResourceTypeEnumClone ResourceTypeEnum = "clone"
+	// ResourceTypeEnumVolumeGroup captures enum value "volume_group"
+	ResourceTypeEnumVolumeGroup ResourceTypeEnum = "volume_group"
+	// ResourceTypeEnumHost captures enum value "host"
+	ResourceTypeEnumHost ResourceTypeEnum = "host"
+	// ResourceTypeEnumHostGroup captures enum value "host_group"
+	ResourceTypeEnumHostGroup ResourceTypeEnum = "host_group"
+	// ResourceTypeEnumHostVolumeMapping captures enum value "host_volume_mapping"
+	ResourceTypeEnumHostVolumeMapping ResourceTypeEnum = "host_volume_mapping"
+	// ResourceTypeEnumReplicationSession captures enum value "replication_session"
+	ResourceTypeEnumReplicationSession ResourceTypeEnum = "replication_session"
+	// ResourceTypeEnumFileSystem captures enum value "file_system"
+	ResourceTypeEnumFileSystem ResourceTypeEnum = "file_system"
+	// ResourceTypeEnumNFSExport captures enum value "nfs_export"
+	ResourceTypeEnumNFSExport ResourceTypeEnum = "nfs_export"
+	// ResourceTypeEnumSMBShare captures enum value "smb_share"
+	ResourceTypeEnumSMBShare ResourceTypeEnum = "smb_share"
+	// ResourceTypeEnumNASServer captures enum value "nas_server"
+	ResourceTypeEnumNASServer ResourceTypeEnum = "nas_server"
+	// ResourceTypeEnumAppliance captures enum value "appliance"
+	ResourceTypeEnumAppliance ResourceTypeEnum = "appliance"
+	// ResourceTypeEnumNode captures enum value "node"
+	ResourceTypeEnumNode ResourceTypeEnum = "node"
+	// ResourceTypeEnumHardware captures enum value "hardware"
+	ResourceTypeEnumHardware ResourceTypeEnum = "hardware"
+	// ResourceTypeEnumRemoteSystem captures enum value "remote_system"
+	ResourceTypeEnumRemoteSystem ResourceTypeEnum = "remote_system"
+	// ResourceTypeEnumImportSession captures enum value "import_session"
+	ResourceTypeEnumImportSession ResourceTypeEnum = "import_session"
+	// ResourceTypeEnumMigrationSession captures enum value "migration_session"
+	ResourceTypeEnumMigrationSession ResourceTypeEnum = "migration_session"
 )
 
 // RequestConfig represents options for request
@@ -58,10 +128,20 @@ type CreateResponse struct {
 	ID string `json:"id,omitempty"`
 }
 
+// JobResponse is returned by operations started in asynchronous mode
+type JobResponse struct {
+	// Unique identifier of the job which performs the operation.
+	ID string `json:"id,omitempty"`
+	// Location of the job returned in location header of the response, e.g. /api/rest/job/<id>.
+	Location string `json:"-"`
+}
+
 // EmptyResponse is response without content
 type EmptyResponse string
 
-// APIError represents API error
+// APIError represents API error.
+// StatusCode holds HTTP status of the response and ErrorCode holds code of the first
+// message returned by PowerStore, so callers can branch on codes which have no helper yet
 type APIError struct {
 	*api.ErrorMsg
 }
@@ -80,7 +160,99 @@ func WrapErr(err error) error {
 	return err
 }
 
+// Sentinel errors matched by APIError with errors.Is, so callers can discriminate errors
+// regardless of resource type, e.g. errors.Is(err, ErrNotFound)
+var (
+	// ErrNotFound requested instance doesn't exist
+	ErrNotFound = errors.New("instance not found")
+	// ErrNameInUse name of the created or renamed instance is already used by another instance
+	ErrNameInUse = errors.New("name is already in use")
+	// ErrUnauthorized credentials are missing or invalid
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// ErrReadOnly is returned, wrapped with method and url, for requests which may change state
+// of the array when client is read-only, check it with errors.Is(err, ErrReadOnly)
+var ErrReadOnly = api.ErrReadOnly
+
+// errorCodeCatalog maps PowerStore error codes to sentinel errors
+var errorCodeCatalog = map[string]error{
+	UnknownVolumeErrorCode:             ErrNotFound,
+	InvalidInstance:                    ErrNotFound,
+	InstanceWasNotFound:                ErrNotFound,
+	NoHostObjectFoundCode:              ErrNotFound,
+	VolumeNameAlreadyUseErrorCode:      ErrNameInUse,
+	SnapshotNameAlreadyUseErrorCode:    ErrNameInUse,
+	VolumeGroupNameAlreadyUseErrorCode: ErrNameInUse,
+	HostNameAlreadyUseErrorCode:        ErrNameInUse,
+	NFSExportNameAlreadyUseErrorCode:   ErrNameInUse,
+}
+
+// Is reports whether the error matches one of sentinel errors ErrNotFound, ErrNameInUse or ErrUnauthorized,
+// the error matches by HTTP status or by code of the message returned by PowerStore
+func (err APIError) Is(target error) bool {
+	if err.ErrorMsg == nil {
+		return false
+	}
+	switch target {
+	case ErrNotFound:
+		if err.StatusCode == http.StatusNotFound {
+			return true
+		}
+	case ErrUnauthorized:
+		return err.StatusCode == http.StatusUnauthorized
+	}
+	return target != nil && errorCodeCatalog[err.ErrorCode] == target
+}
+
+// Status returns HTTP status code of the response
+func (err *APIError) Status() int {
+	return err.StatusCode
+}
+
+// Code returns code of the first message returned by PowerStore, e.g. "0xE04040020002"
+func (err *APIError) Code() string {
+	return err.ErrorCode
+}
+
+// NumericCode returns code of the first message as a number, e.g. 0xE04040020002,
+// 0 is returned if PowerStore returned no code
+func (err *APIError) NumericCode() uint64 {
+	code, parseErr := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(err.ErrorCode), "0x"), 16, 64)
+	if parseErr != nil {
+		return 0
+	}
+	return code
+}
+
+// Args returns arguments of the first message, e.g. name of the instance which caused the error
+func (err *APIError) Args() []string {
+	return err.Arguments
+}
+
+// NotFound returns true if API error indicate that requested instance or endpoint is not found
+func (err *APIError) NotFound() bool {
+	return err.StatusCode == http.StatusNotFound
+}
+
+// BadRequest returns true if API error indicate that request is malformed or has invalid parameters
+func (err *APIError) BadRequest() bool {
+	return err.StatusCode == http.StatusBadRequest
+}
+
+// Unauthorized returns true if API error indicate that credentials are missing or invalid
+func (err *APIError) Unauthorized() bool {
+	return err.StatusCode == http.StatusUnauthorized
+}
+
+// Forbidden returns true if API error indicate that user has no permission for the operation
+func (err *APIError) Forbidden() bool {
+	return err.StatusCode == http.StatusForbidden
+}
+
 // VolumeIsNotExist returns true if API error indicate that volume is not exists
+//
+// Deprecated: use errors.Is(err, ErrNotFound) instead.
 func (err *APIError) VolumeIsNotExist() bool {
 	return (err.StatusCode == http.StatusNotFound || err.StatusCode == http.StatusUnprocessableEntity) &&
 		(err.ErrorCode == UnknownVolumeErrorCode || err.ErrorCode == InvalidInstance ||
@@ -88,29 +260,124 @@ func (err *APIError) VolumeIsNotExist() bool {
 }
 
 // VolumeNameIsAlreadyUse returns true if API error indicate that volume name is already in use
+//
+// Deprecated: use errors.Is(err, ErrNameInUse) instead.
 func (err *APIError) VolumeNameIsAlreadyUse() bool {
 	return err.StatusCode == http.StatusUnprocessableEntity &&
 		err.ErrorCode == VolumeNameAlreadyUseErrorCode
 }
 
 // SnapshotNameIsAlreadyUse returns true if API error indicate that snapshot name is already in use
+//
+// Deprecated: use errors.Is(err, ErrNameInUse) instead.
 func (err *APIError) SnapshotNameIsAlreadyUse() bool {
 	return err.StatusCode == http.StatusBadRequest &&
 		err.ErrorCode == SnapshotNameAlreadyUseErrorCode
 }
 
+// VolumeGroupNameIsAlreadyUse returns true if API error indicate that volume group name is already in use
+//
+// Deprecated: use errors.Is(err, ErrNameInUse) instead.
+func (err *APIError) VolumeGroupNameIsAlreadyUse() bool {
+	return err.StatusCode == http.StatusUnprocessableEntity &&
+		err.ErrorCode == VolumeGroupNameAlreadyUseErrorCode
+}
+
 // HostIsNotAttachedToVolume returns true if API error indicate that host is not attached to volume
 func (err *APIError) HostIsNotAttachedToVolume() bool {
 	return err.StatusCode == http.StatusBadRequest &&
 		err.ErrorCode == HostIsNotAttachedToVolumeErrorCode
 }
 
+// VolumeAlreadyAttached returns true if API error indicate that volume is already attached to host
+func (err *APIError) VolumeAlreadyAttached() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == VolumeAlreadyAttachedErrorCode
+}
+
+// SnapshotRuleIsInUse returns true if API error indicate that snapshot rule can't be deleted
+// because it is used by protection policy
+func (err *APIError) SnapshotRuleIsInUse() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == SnapshotRuleIsInUseErrorCode
+}
+
+// SnapshotIsNotOfVolume returns true if API error indicate that volume can't be restored
+// from the snapshot because the snapshot doesn't belong to the volume
+func (err *APIError) SnapshotIsNotOfVolume() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == SnapshotIsNotOfVolumeErrorCode
+}
+
+// VolumeIsNotClone returns true if API error indicate that operation, e.g. refresh,
+// can't be performed because the volume is not a clone
+func (err *APIError) VolumeIsNotClone() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == VolumeIsNotCloneErrorCode
+}
+
+// FilesystemHasExports returns true if API error indicate that file system can't be deleted
+// because it has NFS exports or SMB shares
+func (err *APIError) FilesystemHasExports() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == FilesystemHasExportsErrorCode
+}
+
+// NasIsNotExist returns true if API error indicate that NAS server is not exists
+//
+// Deprecated: use errors.Is(err, ErrNotFound) instead.
+func (err *APIError) NasIsNotExist() bool {
+	return err.StatusCode == http.StatusNotFound &&
+		(err.ErrorCode == InvalidInstance || err.ErrorCode == InstanceWasNotFound)
+}
+
+// ReplicationSessionInvalidState returns true if API error indicate that operation, e.g. failover,
+// is not allowed in the current state of the replication session
+func (err *APIError) ReplicationSessionInvalidState() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == ReplicationSessionInvalidStateErrorCode
+}
+
+// MetricNotAvailable returns true if API error indicate that the array hasn't collected
+// enough metrics history for the requested entity and interval
+func (err *APIError) MetricNotAvailable() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusNotFound) &&
+		err.ErrorCode == MetricsNotAvailableErrorCode
+}
+
+// PolicyIsNotExist returns true if API error indicate that policy, e.g. protection or I/O limit policy,
+// is not exists. It is returned both for policy queries and for volume create with unknown policy id
+//
+// Deprecated: use errors.Is(err, ErrNotFound) instead.
+func (err *APIError) PolicyIsNotExist() bool {
+	return (err.StatusCode == http.StatusNotFound || err.StatusCode == http.StatusBadRequest ||
+		err.StatusCode == http.StatusUnprocessableEntity) &&
+		(err.ErrorCode == InvalidInstance || err.ErrorCode == InstanceWasNotFound)
+}
+
+// HostAlreadyInHostGroup returns true if API error indicate that host can't be added
+// to the host group because it is already a member of another host group
+func (err *APIError) HostAlreadyInHostGroup() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == HostAlreadyInHostGroupErrorCode
+}
+
 // HostIsNotExist returns true if API error indicate that host is not exists
+//
+// Deprecated: use errors.Is(err, ErrNotFound) instead.
 func (err *APIError) HostIsNotExist() bool {
 	return (err.StatusCode == http.StatusNotFound || err.StatusCode == http.StatusBadRequest) &&
 		(err.ErrorCode == InvalidInstance || err.ErrorCode == NoHostObjectFoundCode)
 }
 
+// HostAlreadyRemoved returns true if API error indicate that host was already removed,
+// so delete or detach of the host can be treated as done
+func (err *APIError) HostAlreadyRemoved() bool {
+	return err.StatusCode == http.StatusNotFound &&
+		(err.ErrorCode == InvalidInstance || err.ErrorCode == NoHostObjectFoundCode ||
+			err.ErrorCode == InstanceWasNotFound)
+}
+
 // BadRange returns true if API error indicate that request was submitted with invalid range
 func (err *APIError) BadRange() bool {
 	return err.StatusCode == http.StatusRequestedRangeNotSatisfiable || err.ErrorCode == BadRangeCode
@@ -119,7 +386,15 @@ func (err *APIError) BadRange() bool {
 // VolumeAttachedToHost returns true if API error indicate that operation can't be complete because
 // volume is attached to host
 func (err *APIError) VolumeAttachedToHost() bool {
-	return err.StatusCode == http.StatusUnprocessableEntity || err.ErrorCode == VolumeAttachedToHost
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == VolumeAttachedToHost
+}
+
+// VolumeSizeIsNotSupported returns true if API error indicate that requested volume size is invalid,
+// e.g. volume shrink was requested
+func (err *APIError) VolumeSizeIsNotSupported() bool {
+	return (err.StatusCode == http.StatusBadRequest || err.StatusCode == http.StatusUnprocessableEntity) &&
+		err.ErrorCode == VolumeSizeIsNotSupportedErrorCode
 }
 
 // NewVolumeIsNotExistError returns new VolumeIsNotExistError
@@ -19,6 +19,11 @@
 package gopowerstore
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"testing"
@@ -32,6 +37,14 @@ func TestAPIError_VolumeNameIsAlreadyUse(t *testing.T) {
 	assert.True(t, apiError.VolumeNameIsAlreadyUse())
 }
 
+func TestAPIError_VolumeGroupNameIsAlreadyUse(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.VolumeGroupNameIsAlreadyUse())
+	apiError.StatusCode = http.StatusUnprocessableEntity
+	apiError.ErrorCode = VolumeGroupNameAlreadyUseErrorCode
+	assert.True(t, apiError.VolumeGroupNameIsAlreadyUse())
+}
+
 func TestAPIError_VolumeIsNotExist(t *testing.T) {
 	apiError := NewAPIError()
 	assert.False(t, apiError.VolumeIsNotExist())
@@ -40,12 +53,212 @@ func TestAPIError_VolumeIsNotExist(t *testing.T) {
 	assert.True(t, apiError.VolumeIsNotExist())
 }
 
+func TestAPIError_VolumeSizeIsNotSupported(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.VolumeSizeIsNotSupported())
+	apiError.StatusCode = http.StatusUnprocessableEntity
+	apiError.ErrorCode = VolumeSizeIsNotSupportedErrorCode
+	assert.True(t, apiError.VolumeSizeIsNotSupported())
+}
+
 func TestAPIError_HostIsNotAttachedToVolume(t *testing.T) {
 	apiError := NewHostIsNotAttachedToVolume()
 	assert.True(t, apiError.HostIsNotAttachedToVolume())
 }
 
+func TestAPIError_VolumeAlreadyAttached(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.VolumeAlreadyAttached())
+	apiError.StatusCode = http.StatusBadRequest
+	apiError.ErrorCode = VolumeAlreadyAttachedErrorCode
+	assert.True(t, apiError.VolumeAlreadyAttached())
+}
+
+func TestAPIError_SnapshotRuleIsInUse(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.SnapshotRuleIsInUse())
+	apiError.StatusCode = http.StatusUnprocessableEntity
+	apiError.ErrorCode = SnapshotRuleIsInUseErrorCode
+	assert.True(t, apiError.SnapshotRuleIsInUse())
+}
+
+func TestAPIError_SnapshotIsNotOfVolume(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.SnapshotIsNotOfVolume())
+	apiError.StatusCode = http.StatusBadRequest
+	apiError.ErrorCode = SnapshotIsNotOfVolumeErrorCode
+	assert.True(t, apiError.SnapshotIsNotOfVolume())
+}
+
+func TestAPIError_VolumeIsNotClone(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.VolumeIsNotClone())
+	apiError.StatusCode = http.StatusUnprocessableEntity
+	apiError.ErrorCode = VolumeIsNotCloneErrorCode
+	assert.True(t, apiError.VolumeIsNotClone())
+}
+
+func TestAPIError_FilesystemHasExports(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.FilesystemHasExports())
+	apiError.StatusCode = http.StatusUnprocessableEntity
+	apiError.ErrorCode = FilesystemHasExportsErrorCode
+	assert.True(t, apiError.FilesystemHasExports())
+}
+
+func TestAPIError_NasIsNotExist(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.NasIsNotExist())
+	notFound := notExistError()
+	assert.True(t, notFound.NasIsNotExist())
+}
+
+func TestAPIError_ReplicationSessionInvalidState(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.ReplicationSessionInvalidState())
+	apiError.StatusCode = http.StatusBadRequest
+	apiError.ErrorCode = ReplicationSessionInvalidStateErrorCode
+	assert.True(t, apiError.ReplicationSessionInvalidState())
+}
+
+func TestAPIError_MetricNotAvailable(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.MetricNotAvailable())
+	apiError.StatusCode = http.StatusNotFound
+	apiError.ErrorCode = MetricsNotAvailableErrorCode
+	assert.True(t, apiError.MetricNotAvailable())
+}
+
+func TestAPIError_PolicyIsNotExist(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.PolicyIsNotExist())
+	apiError.StatusCode = http.StatusBadRequest
+	apiError.ErrorCode = InstanceWasNotFound
+	assert.True(t, apiError.PolicyIsNotExist())
+}
+
+func TestAPIError_HostAlreadyInHostGroup(t *testing.T) {
+	apiError := NewAPIError()
+	assert.False(t, apiError.HostAlreadyInHostGroup())
+	apiError.StatusCode = http.StatusBadRequest
+	apiError.ErrorCode = HostAlreadyInHostGroupErrorCode
+	assert.True(t, apiError.HostAlreadyInHostGroup())
+}
+
 func TestAPIError_VolumeAttachedToHost(t *testing.T) {
 	apiError := NewVolumeAttachedToHostError()
 	assert.True(t, apiError.VolumeAttachedToHost())
+	apiError.ErrorCode = SnapshotRuleIsInUseErrorCode
+	assert.False(t, apiError.VolumeAttachedToHost())
+}
+
+func TestAPIError_FromResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	hostURL := fmt.Sprintf("%s/%s", hostMockURL, hostID)
+	tests := []struct {
+		name   string
+		status int
+		code   string
+		helper func(apiError *APIError) bool
+	}{
+		{"NotFound", http.StatusNotFound, InvalidInstance, (*APIError).NotFound},
+		{"BadRequest", http.StatusBadRequest, "0xE04040010001", (*APIError).BadRequest},
+		{"Unauthorized", http.StatusUnauthorized, "0xE09040040001", (*APIError).Unauthorized},
+		{"Forbidden", http.StatusForbidden, "0xE09040040003", (*APIError).Forbidden},
+		{"HostIsNotExist", http.StatusNotFound, NoHostObjectFoundCode, (*APIError).HostIsNotExist},
+		{"HostAlreadyRemoved", http.StatusNotFound, InstanceWasNotFound, (*APIError).HostAlreadyRemoved},
+		{"VolumeAttachedToHost", http.StatusUnprocessableEntity, VolumeAttachedToHost,
+			(*APIError).VolumeAttachedToHost},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.Reset()
+			respData := fmt.Sprintf(`{"messages": [{"code": "%s", "severity": "Error", "message_l10n": "%s"},
+{"code": "0xE04040010002", "severity": "Error"}]}`, tt.code, tt.name)
+			httpmock.RegisterResponder("GET", hostURL, httpmock.NewStringResponder(tt.status, respData))
+			_, err := C.GetHost(context.Background(), hostID)
+			assert.NotNil(t, err)
+			apiError := err.(APIError)
+			assert.True(t, tt.helper(&apiError))
+			assert.Equal(t, tt.status, apiError.Status())
+			assert.Equal(t, tt.code, apiError.Code())
+			assert.Equal(t, tt.name, apiError.Message)
+		})
+	}
+
+	// helpers keyed off status only don't match other statuses
+	apiError := NewAPIError()
+	apiError.StatusCode = http.StatusUnprocessableEntity
+	assert.False(t, apiError.NotFound())
+	assert.False(t, apiError.BadRequest())
+	assert.False(t, apiError.Unauthorized())
+	assert.False(t, apiError.Forbidden())
+	assert.False(t, apiError.HostAlreadyRemoved())
+	assert.False(t, apiError.VolumeAttachedToHost())
+}
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		code   string
+		target error
+		want   bool
+	}{
+		{"not found by status", http.StatusNotFound, "", ErrNotFound, true},
+		{"not found by code", http.StatusUnprocessableEntity, UnknownVolumeErrorCode, ErrNotFound, true},
+		{"host not found", http.StatusBadRequest, NoHostObjectFoundCode, ErrNotFound, true},
+		{"volume name in use", http.StatusUnprocessableEntity, VolumeNameAlreadyUseErrorCode, ErrNameInUse, true},
+		{"snapshot name in use", http.StatusBadRequest, SnapshotNameAlreadyUseErrorCode, ErrNameInUse, true},
+		{"unauthorized", http.StatusUnauthorized, "0xE09040040001", ErrUnauthorized, true},
+		{"name in use is not not found", http.StatusUnprocessableEntity, VolumeNameAlreadyUseErrorCode,
+			ErrNotFound, false},
+		{"unrelated code", http.StatusUnprocessableEntity, VolumeAttachedToHost, ErrNameInUse, false},
+		{"unrelated sentinel", http.StatusNotFound, InvalidInstance, errors.New("instance not found"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiError := NewAPIError()
+			apiError.StatusCode = tt.status
+			apiError.ErrorCode = tt.code
+			var err error = *apiError
+			assert.Equal(t, tt.want, errors.Is(err, tt.target))
+			assert.Equal(t, tt.want, errors.Is(fmt.Errorf("wrapped: %w", err), tt.target))
+		})
+	}
+}
+
+func TestAPIError_CodeDetails(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		httpmock.NewStringResponder(http.StatusUnprocessableEntity, fmt.Sprintf(`{"messages": [{"code": "%s",
+"severity": "Error", "message_l10n": "Volume name vol1 is already in use", "arguments": ["vol1"]}]}`,
+			VolumeNameAlreadyUseErrorCode)))
+	name := "vol1"
+	size := int64(1048576)
+	_, err := C.CreateVolume(context.Background(), &VolumeCreate{Name: &name, Size: &size})
+	assert.True(t, errors.Is(err, ErrNameInUse))
+	var apiError APIError
+	assert.True(t, errors.As(err, &apiError))
+	assert.Equal(t, uint64(0xE0A080010014), apiError.NumericCode())
+	assert.Equal(t, []string{"vol1"}, apiError.Args())
+
+	assert.Equal(t, uint64(0), NewAPIError().NumericCode())
+}
+
+func TestEnums_UnknownValues(t *testing.T) {
+	// values added by newer arrays must be decoded as is instead of failing the whole response
+	var vol Volume
+	err := json.Unmarshal([]byte(`{"state": "Future_State", "type": "Future_Type"}`), &vol)
+	assert.Nil(t, err)
+	assert.Equal(t, VolumeStateEnum("Future_State"), vol.State)
+	assert.Equal(t, VolumeTypeEnum("Future_Type"), vol.Type)
+
+	var event Event
+	err = json.Unmarshal([]byte(`{"severity": "Warning", "resource_type": "future_resource"}`), &event)
+	assert.Nil(t, err)
+	assert.Equal(t, EventSeverityEnum("Warning"), event.Severity)
+	assert.Equal(t, ResourceTypeEnum("future_resource"), event.ResourceType)
 }
@@ -0,0 +1,91 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const hardwareURL = "hardware"
+
+func getHardwareDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	hw := Hardware{}
+	return c.APIClient().QueryParamsWithFields(&hw)
+}
+
+// GetHardware query and return specific hardware component by id
+func (c *ClientIMPL) GetHardware(ctx context.Context, id string) (resp Hardware, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    hardwareURL,
+			ID:          id,
+			QueryParams: getHardwareDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetHardwareList returns all hardware components of the cluster
+func (c *ClientIMPL) GetHardwareList(ctx context.Context) ([]Hardware, error) {
+	return c.getHardwareList(ctx, nil)
+}
+
+// GetHardwareByType returns hardware components of the type, e.g. all drives
+func (c *ClientIMPL) GetHardwareByType(ctx context.Context, hwType HardwareTypeEnum) ([]Hardware, error) {
+	return c.getHardwareList(ctx, map[string]string{"type": fmt.Sprintf("eq.%s", hwType)})
+}
+
+// GetFailedHardware returns components which are failed, disconnected or failed to prepare
+func (c *ClientIMPL) GetFailedHardware(ctx context.Context) ([]Hardware, error) {
+	states := []string{string(HardwareLifecycleStateEnumFailed), string(HardwareLifecycleStateEnumDisconnected),
+		string(HardwareLifecycleStateEnumPrepareFailed)}
+	return c.getHardwareList(ctx, map[string]string{
+		"lifecycle_state": fmt.Sprintf("in.(%s)", strings.Join(states, ","))})
+}
+
+func (c *ClientIMPL) getHardwareList(ctx context.Context, filters map[string]string) ([]Hardware, error) {
+	result := []Hardware{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Hardware
+		qp := getHardwareDefaultQueryParams(c)
+		for k, v := range filters {
+			qp.RawArg(k, v)
+		}
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    hardwareURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
@@ -0,0 +1,103 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const hardwareMockURL = APIMockURL + hardwareURL
+
+var driveID = "cdbb2d3b0a6b4e1f9d9a3e4e5f7c8d21"
+var enclosureID = "0f4b1c2d3e5a4b6c8d7e9f0a1b2c3d4e"
+
+func TestClientIMPL_GetHardware(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "type": "Drive", "parent_id": "%s", "lifecycle_state": "Healthy",
+"extra_details": {"drive_type": "NVMe_SSD", "size": 1920383410176, "firmware_version": "2.1.3"}}`,
+		driveID, enclosureID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", hardwareMockURL, driveID),
+		httpmock.NewStringResponder(200, respData))
+	hw, err := C.GetHardware(context.Background(), driveID)
+	assert.Nil(t, err)
+	assert.Equal(t, HardwareTypeEnumDrive, hw.Type)
+	assert.Equal(t, enclosureID, hw.ParentID)
+	assert.Equal(t, DriveTypeEnumNVMeSSD, hw.ExtraDetails.DriveType)
+	assert.Equal(t, int64(1920383410176), hw.ExtraDetails.Size)
+	assert.False(t, hw.IsFailed())
+}
+
+func TestClientIMPL_GetHardwareList(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter, stateFilter string
+	httpmock.RegisterResponder("GET", hardwareMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			stateFilter = req.URL.Query().Get("lifecycle_state")
+			if req.URL.Query().Get("offset") == "0" {
+				return pageResponse(fmt.Sprintf(`[{"id": "%s", "lifecycle_state": "Failed"}]`, driveID), "0-0/2"), nil
+			}
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "%s", "lifecycle_state": "Empty"}]`, enclosureID)), nil
+		})
+	list, err := C.GetHardwareList(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, list, 2)
+	assert.True(t, list[0].IsFailed())
+	assert.False(t, list[1].IsFailed())
+	assert.Empty(t, typeFilter)
+
+	_, err = C.GetHardwareByType(context.Background(), HardwareTypeEnumDrive)
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.Drive", typeFilter)
+
+	_, err = C.GetFailedHardware(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "in.(Failed,Disconnected,Prepare_Failed)", stateFilter)
+}
+
+func TestClientIMPL_WearMetricsByDrive(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var entity, entityID string
+	httpmock.RegisterResponder("POST", APIMockURL+metricsURL+"/generate",
+		func(req *http.Request) (*http.Response, error) {
+			var body MetricsRequest
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			entity, entityID = string(body.Entity), body.EntityID
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"timestamp": "2020-05-06T00:00:00Z",
+"drive_id": "%s", "appliance_id": "A1", "percent_endurance_remaining": 87.5}]`, driveID)), nil
+		})
+	samples, err := C.WearMetricsByDrive(context.Background(), driveID, MetricsIntervalEnumOneDay)
+	assert.Nil(t, err)
+	assert.Equal(t, string(MetricsEntityEnumWearByDrive), entity)
+	assert.Equal(t, driveID, entityID)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, 12.5, samples[0].WearPercent())
+}
@@ -0,0 +1,142 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// HardwareTypeEnum type of the hardware component
+type HardwareTypeEnum string
+
+const (
+	// HardwareTypeEnumAppliance captures enum value "Appliance"
+	HardwareTypeEnumAppliance HardwareTypeEnum = "Appliance"
+	// HardwareTypeEnumNode captures enum value "Node"
+	HardwareTypeEnumNode HardwareTypeEnum = "Node"
+	// HardwareTypeEnumEnclosure captures enum value "Enclosure"
+	HardwareTypeEnumEnclosure HardwareTypeEnum = "Enclosure"
+	// HardwareTypeEnumDrive captures enum value "Drive"
+	HardwareTypeEnumDrive HardwareTypeEnum = "Drive"
+	// HardwareTypeEnumFan captures enum value "Fan"
+	HardwareTypeEnumFan HardwareTypeEnum = "Fan"
+	// HardwareTypeEnumPowerSupply captures enum value "Power_Supply"
+	HardwareTypeEnumPowerSupply HardwareTypeEnum = "Power_Supply"
+	// HardwareTypeEnumBattery captures enum value "Battery"
+	HardwareTypeEnumBattery HardwareTypeEnum = "Battery"
+	// HardwareTypeEnumIOModule captures enum value "IO_Module"
+	HardwareTypeEnumIOModule HardwareTypeEnum = "IO_Module"
+	// HardwareTypeEnumSFP captures enum value "SFP"
+	HardwareTypeEnumSFP HardwareTypeEnum = "SFP"
+	// HardwareTypeEnumDIMM captures enum value "DIMM"
+	HardwareTypeEnumDIMM HardwareTypeEnum = "DIMM"
+	// HardwareTypeEnumM2Drive captures enum value "M2_Drive"
+	HardwareTypeEnumM2Drive HardwareTypeEnum = "M2_Drive"
+)
+
+// HardwareLifecycleStateEnum life cycle state of the hardware component
+type HardwareLifecycleStateEnum string
+
+const (
+	// HardwareLifecycleStateEnumUninitialized captures enum value "Uninitialized"
+	HardwareLifecycleStateEnumUninitialized HardwareLifecycleStateEnum = "Uninitialized"
+	// HardwareLifecycleStateEnumHealthy captures enum value "Healthy"
+	HardwareLifecycleStateEnumHealthy HardwareLifecycleStateEnum = "Healthy"
+	// HardwareLifecycleStateEnumFailed captures enum value "Failed"
+	HardwareLifecycleStateEnumFailed HardwareLifecycleStateEnum = "Failed"
+	// HardwareLifecycleStateEnumDisconnected captures enum value "Disconnected"
+	HardwareLifecycleStateEnumDisconnected HardwareLifecycleStateEnum = "Disconnected"
+	// HardwareLifecycleStateEnumPrepareFailed captures enum value "Prepare_Failed"
+	HardwareLifecycleStateEnumPrepareFailed HardwareLifecycleStateEnum = "Prepare_Failed"
+	// HardwareLifecycleStateEnumTriggerUpdate captures enum value "Trigger_Update"
+	HardwareLifecycleStateEnumTriggerUpdate HardwareLifecycleStateEnum = "Trigger_Update"
+	// HardwareLifecycleStateEnumEmpty captures enum value "Empty"
+	HardwareLifecycleStateEnumEmpty HardwareLifecycleStateEnum = "Empty"
+)
+
+// DriveTypeEnum type of the drive
+type DriveTypeEnum string
+
+const (
+	// DriveTypeEnumSASSSD captures enum value "SAS_SSD"
+	DriveTypeEnumSASSSD DriveTypeEnum = "SAS_SSD"
+	// DriveTypeEnumNVMeSCM captures enum value "NVMe_SCM"
+	DriveTypeEnumNVMeSCM DriveTypeEnum = "NVMe_SCM"
+	// DriveTypeEnumNVMeSSD captures enum value "NVMe_SSD"
+	DriveTypeEnumNVMeSSD DriveTypeEnum = "NVMe_SSD"
+	// DriveTypeEnumNVMeNVRAM captures enum value "NVMe_NVRAM"
+	DriveTypeEnumNVMeNVRAM DriveTypeEnum = "NVMe_NVRAM"
+)
+
+// HardwareExtraDetails type specific details of the hardware component, only fields of the component type are set
+type HardwareExtraDetails struct {
+	// Type of the drive.
+	DriveType DriveTypeEnum `json:"drive_type,omitempty"`
+	// Raw capacity of the drive in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Firmware version of the drive or enclosure.
+	FirmwareVersion string `json:"firmware_version,omitempty"`
+	// Encryption status of the drive.
+	EncryptionStatus string `json:"encryption_status,omitempty"`
+	// Indicates whether the drive is FIPS 140-2 compliant.
+	IsFipsCompliant bool `json:"is_fips_compliant,omitempty"`
+	// Physical memory of the node in gigabytes.
+	PhysicalMemorySizeGB int64 `json:"physical_memory_size_gb,omitempty"`
+	// Speed of the SFP or DIMM.
+	Speed string `json:"speed,omitempty"`
+}
+
+// Hardware details about the hardware component, e.g. drive, enclosure or power supply
+type Hardware struct {
+	// Unique identifier of the hardware component.
+	ID string `json:"id,omitempty"`
+	// Name of the hardware component.
+	Name string `json:"name,omitempty"`
+	// Type of the hardware component.
+	Type HardwareTypeEnum `json:"type,omitempty"`
+	// Unique identifier of the appliance the component belongs to.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// Unique identifier of the parent component, e.g. enclosure of the drive.
+	ParentID string `json:"parent_id,omitempty"`
+	// Position of the component in the parent.
+	Slot int64 `json:"slot,omitempty"`
+	// Part number of the component.
+	PartNumber string `json:"part_number,omitempty"`
+	// Serial number of the component.
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Life cycle state of the component.
+	LifecycleState HardwareLifecycleStateEnum `json:"lifecycle_state,omitempty"`
+	// Indicates whether the identification LED of the component is on.
+	IsMarked bool `json:"is_marked,omitempty"`
+	// Type specific details of the component.
+	ExtraDetails HardwareExtraDetails `json:"extra_details,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (h *Hardware) Fields() []string {
+	return []string{"id", "name", "type", "appliance_id", "parent_id", "slot",
+		"part_number", "serial_number", "lifecycle_state", "is_marked", "extra_details"}
+}
+
+// IsFailed returns true if the component requires replacement or reconnection.
+// Empty slots and uninitialized components are not treated as failed
+func (h *Hardware) IsFailed() bool {
+	switch h.LifecycleState {
+	case HardwareLifecycleStateEnumFailed, HardwareLifecycleStateEnumDisconnected,
+		HardwareLifecycleStateEnumPrepareFailed:
+		return true
+	}
+	return false
+}
@@ -20,6 +20,7 @@ package gopowerstore
 
 import (
 	"context"
+	"errors"
 	"github.com/dell/gopowerstore/api"
 	"fmt"
 )
@@ -41,6 +42,11 @@ func getHostVolumeMappingQueryParams(c Client) api.QueryParamsEncoder {
 
 // GetHosts returns hosts list
 func (c *ClientIMPL) GetHosts(ctx context.Context) (resp []Host, err error) {
+	key := cacheKey(hostURL, "list")
+	if cached, ok := c.cache.get(key); ok {
+		return copyHosts(cached.([]Host)), nil
+	}
+	generation := c.cache.generation()
 	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
 		var page []Host
 		qp := getHostDefaultQueryParams(c)
@@ -60,6 +66,9 @@ func (c *ClientIMPL) GetHosts(ctx context.Context) (resp []Host, err error) {
 		}
 		return meta, err
 	})
+	if err == nil {
+		c.cache.set(key, copyHosts(resp), generation)
+	}
 	return resp, err
 }
 
@@ -78,6 +87,11 @@ func (c *ClientIMPL) GetHost(ctx context.Context, id string) (resp Host, err err
 
 // GetHostByName get host by name
 func (c *ClientIMPL) GetHostByName(ctx context.Context, name string) (resp Host, err error) {
+	key := cacheKey(hostURL, "name", name)
+	if cached, ok := c.cache.get(key); ok {
+		return copyHost(cached.(Host)), nil
+	}
+	generation := c.cache.generation()
 	var hostList []Host
 	qp := getHostDefaultQueryParams(c)
 	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
@@ -95,6 +109,7 @@ func (c *ClientIMPL) GetHostByName(ctx context.Context, name string) (resp Host,
 	if len(hostList) != 1 {
 		return resp, NewHostIsNotExistError()
 	}
+	c.cache.set(key, copyHost(hostList[0]), generation)
 	return hostList[0], err
 }
 
@@ -110,6 +125,40 @@ func (c *ClientIMPL) CreateHost(ctx context.Context, createParams *HostCreate) (
 	return resp, WrapErr(err)
 }
 
+// EnsureHost registers the host and returns it. If the registration fails because host with the same
+// name exists, the existing host is returned when its OS type matches and it has all requested initiators,
+// otherwise error matched by ErrNameInUse is returned
+func (c *ClientIMPL) EnsureHost(ctx context.Context, createParams *HostCreate) (Host, error) {
+	resp, err := c.CreateHost(ctx, createParams)
+	if err == nil {
+		return c.GetHost(ctx, resp.ID)
+	}
+	if !errors.Is(err, ErrNameInUse) || createParams.Name == nil {
+		return Host{}, err
+	}
+	existing, getErr := c.GetHostByName(ctx, *createParams.Name)
+	if getErr != nil {
+		return Host{}, err
+	}
+	if createParams.OsType != nil && existing.OsType != *createParams.OsType {
+		return Host{}, fmt.Errorf("%w: host %s exists with OS type %s, requested OS type is %s",
+			ErrNameInUse, existing.Name, existing.OsType, *createParams.OsType)
+	}
+	if createParams.Initiators != nil {
+		ports := make(map[string]bool, len(existing.Initiators))
+		for _, initiator := range existing.Initiators {
+			ports[initiator.PortName] = true
+		}
+		for _, initiator := range *createParams.Initiators {
+			if initiator.PortName != nil && !ports[*initiator.PortName] {
+				return Host{}, fmt.Errorf("%w: host %s exists without initiator %s",
+					ErrNameInUse, existing.Name, *initiator.PortName)
+			}
+		}
+	}
+	return existing, nil
+}
+
 // DeleteHost removes host registration
 func (c *ClientIMPL) DeleteHost(ctx context.Context,
 	deleteParams *HostDelete, id string) (resp EmptyResponse, err error) {
@@ -138,6 +187,28 @@ func (c *ClientIMPL) ModifyHost(ctx context.Context,
 	return resp, WrapErr(err)
 }
 
+// AddHostInitiators adds initiators to the host, e.g. NVMe initiator identified by the host NQN
+func (c *ClientIMPL) AddHostInitiators(ctx context.Context,
+	hostID string, initiators []InitiatorCreateModify) (resp EmptyResponse, err error) {
+	_, err = c.ModifyHost(ctx, &HostModify{AddInitiators: &initiators}, hostID)
+	return resp, err
+}
+
+// RemoveHostInitiators removes initiators with the port names from the host
+func (c *ClientIMPL) RemoveHostInitiators(ctx context.Context,
+	hostID string, portNames []string) (resp EmptyResponse, err error) {
+	_, err = c.ModifyHost(ctx, &HostModify{RemoveInitiators: &portNames}, hostID)
+	return resp, err
+}
+
+// SetHostInitiatorsChap sets CHAP credentials of existing host initiators identified by port name,
+// single credentials authenticate the initiator and mutual credentials authenticate the array
+func (c *ClientIMPL) SetHostInitiatorsChap(ctx context.Context,
+	hostID string, credentials []UpdateInitiatorInHost) (resp EmptyResponse, err error) {
+	_, err = c.ModifyHost(ctx, &HostModify{ModifyInitiators: &credentials}, hostID)
+	return resp, err
+}
+
 // GetHostVolumeMappings returns volume mapping
 func (c *ClientIMPL) GetHostVolumeMappings(ctx context.Context) (resp []HostVolumeMapping, err error) {
 	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
@@ -235,3 +306,147 @@ func (c *ClientIMPL) DetachVolumeFromHost(
 		&resp)
 	return resp, WrapErr(err)
 }
+
+// GetHostInitiatorPaths returns active sessions of all initiators of the host,
+// node-side plugins use it to check multipath connectivity before a volume is reported as published
+func (c *ClientIMPL) GetHostInitiatorPaths(ctx context.Context, hostID string) ([]InitiatorPath, error) {
+	conn, err := c.GetHostConnectivity(ctx, hostID)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Paths, nil
+}
+
+// GetHostConnectivity returns active sessions of the host initiators and initiators which are not logged in
+func (c *ClientIMPL) GetHostConnectivity(ctx context.Context, hostID string) (resp HostConnectivity, err error) {
+	host, err := c.GetHost(ctx, hostID)
+	if err != nil {
+		return resp, err
+	}
+	resp.HostID = host.ID
+	resp.Paths = []InitiatorPath{}
+	for _, initiator := range host.Initiators {
+		if len(initiator.ActiveSessions) == 0 {
+			resp.LoggedOutInitiators = append(resp.LoggedOutInitiators, initiator.PortName)
+			continue
+		}
+		resp.Paths = append(resp.Paths, initiatorPaths(host.ID, initiator)...)
+	}
+	return resp, nil
+}
+
+// GetTargetPortInitiatorPaths returns active sessions of all host initiators logged into the target port,
+// the port is identified by its IQN or WWN
+func (c *ClientIMPL) GetTargetPortInitiatorPaths(ctx context.Context, targetPortName string) ([]InitiatorPath, error) {
+	hosts, err := c.GetHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []InitiatorPath{}
+	for _, host := range hosts {
+		for _, initiator := range host.Initiators {
+			for _, path := range initiatorPaths(host.ID, initiator) {
+				if path.TargetPortName == targetPortName {
+					result = append(result, path)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func initiatorPaths(hostID string, initiator InitiatorInstance) []InitiatorPath {
+	result := make([]InitiatorPath, 0, len(initiator.ActiveSessions))
+	for _, session := range initiator.ActiveSessions {
+		targetPortID := session.EthPortID
+		switch {
+		case session.FcPortID != "":
+			targetPortID = session.FcPortID
+		case session.BondID != "":
+			targetPortID = session.BondID
+		case session.VethID != "":
+			targetPortID = session.VethID
+		}
+		result = append(result, InitiatorPath{
+			HostID:            hostID,
+			InitiatorPortName: initiator.PortName,
+			InitiatorPortType: initiator.PortType,
+			ApplianceID:       session.ApplianceID,
+			NodeID:            session.NodeID,
+			TargetPortName:    session.PortName,
+			TargetPortID:      targetPortID,
+		})
+	}
+	return result
+}
+
+// getHostsByFilter returns hosts which field matches filter
+func (c *ClientIMPL) getHostsByFilter(ctx context.Context, field, filter string) (resp []Host, err error) {
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Host
+		qp := getHostDefaultQueryParams(c)
+		qp.RawArg(field, filter)
+		qp.Order("id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    hostURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
+
+// GetEffectiveHostAccess returns all hosts which can access the volume, either through
+// a direct mapping or through a mapping of the host group they are members of
+func (c *ClientIMPL) GetEffectiveHostAccess(ctx context.Context, volID string) (resp []HostAccess, err error) {
+	var namespace NVMeNamespace
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			ID:          volID,
+			QueryParams: c.APIClient().QueryParams().Select("id", "nsid")},
+		&namespace)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	mappings, err := c.GetHostVolumeMappingByVolumeID(ctx, volID)
+	if err != nil {
+		return resp, err
+	}
+	for _, m := range mappings {
+		var hosts []Host
+		source := HostAccessSourceEnumDirect
+		if m.HostGroupID != "" {
+			source = HostAccessSourceEnumHostGroup
+			hosts, err = c.getHostsByFilter(ctx, "host_group_id", fmt.Sprintf("eq.%s", m.HostGroupID))
+		} else {
+			hosts, err = c.getHostsByFilter(ctx, "id", fmt.Sprintf("eq.%s", m.HostID))
+		}
+		if err != nil {
+			return resp, err
+		}
+		for _, h := range hosts {
+			resp = append(resp, HostAccess{
+				HostID:            h.ID,
+				HostName:          h.Name,
+				Source:            source,
+				HostGroupID:       m.HostGroupID,
+				MappingID:         m.ID,
+				LogicalUnitNumber: m.LogicalUnitNumber,
+				NSID:              namespace.NSID})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,155 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	hostGroupMockURL = APIMockURL + hostGroupURL
+)
+
+var hostGroupID = "19a2b3ef-3d45-4f3c-8b5e-7a6c1d2e9f01"
+
+func TestClientIMPL_GetHostGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "hosts": [{"id": "%s"}, {"id": "%s"}]}`, hostGroupID, hostID, hostID2)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", hostGroupMockURL, hostGroupID),
+		httpmock.NewStringResponder(200, respData))
+	hg, err := C.GetHostGroup(context.Background(), hostGroupID)
+	assert.Nil(t, err)
+	assert.Equal(t, hostGroupID, hg.ID)
+	assert.Len(t, hg.Hosts, 2)
+}
+
+func TestClientIMPL_GetHostGroupByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "name": "cluster"}]`, hostGroupID)
+	httpmock.RegisterResponder("GET", hostGroupMockURL,
+		httpmock.NewStringResponder(200, respData))
+	hg, err := C.GetHostGroupByName(context.Background(), "cluster")
+	assert.Nil(t, err)
+	assert.Equal(t, hostGroupID, hg.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", hostGroupMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetHostGroupByName(context.Background(), "cluster")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetHostGroups(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", hostGroupMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("offset") == "0" {
+				return pageResponse(fmt.Sprintf(`[{"id": "%s"}]`, hostGroupID), "0-0/2"), nil
+			}
+			return pageResponse(`[{"id": "hg2"}]`, "1-1/2"), nil
+		})
+	groups, err := C.GetHostGroups(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, hostGroupID, groups[0].ID)
+}
+
+func TestClientIMPL_CreateHostGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", hostGroupMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, hostGroupID)), nil
+		})
+	name := "cluster"
+	resp, err := C.CreateHostGroup(context.Background(),
+		&HostGroupCreate{Name: &name, HostIDs: []string{hostID, hostID2}})
+	assert.Nil(t, err)
+	assert.Equal(t, hostGroupID, resp.ID)
+	assert.Equal(t, name, reqBody["name"])
+	assert.Len(t, reqBody["host_ids"], 2)
+}
+
+func TestClientIMPL_ModifyHostGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", hostGroupMockURL, hostGroupID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	_, err := C.ModifyHostGroup(context.Background(),
+		&HostGroupModify{RemoveHostIDs: []string{hostID2}}, hostGroupID)
+	assert.Nil(t, err)
+	assert.Len(t, reqBody["remove_host_ids"], 1)
+	assert.NotContains(t, reqBody, "add_host_ids")
+}
+
+func TestClientIMPL_ModifyHostGroup_HostAlreadyInGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"messages": [{"code": "%s", "severity": "Error",
+"message_l10n": "host is already a member of another host group"}]}`, HostAlreadyInHostGroupErrorCode)
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", hostGroupMockURL, hostGroupID),
+		httpmock.NewStringResponder(400, respData))
+	_, err := C.ModifyHostGroup(context.Background(),
+		&HostGroupModify{AddHostIDs: []string{hostID}}, hostGroupID)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.HostAlreadyInHostGroup())
+}
+
+func TestClientIMPL_DeleteHostGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", hostGroupMockURL, hostGroupID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteHostGroup(context.Background(), hostGroupID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_AttachDetachVolumeHostGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/attach", hostGroupMockURL, hostGroupID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/detach", hostGroupMockURL, hostGroupID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.AttachVolumeToHostGroup(context.Background(), hostGroupID, &HostVolumeAttach{VolumeID: &volID})
+	assert.Nil(t, err)
+	_, err = C.DetachVolumeFromHostGroup(context.Background(), hostGroupID, &HostVolumeDetach{VolumeID: &volID})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,58 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// HostGroupCreate create host group request
+type HostGroupCreate struct {
+	// The host group name.
+	Name *string `json:"name"`
+	// An optional description for the host group.
+	Description *string `json:"description,omitempty"`
+	// Hosts to add to the host group. A host can be a member of only one host group.
+	HostIDs []string `json:"host_ids"`
+}
+
+// HostGroupModify modify host group request, unset fields are not changed
+type HostGroupModify struct {
+	// The host group name.
+	Name *string `json:"name,omitempty"`
+	// An optional description for the host group.
+	Description *string `json:"description,omitempty"`
+	// Hosts to add to the host group.
+	AddHostIDs []string `json:"add_host_ids,omitempty"`
+	// Hosts to remove from the host group.
+	RemoveHostIDs []string `json:"remove_host_ids,omitempty"`
+}
+
+// HostGroup Details about a host group.
+type HostGroup struct {
+	// Unique id of the host group.
+	ID string `json:"id,omitempty"`
+	// The host group name.
+	Name string `json:"name,omitempty"`
+	// A description for the host group.
+	Description string `json:"description,omitempty"`
+	// Hosts which are members of the group.
+	Hosts []Host `json:"hosts,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (h *HostGroup) Fields() []string {
+	return []string{"id", "name", "description", "hosts(id,name)"}
+}
@@ -20,9 +20,12 @@ package gopowerstore
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
 )
 
@@ -116,6 +119,92 @@ func TestClientIMPL_ModifyHost(t *testing.T) {
 	assert.Equal(t, hostID, resp.ID)
 }
 
+func TestClientIMPL_HostInitiators(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string][]map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", hostMockURL, hostID),
+		func(req *http.Request) (*http.Response, error) {
+			reqBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	nqn := "nqn.2014-08.org.nvmexpress:uuid:4c4c4544-0056-4d10-8047-b7c04f4e3332"
+	portType := InitiatorProtocolTypeEnumNVMe
+	_, err := C.AddHostInitiators(context.Background(), hostID,
+		[]InitiatorCreateModify{{PortName: &nqn, PortType: &portType}})
+	assert.Nil(t, err)
+	assert.Equal(t, nqn, reqBody["add_initiators"][0]["port_name"])
+	assert.Equal(t, "NVMe", reqBody["add_initiators"][0]["port_type"])
+
+	iqn := "iqn.1994-05.com.redhat:4c4c4544"
+	user := "chap_user"
+	password := "chap_password_1"
+	_, err = C.SetHostInitiatorsChap(context.Background(), hostID,
+		[]UpdateInitiatorInHost{{PortName: &iqn, ChapSingleUsername: &user, ChapSinglePassword: &password}})
+	assert.Nil(t, err)
+	assert.Equal(t, iqn, reqBody["modify_initiators"][0]["port_name"])
+	assert.Equal(t, user, reqBody["modify_initiators"][0]["chap_single_username"])
+	assert.NotContains(t, reqBody["modify_initiators"][0], "chap_mutual_username")
+
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", hostMockURL, hostID),
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string][]string
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			assert.Equal(t, []string{nqn}, body["remove_initiators"])
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	_, err = C.RemoveHostInitiators(context.Background(), hostID, []string{nqn})
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_GetHostConnectivity(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "host_initiators": [
+{"port_name": "iqn.1994-05.com.redhat:a1", "port_type": "iSCSI", "active_sessions": [
+  {"appliance_id": "A1", "node_id": "N1", "port_name": "iqn.2015-10.com.dell:t1", "eth_port_id": "eth1"},
+  {"appliance_id": "A1", "node_id": "N2", "port_name": "iqn.2015-10.com.dell:t2", "bond_id": "bond1"}]},
+{"port_name": "iqn.1994-05.com.redhat:a2", "port_type": "iSCSI", "active_sessions": []}]}`, hostID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", hostMockURL, hostID),
+		httpmock.NewStringResponder(200, respData))
+	conn, err := C.GetHostConnectivity(context.Background(), hostID)
+	assert.Nil(t, err)
+	assert.Len(t, conn.Paths, 2)
+	assert.Equal(t, []string{"iqn.1994-05.com.redhat:a2"}, conn.LoggedOutInitiators)
+	assert.Equal(t, []string{"N1", "N2"}, conn.NodeIDs())
+	assert.Equal(t, InitiatorPath{HostID: hostID, InitiatorPortName: "iqn.1994-05.com.redhat:a1",
+		InitiatorPortType: InitiatorProtocolTypeEnumISCSI, ApplianceID: "A1", NodeID: "N2",
+		TargetPortName: "iqn.2015-10.com.dell:t2", TargetPortID: "bond1"}, conn.Paths[1])
+
+	paths, err := C.GetHostInitiatorPaths(context.Background(), hostID)
+	assert.Nil(t, err)
+	assert.Equal(t, conn.Paths, paths)
+}
+
+func TestClientIMPL_GetTargetPortInitiatorPaths(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[
+{"id": "%s", "host_initiators": [{"port_name": "58:cc:f0:90:4d:20:00:01", "port_type": "FC", "active_sessions": [
+  {"node_id": "N1", "port_name": "58:cc:f0:98:49:21:07:02", "fc_port_id": "fc1"},
+  {"node_id": "N2", "port_name": "58:cc:f0:98:49:29:07:02", "fc_port_id": "fc2"}]}]},
+{"id": "%s", "host_initiators": [{"port_name": "58:cc:f0:90:4d:20:00:02", "port_type": "FC", "active_sessions": [
+  {"node_id": "N1", "port_name": "58:cc:f0:98:49:21:07:02", "fc_port_id": "fc1"}]}]}]`, hostID, hostID2)
+	httpmock.RegisterResponder("GET", hostMockURL,
+		httpmock.NewStringResponder(200, respData))
+	paths, err := C.GetTargetPortInitiatorPaths(context.Background(), "58:cc:f0:98:49:21:07:02")
+	assert.Nil(t, err)
+	assert.Len(t, paths, 2)
+	assert.Equal(t, hostID, paths[0].HostID)
+	assert.Equal(t, hostID2, paths[1].HostID)
+	assert.Equal(t, "fc1", paths[1].TargetPortID)
+}
+
 func TestClientIMPL_GetHostVolumeMappings(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -161,3 +250,81 @@ func TestClientIMPL_DetachVolumeFromHost(t *testing.T) {
 	_, err := C.DetachVolumeFromHost(context.Background(), hostID, &detach)
 	assert.Nil(t, err)
 }
+
+func TestClientIMPL_GetEffectiveHostAccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	hostGroupID := "9d3e2a1c-4b5f-4d6e-8a7b-0c1d2e3f4a5b"
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "nsid": 12}`, volID)))
+	httpmock.RegisterResponder("GET", hostMappingMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[
+{"id": "m1", "host_id": "%s", "logical_unit_number": 1, "volume_id": "%s"},
+{"id": "m2", "host_group_id": "%s", "logical_unit_number": 2, "volume_id": "%s"}]`,
+			hostID, volID, hostGroupID, volID)))
+	httpmock.RegisterResponder("GET", hostMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("host_group_id") != "" {
+				return httpmock.NewStringResponse(200, fmt.Sprintf(
+					`[{"id": "%s", "name": "node2", "host_group_id": "%s"}, {"id": "h3", "name": "node3"}]`,
+					hostID2, hostGroupID)), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "node1"}]`, hostID)), nil
+		})
+
+	access, err := C.GetEffectiveHostAccess(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Len(t, access, 3)
+	assert.Equal(t, HostAccessSourceEnumDirect, access[0].Source)
+	assert.Equal(t, "node1", access[0].HostName)
+	assert.Equal(t, int64(1), access[0].LogicalUnitNumber)
+	assert.Equal(t, int64(12), access[0].NSID)
+	assert.Equal(t, HostAccessSourceEnumHostGroup, access[1].Source)
+	assert.Equal(t, hostGroupID, access[1].HostGroupID)
+	assert.Equal(t, int64(2), access[2].LogicalUnitNumber)
+}
+
+func TestClientIMPL_EnsureHost(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", hostMockURL,
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE0A01001000C"}]}`))
+	httpmock.RegisterResponder("GET", hostMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "host1", "os_type": "Linux",
+"host_initiators": [{"port_name": "iqn.1994-05.com.redhat:1", "port_type": "iSCSI"}]}]`, hostID)))
+	name := "host1"
+	osType := OSTypeEnumLinux
+	port := "iqn.1994-05.com.redhat:1"
+	portType := InitiatorProtocolTypeEnumISCSI
+	initiators := []InitiatorCreateModify{{PortName: &port, PortType: &portType}}
+
+	host, err := C.EnsureHost(context.Background(), &HostCreate{Name: &name, OsType: &osType, Initiators: &initiators})
+	assert.Nil(t, err)
+	assert.Equal(t, hostID, host.ID)
+
+	otherPort := "iqn.1994-05.com.redhat:2"
+	otherInitiators := []InitiatorCreateModify{{PortName: &otherPort, PortType: &portType}}
+	_, err = C.EnsureHost(context.Background(), &HostCreate{Name: &name, OsType: &osType,
+		Initiators: &otherInitiators})
+	assert.True(t, errors.Is(err, ErrNameInUse))
+
+	windows := OSTypeEnumWindows
+	_, err = C.EnsureHost(context.Background(), &HostCreate{Name: &name, OsType: &windows, Initiators: &initiators})
+	assert.True(t, errors.Is(err, ErrNameInUse))
+
+	// validation error is returned as is, even if host with the name exists
+	httpmock.RegisterResponder("POST", hostMockURL,
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE0A010010014"}]}`))
+	_, err = C.EnsureHost(context.Background(), &HostCreate{Name: &name, OsType: &osType, Initiators: &initiators})
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrNameInUse))
+	assert.Equal(t, "0xE0A010010014", err.(APIError).ErrorCode)
+
+	// create error is returned when there is no host with the name
+	httpmock.RegisterResponder("POST", hostMockURL,
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE0A01001000C"}]}`))
+	httpmock.RegisterResponder("GET", hostMockURL, httpmock.NewStringResponder(200, "[]"))
+	_, err = C.EnsureHost(context.Background(), &HostCreate{Name: &name, OsType: &osType, Initiators: &initiators})
+	assert.NotNil(t, err)
+	assert.Equal(t, 422, err.(APIError).StatusCode)
+}
@@ -47,6 +47,7 @@ const (
 // InitiatorProtocolTypeEnum  Protocol type of the host initiator.
 //  * iSCSI - An iSCSI initiator.
 //  * FC - A Fibre Channel initiator.
+//  * NVMe - An NVMe initiator.
 type InitiatorProtocolTypeEnum string
 
 const (
@@ -54,6 +55,8 @@ const (
 	InitiatorProtocolTypeEnumISCSI InitiatorProtocolTypeEnum = "iSCSI"
 	// InitiatorProtocolTypeEnumFC captures enum value "FC"
 	InitiatorProtocolTypeEnumFC InitiatorProtocolTypeEnum = "FC"
+	// InitiatorProtocolTypeEnumNVMe captures enum value "NVMe"
+	InitiatorProtocolTypeEnumNVMe InitiatorProtocolTypeEnum = "NVMe"
 )
 
 // ActiveSessionInstance active session instance
@@ -76,6 +79,8 @@ type ActiveSessionInstance struct {
 	// Unique identifier of the virtual Ethernet port the initiator is logged into.
 	// Null if one of the following is non-null: bond, eth_port_id or fc_port_id.
 	VethID string `json:"veth_id,omitempty"`
+	// NVMe controller identifier of the session. Set for NVMe initiators only.
+	ControllerID int64 `json:"controller_id,omitempty"`
 }
 
 // InitiatorInstance initiator instance
@@ -260,3 +265,73 @@ type HostVolumeDetach struct {
 	// Volume to detach.
 	VolumeID *string `json:"volume_id"`
 }
+
+// HostAccessSourceEnum the way host gets access to the volume
+type HostAccessSourceEnum string
+
+const (
+	// HostAccessSourceEnumDirect - volume is mapped to the host
+	HostAccessSourceEnumDirect HostAccessSourceEnum = "Direct"
+	// HostAccessSourceEnumHostGroup - volume is mapped to the host group the host is member of
+	HostAccessSourceEnumHostGroup HostAccessSourceEnum = "Host_Group"
+)
+
+// HostAccess host which can access the volume
+type HostAccess struct {
+	// Unique id of the host.
+	HostID string
+	// The host name.
+	HostName string
+	// Direct or inherited from host group access.
+	Source HostAccessSourceEnum
+	// Unique identifier of the host group the access is inherited from. Empty for direct access.
+	HostGroupID string
+	// Unique identifier of the mapping which grants the access.
+	MappingID string
+	// Logical unit number for the host volume access.
+	LogicalUnitNumber int64
+	// NVMe namespace identifier of the volume.
+	NSID int64
+}
+
+// InitiatorPath active login session of the host initiator to the target port of the array
+type InitiatorPath struct {
+	// Unique id of the host.
+	HostID string
+	// IQN, WWN or NQN of the host initiator.
+	InitiatorPortName string
+	// Protocol type of the host initiator.
+	InitiatorPortType InitiatorProtocolTypeEnum
+	// Unique identifier of the appliance containing the session.
+	ApplianceID string
+	// Unique identifier of the node the session is created on.
+	NodeID string
+	// IQN or WWN of the target port that the initiator is logged into.
+	TargetPortName string
+	// Unique identifier of the Ethernet, FC, bond or virtual Ethernet port the initiator is logged into.
+	TargetPortID string
+}
+
+// HostConnectivity login state of all initiators of the host
+type HostConnectivity struct {
+	// Unique id of the host.
+	HostID string
+	// Active sessions of all host initiators.
+	Paths []InitiatorPath
+	// Port names of the initiators which have no active session.
+	LoggedOutInitiators []string
+}
+
+// NodeIDs returns ids of the nodes the host has at least one active session to
+func (hc *HostConnectivity) NodeIDs() []string {
+	seen := make(map[string]struct{})
+	var result []string
+	for _, p := range hc.Paths {
+		if _, ok := seen[p.NodeID]; ok {
+			continue
+		}
+		seen[p.NodeID] = struct{}{}
+		result = append(result, p.NodeID)
+	}
+	return result
+}
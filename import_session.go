@@ -0,0 +1,170 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const importSessionURL = "import_session"
+
+func getImportSessionDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	session := ImportSession{}
+	return c.APIClient().QueryParamsWithFields(&session)
+}
+
+// GetImportSession query and return specific import session by id
+func (c *ClientIMPL) GetImportSession(ctx context.Context, id string) (resp ImportSession, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    importSessionURL,
+			ID:          id,
+			QueryParams: getImportSessionDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetImportSessions returns all import sessions
+func (c *ClientIMPL) GetImportSessions(ctx context.Context) ([]ImportSession, error) {
+	return c.getImportSessions(ctx, nil)
+}
+
+// GetImportSessionsByRemoteSystemID returns import sessions from the remote system
+func (c *ClientIMPL) GetImportSessionsByRemoteSystemID(ctx context.Context,
+	remoteSystemID string) ([]ImportSession, error) {
+	return c.getImportSessions(ctx, func(qp api.QueryParamsEncoder) {
+		qp.RawArg("remote_system_id", fmt.Sprintf("eq.%s", remoteSystemID))
+	})
+}
+
+func (c *ClientIMPL) getImportSessions(ctx context.Context,
+	filter func(qp api.QueryParamsEncoder)) ([]ImportSession, error) {
+	result := []ImportSession{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []ImportSession
+		qp := getImportSessionDefaultQueryParams(c)
+		if filter != nil {
+			filter(qp)
+		}
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    importSessionURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateImportSession creates session which imports volume or consistency group from the remote system.
+// The remote system must be registered with CreateRemoteSystem first
+func (c *ClientIMPL) CreateImportSession(ctx context.Context,
+	createParams *ImportSessionCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: importSessionURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyImportSession modifies import session which didn't start yet
+func (c *ClientIMPL) ModifyImportSession(ctx context.Context,
+	modifyParams *ImportSessionModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: importSessionURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteImportSession deletes import session which is finished
+func (c *ClientIMPL) DeleteImportSession(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: importSessionURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CutoverImportSession switches host access to the imported resource, the session must be in
+// Ready_For_Cutover state. Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) CutoverImportSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.importSessionAction(ctx, id, "cutover", nil)
+}
+
+// CancelImportSession stops the import and removes the destination resource.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) CancelImportSession(ctx context.Context,
+	id string, cancelParams *ImportSessionCancel) (resp JobResponse, err error) {
+	return c.importSessionAction(ctx, id, "cancel", cancelParams)
+}
+
+// PauseImportSession pauses copying of the data.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) PauseImportSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.importSessionAction(ctx, id, "pause", nil)
+}
+
+// ResumeImportSession resumes paused import session.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) ResumeImportSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.importSessionAction(ctx, id, "resume", nil)
+}
+
+// CleanupImportSession removes artifacts of the failed or cancelled import from the source system.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) CleanupImportSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.importSessionAction(ctx, id, "cleanup", nil)
+}
+
+func (c *ClientIMPL) importSessionAction(ctx context.Context,
+	id, action string, body interface{}) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    importSessionURL,
+		ID:          id,
+		Action:      action,
+		QueryParams: qp,
+		Body:        body})
+}
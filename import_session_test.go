@@ -0,0 +1,147 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const importSessionMockURL = APIMockURL + importSessionURL
+
+var importSessionID = "4f1c8a2e-6d3b-4e7a-9c5f-2b8d1e0a3c6f"
+
+func TestClientIMPL_GetImportSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "state": "Ready_For_Cutover", "progress_percentage": 100,
+"destination_resource_id": "%s", "destination_resource_type": "volume"}`, importSessionID, volID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", importSessionMockURL, importSessionID),
+		httpmock.NewStringResponder(200, respData))
+	session, err := C.GetImportSession(context.Background(), importSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, ImportSessionStateEnumReadyForCutover, session.State)
+	assert.Equal(t, int32(100), session.ProgressPercentage)
+	assert.Equal(t, volID, session.DestinationResourceID)
+	assert.Equal(t, ImportResourceTypeEnumVolume, session.DestinationResourceType)
+	assert.False(t, session.IsTerminal())
+}
+
+func TestClientIMPL_GetImportSessionsByRemoteSystemID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var systemFilter string
+	httpmock.RegisterResponder("GET", importSessionMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			systemFilter = req.URL.Query().Get("remote_system_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "state": "Import_Completed"}]`,
+				importSessionID)), nil
+		})
+	sessions, err := C.GetImportSessionsByRemoteSystemID(context.Background(), "RS1")
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 1)
+	assert.True(t, sessions[0].IsTerminal())
+	assert.Equal(t, "eq.RS1", systemFilter)
+
+	sessions, err = C.GetImportSessions(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "", systemFilter)
+}
+
+func TestClientIMPL_CreateImportSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", importSessionMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, importSessionID)), nil
+		})
+	name, remoteSystemID, sourceID := "import_lun_1", "RS1", "sv_42"
+	automaticCutover := true
+	resp, err := C.CreateImportSession(context.Background(), &ImportSessionCreate{
+		Name:             &name,
+		RemoteSystemID:   &remoteSystemID,
+		SourceResourceID: &sourceID,
+		AutomaticCutover: &automaticCutover})
+	assert.Nil(t, err)
+	assert.Equal(t, importSessionID, resp.ID)
+	assert.Equal(t, "sv_42", reqBody["source_resource_id"])
+	assert.Equal(t, true, reqBody["automatic_cutover"])
+	assert.NotContains(t, reqBody, "scheduled_timestamp")
+}
+
+func TestClientIMPL_ModifyDeleteImportSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", importSessionMockURL, importSessionID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", importSessionMockURL, importSessionID),
+		httpmock.NewStringResponder(204, ""))
+	scheduled := "2020-09-01T00:00:00Z"
+	_, err := C.ModifyImportSession(context.Background(),
+		&ImportSessionModify{ScheduledTimestamp: &scheduled}, importSessionID)
+	assert.Nil(t, err)
+	_, err = C.DeleteImportSession(context.Background(), importSessionID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_ImportSessionActions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var isAsync string
+	var reqBody map[string]interface{}
+	for _, action := range []string{"cutover", "cancel", "pause", "resume", "cleanup"} {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/%s", importSessionMockURL, importSessionID, action),
+			func(req *http.Request) (*http.Response, error) {
+				isAsync = req.URL.Query().Get("is_async")
+				reqBody = nil
+				if req.Body != nil {
+					_ = json.NewDecoder(req.Body).Decode(&reqBody)
+				}
+				return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+			})
+	}
+	ctx := context.Background()
+	actions := []func() (JobResponse, error){
+		func() (JobResponse, error) { return C.CutoverImportSession(ctx, importSessionID) },
+		func() (JobResponse, error) { return C.PauseImportSession(ctx, importSessionID) },
+		func() (JobResponse, error) { return C.ResumeImportSession(ctx, importSessionID) },
+		func() (JobResponse, error) { return C.CleanupImportSession(ctx, importSessionID) },
+	}
+	for _, action := range actions {
+		resp, err := action()
+		assert.Nil(t, err)
+		assert.Equal(t, jobID, resp.ID)
+		assert.Equal(t, "true", isAsync)
+	}
+	force := true
+	_, err := C.CancelImportSession(ctx, importSessionID, &ImportSessionCancel{Force: &force})
+	assert.Nil(t, err)
+	assert.Equal(t, true, reqBody["force"])
+	assert.Equal(t, 5, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,152 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// ImportSessionStateEnum state of the import session
+type ImportSessionStateEnum string
+
+const (
+	// ImportSessionStateEnumScheduled captures enum value "Scheduled"
+	ImportSessionStateEnumScheduled ImportSessionStateEnum = "Scheduled"
+	// ImportSessionStateEnumQueued captures enum value "Queued"
+	ImportSessionStateEnumQueued ImportSessionStateEnum = "Queued"
+	// ImportSessionStateEnumInitialCopyInProgress captures enum value "Initial_Copy_In_Progress"
+	ImportSessionStateEnumInitialCopyInProgress ImportSessionStateEnum = "Initial_Copy_In_Progress"
+	// ImportSessionStateEnumReadyForCutover captures enum value "Ready_For_Cutover"
+	ImportSessionStateEnumReadyForCutover ImportSessionStateEnum = "Ready_For_Cutover"
+	// ImportSessionStateEnumCutoverInProgress captures enum value "Cutover_In_Progress"
+	ImportSessionStateEnumCutoverInProgress ImportSessionStateEnum = "Cutover_In_Progress"
+	// ImportSessionStateEnumPaused captures enum value "Paused"
+	ImportSessionStateEnumPaused ImportSessionStateEnum = "Paused"
+	// ImportSessionStateEnumCancelling captures enum value "Cancelling"
+	ImportSessionStateEnumCancelling ImportSessionStateEnum = "Cancelling"
+	// ImportSessionStateEnumCancelled captures enum value "Cancelled"
+	ImportSessionStateEnumCancelled ImportSessionStateEnum = "Cancelled"
+	// ImportSessionStateEnumCancelFailed captures enum value "Cancel_Failed"
+	ImportSessionStateEnumCancelFailed ImportSessionStateEnum = "Cancel_Failed"
+	// ImportSessionStateEnumCleanupInProgress captures enum value "Cleanup_In_Progress"
+	ImportSessionStateEnumCleanupInProgress ImportSessionStateEnum = "Cleanup_In_Progress"
+	// ImportSessionStateEnumCleanupRequired captures enum value "Cleanup_Required"
+	ImportSessionStateEnumCleanupRequired ImportSessionStateEnum = "Cleanup_Required"
+	// ImportSessionStateEnumFailed captures enum value "Failed"
+	ImportSessionStateEnumFailed ImportSessionStateEnum = "Failed"
+	// ImportSessionStateEnumCompleted captures enum value "Import_Completed"
+	ImportSessionStateEnumCompleted ImportSessionStateEnum = "Import_Completed"
+	// ImportSessionStateEnumCompletedWithErrors captures enum value "Import_Completed_With_Errors"
+	ImportSessionStateEnumCompletedWithErrors ImportSessionStateEnum = "Import_Completed_With_Errors"
+)
+
+// ImportResourceTypeEnum type of the resource imported to PowerStore
+type ImportResourceTypeEnum string
+
+const (
+	// ImportResourceTypeEnumVolume captures enum value "volume"
+	ImportResourceTypeEnumVolume ImportResourceTypeEnum = "volume"
+	// ImportResourceTypeEnumVolumeGroup captures enum value "volume_group"
+	ImportResourceTypeEnumVolumeGroup ImportResourceTypeEnum = "volume_group"
+)
+
+// ImportSessionCreate create import session request
+type ImportSessionCreate struct {
+	// Name of the import session.
+	Name *string `json:"name"`
+	// Description of the import session.
+	Description *string `json:"description,omitempty"`
+	// Unique identifier of the remote system the resource is imported from.
+	RemoteSystemID *string `json:"remote_system_id"`
+	// Identifier of the volume or consistency group on the remote system.
+	SourceResourceID *string `json:"source_resource_id"`
+	// Cutover starts automatically once the data is copied.
+	AutomaticCutover *bool `json:"automatic_cutover,omitempty"`
+	// Timestamp the import starts at, the import starts immediately if not set.
+	ScheduledTimestamp *string `json:"scheduled_timestamp,omitempty"`
+	// Unique identifier of the protection policy assigned to the imported resource.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+	// Unique identifier of the volume group the imported volume is added to.
+	VolumeGroupID *string `json:"volume_group_id,omitempty"`
+}
+
+// ImportSessionModify modify import session request, unset fields are not changed.
+// Only sessions which didn't start yet may be modified
+type ImportSessionModify struct {
+	// Name of the import session.
+	Name *string `json:"name,omitempty"`
+	// Description of the import session.
+	Description *string `json:"description,omitempty"`
+	// Cutover starts automatically once the data is copied.
+	AutomaticCutover *bool `json:"automatic_cutover,omitempty"`
+	// Timestamp the import starts at.
+	ScheduledTimestamp *string `json:"scheduled_timestamp,omitempty"`
+}
+
+// ImportSessionCancel cancel import session request
+type ImportSessionCancel struct {
+	// Cancel the session even if the source system can't be reached.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ImportSession details about the session importing volume or consistency group from a remote system
+type ImportSession struct {
+	// Unique identifier of the import session.
+	ID string `json:"id,omitempty"`
+	// Name of the import session.
+	Name string `json:"name,omitempty"`
+	// Description of the import session.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the remote system the resource is imported from.
+	RemoteSystemID string `json:"remote_system_id,omitempty"`
+	// Identifier of the resource on the remote system.
+	SourceResourceID string `json:"source_resource_id,omitempty"`
+	// Unique identifier of the volume or volume group the resource is imported to.
+	DestinationResourceID string `json:"destination_resource_id,omitempty"`
+	// Type of the resource the resource is imported to.
+	DestinationResourceType ImportResourceTypeEnum `json:"destination_resource_type,omitempty"`
+	// State of the import session.
+	State ImportSessionStateEnum `json:"state,omitempty"`
+	// Progress of the data copy in percents.
+	ProgressPercentage int32 `json:"progress_percentage,omitempty"`
+	// Average data transfer rate in MB/s.
+	AverageTransferRate float64 `json:"average_transfer_rate,omitempty"`
+	// Estimated time the data copy completes at.
+	EstimatedCompletionTimestamp string `json:"estimated_completion_timestamp,omitempty"`
+	// Timestamp the import starts at.
+	ScheduledTimestamp string `json:"scheduled_timestamp,omitempty"`
+	// Cutover starts automatically once the data is copied.
+	AutomaticCutover bool `json:"automatic_cutover,omitempty"`
+	// Unique identifier of the protection policy assigned to the imported resource.
+	ProtectionPolicyID string `json:"protection_policy_id,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *ImportSession) Fields() []string {
+	return []string{"id", "name", "description", "remote_system_id", "source_resource_id",
+		"destination_resource_id", "destination_resource_type", "state", "progress_percentage",
+		"average_transfer_rate", "estimated_completion_timestamp", "scheduled_timestamp",
+		"automatic_cutover", "protection_policy_id"}
+}
+
+// IsTerminal returns true if import session is finished and its state won't change
+func (s *ImportSession) IsTerminal() bool {
+	switch s.State {
+	case ImportSessionStateEnumCompleted, ImportSessionStateEnumCompletedWithErrors,
+		ImportSessionStateEnumCancelled, ImportSessionStateEnumFailed:
+		return true
+	}
+	return false
+}
@@ -27,6 +27,7 @@ import (
 )
 
 func TestTimeout(t *testing.T) {
+	skipWithFake(t)
 	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Millisecond*10)
 	defer cancelFunc()
 	_, err := C.GetVolumes(ctx)
@@ -25,6 +25,7 @@ import (
 )
 
 func TestGetApplianceListCMA(t *testing.T) {
+	skipWithFake(t)
 	resp, err := C.GetApplianceListCMA(context.Background())
 	checkAPIErr(t, err)
 	assert.Len(t, resp, 1)
@@ -33,7 +34,44 @@ func TestGetApplianceListCMA(t *testing.T) {
 }
 
 func TestGetCapacity(t *testing.T) {
+	skipWithFake(t)
 	resp, err := C.GetCapacity(context.Background())
 	checkAPIErr(t, err)
 	assert.NotEmpty(t, resp)
 }
+
+func TestGetCapacityByAppliance(t *testing.T) {
+	skipWithFake(t)
+	resp, err := C.GetCapacityByAppliance(context.Background())
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, resp)
+	for _, c := range resp {
+		assert.NotEmpty(t, c.ApplianceID)
+		assert.True(t, c.PhysicalTotal > 0)
+		assert.True(t, c.PhysicalFree >= 0 && c.PhysicalFree <= c.PhysicalTotal)
+	}
+}
+
+func TestGetCluster(t *testing.T) {
+	skipWithFake(t)
+	resp, err := C.GetCluster(context.Background())
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, resp.Name)
+}
+
+func TestGetSoftwareVersion(t *testing.T) {
+	skipWithFake(t)
+	resp, err := C.GetSoftwareVersion(context.Background())
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, resp)
+}
+
+func TestGetAppliances(t *testing.T) {
+	skipWithFake(t)
+	resp, err := C.GetAppliances(context.Background())
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, resp)
+	appliance, err := C.GetApplianceByName(context.Background(), resp[0].Name)
+	checkAPIErr(t, err)
+	assert.Equal(t, resp[0].ID, appliance.ID)
+}
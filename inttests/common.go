@@ -31,6 +31,14 @@ func checkAPIErr(t *testing.T, err error) {
 	}
 }
 
+// skipWithFake skips the test if it runs against the fake server,
+// which doesn't support all resources used by the test
+func skipWithFake(t *testing.T) {
+	if usingFake {
+		t.Skip("resources used by the test are not supported by fake server")
+	}
+}
+
 const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
 func randString(n int) string {
@@ -25,6 +25,7 @@ import (
 )
 
 func TestGetFCPorts(t *testing.T) {
+	skipWithFake(t)
 	ports, err := C.GetFCPorts(context.Background())
 	checkAPIErr(t, err)
 	assert.NotEmpty(t, ports)
@@ -0,0 +1,223 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const TestFSPrefix = "test_fs_"
+const DefaultFSSize int64 = 3221225472
+
+// getNASServerID returns id of any NAS server, test is skipped if the array has no NAS
+func getNASServerID(t *testing.T) string {
+	nasList, err := C.GetNASServers(context.Background())
+	checkAPIErr(t, err)
+	if len(nasList) == 0 {
+		t.Skip("no NAS server is configured on the array")
+	}
+	return nasList[0].ID
+}
+
+func createFS(t *testing.T, nasID string) (string, string) {
+	fsName := TestFSPrefix + randString(8)
+	size := DefaultFSSize
+	resp, err := C.CreateFS(context.Background(), &gopowerstore.FsCreate{
+		Name:        fsName,
+		NasServerID: nasID,
+		Size:        size,
+	})
+	checkAPIErr(t, err)
+	return resp.ID, fsName
+}
+
+func deleteFS(t *testing.T, id string) {
+	_, err := C.DeleteFS(context.Background(), id)
+	checkAPIErr(t, err)
+}
+
+func TestCreateDeleteFS(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	fsID, fsName := createFS(t, nasID)
+	fs, err := C.GetFSByName(context.Background(), fsName)
+	checkAPIErr(t, err)
+	assert.Equal(t, fsID, fs.ID)
+	assert.Equal(t, nasID, fs.NasServerID)
+	deleteFS(t, fsID)
+}
+
+func TestFsSnapshot(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	fsID, fsName := createFS(t, nasID)
+	defer deleteFS(t, fsID)
+
+	snapName := fsName + "_snap"
+	snap, err := C.CreateFsSnapshot(context.Background(), &gopowerstore.FsSnapshotCreate{Name: &snapName}, fsID)
+	checkAPIErr(t, err)
+	snaps, err := C.GetFsSnapshotsByFSID(context.Background(), fsID)
+	checkAPIErr(t, err)
+	assert.Len(t, snaps, 1)
+	assert.Equal(t, snap.ID, snaps[0].ID)
+
+	copyName := fsName + "_backup"
+	backup, err := C.RestoreFsSnapshot(context.Background(), snap.ID,
+		&gopowerstore.FsSnapshotRestore{CopyName: &copyName})
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, backup.ID)
+
+	// primary file system can't be deleted as a snapshot
+	_, err = C.DeleteFsSnapshot(context.Background(), fsID)
+	assert.NotNil(t, err)
+	_, err = C.DeleteFsSnapshot(context.Background(), backup.ID)
+	checkAPIErr(t, err)
+	_, err = C.DeleteFsSnapshot(context.Background(), snap.ID)
+	checkAPIErr(t, err)
+}
+
+func TestCloneFS(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	fsID, fsName := createFS(t, nasID)
+	defer deleteFS(t, fsID)
+
+	cloneName := fsName + "_clone"
+	resp, err := C.CloneFS(context.Background(), &gopowerstore.FsClone{Name: &cloneName}, fsID)
+	checkAPIErr(t, err)
+	defer deleteFS(t, resp.ID)
+	clone, err := C.GetFS(context.Background(), resp.ID)
+	checkAPIErr(t, err)
+	assert.Equal(t, cloneName, clone.Name)
+	assert.Equal(t, nasID, clone.NasServerID)
+}
+
+func TestNFSExport(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	fsID, fsName := createFS(t, nasID)
+	defer deleteFS(t, fsID)
+
+	path := "/" + fsName
+	resp, err := C.CreateNFSExport(context.Background(), &gopowerstore.NFSExportCreate{
+		Name:         &fsName,
+		FileSystemID: &fsID,
+		Path:         &path,
+	})
+	checkAPIErr(t, err)
+
+	rwHosts := []string{"192.168.100.10"}
+	_, err = C.ModifyNFSExport(context.Background(), &gopowerstore.NFSExportModify{RwHosts: &rwHosts}, resp.ID)
+	checkAPIErr(t, err)
+	export, err := C.GetNFSExportByName(context.Background(), fsName)
+	checkAPIErr(t, err)
+	assert.Equal(t, resp.ID, export.ID)
+	assert.Len(t, export.RwHosts, 1)
+	export, err = C.GetNFSExportByFileSystemID(context.Background(), fsID)
+	checkAPIErr(t, err)
+	assert.Equal(t, resp.ID, export.ID)
+
+	// file system with export can't be deleted
+	_, err = C.DeleteFS(context.Background(), fsID)
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.FilesystemHasExports())
+
+	_, err = C.DeleteNFSExport(context.Background(), resp.ID)
+	checkAPIErr(t, err)
+}
+
+func TestSMBShare(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	if _, err := C.GetADConfig(context.Background(), nasID); err != nil {
+		t.Skip("NAS server has no SMB server configured")
+	}
+	fsID, fsName := createFS(t, nasID)
+	defer deleteFS(t, fsID)
+
+	path := "/"
+	resp, err := C.CreateSMBShare(context.Background(), &gopowerstore.SMBShareCreate{
+		Name:         &fsName,
+		FileSystemID: &fsID,
+		Path:         &path,
+	})
+	checkAPIErr(t, err)
+	encryption := true
+	_, err = C.ModifySMBShare(context.Background(),
+		&gopowerstore.SMBShareModify{IsEncryptionEnabled: &encryption}, resp.ID)
+	checkAPIErr(t, err)
+	share, err := C.GetSMBShareByName(context.Background(), fsName)
+	checkAPIErr(t, err)
+	assert.Equal(t, resp.ID, share.ID)
+	assert.True(t, share.IsEncryptionEnabled)
+	shares, err := C.GetSMBSharesByFileSystemID(context.Background(), fsID)
+	checkAPIErr(t, err)
+	assert.Len(t, shares, 1)
+
+	_, err = C.DeleteSMBShare(context.Background(), resp.ID)
+	checkAPIErr(t, err)
+}
+
+func TestNASServerInterfaces(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	interfaces, err := C.GetFileInterfacesByNASServerID(context.Background(), nasID)
+	checkAPIErr(t, err)
+	for _, fi := range interfaces {
+		assert.Equal(t, nasID, fi.NasServerID)
+		assert.NotEmpty(t, fi.IPAddress)
+	}
+	nfs, err := C.GetNFSServerByNASServerID(context.Background(), nasID)
+	if apiError, ok := err.(gopowerstore.APIError); ok && apiError.NotFound() {
+		t.Skip("NAS server has no NFS server configured")
+	}
+	checkAPIErr(t, err)
+	assert.Equal(t, nasID, nfs.NasServerID)
+}
+
+func TestFsQuotas(t *testing.T) {
+	skipWithFake(t)
+	nasID := getNASServerID(t)
+	fsID, _ := createFS(t, nasID)
+	defer deleteFS(t, fsID)
+
+	hard := int64(1073741824)
+	tree, err := C.CreateTreeQuota(context.Background(),
+		&gopowerstore.TreeQuotaCreate{FileSystemID: fsID, Path: "/tenant1", HardLimit: &hard})
+	checkAPIErr(t, err)
+	quotas, err := C.GetTreeQuotasByFSID(context.Background(), fsID)
+	checkAPIErr(t, err)
+	assert.Len(t, quotas, 1)
+	assert.Equal(t, hard, quotas[0].HardLimit)
+
+	uid := int64(1001)
+	_, err = C.CreateUserQuota(context.Background(),
+		&gopowerstore.UserQuotaCreate{FileSystemID: fsID, TreeQuotaID: &tree.ID, UID: &uid, HardLimit: &hard})
+	checkAPIErr(t, err)
+	userQuotas, err := C.GetUserQuotasByFSID(context.Background(), fsID)
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, userQuotas)
+
+	_, err = C.DeleteTreeQuota(context.Background(), tree.ID)
+	checkAPIErr(t, err)
+}
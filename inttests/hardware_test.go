@@ -0,0 +1,55 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetHardwareList(t *testing.T) {
+	skipWithFake(t)
+	list, err := C.GetHardwareList(context.Background())
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, list)
+	hw, err := C.GetHardware(context.Background(), list[0].ID)
+	checkAPIErr(t, err)
+	assert.Equal(t, list[0].ID, hw.ID)
+	assert.NotEmpty(t, hw.Type)
+}
+
+func TestDriveWearMetrics(t *testing.T) {
+	skipWithFake(t)
+	drives, err := C.GetHardwareByType(context.Background(), gopowerstore.HardwareTypeEnumDrive)
+	checkAPIErr(t, err)
+	for _, d := range drives {
+		assert.Equal(t, gopowerstore.HardwareTypeEnumDrive, d.Type)
+	}
+	if len(drives) == 0 {
+		t.Skip("drives not found")
+		return
+	}
+	samples, err := C.WearMetricsByDrive(context.Background(), drives[0].ID, gopowerstore.MetricsIntervalEnumOneDay)
+	checkAPIErr(t, err)
+	for _, s := range samples {
+		assert.True(t, s.PercentEnduranceRemaining >= 0 && s.PercentEnduranceRemaining <= 100)
+	}
+}
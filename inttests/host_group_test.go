@@ -0,0 +1,83 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	testHostGroupPrefix = "test_hg_"
+)
+
+func TestHostGroupAttachDetachVolume(t *testing.T) {
+	skipWithFake(t)
+	hostID, _ := createHost(t)
+	hostID2, _ := createHost(t)
+	volID, _ := createVol(t)
+
+	// create group with two hosts
+	name := testHostGroupPrefix + randString(8)
+	resp, err := C.CreateHostGroup(context.Background(),
+		&gopowerstore.HostGroupCreate{Name: &name, HostIDs: []string{hostID, hostID2}})
+	checkAPIErr(t, err)
+	hostGroupID := resp.ID
+	hg, err := C.GetHostGroupByName(context.Background(), name)
+	checkAPIErr(t, err)
+	assert.Equal(t, hostGroupID, hg.ID)
+	assert.Len(t, hg.Hosts, 2)
+
+	// host can't be a member of two groups
+	name2 := testHostGroupPrefix + randString(8)
+	_, err = C.CreateHostGroup(context.Background(),
+		&gopowerstore.HostGroupCreate{Name: &name2, HostIDs: []string{hostID}})
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.HostAlreadyInHostGroup())
+
+	// attach
+	_, err = C.AttachVolumeToHostGroup(context.Background(), hostGroupID,
+		&gopowerstore.HostVolumeAttach{VolumeID: &volID})
+	checkAPIErr(t, err)
+	mappings, err := C.GetHostVolumeMappingByVolumeID(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Len(t, mappings, 1)
+	assert.Equal(t, hostGroupID, mappings[0].HostGroupID)
+
+	// detach
+	_, err = C.DetachVolumeFromHostGroup(context.Background(), hostGroupID,
+		&gopowerstore.HostVolumeDetach{VolumeID: &volID})
+	checkAPIErr(t, err)
+
+	// remove member hosts and tear down
+	_, err = C.ModifyHostGroup(context.Background(),
+		&gopowerstore.HostGroupModify{RemoveHostIDs: []string{hostID2}}, hostGroupID)
+	checkAPIErr(t, err)
+	hg, err = C.GetHostGroup(context.Background(), hostGroupID)
+	checkAPIErr(t, err)
+	assert.Len(t, hg.Hosts, 1)
+	_, err = C.DeleteHostGroup(context.Background(), hostGroupID)
+	checkAPIErr(t, err)
+	deleteVol(t, volID)
+	deleteHost(t, hostID)
+	deleteHost(t, hostID2)
+}
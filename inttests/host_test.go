@@ -140,6 +140,11 @@ func TestAttachDetachVolume(t *testing.T) {
 	attach.VolumeID = &volID
 	_, err := C.AttachVolumeToHost(context.Background(), hostID, &attach)
 	assert.Nil(t, err)
+	// try attach second time
+	_, err = C.AttachVolumeToHost(context.Background(), hostID, &attach)
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.VolumeAlreadyAttached())
 
 	// read volume mapping
 	resp, err := C.GetHostVolumeMappingByVolumeID(context.Background(), volID)
@@ -154,7 +159,7 @@ func TestAttachDetachVolume(t *testing.T) {
 	_, err = C.DetachVolumeFromHost(context.Background(), hostID, &detach)
 	assert.NotNil(t, err)
 	// try detach second time
-	apiError := err.(gopowerstore.APIError)
+	apiError = err.(gopowerstore.APIError)
 	assert.True(t, apiError.HostIsNotAttachedToVolume())
 	deleteVol(t, volID)
 	deleteHost(t, hostID)
@@ -20,21 +20,35 @@ package inttests
 
 import (
 	"github.com/dell/gopowerstore"
+	"github.com/dell/gopowerstore/fake"
 	"github.com/joho/godotenv"
 	"log"
+	"os"
+	"strconv"
 )
 
 const envVarsFile = "GOPOWERSTORE_TEST.env"
 
+// fakeEnv enables running of the tests against in-memory fake server instead of the array
+const fakeEnv = "GOPOWERSTORE_TEST_FAKE"
+
 // C is global powerstore Client instance for testing
 var C gopowerstore.Client
 
+// usingFake is true when C sends requests to the fake server
+var usingFake bool
+
 func initClient() {
 	err := godotenv.Load(envVarsFile)
 	if err != nil {
 		log.Printf("%s file not found.", envVarsFile)
 	}
-	C, err = gopowerstore.NewClient()
+	if usingFake, _ = strconv.ParseBool(os.Getenv(fakeEnv)); usingFake {
+		log.Printf("running tests against fake server")
+		C, err = fake.NewServer().NewClient()
+	} else {
+		C, err = gopowerstore.NewClient()
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,74 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const TestIoLimitPolicyPrefix = "test_qos_"
+
+func TestIoLimitPolicyVolume(t *testing.T) {
+	skipWithFake(t)
+	name := TestIoLimitPolicyPrefix + randString(8)
+	maxIops := int64(5000)
+	maxBandwidth := int64(100 * 1024 * 1024)
+	burst := int32(10)
+	policy, err := C.CreateIoLimitPolicy(context.Background(), &gopowerstore.IoLimitPolicyCreate{
+		Name:            &name,
+		MaxIops:         &maxIops,
+		MaxBandwidth:    &maxBandwidth,
+		BurstPercentage: &burst,
+	})
+	checkAPIErr(t, err)
+
+	got, err := C.GetIoLimitPolicyByName(context.Background(), name)
+	checkAPIErr(t, err)
+	assert.Equal(t, policy.ID, got.ID)
+	assert.Equal(t, maxIops, got.IoLimitRule.MaxIops)
+	assert.Equal(t, maxBandwidth, got.IoLimitRule.MaxBandwidth)
+	assert.Equal(t, burst, got.IoLimitRule.BurstPercentage)
+
+	volName := TestVolumePrefix + randString(8)
+	size := DefaultVolSize
+	vol, err := C.CreateVolume(context.Background(),
+		&gopowerstore.VolumeCreate{Name: &volName, Size: &size, IoLimitPolicyID: &policy.ID})
+	checkAPIErr(t, err)
+
+	got, err = C.GetIoLimitPolicy(context.Background(), policy.ID)
+	checkAPIErr(t, err)
+	assert.Len(t, got.Volumes, 1)
+	assert.Equal(t, vol.ID, got.Volumes[0].ID)
+
+	// unknown policy
+	unknownID := "f98de58e-9223-4fdc-86bd-d4ff268e20e1"
+	volName2 := TestVolumePrefix + randString(8)
+	_, err = C.CreateVolume(context.Background(),
+		&gopowerstore.VolumeCreate{Name: &volName2, Size: &size, IoLimitPolicyID: &unknownID})
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.PolicyIsNotExist())
+
+	deleteVol(t, vol.ID)
+	_, err = C.DeleteIoLimitPolicy(context.Background(), policy.ID)
+	checkAPIErr(t, err)
+}
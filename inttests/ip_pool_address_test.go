@@ -20,13 +20,25 @@ package inttests
 
 import (
 	"context"
+	"github.com/dell/gopowerstore"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 func TestGetIPPoolAddress(t *testing.T) {
+	skipWithFake(t)
 	resp, err := C.GetStorageISCSITargetAddresses(context.Background())
 	checkAPIErr(t, err)
 	assert.Len(t, resp, 2)
 	assert.NotEmpty(t, resp[0].Address)
 }
+
+func TestGetIPPoolAddresses(t *testing.T) {
+	skipWithFake(t)
+	resp, err := C.GetIPPoolAddresses(context.Background(), gopowerstore.IPPurposeTypeEnumStorageIscsiTarget)
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, resp)
+	for _, a := range resp {
+		assert.Contains(t, a.Purposes, gopowerstore.IPPurposeTypeEnumStorageIscsiTarget)
+	}
+}
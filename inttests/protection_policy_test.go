@@ -0,0 +1,77 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const TestProtectionPolicyPrefix = "test_pp_"
+
+func TestProtectionPolicySnapshotRule(t *testing.T) {
+	skipWithFake(t)
+	ruleName := TestProtectionPolicyPrefix + "rule_" + randString(8)
+	interval := gopowerstore.SnapshotRuleIntervalEnumFourHours
+	retention := int32(24)
+	rule, err := C.CreateSnapshotRule(context.Background(), &gopowerstore.SnapshotRuleCreate{
+		Name:             &ruleName,
+		Interval:         &interval,
+		DesiredRetention: &retention,
+	})
+	checkAPIErr(t, err)
+
+	policyName := TestProtectionPolicyPrefix + randString(8)
+	policy, err := C.CreateProtectionPolicy(context.Background(), &gopowerstore.ProtectionPolicyCreate{
+		Name:            &policyName,
+		SnapshotRuleIDs: []string{rule.ID},
+	})
+	checkAPIErr(t, err)
+
+	got, err := C.GetProtectionPolicyByName(context.Background(), policyName)
+	checkAPIErr(t, err)
+	assert.Equal(t, policy.ID, got.ID)
+	assert.Len(t, got.SnapshotRules, 1)
+	assert.Equal(t, rule.ID, got.SnapshotRules[0].ID)
+
+	// assign and unassign the policy on existing volume
+	volID, _ := createVol(t)
+	defer deleteVol(t, volID)
+	_, err = C.ModifyVolume(context.Background(), &gopowerstore.VolumeModify{ProtectionPolicyID: &policy.ID}, volID)
+	checkAPIErr(t, err)
+	vol, err := C.GetVolume(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Equal(t, policy.ID, vol.ProtectionPolicyID)
+	noPolicy := ""
+	_, err = C.ModifyVolume(context.Background(), &gopowerstore.VolumeModify{ProtectionPolicyID: &noPolicy}, volID)
+	checkAPIErr(t, err)
+
+	// rule is used by policy
+	_, err = C.DeleteSnapshotRule(context.Background(), rule.ID)
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.SnapshotRuleIsInUse())
+
+	_, err = C.DeleteProtectionPolicy(context.Background(), policy.ID)
+	checkAPIErr(t, err)
+	_, err = C.DeleteSnapshotRule(context.Background(), rule.ID)
+	checkAPIErr(t, err)
+}
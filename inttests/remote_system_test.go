@@ -0,0 +1,46 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetRemoteSystems(t *testing.T) {
+	skipWithFake(t)
+	systems, err := C.GetRemoteSystems(context.Background())
+	checkAPIErr(t, err)
+	if len(systems) == 0 {
+		t.Skip("no remote systems are registered on the array")
+	}
+	system, err := C.GetRemoteSystemByName(context.Background(), systems[0].Name)
+	checkAPIErr(t, err)
+	assert.Equal(t, systems[0].ID, system.ID)
+	system, err = C.GetRemoteSystemByMgmtAddress(context.Background(), systems[0].ManagementAddress)
+	checkAPIErr(t, err)
+	assert.Equal(t, systems[0].ID, system.ID)
+
+	_, err = C.VerifyRemoteSystem(context.Background(), system.ID)
+	checkAPIErr(t, err)
+	system, err = C.GetRemoteSystem(context.Background(), system.ID)
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, system.DataConnectionState)
+}
@@ -0,0 +1,68 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const TestStorageContainerPrefix = "test_sc_"
+
+func TestStorageContainer(t *testing.T) {
+	skipWithFake(t)
+	name := TestStorageContainerPrefix + randString(8)
+	quota := int64(1024 * 1024 * 1024 * 10)
+	resp, err := C.CreateStorageContainer(context.Background(),
+		&gopowerstore.StorageContainerCreate{Name: &name, Quota: &quota})
+	checkAPIErr(t, err)
+	defer func() {
+		_, err := C.DeleteStorageContainer(context.Background(), resp.ID)
+		checkAPIErr(t, err)
+	}()
+
+	container, err := C.GetStorageContainerByName(context.Background(), name)
+	checkAPIErr(t, err)
+	assert.Equal(t, resp.ID, container.ID)
+	assert.Equal(t, quota, container.Quota)
+
+	quota *= 2
+	_, err = C.ModifyStorageContainer(context.Background(),
+		&gopowerstore.StorageContainerModify{Quota: &quota}, resp.ID)
+	checkAPIErr(t, err)
+	container, err = C.GetStorageContainer(context.Background(), resp.ID)
+	checkAPIErr(t, err)
+	assert.Equal(t, quota, container.Quota)
+}
+
+func TestGetVirtualVolumes(t *testing.T) {
+	skipWithFake(t)
+	vvols, err := C.GetVirtualVolumes(context.Background())
+	checkAPIErr(t, err)
+	if len(vvols) == 0 {
+		t.Skip("no virtual volumes on the array")
+	}
+	vvol, err := C.GetVirtualVolume(context.Background(), vvols[0].ID)
+	checkAPIErr(t, err)
+	assert.Equal(t, vvols[0].Name, vvol.Name)
+	_, err = C.GetVirtualVolumeSnapshots(context.Background(), vvol.ID)
+	checkAPIErr(t, err)
+}
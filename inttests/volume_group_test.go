@@ -0,0 +1,81 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package inttests
+
+import (
+	"context"
+	"github.com/dell/gopowerstore"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const TestVolumeGroupPrefix = "test_vg_"
+
+func TestVolumeGroupSnapshot(t *testing.T) {
+	skipWithFake(t)
+	volID, _ := createVol(t)
+	defer deleteVol(t, volID)
+	volID2, _ := createVol(t)
+	defer deleteVol(t, volID2)
+
+	groupName := TestVolumeGroupPrefix + randString(8)
+	group, err := C.CreateVolumeGroup(context.Background(), &gopowerstore.VolumeGroupCreate{
+		Name:      &groupName,
+		VolumeIDs: []string{volID, volID2},
+	})
+	checkAPIErr(t, err)
+	defer func() {
+		_, err := C.RemoveMembersFromVolumeGroup(context.Background(), group.ID, []string{volID, volID2})
+		checkAPIErr(t, err)
+		_, err = C.DeleteVolumeGroup(context.Background(), group.ID)
+		checkAPIErr(t, err)
+	}()
+
+	got, err := C.GetVolumeGroupByName(context.Background(), groupName)
+	checkAPIErr(t, err)
+	assert.Equal(t, group.ID, got.ID)
+	assert.Len(t, got.Volumes, 2)
+
+	groups, err := C.GetVolumeGroupsByVolumeID(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, group.ID, groups[0].ID)
+	groups, err = C.GetVolumeGroups(context.Background())
+	checkAPIErr(t, err)
+	found := false
+	for _, g := range groups {
+		found = found || g.ID == group.ID
+	}
+	assert.True(t, found)
+
+	snapName := groupName + "_snapshot"
+	snap, err := C.CreateVolumeGroupSnapshot(context.Background(), group.ID,
+		&gopowerstore.VolumeGroupSnapshotCreate{Name: &snapName})
+	checkAPIErr(t, err)
+	snapGroup, err := C.GetVolumeGroup(context.Background(), snap.ID)
+	checkAPIErr(t, err)
+	assert.Len(t, snapGroup.Volumes, 2)
+	_, err = C.DeleteVolumeGroup(context.Background(), snap.ID)
+	checkAPIErr(t, err)
+
+	_, err = C.CreateVolumeGroup(context.Background(), &gopowerstore.VolumeGroupCreate{Name: &groupName})
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.VolumeGroupNameIsAlreadyUse())
+}
@@ -20,6 +20,7 @@ package inttests
 
 import (
 	"context"
+	"fmt"
 	"github.com/dell/gopowerstore"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -73,6 +74,27 @@ func TestGetSnapshotsByVolumeID(t *testing.T) {
 	assert.Equal(t, snap.ID, snapList[0].ID)
 }
 
+func TestDeleteSnapshotsByVolumeID(t *testing.T) {
+	volID, volName := createVol(t)
+	defer deleteVol(t, volID)
+
+	snapCount := 3
+	for i := 0; i < snapCount; i++ {
+		snapName := fmt.Sprintf("%s_snapshot_%d", volName, i)
+		_, err := C.CreateSnapshot(context.Background(), &gopowerstore.SnapshotCreate{Name: &snapName}, volID)
+		checkAPIErr(t, err)
+	}
+
+	result, err := C.DeleteSnapshotsByVolumeID(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Equal(t, snapCount, result.Deleted)
+	assert.Empty(t, result.Errors)
+
+	snapList, err := C.GetSnapshotsByVolumeID(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Empty(t, snapList)
+}
+
 func TestGetSnapshot(t *testing.T) {
 	volID, volName := createVol(t)
 	defer deleteVol(t, volID)
@@ -136,6 +158,93 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 	deleteVol(t, snapVol.ID)
 }
 
+func TestCloneVolume(t *testing.T) {
+	volID, _ := createVol(t)
+	defer deleteVol(t, volID)
+	hostID, _ := createHost(t)
+	defer deleteHost(t, hostID)
+	// source stays attached while it is cloned
+	_, err := C.AttachVolumeToHost(context.Background(), hostID, &gopowerstore.HostVolumeAttach{VolumeID: &volID})
+	checkAPIErr(t, err)
+	defer func() {
+		_, err := C.DetachVolumeFromHost(context.Background(), hostID,
+			&gopowerstore.HostVolumeDetach{VolumeID: &volID})
+		checkAPIErr(t, err)
+	}()
+
+	cloneName := TestVolumePrefix + "clone_" + randString(8)
+	clone, err := C.CloneVolume(context.Background(), &gopowerstore.VolumeClone{Name: &cloneName}, volID)
+	checkAPIErr(t, err)
+	assert.NotEmpty(t, clone.ID)
+	defer deleteVol(t, clone.ID)
+	source, err := C.GetVolume(context.Background(), volID)
+	checkAPIErr(t, err)
+	cloneVol, err := C.GetVolume(context.Background(), clone.ID)
+	checkAPIErr(t, err)
+	assert.Equal(t, source.Size, cloneVol.Size)
+	assert.Equal(t, volID, cloneVol.ProtectionData.SourceID)
+
+	otherName := TestVolumePrefix + "clone_" + randString(8)
+	_, err = C.CloneVolume(context.Background(),
+		&gopowerstore.VolumeClone{Name: &otherName}, "4961282c-c5c5-4234-935f-2742fed499d0")
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.VolumeIsNotExist())
+}
+
+func TestRestoreVolumeFromSnapshot(t *testing.T) {
+	volID, volName := createVol(t)
+	defer deleteVol(t, volID)
+	snap := createSnap(volID, t, volName)
+	assert.NotEmpty(t, snap.ID)
+
+	snapshot, err := C.GetSnapshot(context.Background(), snap.ID)
+	checkAPIErr(t, err)
+	_, err = C.RestoreVolumeFromSnapshot(context.Background(), volID,
+		&gopowerstore.VolumeRestore{SnapshotID: &snap.ID})
+	checkAPIErr(t, err)
+	volume, err := C.GetVolume(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Equal(t, snap.ID, volume.ProtectionData.SourceID)
+	assert.Equal(t, snapshot.ProtectionData.SourceTimestamp, volume.ProtectionData.SourceTimestamp)
+
+	otherVolID, otherVolName := createVol(t)
+	defer deleteVol(t, otherVolID)
+	otherSnap := createSnap(otherVolID, t, otherVolName)
+	_, err = C.RestoreVolumeFromSnapshot(context.Background(), volID,
+		&gopowerstore.VolumeRestore{SnapshotID: &otherSnap.ID})
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.SnapshotIsNotOfVolume())
+}
+
+func TestRefreshVolume(t *testing.T) {
+	volID, _ := createVol(t)
+	defer deleteVol(t, volID)
+	cloneName := TestVolumePrefix + "clone_" + randString(8)
+	clone, err := C.CreateVolumeFromSnapshot(context.Background(),
+		&gopowerstore.VolumeClone{Name: &cloneName}, volID)
+	checkAPIErr(t, err)
+	defer deleteVol(t, clone.ID)
+
+	backup := true
+	job, err := C.RefreshVolume(context.Background(), clone.ID,
+		&gopowerstore.VolumeRefresh{FromObjectID: &volID, CreateBackupSnap: &backup})
+	checkAPIErr(t, err)
+	_, err = C.WaitForJob(context.Background(), job.ID)
+	checkAPIErr(t, err)
+
+	// base volume can't be refreshed
+	job, err = C.RefreshVolume(context.Background(), volID,
+		&gopowerstore.VolumeRefresh{FromObjectID: &clone.ID})
+	if err == nil {
+		_, err = C.WaitForJob(context.Background(), job.ID)
+	}
+	assert.NotNil(t, err)
+	apiError := err.(gopowerstore.APIError)
+	assert.True(t, apiError.VolumeIsNotClone())
+}
+
 func TestGetVolumes(t *testing.T) {
 	_, err := C.GetVolumes(context.Background())
 	checkAPIErr(t, err)
@@ -159,6 +268,38 @@ func TestGetVolumeByName(t *testing.T) {
 	deleteVol(t, volID)
 }
 
+func TestModifyVolume(t *testing.T) {
+	volID, _ := createVol(t)
+	defer deleteVol(t, volID)
+	newSize := DefaultVolSize * 2
+	_, err := C.ModifyVolume(context.Background(), &gopowerstore.VolumeModify{Size: &newSize}, volID)
+	checkAPIErr(t, err)
+	volume, err := C.GetVolume(context.Background(), volID)
+	checkAPIErr(t, err)
+	assert.Equal(t, newSize, volume.Size)
+}
+
+func TestGetVolumeByMetadata(t *testing.T) {
+	volName := TestVolumePrefix + randString(8)
+	size := DefaultVolSize
+	pvcID := "pvc-" + randString(8)
+	metadata := map[string]string{"k8s.pvc": pvcID, "k8s.namespace": "default"}
+	createResp, err := C.CreateVolume(context.Background(),
+		&gopowerstore.VolumeCreate{Name: &volName, Size: &size, Metadata: &metadata})
+	checkAPIErr(t, err)
+	defer deleteVol(t, createResp.ID)
+
+	vols, err := C.GetVolumeByMetadata(context.Background(), "k8s.pvc", pvcID)
+	checkAPIErr(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, createResp.ID, vols[0].ID)
+	assert.Equal(t, metadata, vols[0].Metadata)
+
+	vols, err = C.GetVolumeByMetadata(context.Background(), "k8s.pvc", "pvc-"+randString(8))
+	checkAPIErr(t, err)
+	assert.Empty(t, vols)
+}
+
 func TestCreateDeleteVolume(t *testing.T) {
 	volID, _ := createVol(t)
 	deleteVol(t, volID)
@@ -0,0 +1,261 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	ioLimitRuleURL       = "io_limit_rule"
+	performancePolicyURL = "performance_policy"
+)
+
+func getIoLimitPolicyDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	policy := IoLimitPolicy{}
+	return c.APIClient().QueryParamsWithFields(&policy)
+}
+
+// GetIoLimitPolicy query and return specific I/O limit policy by id
+func (c *ClientIMPL) GetIoLimitPolicy(ctx context.Context, id string) (resp IoLimitPolicy, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    policyURL,
+			ID:          id,
+			QueryParams: getIoLimitPolicyDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetIoLimitPolicyByName query and return specific I/O limit policy by name
+func (c *ClientIMPL) GetIoLimitPolicyByName(ctx context.Context, name string) (resp IoLimitPolicy, err error) {
+	var policyList []IoLimitPolicy
+	qp := getIoLimitPolicyDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	qp.RawArg("type", fmt.Sprintf("eq.%s", PolicyTypeEnumQoS))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    policyURL,
+			QueryParams: qp},
+		&policyList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(policyList) != 1 {
+		return resp, notExistError()
+	}
+	return policyList[0], nil
+}
+
+// GetIoLimitPolicies returns all I/O limit policies
+func (c *ClientIMPL) GetIoLimitPolicies(ctx context.Context) ([]IoLimitPolicy, error) {
+	result := []IoLimitPolicy{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []IoLimitPolicy
+		qp := getIoLimitPolicyDefaultQueryParams(c)
+		qp.RawArg("type", fmt.Sprintf("eq.%s", PolicyTypeEnumQoS))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    policyURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateIoLimitPolicy creates I/O limit rule and QoS policy which applies the rule to its volumes.
+// Returned id is the id of the policy, it can be used as IoLimitPolicyID of VolumeCreate and VolumeClone.
+// The rule is deleted if the policy can't be created.
+// I/O limit policies are supported by PowerStore 3.0 and newer
+func (c *ClientIMPL) CreateIoLimitPolicy(ctx context.Context,
+	createParams *IoLimitPolicyCreate) (resp CreateResponse, err error) {
+	if createParams == nil {
+		return resp, errors.New("I/O limit policy create params are required")
+	}
+	var rule CreateResponse
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: ioLimitRuleURL,
+			Body: &ioLimitRuleCreate{
+				Name:            createParams.Name,
+				Type:            createParams.Type,
+				MaxIops:         createParams.MaxIops,
+				MaxBandwidth:    createParams.MaxBandwidth,
+				BurstPercentage: createParams.BurstPercentage}},
+		&rule)
+	if err = WrapErr(err); err != nil {
+		return resp, err
+	}
+	qosType := PolicyTypeEnumQoS
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: policyURL,
+			Body: &qosPolicyCreate{
+				Name:          createParams.Name,
+				Description:   createParams.Description,
+				Type:          &qosType,
+				IoLimitRuleID: &rule.ID}},
+		&resp)
+	if err = WrapErr(err); err != nil {
+		_, _ = c.deleteIoLimitRule(ctx, rule.ID)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ModifyIoLimitPolicy modifies I/O limit policy, new limits are applied to all volumes of the policy right away
+func (c *ClientIMPL) ModifyIoLimitPolicy(ctx context.Context,
+	modifyParams *IoLimitPolicyModify, id string) (resp EmptyResponse, err error) {
+	if modifyParams == nil {
+		return resp, errors.New("I/O limit policy modify params are required")
+	}
+	limitsChanged := modifyParams.Type != nil || modifyParams.MaxIops != nil ||
+		modifyParams.MaxBandwidth != nil || modifyParams.BurstPercentage != nil
+	if limitsChanged || modifyParams.Name != nil {
+		policy, err := c.GetIoLimitPolicy(ctx, id)
+		if err != nil {
+			return resp, err
+		}
+		_, err = c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:   "PATCH",
+				Endpoint: ioLimitRuleURL,
+				ID:       policy.IoLimitRule.ID,
+				Body: &ioLimitRuleModify{
+					Name:            modifyParams.Name,
+					Type:            modifyParams.Type,
+					MaxIops:         modifyParams.MaxIops,
+					MaxBandwidth:    modifyParams.MaxBandwidth,
+					BurstPercentage: modifyParams.BurstPercentage}},
+			&resp)
+		if err = WrapErr(err); err != nil {
+			return resp, err
+		}
+	}
+	if modifyParams.Name == nil && modifyParams.Description == nil {
+		return resp, nil
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: policyURL,
+			ID:       id,
+			Body: &qosPolicyModify{
+				Name:        modifyParams.Name,
+				Description: modifyParams.Description}},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteIoLimitPolicy deletes I/O limit policy together with its I/O limit rule.
+// Policy can't be deleted while it is assigned to volumes
+func (c *ClientIMPL) DeleteIoLimitPolicy(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	policy, err := c.GetIoLimitPolicy(ctx, id)
+	if err != nil {
+		return resp, err
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: policyURL,
+			ID:       id},
+		&resp)
+	if err = WrapErr(err); err != nil {
+		return resp, err
+	}
+	if policy.IoLimitRule.ID == "" {
+		return resp, nil
+	}
+	return c.deleteIoLimitRule(ctx, policy.IoLimitRule.ID)
+}
+
+func (c *ClientIMPL) deleteIoLimitRule(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: ioLimitRuleURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetPerformancePolicy query and return specific performance policy by id
+func (c *ClientIMPL) GetPerformancePolicy(ctx context.Context, id string) (resp PerformancePolicy, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    performancePolicyURL,
+			ID:          id,
+			QueryParams: c.APIClient().QueryParamsWithFields(&resp)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetPerformancePolicies returns all performance policies, their ids may be used as
+// PerformancePolicyID of VolumeCreate and VolumeModify
+func (c *ClientIMPL) GetPerformancePolicies(ctx context.Context) ([]PerformancePolicy, error) {
+	result := []PerformancePolicy{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []PerformancePolicy
+		policy := PerformancePolicy{}
+		qp := c.APIClient().QueryParamsWithFields(&policy)
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    performancePolicyURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
@@ -0,0 +1,242 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const ioLimitRuleMockURL = APIMockURL + ioLimitRuleURL
+
+var ioLimitPolicyID = "3e1c2a5b-7d9f-4b6a-8e0c-1f2d3c4b5a69"
+var ioLimitRuleID = "8b7a6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+
+func TestClientIMPL_GetIoLimitPolicyByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter string
+	respData := fmt.Sprintf(`[{"id": "%s", "name": "gold", "type": "QoS",
+"io_limit_rule": {"id": "%s", "max_iops": 1000, "max_bw": 104857600, "burst_percentage": 20},
+"volumes": [{"id": "%s"}]}]`, ioLimitPolicyID, ioLimitRuleID, volID)
+	httpmock.RegisterResponder("GET", policyMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	policy, err := C.GetIoLimitPolicyByName(context.Background(), "gold")
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.QoS", typeFilter)
+	assert.Equal(t, ioLimitPolicyID, policy.ID)
+	assert.Equal(t, int64(1000), policy.IoLimitRule.MaxIops)
+	assert.Equal(t, int64(104857600), policy.IoLimitRule.MaxBandwidth)
+	assert.Equal(t, int32(20), policy.IoLimitRule.BurstPercentage)
+	assert.Equal(t, volID, policy.Volumes[0].ID)
+}
+
+func TestClientIMPL_CreateIoLimitPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var ruleBody, policyBody map[string]interface{}
+	httpmock.RegisterResponder("POST", ioLimitRuleMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&ruleBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, ioLimitRuleID)), nil
+		})
+	httpmock.RegisterResponder("POST", policyMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&policyBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, ioLimitPolicyID)), nil
+		})
+	name := "gold"
+	maxIops := int64(1000)
+	burst := int32(20)
+	resp, err := C.CreateIoLimitPolicy(context.Background(),
+		&IoLimitPolicyCreate{Name: &name, MaxIops: &maxIops, BurstPercentage: &burst})
+	assert.Nil(t, err)
+	assert.Equal(t, ioLimitPolicyID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "max_iops": float64(1000),
+		"burst_percentage": float64(20)}, ruleBody)
+	assert.Equal(t, map[string]interface{}{"name": name, "type": "QoS",
+		"io_limit_rule_id": ioLimitRuleID}, policyBody)
+}
+
+func TestClientIMPL_CreateIoLimitPolicy_Rollback(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", ioLimitRuleMockURL,
+		httpmock.NewStringResponder(201, fmt.Sprintf(`{"id": "%s"}`, ioLimitRuleID)))
+	httpmock.RegisterResponder("POST", policyMockURL,
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE0A090010005"}]}`))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", ioLimitRuleMockURL, ioLimitRuleID),
+		httpmock.NewStringResponder(204, ""))
+	name := "gold"
+	_, err := C.CreateIoLimitPolicy(context.Background(), &IoLimitPolicyCreate{Name: &name})
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_DeleteIoLimitPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", policyMockURL, ioLimitPolicyID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "io_limit_rule": {"id": "%s"}}`,
+			ioLimitPolicyID, ioLimitRuleID)))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", policyMockURL, ioLimitPolicyID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", ioLimitRuleMockURL, ioLimitRuleID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteIoLimitPolicy(context.Background(), ioLimitPolicyID)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_GetIoLimitPolicies(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter string
+	httpmock.RegisterResponder("GET", policyMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(
+				`[{"id": "%s", "name": "gold", "io_limit_rule": {"id": "%s", "max_iops": 1000}}]`,
+				ioLimitPolicyID, ioLimitRuleID)), nil
+		})
+	policies, err := C.GetIoLimitPolicies(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, policies, 1)
+	assert.Equal(t, int64(1000), policies[0].IoLimitRule.MaxIops)
+	assert.Equal(t, "eq.QoS", typeFilter)
+}
+
+func TestClientIMPL_ModifyIoLimitPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", policyMockURL, ioLimitPolicyID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "io_limit_rule": {"id": "%s"}}`,
+			ioLimitPolicyID, ioLimitRuleID)))
+	var ruleBody, policyBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", ioLimitRuleMockURL, ioLimitRuleID),
+		func(req *http.Request) (*http.Response, error) {
+			ruleBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&ruleBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", policyMockURL, ioLimitPolicyID),
+		func(req *http.Request) (*http.Response, error) {
+			policyBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&policyBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+
+	maxIops := int64(500)
+	_, err := C.ModifyIoLimitPolicy(context.Background(), &IoLimitPolicyModify{MaxIops: &maxIops}, ioLimitPolicyID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"max_iops": float64(500)}, ruleBody)
+	assert.Nil(t, policyBody)
+
+	description := "tenant A"
+	_, err = C.ModifyIoLimitPolicy(context.Background(), &IoLimitPolicyModify{Description: &description},
+		ioLimitPolicyID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"description": description}, policyBody)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["PATCH "+fmt.Sprintf("%s/%s", ioLimitRuleMockURL, ioLimitRuleID)])
+
+	name := "silver"
+	_, err = C.ModifyIoLimitPolicy(context.Background(), &IoLimitPolicyModify{Name: &name}, ioLimitPolicyID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": name}, ruleBody)
+	assert.Equal(t, map[string]interface{}{"name": name}, policyBody)
+}
+
+func TestClientIMPL_GetPerformancePolicies(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", APIMockURL+performancePolicyURL,
+		httpmock.NewStringResponder(200, `[{"id": "default_high", "name": "High", "io_priority": "High"},
+{"id": "default_low", "name": "Low", "io_priority": "Low"}]`))
+	httpmock.RegisterResponder("GET", APIMockURL+performancePolicyURL+"/default_medium",
+		httpmock.NewStringResponder(200, `{"id": "default_medium", "name": "Medium", "io_priority": "Medium"}`))
+	policies, err := C.GetPerformancePolicies(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, policies, 2)
+	assert.Equal(t, PerformancePolicyIoPriorityEnumHigh, policies[0].IoPriority)
+
+	policy, err := C.GetPerformancePolicy(context.Background(), "default_medium")
+	assert.Nil(t, err)
+	assert.Equal(t, PerformancePolicyIoPriorityEnumMedium, policy.IoPriority)
+
+	httpmock.RegisterResponder("GET", APIMockURL+performancePolicyURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("offset") == "0" {
+				return pageResponse(`[{"id": "default_high"}]`, "0-0/2"), nil
+			}
+			return pageResponse(`[{"id": "default_low"}]`, "1-1/2"), nil
+		})
+	policies, err = C.GetPerformancePolicies(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, policies, 2)
+	assert.Equal(t, "default_low", policies[1].ID)
+}
+
+func TestClientIMPL_IoLimitPolicy_NilParams(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	_, err := C.CreateIoLimitPolicy(context.Background(), nil)
+	assert.NotNil(t, err)
+	_, err = C.ModifyIoLimitPolicy(context.Background(), nil, "policy-1")
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_CreateVolume_UnknownIoLimitPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("3.0.0.0")
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(404, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`,
+				InstanceWasNotFound)), nil
+		})
+	name := "vol"
+	size := int64(1048576)
+	_, err := C.CreateVolume(context.Background(),
+		&VolumeCreate{Name: &name, Size: &size, IoLimitPolicyID: &ioLimitPolicyID})
+	assert.NotNil(t, err)
+	assert.Equal(t, ioLimitPolicyID, reqBody["qos_performance_policy_id"])
+	apiError := err.(APIError)
+	assert.True(t, apiError.PolicyIsNotExist())
+}
@@ -0,0 +1,160 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// IoLimitTypeEnum type of I/O limit rule
+type IoLimitTypeEnum string
+
+const (
+	// IoLimitTypeEnumAbsolute - limits are set in IOPS and bytes per second
+	IoLimitTypeEnumAbsolute IoLimitTypeEnum = "Absolute"
+	// IoLimitTypeEnumDensity - limits are set in IOPS and bytes per second per GB of volume size
+	IoLimitTypeEnumDensity IoLimitTypeEnum = "Density"
+)
+
+// IoLimitPolicyCreate create I/O limit policy request
+type IoLimitPolicyCreate struct {
+	// Name of the policy, the same name is used for its I/O limit rule.
+	Name *string `json:"name"`
+	// Description of the policy.
+	Description *string `json:"description,omitempty"`
+	// Type of the limits, Absolute if not set.
+	Type *IoLimitTypeEnum `json:"type,omitempty"`
+	// Maximum I/O operations per second.
+	MaxIops *int64 `json:"max_iops,omitempty"`
+	// Maximum bandwidth in bytes per second.
+	MaxBandwidth *int64 `json:"max_bw,omitempty"`
+	// Percentage by which the limits can be exceeded for short periods of time.
+	BurstPercentage *int32 `json:"burst_percentage,omitempty"`
+}
+
+// IoLimitPolicyModify modify I/O limit policy request, unset fields are not changed
+type IoLimitPolicyModify struct {
+	// Name of the policy, its I/O limit rule is renamed too.
+	Name *string `json:"name,omitempty"`
+	// Description of the policy.
+	Description *string `json:"description,omitempty"`
+	// Type of the limits.
+	Type *IoLimitTypeEnum `json:"type,omitempty"`
+	// Maximum I/O operations per second.
+	MaxIops *int64 `json:"max_iops,omitempty"`
+	// Maximum bandwidth in bytes per second.
+	MaxBandwidth *int64 `json:"max_bw,omitempty"`
+	// Percentage by which the limits can be exceeded for short periods of time.
+	BurstPercentage *int32 `json:"burst_percentage,omitempty"`
+}
+
+// ioLimitRuleCreate body of io_limit_rule create request
+type ioLimitRuleCreate struct {
+	Name            *string          `json:"name"`
+	Type            *IoLimitTypeEnum `json:"type,omitempty"`
+	MaxIops         *int64           `json:"max_iops,omitempty"`
+	MaxBandwidth    *int64           `json:"max_bw,omitempty"`
+	BurstPercentage *int32           `json:"burst_percentage,omitempty"`
+}
+
+// ioLimitRuleModify body of io_limit_rule modify request
+type ioLimitRuleModify struct {
+	Name            *string          `json:"name,omitempty"`
+	Type            *IoLimitTypeEnum `json:"type,omitempty"`
+	MaxIops         *int64           `json:"max_iops,omitempty"`
+	MaxBandwidth    *int64           `json:"max_bw,omitempty"`
+	BurstPercentage *int32           `json:"burst_percentage,omitempty"`
+}
+
+// qosPolicyModify body of QoS policy modify request
+type qosPolicyModify struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// qosPolicyCreate body of QoS policy create request
+type qosPolicyCreate struct {
+	Name          *string         `json:"name"`
+	Description   *string         `json:"description,omitempty"`
+	Type          *PolicyTypeEnum `json:"type"`
+	IoLimitRuleID *string         `json:"io_limit_rule_id"`
+}
+
+// IoLimitRule limits applied to every volume of I/O limit policy
+type IoLimitRule struct {
+	// Unique identifier of the I/O limit rule.
+	ID string `json:"id,omitempty"`
+	// Name of the I/O limit rule.
+	Name string `json:"name,omitempty"`
+	// Type of the limits.
+	Type IoLimitTypeEnum `json:"type,omitempty"`
+	// Maximum I/O operations per second.
+	MaxIops int64 `json:"max_iops,omitempty"`
+	// Maximum bandwidth in bytes per second.
+	MaxBandwidth int64 `json:"max_bw,omitempty"`
+	// Percentage by which the limits can be exceeded for short periods of time.
+	BurstPercentage int32 `json:"burst_percentage,omitempty"`
+}
+
+// IoLimitPolicy details about QoS policy with I/O limit rule
+type IoLimitPolicy struct {
+	// Unique identifier of the policy.
+	ID string `json:"id,omitempty"`
+	// Name of the policy.
+	Name string `json:"name,omitempty"`
+	// Description of the policy.
+	Description string `json:"description,omitempty"`
+	// Type of the policy.
+	Type PolicyTypeEnum `json:"type,omitempty"`
+	// Limits applied by the policy.
+	IoLimitRule IoLimitRule `json:"io_limit_rule,omitempty"`
+	// Volumes the policy is assigned to, only id and name are filled.
+	Volumes []Volume `json:"volumes,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (p *IoLimitPolicy) Fields() []string {
+	return []string{"id", "name", "description", "type",
+		"io_limit_rule(id,name,type,max_iops,max_bw,burst_percentage)", "volumes(id,name)"}
+}
+
+// PerformancePolicyIoPriorityEnum relative I/O priority of volumes with the performance policy
+type PerformancePolicyIoPriorityEnum string
+
+const (
+	// PerformancePolicyIoPriorityEnumLow captures enum value "Low"
+	PerformancePolicyIoPriorityEnumLow PerformancePolicyIoPriorityEnum = "Low"
+	// PerformancePolicyIoPriorityEnumMedium captures enum value "Medium"
+	PerformancePolicyIoPriorityEnumMedium PerformancePolicyIoPriorityEnum = "Medium"
+	// PerformancePolicyIoPriorityEnumHigh captures enum value "High"
+	PerformancePolicyIoPriorityEnumHigh PerformancePolicyIoPriorityEnum = "High"
+)
+
+// PerformancePolicy built-in policy which sets relative I/O priority of volumes, e.g. default_medium
+type PerformancePolicy struct {
+	// Unique identifier of the performance policy.
+	ID string `json:"id,omitempty"`
+	// Name of the performance policy.
+	Name string `json:"name,omitempty"`
+	// Description of the performance policy.
+	Description string `json:"description,omitempty"`
+	// Relative I/O priority of volumes with the policy.
+	IoPriority PerformancePolicyIoPriorityEnum `json:"io_priority,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (p *PerformancePolicy) Fields() []string {
+	return []string{"id", "name", "description", "io_priority"}
+}
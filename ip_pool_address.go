@@ -22,31 +22,130 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+
+	"github.com/dell/gopowerstore/api"
 )
 
 const apiPoolAddressURL = "ip_pool_address"
 
-// GetStorageISCSITargetAddresses returns a list of PowerStore iSCSI targets ip addresses
+// GetStorageISCSITargetAddresses returns a list of PowerStore iSCSI targets ip addresses.
+// Only addresses with iSCSI target purpose which ip port is bound to an iSCSI target are returned
 func (c *ClientIMPL) GetStorageISCSITargetAddresses(
 	ctx context.Context) (resp []IPPoolAddress, err error) {
-	var ipPoolAddress IPPoolAddress
-	client := c.APIClient()
-	qp := client.QueryParamsWithFields(&ipPoolAddress)
-	qp.RawArg("purposes", fmt.Sprintf("eq.{%s}", IPPurposeTypeEnumStorageIscsiTarget))
-	qp.Order("id")
-	_, err = client.Query(
-		ctx,
-		RequestConfig{
-			Method:      "GET",
-			Endpoint:    apiPoolAddressURL,
-			QueryParams: qp},
-		&resp)
-	err = WrapErr(err)
+	addresses, err := c.getIPPoolAddresses(ctx, fmt.Sprintf("cs.{%s}", IPPurposeTypeEnumStorageIscsiTarget))
 	if err != nil {
 		return resp, err
 	}
+	for _, address := range addresses {
+		if address.IPPort.TargetIqn != "" {
+			resp = append(resp, address)
+		}
+	}
 	if len(resp) == 0 {
 		return resp, errors.New("can't get iscsi target address")
 	}
 	return resp, nil
 }
+
+// GetStorageNVMeTCPTargetAddresses returns ip addresses of NVMe/TCP target portals of the cluster
+func (c *ClientIMPL) GetStorageNVMeTCPTargetAddresses(ctx context.Context) ([]IPPoolAddress, error) {
+	resp, err := c.GetIPPoolAddresses(ctx, IPPurposeTypeEnumStorageNVMeTCPPort)
+	if err != nil {
+		return resp, err
+	}
+	if len(resp) == 0 {
+		return resp, errors.New("can't get nvme/tcp target address")
+	}
+	return resp, nil
+}
+
+// GetIPPoolAddresses returns ip pool addresses with the purpose, all addresses are returned for empty purpose
+func (c *ClientIMPL) GetIPPoolAddresses(ctx context.Context, purpose IPPurposeTypeEnum) ([]IPPoolAddress, error) {
+	var purposesFilter string
+	if purpose != "" {
+		purposesFilter = fmt.Sprintf("cs.{%s}", purpose)
+	}
+	resp, err := c.getIPPoolAddresses(ctx, purposesFilter)
+	if resp == nil {
+		resp = []IPPoolAddress{}
+	}
+	return resp, err
+}
+
+// GetISCSITargetIQNs returns unique iSCSI qualified names of the cluster targets
+func (c *ClientIMPL) GetISCSITargetIQNs(ctx context.Context) ([]string, error) {
+	addresses, err := c.GetStorageISCSITargetAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		if !seen[address.IPPort.TargetIqn] {
+			seen[address.IPPort.TargetIqn] = true
+			result = append(result, address.IPPort.TargetIqn)
+		}
+	}
+	return result, nil
+}
+
+// GetManagementIPs returns cluster floating management IP and physical management IP of each node.
+// Clients for provisioning and cluster configuration should use the floating IP. Client created
+// with ManagementAPIURL of a node IP reaches the management service of that node only, it is intended
+// for node-level maintenance and for access while the floating IP is being moved between nodes
+func (c *ClientIMPL) GetManagementIPs(ctx context.Context) (resp ManagementIPs, err error) {
+	addresses, err := c.getIPPoolAddresses(ctx, fmt.Sprintf("ov.{%s,%s}",
+		IPPurposeTypeEnumMgmtClusterFloating, IPPurposeTypeEnumMgmtNodeCoreOS))
+	if err != nil {
+		return resp, err
+	}
+	for _, address := range addresses {
+		for _, purpose := range address.Purposes {
+			switch purpose {
+			case IPPurposeTypeEnumMgmtClusterFloating:
+				resp.ClusterIP = address.Address
+			case IPPurposeTypeEnumMgmtNodeCoreOS:
+				resp.NodeIPs = append(resp.NodeIPs, NodeManagementIP{
+					NodeID:      address.NodeID,
+					ApplianceID: address.ApplianceID,
+					Address:     address.Address})
+			}
+		}
+	}
+	if resp.ClusterIP == "" {
+		return resp, errors.New("can't get cluster management address")
+	}
+	return resp, nil
+}
+
+// ManagementAPIURL returns REST API URL for management IP address,
+// result can be passed to NewClientWithArgs
+func ManagementAPIURL(address string) string {
+	return fmt.Sprintf("https://%s/api/rest", net.JoinHostPort(address, "443"))
+}
+
+// getIPPoolAddresses returns all ip pool addresses matching the purposes filter
+func (c *ClientIMPL) getIPPoolAddresses(ctx context.Context, purposesFilter string) (resp []IPPoolAddress, err error) {
+	var ipPoolAddress IPPoolAddress
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []IPPoolAddress
+		qp := c.APIClient().QueryParamsWithFields(&ipPoolAddress)
+		qp.RawArg("purposes", purposesFilter)
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    apiPoolAddressURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	return resp, err
+}
@@ -23,6 +23,8 @@ import (
 	"fmt"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
 	"testing"
 )
 
@@ -30,21 +32,117 @@ const (
 	ipPoolAddressMockURL = APIMockURL + apiPoolAddressURL
 )
 
+var targetIQN = "iqn.2015-10.com.dell:dellemc-powerstore-fnm00194601320-a-39f17e0e"
+
 func TestClientIMPL_GetIPPoolAddress(t *testing.T) {
 	id1 := "IP1"
 	purpose1 := IPPurposeTypeEnumStorageIscsiTarget
 
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
-	respData := fmt.Sprintf(`[{"id": "%s", "purpose": "%s"}]`,
-		id1, purpose1)
+	respData := fmt.Sprintf(`[{"id": "%s", "purposes": ["%s"], "ip_port": {"id": "P1", "target_iqn": "%s"}}]`,
+		id1, purpose1, targetIQN)
+	var purposesFilter string
 	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
-		httpmock.NewStringResponder(200, respData))
+		func(req *http.Request) (*http.Response, error) {
+			purposesFilter = req.URL.Query().Get("purposes")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
 
 	vols, err := C.GetStorageISCSITargetAddresses(context.Background())
 	assert.Nil(t, err)
 	assert.Len(t, vols, 1)
 	assert.Equal(t, id1, vols[0].ID)
+	assert.Equal(t, "cs.{Storage_Iscsi_Target}", purposesFilter)
+}
+
+func TestClientIMPL_GetStorageNVMeTCPTargetAddresses(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := `[{"id": "IP2", "purposes": ["Storage_NVMe_TCP_Port"], "address": "10.0.0.2",
+		"ip_port": {"id": "P2", "current_usages": ["NVMe_TCP"]}}]`
+	var purposesFilter string
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			purposesFilter = req.URL.Query().Get("purposes")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+
+	addrs, err := C.GetStorageNVMeTCPTargetAddresses(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, addrs, 1)
+	assert.Equal(t, "10.0.0.2", addrs[0].Address)
+	assert.Equal(t, []IPPortUsageEnum{IPPortUsageEnumNVMeTCP}, addrs[0].IPPort.CurrentUsages)
+	assert.Equal(t, "cs.{Storage_NVMe_TCP_Port}", purposesFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetStorageNVMeTCPTargetAddresses(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetIPPoolAddresses(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var query url.Values
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, `[{"id": "IP1"}, {"id": "IP2"}]`), nil
+		})
+
+	addrs, err := C.GetIPPoolAddresses(context.Background(), IPPurposeTypeEnumMgmtNodeHost)
+	assert.Nil(t, err)
+	assert.Len(t, addrs, 2)
+	assert.Equal(t, "cs.{Mgmt_Node_Host}", query.Get("purposes"))
+
+	addrs, err = C.GetIPPoolAddresses(context.Background(), "")
+	assert.Nil(t, err)
+	assert.Len(t, addrs, 2)
+	_, ok := query["purposes"]
+	assert.False(t, ok)
+}
+
+func TestClientIMPL_GetIPPoolAddress_Pagination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("offset") == "0" {
+				return pageResponse(`[{"id": "IP1", "ip_port": {"id": "P1", "target_iqn": "iqn1"}}]`, "0-0/2"), nil
+			}
+			return pageResponse(`[{"id": "IP2", "ip_port": {"id": "P2", "target_iqn": "iqn2"}}]`, "1-1/2"), nil
+		})
+	iqns, err := C.GetISCSITargetIQNs(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"iqn1", "iqn2"}, iqns)
+}
+
+func TestClientIMPL_GetIPPoolAddress_Unbound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "IP1", "address": "10.0.1.10", "ip_port": {"id": "P1", "target_iqn": "%s"}},
+{"id": "IP2", "address": "10.0.1.11", "ip_port": {"id": "P2", "target_iqn": ""}},
+{"id": "IP3", "address": "10.0.1.12", "ip_port": {"id": "P3", "target_iqn": "%s"}}]`, targetIQN, targetIQN)
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		httpmock.NewStringResponder(200, respData))
+
+	addresses, err := C.GetStorageISCSITargetAddresses(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, addresses, 2)
+	assert.Equal(t, "IP1", addresses[0].ID)
+	assert.Equal(t, "IP3", addresses[1].ID)
+
+	iqns, err := C.GetISCSITargetIQNs(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{targetIQN}, iqns)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "IP2", "ip_port": {"id": "P2"}}]`))
+	_, err = C.GetISCSITargetIQNs(context.Background())
+	assert.NotNil(t, err)
 }
 
 func TestClientIMPL_GetIPPoolAddress_NotFound(t *testing.T) {
@@ -58,3 +156,30 @@ func TestClientIMPL_GetIPPoolAddress_NotFound(t *testing.T) {
 	assert.NotNil(t, err)
 
 }
+
+func TestClientIMPL_GetManagementIPs(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := `[{"id": "IP1", "address": "10.0.0.10", "purposes": ["Mgmt_Cluster_Floating"]},
+{"id": "IP2", "address": "10.0.0.11", "node_id": "N1", "appliance_id": "A1", "purposes": ["Mgmt_Node_CoreOS"]},
+{"id": "IP3", "address": "10.0.0.12", "node_id": "N2", "appliance_id": "A1", "purposes": ["Mgmt_Node_CoreOS"]}]`
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		httpmock.NewStringResponder(200, respData))
+
+	ips, err := C.GetManagementIPs(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.10", ips.ClusterIP)
+	assert.Len(t, ips.NodeIPs, 2)
+	assert.Equal(t, "N2", ips.NodeIPs[1].NodeID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", ipPoolAddressMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetManagementIPs(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestManagementAPIURL(t *testing.T) {
+	assert.Equal(t, "https://10.0.0.11:443/api/rest", ManagementAPIURL("10.0.0.11"))
+	assert.Equal(t, "https://[fd00::11]:443/api/rest", ManagementAPIURL("fd00::11"))
+}
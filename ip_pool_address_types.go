@@ -44,6 +44,8 @@ const (
 	IPPurposeTypeEnumStorageIscsiTarget IPPurposeTypeEnum = "Storage_Iscsi_Target"
 	// IPPurposeTypeEnumStorageClusterFloating captures enum value "Storage_Cluster_Floating"
 	IPPurposeTypeEnumStorageClusterFloating IPPurposeTypeEnum = "Storage_Cluster_Floating"
+	// IPPurposeTypeEnumStorageNVMeTCPPort captures enum value "Storage_NVMe_TCP_Port"
+	IPPurposeTypeEnumStorageNVMeTCPPort IPPurposeTypeEnum = "Storage_NVMe_TCP_Port"
 	// IPPurposeTypeEnumICDNode captures enum value "ICD_Node"
 	IPPurposeTypeEnumICDNode IPPurposeTypeEnum = "ICD_Node"
 	// IPPurposeTypeEnumSDNASClusterFloating captures enum value "SDNAS_Cluster_Floating"
@@ -81,7 +83,7 @@ type IPPoolAddress struct {
 // Fields returns fields which must be requested to fill struct
 func (ip *IPPoolAddress) Fields() []string {
 	return []string{"address", "appliance_id", "id", "ip_port_id",
-		"ip_port(target_iqn, id)", "network_id", "node_id", "purposes"}
+		"ip_port(target_iqn, id, current_usages)", "network_id", "node_id", "purposes"}
 }
 
 // IPPortInstance ip port instance
@@ -90,4 +92,36 @@ type IPPortInstance struct {
 	ID string `json:"id,omitempty"`
 	// iSCSI qualified name used by the target configured on top of the IP port initially or as a result of network scaling. If the IP port is not used by an iSCSI connection, this attribute should be empty.
 	TargetIqn string `json:"target_iqn,omitempty"`
+	// Storage protocols and services currently served by the IP port, e.g. ISCSI or NVMe_TCP.
+	CurrentUsages []IPPortUsageEnum `json:"current_usages,omitempty"`
+}
+
+// IPPortUsageEnum storage protocol or service served by the IP port
+type IPPortUsageEnum string
+
+const (
+	// IPPortUsageEnumISCSI captures enum value "ISCSI"
+	IPPortUsageEnumISCSI IPPortUsageEnum = "ISCSI"
+	// IPPortUsageEnumNVMeTCP captures enum value "NVMe_TCP"
+	IPPortUsageEnumNVMeTCP IPPortUsageEnum = "NVMe_TCP"
+	// IPPortUsageEnumReplicationIP captures enum value "Replication_IP"
+	IPPortUsageEnumReplicationIP IPPortUsageEnum = "Replication_IP"
+)
+
+// NodeManagementIP physical management address of the cluster node
+type NodeManagementIP struct {
+	// Unique identifier of the cluster node.
+	NodeID string
+	// Unique identifier of the appliance the node belongs to.
+	ApplianceID string
+	// IP address value, in IPv4 or IPv6 format.
+	Address string
+}
+
+// ManagementIPs management addresses of the cluster
+type ManagementIPs struct {
+	// Cluster floating management IP address, it follows the primary node on failover.
+	ClusterIP string
+	// Physical management IP addresses of the nodes.
+	NodeIPs []NodeManagementIP
 }
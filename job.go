@@ -0,0 +1,230 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const jobURL = "job"
+
+// interval between job state checks
+var jobPollInterval = 2 * time.Second
+
+// upper bound of interval between job state checks in WaitForJob
+var jobMaxPollInterval = 30 * time.Second
+
+// upper bound of time a single job state check may take, unless the caller set own timeout with WithRequestTimeout
+var jobPollRequestTimeout = 30 * time.Second
+
+func getJobDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	job := Job{}
+	return c.APIClient().QueryParamsWithFields(&job)
+}
+
+// GetJob query and return specific job by id
+func (c *ClientIMPL) GetJob(ctx context.Context, id string) (resp Job, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    jobURL,
+			ID:          id,
+			QueryParams: getJobDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// queryJob sends request of operation started in asynchronous mode and returns reference to the job.
+// Location header of the response is kept in JobResponse, id of the job is taken from the location
+// when the response body has no id
+func (c *ClientIMPL) queryJob(ctx context.Context, cfg RequestConfig) (resp JobResponse, err error) {
+	meta, err := c.APIClient().Query(ctx, cfg, &resp)
+	if err != nil {
+		return resp, WrapErr(err)
+	}
+	resp.Location = meta.Location
+	if resp.ID == "" && meta.Location != "" {
+		resp.ID = path.Base(meta.Location)
+	}
+	return resp, nil
+}
+
+// WaitForJob polls job until it reaches a terminal state or ctx is done. Poll interval grows
+// with every check. If the job didn't complete successfully APIError built from the job response is returned
+func (c *ClientIMPL) WaitForJob(ctx context.Context, id string) (Job, error) {
+	return c.waitForJob(ctx, id, jobPollInterval, jobMaxPollInterval)
+}
+
+// WaitForJobCompletion polls job with fixed pollInterval until it reaches a terminal state or ctx is done.
+// Non-positive pollInterval is replaced by the default one.
+// If the job didn't complete successfully APIError built from the job response is returned
+func (c *ClientIMPL) WaitForJobCompletion(ctx context.Context, id string, pollInterval time.Duration) (Job, error) {
+	if pollInterval <= 0 {
+		pollInterval = jobPollInterval
+	}
+	return c.waitForJob(ctx, id, pollInterval, pollInterval)
+}
+
+// waitForJob polls job starting with interval, interval grows up to maxInterval
+func (c *ClientIMPL) waitForJob(ctx context.Context, id string, interval, maxInterval time.Duration) (Job, error) {
+	pollCtx := jobPollContext(ctx)
+	for {
+		job, err := c.GetJob(pollCtx, id)
+		switch {
+		case err != nil && ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded):
+			// only the poll request timed out, check again on the next tick
+		case err != nil:
+			return job, err
+		case job.IsTerminal():
+			if job.State != JobStateEnumCompleted {
+				return job, jobError(job)
+			}
+			return job, nil
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return job, ctx.Err()
+		case <-timer.C:
+		}
+		interval = interval * 3 / 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// jobPollContext bounds every job state check by jobPollRequestTimeout, so a hung request
+// can't hold the wait until the deadline of ctx
+func jobPollContext(ctx context.Context) context.Context {
+	if api.RequestTimeout(ctx) > 0 {
+		return ctx
+	}
+	return api.WithRequestTimeout(ctx, jobPollRequestTimeout)
+}
+
+// jobError returns APIError built from the first error message of failed job response
+func jobError(job Job) APIError {
+	var body struct {
+		Messages []api.ErrorMsg `json:"messages"`
+	}
+	apiError := APIError{&api.ErrorMsg{}}
+	if err := json.Unmarshal(job.ResponseBody, &body); err == nil && len(body.Messages) > 0 {
+		apiError.ErrorMsg = &body.Messages[0]
+	} else {
+		apiError.Severity = "Error"
+		apiError.Message = fmt.Sprintf("job %s finished in %s state", job.ID, job.State)
+	}
+	apiError.StatusCode, _ = strconv.Atoi(job.ResponseStatus)
+	if apiError.StatusCode == 0 {
+		apiError.StatusCode = http.StatusUnprocessableEntity
+	}
+	return apiError
+}
+
+// getJobsByIDs returns jobs with specified ids, jobs which don't exist are omitted
+func (c *ClientIMPL) getJobsByIDs(ctx context.Context, ids []string) (resp []Job, err error) {
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Job
+		qp := getJobDefaultQueryParams(c)
+		qp.RawArg("id", fmt.Sprintf("in.(%s)", strings.Join(ids, ",")))
+		qp.Order("id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    jobURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
+
+// WatchJobs polls jobs and emits result of each job on returned channel as soon as it is finished.
+// All pending jobs are checked with a single request per poll. The channel is closed
+// when all jobs are finished or ctx is cancelled. Failed poll requests are retried on the next tick
+func (c *ClientIMPL) WatchJobs(ctx context.Context, jobIDs []string) <-chan JobResult {
+	results := make(chan JobResult, len(jobIDs))
+	pending := make(map[string]bool, len(jobIDs))
+	for _, id := range jobIDs {
+		pending[id] = true
+	}
+	go func() {
+		defer close(results)
+		pollCtx := jobPollContext(ctx)
+		ticker := time.NewTicker(jobPollInterval)
+		defer ticker.Stop()
+		for len(pending) > 0 {
+			ids := make([]string, 0, len(pending))
+			for id := range pending {
+				ids = append(ids, id)
+			}
+			jobs, err := c.getJobsByIDs(pollCtx, ids)
+			if err == nil {
+				found := make(map[string]bool, len(jobs))
+				for _, job := range jobs {
+					found[job.ID] = true
+					if !pending[job.ID] || !job.IsTerminal() {
+						continue
+					}
+					delete(pending, job.ID)
+					result := JobResult{JobID: job.ID, Job: job}
+					if job.State != JobStateEnumCompleted {
+						result.Err = jobError(job)
+					}
+					results <- result
+				}
+				for _, id := range ids {
+					if !found[id] {
+						delete(pending, id)
+						results <- JobResult{JobID: id, Err: notExistError()}
+					}
+				}
+			}
+			if len(pending) == 0 {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return results
+}
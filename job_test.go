@@ -0,0 +1,254 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const jobMockURL = APIMockURL + jobURL
+
+var jobID2 = "8a1e4f22-9b6a-4f0c-8f4c-1b7bb1f3c2d9"
+
+func TestClientIMPL_GetJob(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "state": "RUNNING"}`, jobID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		httpmock.NewStringResponder(200, respData))
+	job, err := C.GetJob(context.Background(), jobID)
+	assert.Nil(t, err)
+	assert.Equal(t, JobStateEnumRunning, job.State)
+	assert.False(t, job.IsTerminal())
+}
+
+func TestClientIMPL_JobResponseLocation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	location := "/api/rest/job/" + jobID
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/clone", volumeGroupMockURL, volumeGroupID),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(202, "")
+			resp.Header.Set("Location", location)
+			return resp, nil
+		})
+	resp, err := C.CloneVolumeGroup(context.Background(), volumeGroupID, &VolumeGroupClone{})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+	assert.Equal(t, location, resp.Location)
+
+	// id from the response body is preferred
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/clone", volumeGroupMockURL, volumeGroupID),
+		httpmock.NewStringResponder(202, fmt.Sprintf(`{"id": "%s"}`, jobID2)))
+	resp, err = C.CloneVolumeGroup(context.Background(), volumeGroupID, &VolumeGroupClone{})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID2, resp.ID)
+	assert.Empty(t, resp.Location)
+}
+
+func TestClientIMPL_WaitForJob(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defaultInterval := jobPollInterval
+	jobPollInterval = 10 * time.Millisecond
+	defer func() { jobPollInterval = defaultInterval }()
+
+	calls := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls < 3 {
+				return httpmock.NewStringResponse(200, fmt.Sprintf(
+					`{"id": "%s", "state": "RUNNING", "progress_percentage": %d}`, jobID, calls*30)), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(
+				`{"id": "%s", "state": "COMPLETED", "progress_percentage": 100, "response_status": "201",
+"response_body": {"id": "%s"}}`, jobID, volID)), nil
+		})
+	job, err := C.WaitForJob(context.Background(), jobID)
+	assert.Nil(t, err)
+	assert.Equal(t, JobStateEnumCompleted, job.State)
+	assert.Equal(t, int32(100), job.ProgressPercentage)
+	assert.JSONEq(t, fmt.Sprintf(`{"id": "%s"}`, volID), string(job.ResponseBody))
+	assert.Equal(t, 3, calls)
+}
+
+func TestClientIMPL_WaitForJob_Failed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "state": "FAILED", "response_status": "422",
+"response_body": {"messages": [{"code": "%s", "severity": "Error", "message_l10n": "Volume not found"}]}}`,
+			jobID, UnknownVolumeErrorCode)))
+	job, err := C.WaitForJob(context.Background(), jobID)
+	assert.NotNil(t, err)
+	assert.Equal(t, JobStateEnumFailed, job.State)
+	apiError, ok := err.(APIError)
+	assert.True(t, ok)
+	assert.True(t, apiError.VolumeIsNotExist())
+	assert.Equal(t, "Volume not found", apiError.Message)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "state": "CANCELLED"}`, jobID)))
+	_, err = C.WaitForJob(context.Background(), jobID)
+	assert.NotNil(t, err)
+	apiError = err.(APIError)
+	assert.Equal(t, http.StatusUnprocessableEntity, apiError.StatusCode)
+}
+
+func TestClientIMPL_WaitForJobCompletion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var polls []time.Time
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		func(req *http.Request) (*http.Response, error) {
+			polls = append(polls, time.Now())
+			if len(polls) < 4 {
+				return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id": "%s", "state": "RUNNING"}`, jobID)), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id": "%s", "state": "FAILED",
+"response_body": {"messages": [{"code": "%s", "message_l10n": "Volume not found"}]}}`,
+				jobID, UnknownVolumeErrorCode)), nil
+		})
+	job, err := C.WaitForJobCompletion(context.Background(), jobID, 20*time.Millisecond)
+	assert.Equal(t, JobStateEnumFailed, job.State)
+	apiError, ok := err.(APIError)
+	assert.True(t, ok)
+	assert.Equal(t, UnknownVolumeErrorCode, apiError.Code())
+	assert.Len(t, polls, 4)
+	// interval doesn't grow
+	assert.True(t, polls[3].Sub(polls[2]) < 200*time.Millisecond)
+}
+
+func TestClientIMPL_WaitForJob_Cancel(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defaultInterval := jobPollInterval
+	jobPollInterval = 10 * time.Millisecond
+	defer func() { jobPollInterval = defaultInterval }()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "state": "RUNNING"}`, jobID)))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	job, err := C.WaitForJob(ctx, jobID)
+	assert.NotNil(t, err)
+	assert.False(t, job.IsTerminal())
+}
+
+func TestClientIMPL_WaitForJob_HungPoll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defaultInterval, defaultTimeout := jobPollInterval, jobPollRequestTimeout
+	jobPollInterval, jobPollRequestTimeout = 10*time.Millisecond, 50*time.Millisecond
+	defer func() { jobPollInterval, jobPollRequestTimeout = defaultInterval, defaultTimeout }()
+
+	var polls int32
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		func(req *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&polls, 1) == 1 {
+				// array doesn't answer until the request is aborted
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			}
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`{"id": "%s", "state": "COMPLETED"}`, jobID)), nil
+		})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	job, err := C.WaitForJob(ctx, jobID)
+	assert.Nil(t, err)
+	assert.Equal(t, JobStateEnumCompleted, job.State)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+}
+
+func TestClientIMPL_WaitForJob_CancelInFlight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := C.WaitForJob(ctx, jobID)
+	assert.NotNil(t, err)
+	assert.True(t, time.Since(start) < jobPollRequestTimeout)
+}
+
+func TestClientIMPL_WatchJobs(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defaultInterval := jobPollInterval
+	jobPollInterval = 10 * time.Millisecond
+	defer func() { jobPollInterval = defaultInterval }()
+
+	missingJobID := "00000000-0000-0000-0000-000000000000"
+	calls := 0
+	httpmock.RegisterResponder("GET", jobMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			state := "RUNNING"
+			if calls > 1 {
+				state = "COMPLETED"
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "state": "FAILED"},
+{"id": "%s", "state": "%s"}]`, jobID, jobID2, state)), nil
+		})
+
+	results := map[string]JobResult{}
+	for r := range C.WatchJobs(context.Background(), []string{jobID, jobID2, missingJobID}) {
+		results[r.JobID] = r
+	}
+	assert.Len(t, results, 3)
+	assert.NotNil(t, results[jobID].Err)
+	assert.Equal(t, JobStateEnumFailed, results[jobID].Job.State)
+	assert.Nil(t, results[jobID2].Err)
+	assert.Equal(t, JobStateEnumCompleted, results[jobID2].Job.State)
+	assert.NotNil(t, results[missingJobID].Err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClientIMPL_WatchJobs_Cancel(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defaultInterval := jobPollInterval
+	jobPollInterval = 10 * time.Millisecond
+	defer func() { jobPollInterval = defaultInterval }()
+
+	httpmock.RegisterResponder("GET", jobMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "state": "RUNNING"}]`, jobID)))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	count := 0
+	for range C.WatchJobs(ctx, []string{jobID}) {
+		count++
+	}
+	assert.Equal(t, 0, count)
+}
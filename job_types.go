@@ -0,0 +1,93 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import "encoding/json"
+
+// JobStateEnum state of the job
+type JobStateEnum string
+
+const (
+	// JobStateEnumQueued captures enum value "QUEUED"
+	JobStateEnumQueued JobStateEnum = "QUEUED"
+	// JobStateEnumRunning captures enum value "RUNNING"
+	JobStateEnumRunning JobStateEnum = "RUNNING"
+	// JobStateEnumSuspended captures enum value "SUSPENDED"
+	JobStateEnumSuspended JobStateEnum = "SUSPENDED"
+	// JobStateEnumCompleted captures enum value "COMPLETED"
+	JobStateEnumCompleted JobStateEnum = "COMPLETED"
+	// JobStateEnumFailed captures enum value "FAILED"
+	JobStateEnumFailed JobStateEnum = "FAILED"
+	// JobStateEnumCancelling captures enum value "CANCELLING"
+	JobStateEnumCancelling JobStateEnum = "CANCELLING"
+	// JobStateEnumCancelled captures enum value "CANCELLED"
+	JobStateEnumCancelled JobStateEnum = "CANCELLED"
+	// JobStateEnumUnrecoverableFailed captures enum value "UNRECOVERABLE_FAILED"
+	JobStateEnumUnrecoverableFailed JobStateEnum = "UNRECOVERABLE_FAILED"
+)
+
+// Job asynchronous operation started on the array
+type Job struct {
+	// Unique identifier of the job.
+	ID string `json:"id,omitempty"`
+	// Type of the resource the job operates on.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Action performed on the resource.
+	ResourceAction string `json:"resource_action,omitempty"`
+	// Unique identifier of the resource the job operates on.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Current state of the job.
+	State JobStateEnum `json:"state,omitempty"`
+	// Time when the job was started.
+	StartTime string `json:"start_time,omitempty"`
+	// Time when the job was finished.
+	EndTime string `json:"end_time,omitempty"`
+	// Completion percentage of the job.
+	ProgressPercentage int32 `json:"progress_percentage,omitempty"`
+	// HTTP status of the operation performed by the job.
+	ResponseStatus string `json:"response_status,omitempty"`
+	// Body of the operation response, holds error messages if the job failed.
+	ResponseBody json.RawMessage `json:"response_body,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (j *Job) Fields() []string {
+	return []string{"id", "resource_type", "resource_action", "resource_id",
+		"state", "start_time", "end_time", "progress_percentage", "response_status", "response_body"}
+}
+
+// IsTerminal returns true if job is finished and its state won't change
+func (j *Job) IsTerminal() bool {
+	switch j.State {
+	case JobStateEnumCompleted, JobStateEnumFailed,
+		JobStateEnumCancelled, JobStateEnumUnrecoverableFailed:
+		return true
+	}
+	return false
+}
+
+// JobResult is emitted by WatchJobs when job is finished
+type JobResult struct {
+	// Unique identifier of the job.
+	JobID string
+	// Final state of the job.
+	Job Job
+	// Error is set if job didn't complete successfully or can't be found.
+	Err error
+}
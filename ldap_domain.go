@@ -0,0 +1,206 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	ldapDomainURL  = "ldap_domain"
+	ldapAccountURL = "ldap_account"
+)
+
+func getLDAPDomainDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	domain := LDAPDomain{}
+	return c.APIClient().QueryParamsWithFields(&domain)
+}
+
+func getLDAPAccountDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	account := LDAPAccount{}
+	return c.APIClient().QueryParamsWithFields(&account)
+}
+
+// GetLDAPDomain query and return specific LDAP domain by id
+func (c *ClientIMPL) GetLDAPDomain(ctx context.Context, id string) (resp LDAPDomain, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    ldapDomainURL,
+			ID:          id,
+			QueryParams: getLDAPDomainDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetLDAPDomains returns all configured LDAP domains
+func (c *ClientIMPL) GetLDAPDomains(ctx context.Context) ([]LDAPDomain, error) {
+	result := []LDAPDomain{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []LDAPDomain
+		qp := getLDAPDomainDefaultQueryParams(c)
+		qp.Order("domain_name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    ldapDomainURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateLDAPDomain configures LDAP or Active Directory domain users of which may log in to the cluster
+func (c *ClientIMPL) CreateLDAPDomain(ctx context.Context,
+	createParams *LDAPDomainCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: ldapDomainURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyLDAPDomain modifies existing LDAP domain configuration
+func (c *ClientIMPL) ModifyLDAPDomain(ctx context.Context,
+	modifyParams *LDAPDomainModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: ldapDomainURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteLDAPDomain removes LDAP domain configuration, accounts of the domain must be deleted first
+func (c *ClientIMPL) DeleteLDAPDomain(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: ldapDomainURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// VerifyLDAPDomain checks that LDAP servers of the domain are reachable and bind with configured credentials succeeds.
+// Error is returned if verification fails
+func (c *ClientIMPL) VerifyLDAPDomain(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: ldapDomainURL,
+			ID:       id,
+			Action:   "verify"},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetLDAPAccount query and return specific LDAP account by id
+func (c *ClientIMPL) GetLDAPAccount(ctx context.Context, id string) (resp LDAPAccount, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    ldapAccountURL,
+			ID:          id,
+			QueryParams: getLDAPAccountDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetLDAPAccounts returns all LDAP users and groups granted a role on the cluster
+func (c *ClientIMPL) GetLDAPAccounts(ctx context.Context) ([]LDAPAccount, error) {
+	result := []LDAPAccount{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []LDAPAccount
+		qp := getLDAPAccountDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    ldapAccountURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateLDAPAccount grants role to LDAP user or group
+func (c *ClientIMPL) CreateLDAPAccount(ctx context.Context,
+	createParams *LDAPAccountCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: ldapAccountURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyLDAPAccount changes role granted to the LDAP account
+func (c *ClientIMPL) ModifyLDAPAccount(ctx context.Context,
+	modifyParams *LDAPAccountModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: ldapAccountURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteLDAPAccount revokes access of the LDAP user or group
+func (c *ClientIMPL) DeleteLDAPAccount(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: ldapAccountURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
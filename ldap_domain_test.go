@@ -0,0 +1,168 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	ldapDomainMockURL  = APIMockURL + ldapDomainURL
+	ldapAccountMockURL = APIMockURL + ldapAccountURL
+)
+
+var ldapDomainID = "1"
+var ldapAccountID = "5"
+
+func TestClientIMPL_GetLDAPDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "domain_name": "corp.example.com", "ldap_servers": ["10.0.0.5"],
+"ldap_server_type": "AD", "protocol": "LDAPS", "port": 636}`, ldapDomainID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", ldapDomainMockURL, ldapDomainID),
+		httpmock.NewStringResponder(200, respData))
+	domain, err := C.GetLDAPDomain(context.Background(), ldapDomainID)
+	assert.Nil(t, err)
+	assert.Equal(t, "corp.example.com", domain.DomainName)
+	assert.Equal(t, LDAPServerTypeEnumAD, domain.LDAPServerType)
+	assert.Equal(t, LDAPProtocolEnumLDAPS, domain.Protocol)
+	assert.Equal(t, int32(636), domain.Port)
+}
+
+func TestClientIMPL_GetLDAPDomains(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", ldapDomainMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}]`, ldapDomainID)))
+	domains, err := C.GetLDAPDomains(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, domains, 1)
+}
+
+func TestClientIMPL_CreateLDAPDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", ldapDomainMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, ldapDomainID)), nil
+		})
+	domainName := "corp.example.com"
+	bindUser := "cn=svc,dc=corp,dc=example,dc=com"
+	bindPassword := "secret"
+	resp, err := C.CreateLDAPDomain(context.Background(), &LDAPDomainCreate{
+		DomainName:     &domainName,
+		LDAPServers:    []string{"10.0.0.5"},
+		LDAPServerType: LDAPServerTypeEnumAD,
+		BindUser:       &bindUser,
+		BindPassword:   &bindPassword,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ldapDomainID, resp.ID)
+	assert.Equal(t, "AD", reqBody["ldap_server_type"])
+	assert.NotContains(t, reqBody, "protocol")
+	assert.NotContains(t, reqBody, "port")
+}
+
+func TestClientIMPL_ModifyLDAPDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", ldapDomainMockURL, ldapDomainID),
+		httpmock.NewStringResponder(204, ""))
+	protocol := LDAPProtocolEnumLDAPS
+	_, err := C.ModifyLDAPDomain(context.Background(), &LDAPDomainModify{Protocol: &protocol}, ldapDomainID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_DeleteLDAPDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", ldapDomainMockURL, ldapDomainID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteLDAPDomain(context.Background(), ldapDomainID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_VerifyLDAPDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/verify", ldapDomainMockURL, ldapDomainID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.VerifyLDAPDomain(context.Background(), ldapDomainID)
+	assert.Nil(t, err)
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/verify", ldapDomainMockURL, ldapDomainID),
+		httpmock.NewStringResponder(400, `{"messages": [{"code": "0xE0C01003000D", "severity": "Error"}]}`))
+	_, err = C.VerifyLDAPDomain(context.Background(), ldapDomainID)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_LDAPAccounts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", ldapAccountMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, ldapAccountID)), nil
+		})
+	httpmock.RegisterResponder("GET", ldapAccountMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "storage-admins", "type": "Group"}]`,
+			ldapAccountID)))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", ldapAccountMockURL, ldapAccountID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "role_id": "1"}`, ldapAccountID)))
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", ldapAccountMockURL, ldapAccountID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", ldapAccountMockURL, ldapAccountID),
+		httpmock.NewStringResponder(204, ""))
+
+	name := "storage-admins"
+	roleID := "1"
+	resp, err := C.CreateLDAPAccount(context.Background(), &LDAPAccountCreate{
+		Name: &name, Type: LDAPAccountTypeEnumGroup, DomainID: &ldapDomainID, RoleID: &roleID})
+	assert.Nil(t, err)
+	assert.Equal(t, ldapAccountID, resp.ID)
+	assert.Equal(t, "Group", reqBody["type"])
+	assert.Equal(t, ldapDomainID, reqBody["domain_id"])
+
+	accounts, err := C.GetLDAPAccounts(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, LDAPAccountTypeEnumGroup, accounts[0].Type)
+
+	account, err := C.GetLDAPAccount(context.Background(), ldapAccountID)
+	assert.Nil(t, err)
+	assert.Equal(t, roleID, account.RoleID)
+
+	operatorRoleID := "3"
+	_, err = C.ModifyLDAPAccount(context.Background(), &LDAPAccountModify{RoleID: &operatorRoleID}, ldapAccountID)
+	assert.Nil(t, err)
+	_, err = C.DeleteLDAPAccount(context.Background(), ldapAccountID)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,173 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// LDAPProtocolEnum protocol used to connect to LDAP servers
+type LDAPProtocolEnum string
+
+const (
+	// LDAPProtocolEnumLDAP captures enum value "LDAP"
+	LDAPProtocolEnumLDAP LDAPProtocolEnum = "LDAP"
+	// LDAPProtocolEnumLDAPS captures enum value "LDAPS"
+	LDAPProtocolEnumLDAPS LDAPProtocolEnum = "LDAPS"
+)
+
+// LDAPServerTypeEnum type of LDAP servers
+type LDAPServerTypeEnum string
+
+const (
+	// LDAPServerTypeEnumAD captures enum value "AD", Active Directory
+	LDAPServerTypeEnumAD LDAPServerTypeEnum = "AD"
+	// LDAPServerTypeEnumOpenLDAP captures enum value "OpenLDAP"
+	LDAPServerTypeEnumOpenLDAP LDAPServerTypeEnum = "OpenLDAP"
+)
+
+// LDAPDomain LDAP or Active Directory domain users of which may log in to the cluster
+type LDAPDomain struct {
+	// Unique identifier of the domain.
+	ID string `json:"id,omitempty"`
+	// Name of the domain.
+	DomainName string `json:"domain_name,omitempty"`
+	// IP addresses or FQDNs of LDAP servers.
+	LDAPServers []string `json:"ldap_servers,omitempty"`
+	// Type of LDAP servers.
+	LDAPServerType LDAPServerTypeEnum `json:"ldap_server_type,omitempty"`
+	// LDAP server port.
+	Port int32 `json:"port,omitempty"`
+	// Protocol used to connect to LDAP servers.
+	Protocol LDAPProtocolEnum `json:"protocol,omitempty"`
+	// DN of the user used to bind to LDAP servers.
+	BindUser string `json:"bind_user,omitempty"`
+	// Timeout of LDAP requests in milliseconds.
+	LDAPTimeout int32 `json:"ldap_timeout,omitempty"`
+	// Indicates whether Active Directory global catalog is searched.
+	IsGlobalCatalog bool `json:"is_global_catalog,omitempty"`
+	// DN of the directory users are searched in.
+	UserSearchPath string `json:"user_search_path,omitempty"`
+	// DN of the directory groups are searched in.
+	GroupSearchPath string `json:"group_search_path,omitempty"`
+	// Depth of nested groups search.
+	GroupSearchLevel int32 `json:"group_search_level,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (d *LDAPDomain) Fields() []string {
+	return []string{"id", "domain_name", "ldap_servers", "ldap_server_type", "port", "protocol",
+		"bind_user", "ldap_timeout", "is_global_catalog", "user_search_path", "group_search_path",
+		"group_search_level"}
+}
+
+// LDAPDomainCreate configure LDAP domain request
+type LDAPDomainCreate struct {
+	// Name of the domain.
+	DomainName *string `json:"domain_name"`
+	// IP addresses or FQDNs of LDAP servers.
+	LDAPServers []string `json:"ldap_servers"`
+	// Type of LDAP servers.
+	LDAPServerType LDAPServerTypeEnum `json:"ldap_server_type"`
+	// LDAP server port.
+	Port *int32 `json:"port,omitempty"`
+	// Protocol used to connect to LDAP servers.
+	Protocol LDAPProtocolEnum `json:"protocol,omitempty"`
+	// DN of the user used to bind to LDAP servers.
+	BindUser *string `json:"bind_user"`
+	// Password of the bind user. This value is not queriable.
+	BindPassword *string `json:"bind_password"`
+	// Timeout of LDAP requests in milliseconds.
+	LDAPTimeout *int32 `json:"ldap_timeout,omitempty"`
+	// Indicates whether Active Directory global catalog is searched.
+	IsGlobalCatalog *bool `json:"is_global_catalog,omitempty"`
+	// DN of the directory users are searched in.
+	UserSearchPath *string `json:"user_search_path,omitempty"`
+	// DN of the directory groups are searched in.
+	GroupSearchPath *string `json:"group_search_path,omitempty"`
+	// Depth of nested groups search.
+	GroupSearchLevel *int32 `json:"group_search_level,omitempty"`
+}
+
+// LDAPDomainModify modify LDAP domain request, unset fields are not changed
+type LDAPDomainModify struct {
+	// IP addresses or FQDNs of LDAP servers.
+	LDAPServers *[]string `json:"ldap_servers,omitempty"`
+	// LDAP server port.
+	Port *int32 `json:"port,omitempty"`
+	// Protocol used to connect to LDAP servers.
+	Protocol *LDAPProtocolEnum `json:"protocol,omitempty"`
+	// DN of the user used to bind to LDAP servers.
+	BindUser *string `json:"bind_user,omitempty"`
+	// Password of the bind user. This value is not queriable.
+	BindPassword *string `json:"bind_password,omitempty"`
+	// Timeout of LDAP requests in milliseconds.
+	LDAPTimeout *int32 `json:"ldap_timeout,omitempty"`
+	// DN of the directory users are searched in.
+	UserSearchPath *string `json:"user_search_path,omitempty"`
+	// DN of the directory groups are searched in.
+	GroupSearchPath *string `json:"group_search_path,omitempty"`
+	// Depth of nested groups search.
+	GroupSearchLevel *int32 `json:"group_search_level,omitempty"`
+}
+
+// LDAPAccountTypeEnum type of LDAP account
+type LDAPAccountTypeEnum string
+
+const (
+	// LDAPAccountTypeEnumUser captures enum value "User"
+	LDAPAccountTypeEnumUser LDAPAccountTypeEnum = "User"
+	// LDAPAccountTypeEnumGroup captures enum value "Group", all members of the group get the role
+	LDAPAccountTypeEnumGroup LDAPAccountTypeEnum = "Group"
+)
+
+// LDAPAccount LDAP user or group which is granted a role on the cluster
+type LDAPAccount struct {
+	// Unique identifier of the account.
+	ID string `json:"id,omitempty"`
+	// Name of the LDAP user or group.
+	Name string `json:"name,omitempty"`
+	// Type of the account.
+	Type LDAPAccountTypeEnum `json:"type,omitempty"`
+	// Unique identifier of the LDAP domain the account belongs to.
+	DomainID string `json:"domain_id,omitempty"`
+	// Unique identifier of the role granted to the account.
+	RoleID string `json:"role_id,omitempty"`
+	// DN of the LDAP user or group.
+	DN string `json:"dn,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (a *LDAPAccount) Fields() []string {
+	return []string{"id", "name", "type", "domain_id", "role_id", "dn"}
+}
+
+// LDAPAccountCreate grant role to LDAP user or group request
+type LDAPAccountCreate struct {
+	// Name of the LDAP user or group.
+	Name *string `json:"name"`
+	// Type of the account.
+	Type LDAPAccountTypeEnum `json:"type"`
+	// Unique identifier of the LDAP domain the account belongs to.
+	DomainID *string `json:"domain_id"`
+	// Unique identifier of the role granted to the account.
+	RoleID *string `json:"role_id"`
+}
+
+// LDAPAccountModify modify LDAP account request
+type LDAPAccountModify struct {
+	// Unique identifier of the role granted to the account.
+	RoleID *string `json:"role_id"`
+}
@@ -0,0 +1,58 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const licenseMockURL = APIMockURL + licenseURL
+
+func TestClientIMPL_GetLicenses(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	expiration := time.Now().Add(10*24*time.Hour + time.Hour).UTC().Format(time.RFC3339)
+	respData := fmt.Sprintf(`[{"id": "1", "feature": "Base", "state": "Active"},
+{"id": "2", "feature": "Metro", "state": "Evaluation", "expiration_time": "%s"},
+{"id": "3", "feature": "File", "state": "Expired", "expiration_time": "2020-01-01T00:00:00Z"}]`, expiration)
+	httpmock.RegisterResponder("GET", licenseMockURL,
+		httpmock.NewStringResponder(200, respData))
+	licenses, err := C.GetLicenses(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, licenses, 3)
+
+	_, ok := licenses[0].DaysUntilExpiry()
+	assert.False(t, ok)
+	assert.True(t, licenses[0].IsActive())
+
+	days, ok := licenses[1].DaysUntilExpiry()
+	assert.True(t, ok)
+	assert.Equal(t, 10, days)
+	assert.True(t, licenses[1].IsActive())
+
+	days, ok = licenses[2].DaysUntilExpiry()
+	assert.True(t, ok)
+	assert.True(t, days < 0)
+	assert.False(t, licenses[2].IsActive())
+}
@@ -0,0 +1,71 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"math"
+	"time"
+)
+
+// LicenseStateEnum state of the license
+type LicenseStateEnum string
+
+const (
+	// LicenseStateEnumActive captures enum value "Active"
+	LicenseStateEnumActive LicenseStateEnum = "Active"
+	// LicenseStateEnumExpired captures enum value "Expired"
+	LicenseStateEnumExpired LicenseStateEnum = "Expired"
+	// LicenseStateEnumEvaluation captures enum value "Evaluation"
+	LicenseStateEnumEvaluation LicenseStateEnum = "Evaluation"
+)
+
+// License licensed feature of the cluster
+type License struct {
+	// Unique identifier of the license.
+	ID string `json:"id,omitempty"`
+	// Name of the licensed feature.
+	Feature string `json:"feature,omitempty"`
+	// State of the license.
+	State LicenseStateEnum `json:"state,omitempty"`
+	// Time when the license expires. Empty for perpetual licenses.
+	ExpirationTime string `json:"expiration_time,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (l *License) Fields() []string {
+	return []string{"id", "feature", "state", "expiration_time"}
+}
+
+// IsActive returns true if licensed feature can be used
+func (l *License) IsActive() bool {
+	return l.State == LicenseStateEnumActive || l.State == LicenseStateEnumEvaluation
+}
+
+// DaysUntilExpiry returns number of whole days left until license expires, negative if already expired.
+// False is returned for perpetual licenses
+func (l *License) DaysUntilExpiry() (int, bool) {
+	if l.ExpirationTime == "" {
+		return 0, false
+	}
+	expiration, err := time.Parse(time.RFC3339, l.ExpirationTime)
+	if err != nil {
+		return 0, false
+	}
+	return int(math.Floor(time.Until(expiration).Hours() / 24)), true
+}
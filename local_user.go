@@ -0,0 +1,188 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	localUserURL = "local_user"
+	roleURL      = "role"
+)
+
+func getLocalUserDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	user := LocalUser{}
+	return c.APIClient().QueryParamsWithFields(&user)
+}
+
+func getRoleDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	role := Role{}
+	return c.APIClient().QueryParamsWithFields(&role)
+}
+
+// GetLocalUser query and return specific local user by id
+func (c *ClientIMPL) GetLocalUser(ctx context.Context, id string) (resp LocalUser, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    localUserURL,
+			ID:          id,
+			QueryParams: getLocalUserDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetLocalUserByName query and return specific local user by name
+func (c *ClientIMPL) GetLocalUserByName(ctx context.Context, name string) (resp LocalUser, err error) {
+	var userList []LocalUser
+	qp := getLocalUserDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    localUserURL,
+			QueryParams: qp},
+		&userList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(userList) != 1 {
+		return resp, notExistError()
+	}
+	return userList[0], nil
+}
+
+// GetLocalUsers returns all local users, built-in ones included
+func (c *ClientIMPL) GetLocalUsers(ctx context.Context) ([]LocalUser, error) {
+	result := []LocalUser{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []LocalUser
+		qp := getLocalUserDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    localUserURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateLocalUser creates new local user
+func (c *ClientIMPL) CreateLocalUser(ctx context.Context,
+	createParams *LocalUserCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: localUserURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyLocalUser modifies existing local user, e.g. changes its role or password
+func (c *ClientIMPL) ModifyLocalUser(ctx context.Context,
+	modifyParams *LocalUserModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: localUserURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteLocalUser deletes local user, built-in users can't be deleted
+func (c *ClientIMPL) DeleteLocalUser(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: localUserURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetRole query and return specific role by id
+func (c *ClientIMPL) GetRole(ctx context.Context, id string) (resp Role, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    roleURL,
+			ID:          id,
+			QueryParams: getRoleDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetRoleByName query and return specific role by name, e.g. "Administrator"
+func (c *ClientIMPL) GetRoleByName(ctx context.Context, name string) (resp Role, err error) {
+	var roleList []Role
+	qp := getRoleDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    roleURL,
+			QueryParams: qp},
+		&roleList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(roleList) != 1 {
+		return resp, notExistError()
+	}
+	return roleList[0], nil
+}
+
+// GetRoles returns all roles
+func (c *ClientIMPL) GetRoles(ctx context.Context) (resp []Role, err error) {
+	qp := getRoleDefaultQueryParams(c)
+	qp.Order("id")
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    roleURL,
+			QueryParams: qp},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,154 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	localUserMockURL = APIMockURL + localUserURL
+	roleMockURL      = APIMockURL + roleURL
+)
+
+var localUserID = "3"
+
+func TestClientIMPL_GetLocalUser(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", localUserMockURL, localUserID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "name": "csi", "role_id": "2", "is_locked": true}`,
+			localUserID)))
+	user, err := C.GetLocalUser(context.Background(), localUserID)
+	assert.Nil(t, err)
+	assert.Equal(t, "csi", user.Name)
+	assert.Equal(t, "2", user.RoleID)
+	assert.True(t, user.IsLocked)
+}
+
+func TestClientIMPL_GetLocalUserByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var nameFilter string
+	httpmock.RegisterResponder("GET", localUserMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			nameFilter = req.URL.Query().Get("name")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "name": "csi"}]`, localUserID)), nil
+		})
+	user, err := C.GetLocalUserByName(context.Background(), "csi")
+	assert.Nil(t, err)
+	assert.Equal(t, localUserID, user.ID)
+	assert.Equal(t, "eq.csi", nameFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", localUserMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetLocalUserByName(context.Background(), "csi")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetLocalUsers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", localUserMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "1", "name": "admin", "is_built_in": true}, {"id": "3", "name": "csi"}]`))
+	users, err := C.GetLocalUsers(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, users, 2)
+	assert.True(t, users[0].IsBuiltIn)
+}
+
+func TestClientIMPL_CreateLocalUser(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", localUserMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, localUserID)), nil
+		})
+	name := "csi"
+	roleID := "2"
+	password := "Password123!"
+	resp, err := C.CreateLocalUser(context.Background(),
+		&LocalUserCreate{Name: &name, RoleID: &roleID, Password: &password})
+	assert.Nil(t, err)
+	assert.Equal(t, localUserID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "role_id": roleID, "password": password}, reqBody)
+}
+
+func TestClientIMPL_ModifyLocalUser(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", localUserMockURL, localUserID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	isLocked := false
+	_, err := C.ModifyLocalUser(context.Background(), &LocalUserModify{IsLocked: &isLocked}, localUserID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"is_locked": false}, reqBody)
+}
+
+func TestClientIMPL_DeleteLocalUser(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", localUserMockURL, localUserID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteLocalUser(context.Background(), localUserID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_GetRoles(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", roleMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("name") == "eq.Operator" {
+				return httpmock.NewStringResponse(200, `[{"id": "3", "name": "Operator", "is_built_in": true}]`), nil
+			}
+			return httpmock.NewStringResponse(200,
+				`[{"id": "1", "name": "Administrator"}, {"id": "3", "name": "Operator"}]`), nil
+		})
+	httpmock.RegisterResponder("GET", roleMockURL+"/1",
+		httpmock.NewStringResponder(200, `{"id": "1", "name": "Administrator", "is_built_in": true}`))
+	roles, err := C.GetRoles(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, roles, 2)
+
+	role, err := C.GetRoleByName(context.Background(), "Operator")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", role.ID)
+
+	role, err = C.GetRole(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Administrator", role.Name)
+}
@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// LocalUser local user account of the cluster
+type LocalUser struct {
+	// Unique identifier of the user.
+	ID string `json:"id,omitempty"`
+	// Name of the user.
+	Name string `json:"name,omitempty"`
+	// Unique identifier of the role assigned to the user.
+	RoleID string `json:"role_id,omitempty"`
+	// Indicates whether the user is built-in, built-in users can't be deleted.
+	IsBuiltIn bool `json:"is_built_in,omitempty"`
+	// Indicates whether the user is locked and can't log in.
+	IsLocked bool `json:"is_locked,omitempty"`
+	// Indicates whether the user still has the default password.
+	IsDefaultPassword bool `json:"is_default_password,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (u *LocalUser) Fields() []string {
+	return []string{"id", "name", "role_id", "is_built_in", "is_locked", "is_default_password"}
+}
+
+// LocalUserCreate create local user request
+type LocalUserCreate struct {
+	// Name of the user.
+	Name *string `json:"name"`
+	// Unique identifier of the role assigned to the user.
+	RoleID *string `json:"role_id"`
+	// Password of the user. This value is not queriable.
+	Password *string `json:"password"`
+}
+
+// LocalUserModify modify local user request, unset fields are not changed
+type LocalUserModify struct {
+	// Unique identifier of the role assigned to the user.
+	RoleID *string `json:"role_id,omitempty"`
+	// Locks or unlocks the user.
+	IsLocked *bool `json:"is_locked,omitempty"`
+	// New password of the user.
+	Password *string `json:"password,omitempty"`
+	// Current password, required when users change their own password.
+	CurrentPassword *string `json:"current_password,omitempty"`
+}
+
+// Role role which grants permissions to users and LDAP accounts
+type Role struct {
+	// Unique identifier of the role.
+	ID string `json:"id,omitempty"`
+	// Name of the role.
+	Name string `json:"name,omitempty"`
+	// Indicates whether the role is built-in.
+	IsBuiltIn bool `json:"is_built_in,omitempty"`
+	// Description of the role.
+	Description string `json:"description,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (r *Role) Fields() []string {
+	return []string{"id", "name", "is_built_in", "description"}
+}
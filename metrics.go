@@ -0,0 +1,330 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	metricsURL = "metrics"
+	// maximum number of samples returned by metrics wrappers, older samples are dropped
+	metricsMaxSamples = 2000
+	// number of daily space samples used to compute capacity forecast
+	capacityForecastLookbackDays = 30
+	// minimum number of daily space samples required to establish the trend
+	capacityForecastMinSamples = 7
+)
+
+// latency buckets bounds in microseconds: <1ms, 1-5ms, 5-20ms, >20ms
+var latencyHistogramBounds = []float64{1000, 5000, 20000}
+
+func validateMetricsInterval(interval MetricsIntervalEnum) error {
+	switch interval {
+	case MetricsIntervalEnumTwentySec, MetricsIntervalEnumFiveMins,
+		MetricsIntervalEnumOneHour, MetricsIntervalEnumOneDay:
+		return nil
+	default:
+		return fmt.Errorf("unsupported metrics interval: %s", interval)
+	}
+}
+
+// latestSamplesStart returns index of the first of n samples returned by metrics wrappers,
+// so only metricsMaxSamples latest samples are kept
+func latestSamplesStart(n int) int {
+	if n > metricsMaxSamples {
+		return n - metricsMaxSamples
+	}
+	return 0
+}
+
+// generateMetrics reads metrics samples for entity into resp
+func (c *ClientIMPL) generateMetrics(ctx context.Context, entity MetricsEntityEnum,
+	entityID string, interval MetricsIntervalEnum, resp interface{}) error {
+	if err := validateMetricsInterval(interval); err != nil {
+		return err
+	}
+	_, err := c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: metricsURL,
+			Action:   "generate",
+			Body: &MetricsRequest{
+				Entity:   entity,
+				EntityID: entityID,
+				Interval: interval}},
+		resp)
+	return WrapErr(err)
+}
+
+// GetVolumePerformanceMetrics returns IOPS, latency and bandwidth samples of the volume.
+// Entity of the request may be omitted, only performance_metrics_by_volume is accepted
+func (c *ClientIMPL) GetVolumePerformanceMetrics(ctx context.Context,
+	request *MetricsRequest) ([]VolumePerformanceMetric, error) {
+	if request == nil {
+		return nil, errors.New("metrics request is required")
+	}
+	if request.Entity != "" && request.Entity != MetricsEntityEnumPerformanceByVolume {
+		return nil, fmt.Errorf("unsupported metrics entity for volume performance: %s", request.Entity)
+	}
+	var samples []VolumePerformanceMetric
+	err := c.generateMetrics(ctx, MetricsEntityEnumPerformanceByVolume, request.EntityID, request.Interval, &samples)
+	if err != nil {
+		return nil, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	return samples, nil
+}
+
+// GetVolumeSpaceMetrics returns logical space usage samples of the volume.
+// Empty list is returned if the array has no history for the volume yet
+func (c *ClientIMPL) GetVolumeSpaceMetrics(ctx context.Context,
+	volID string, interval MetricsIntervalEnum) ([]VolumeSpaceMetric, error) {
+	samples := []VolumeSpaceMetric{}
+	err := c.generateMetrics(ctx, MetricsEntityEnumSpaceByVolume, volID, interval, &samples)
+	if err != nil {
+		return nil, err
+	}
+	if samples == nil {
+		return []VolumeSpaceMetric{}, nil
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	return samples, nil
+}
+
+// PerformanceMetricsByVolume returns IOPS, latency and bandwidth samples of the volume
+func (c *ClientIMPL) PerformanceMetricsByVolume(ctx context.Context,
+	volID string, interval MetricsIntervalEnum) ([]VolumePerformanceMetric, error) {
+	return c.GetVolumePerformanceMetrics(ctx, &MetricsRequest{EntityID: volID, Interval: interval})
+}
+
+// PerformanceMetricsByAppliance returns IOPS, latency, bandwidth and CPU utilization samples of the appliance
+func (c *ClientIMPL) PerformanceMetricsByAppliance(ctx context.Context,
+	applianceID string, interval MetricsIntervalEnum) ([]AppliancePerformanceMetric, error) {
+	var samples []AppliancePerformanceMetric
+	err := c.generateMetrics(ctx, MetricsEntityEnumPerformanceByAppliance, applianceID, interval, &samples)
+	if err != nil {
+		return nil, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	return samples, nil
+}
+
+// PerformanceMetricsByNode returns IOPS, latency and bandwidth samples of the node
+func (c *ClientIMPL) PerformanceMetricsByNode(ctx context.Context,
+	nodeID string, interval MetricsIntervalEnum) ([]NodePerformanceMetric, error) {
+	var samples []NodePerformanceMetric
+	err := c.generateMetrics(ctx, MetricsEntityEnumPerformanceByNode, nodeID, interval, &samples)
+	if err != nil {
+		return nil, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	return samples, nil
+}
+
+// SpaceMetricsByVolume returns logical space usage samples of the volume
+func (c *ClientIMPL) SpaceMetricsByVolume(ctx context.Context,
+	volID string, interval MetricsIntervalEnum) ([]VolumeSpaceMetric, error) {
+	return c.GetVolumeSpaceMetrics(ctx, volID, interval)
+}
+
+// SpaceMetricsByCluster returns physical and logical space usage samples of the cluster,
+// id of the cluster is returned by GetCluster
+func (c *ClientIMPL) SpaceMetricsByCluster(ctx context.Context,
+	clusterID string, interval MetricsIntervalEnum) ([]ClusterSpaceMetric, error) {
+	var samples []ClusterSpaceMetric
+	err := c.generateMetrics(ctx, MetricsEntityEnumSpaceByCluster, clusterID, interval, &samples)
+	if err != nil {
+		return nil, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	return samples, nil
+}
+
+// WearMetricsByDrive returns write endurance samples of the SSD drive, ids of drives
+// are returned by GetHardwareByType with HardwareTypeEnumDrive
+func (c *ClientIMPL) WearMetricsByDrive(ctx context.Context,
+	driveID string, interval MetricsIntervalEnum) ([]DriveWearMetric, error) {
+	var samples []DriveWearMetric
+	err := c.generateMetrics(ctx, MetricsEntityEnumWearByDrive, driveID, interval, &samples)
+	if err != nil {
+		return nil, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	return samples, nil
+}
+
+func (c *ClientIMPL) getLatencyHistogram(ctx context.Context, entity MetricsEntityEnum,
+	entityID string, interval MetricsIntervalEnum) (resp LatencyHistogram, err error) {
+	var samples []latencyMetric
+	err = c.generateMetrics(ctx, entity, entityID, interval, &samples)
+	if err != nil {
+		return resp, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	resp.Interval = interval
+	resp.Samples = len(samples)
+	lower := 0.0
+	for _, upper := range latencyHistogramBounds {
+		resp.Buckets = append(resp.Buckets, LatencyBucket{LowerBound: lower, UpperBound: upper})
+		lower = upper
+	}
+	resp.Buckets = append(resp.Buckets, LatencyBucket{LowerBound: lower})
+	for _, s := range samples {
+		if s.AvgLatency > resp.MaxLatency {
+			resp.MaxLatency = s.AvgLatency
+		}
+		for i := range resp.Buckets {
+			if resp.Buckets[i].UpperBound == 0 || s.AvgLatency < resp.Buckets[i].UpperBound {
+				resp.Buckets[i].Count++
+				break
+			}
+		}
+	}
+	return resp, nil
+}
+
+// GetLatencyHistogramByVolume returns distribution of volume average latency samples
+func (c *ClientIMPL) GetLatencyHistogramByVolume(ctx context.Context,
+	volID string, interval MetricsIntervalEnum) (LatencyHistogram, error) {
+	return c.getLatencyHistogram(ctx, MetricsEntityEnumPerformanceByVolume, volID, interval)
+}
+
+// GetLatencyHistogramByAppliance returns distribution of appliance average latency samples
+func (c *ClientIMPL) GetLatencyHistogramByAppliance(ctx context.Context,
+	applianceID string, interval MetricsIntervalEnum) (LatencyHistogram, error) {
+	return c.getLatencyHistogram(ctx, MetricsEntityEnumPerformanceByAppliance, applianceID, interval)
+}
+
+// GetLatencyHistogramByNode returns distribution of node average latency samples
+func (c *ClientIMPL) GetLatencyHistogramByNode(ctx context.Context,
+	nodeID string, interval MetricsIntervalEnum) (LatencyHistogram, error) {
+	return c.getLatencyHistogram(ctx, MetricsEntityEnumPerformanceByNode, nodeID, interval)
+}
+
+// GetCapacityForecast returns projected days-to-full of appliance physical space.
+// Forecast is a linear regression over daily space samples of the last 30 days,
+// at least 7 samples are required, otherwise InsufficientData state is returned.
+func (c *ClientIMPL) GetCapacityForecast(ctx context.Context, applianceID string) (resp CapacityForecast, err error) {
+	var samples []spaceMetric
+	err = c.generateMetrics(ctx, MetricsEntityEnumSpaceByAppliance, applianceID,
+		MetricsIntervalEnumOneDay, &samples)
+	if err != nil {
+		return resp, err
+	}
+	if len(samples) > capacityForecastLookbackDays {
+		samples = samples[len(samples)-capacityForecastLookbackDays:]
+	}
+	resp.ApplianceID = applianceID
+	resp.Samples = len(samples)
+	resp.State = CapacityForecastStateEnumInsufficientData
+	if len(samples) == 0 {
+		return resp, nil
+	}
+	last := samples[len(samples)-1]
+	resp.PhysicalTotal = last.PhysicalTotal
+	resp.PhysicalUsed = last.PhysicalUsed
+	if len(samples) < capacityForecastMinSamples {
+		return resp, nil
+	}
+	first, err := time.Parse(time.RFC3339, samples[0].Timestamp)
+	if err != nil {
+		return resp, fmt.Errorf("can't parse space metrics timestamp: %s", err.Error())
+	}
+	// least squares fit of used space (bytes) against time (days since first sample)
+	var sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		ts, err := time.Parse(time.RFC3339, s.Timestamp)
+		if err != nil {
+			return resp, fmt.Errorf("can't parse space metrics timestamp: %s", err.Error())
+		}
+		x := ts.Sub(first).Hours() / 24
+		y := float64(s.PhysicalUsed)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	n := float64(len(samples))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return resp, nil
+	}
+	resp.GrowthPerDay = (n*sumXY - sumX*sumY) / denominator
+	if resp.GrowthPerDay <= 0 {
+		resp.State = CapacityForecastStateEnumNotGrowing
+		return resp, nil
+	}
+	resp.State = CapacityForecastStateEnumOK
+	free := resp.PhysicalTotal - resp.PhysicalUsed
+	if free > 0 {
+		resp.DaysToFull = float64(free) / resp.GrowthPerDay
+	}
+	return resp, nil
+}
+
+// GetReplicationThroughput returns replication traffic samples of the link to remote system.
+// ActiveSessions holds the number of sessions which are replicating over the link at the moment
+func (c *ClientIMPL) GetReplicationThroughput(ctx context.Context, remoteSystemID string,
+	interval MetricsIntervalEnum) (resp ReplicationThroughput, err error) {
+	var samples []ReplicationThroughputSample
+	err = c.generateMetrics(ctx, MetricsEntityEnumCopyByRemoteSystem, remoteSystemID, interval, &samples)
+	if err != nil {
+		return resp, err
+	}
+	samples = samples[latestSamplesStart(len(samples)):]
+	resp.RemoteSystemID = remoteSystemID
+	resp.Interval = interval
+	resp.Samples = samples
+	for _, s := range samples {
+		resp.TotalTransferred += s.DataTransferred
+		if s.TransferRate > resp.MaxTransferRate {
+			resp.MaxTransferRate = s.TransferRate
+		}
+	}
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []resourceRef
+		qp := c.APIClient().QueryParams()
+		qp.Select("id")
+		qp.RawArg("remote_system_id", fmt.Sprintf("eq.%s", remoteSystemID))
+		qp.RawArg("state", "in.(OK,Synchronizing)")
+		qp.Order("id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    replicationSessionURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp.ActiveSessions += len(page)
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
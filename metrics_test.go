@@ -0,0 +1,308 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const metricsMockURL = APIMockURL + metricsURL + "/generate"
+
+func TestClientIMPL_GetLatencyHistogramByVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := `[{"timestamp": "2020-05-06T10:00:00Z", "avg_latency": 500},
+{"timestamp": "2020-05-06T10:05:00Z", "avg_latency": 700},
+{"timestamp": "2020-05-06T10:10:00Z", "avg_latency": 3000},
+{"timestamp": "2020-05-06T10:15:00Z", "avg_latency": 45000}]`
+	var reqBody MetricsRequest
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+
+	resp, err := C.GetLatencyHistogramByVolume(context.Background(), volID, MetricsIntervalEnumFiveMins)
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsEntityEnumPerformanceByVolume, reqBody.Entity)
+	assert.Equal(t, volID, reqBody.EntityID)
+	assert.Equal(t, MetricsIntervalEnumFiveMins, reqBody.Interval)
+	assert.Equal(t, 4, resp.Samples)
+	assert.Len(t, resp.Buckets, 4)
+	assert.Equal(t, 2, resp.Buckets[0].Count)
+	assert.Equal(t, 1, resp.Buckets[1].Count)
+	assert.Equal(t, 0, resp.Buckets[2].Count)
+	assert.Equal(t, 1, resp.Buckets[3].Count)
+	assert.Equal(t, float64(1000), resp.Percentile(50))
+	assert.Equal(t, float64(45000), resp.Percentile(99))
+}
+
+func TestClientIMPL_GetVolumePerformanceMetrics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"timestamp": "2020-05-06T10:00:00Z", "volume_id": "%s", "read_iops": 120.5,
+"write_iops": 80, "avg_read_latency": 350, "avg_write_latency": 600, "read_bandwidth": 4194304,
+"write_bandwidth": 2097152}]`, volID)
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	samples, err := C.GetVolumePerformanceMetrics(context.Background(),
+		&MetricsRequest{EntityID: volID, Interval: MetricsIntervalEnumFiveMins})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"entity":    "performance_metrics_by_volume",
+		"entity_id": volID,
+		"interval":  "Five_Mins"}, reqBody)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, "2020-05-06T10:00:00Z", samples[0].Timestamp)
+	assert.Equal(t, 120.5, samples[0].ReadIops)
+	assert.Equal(t, float64(80), samples[0].WriteIops)
+	assert.Equal(t, float64(350), samples[0].ReadLatency)
+	assert.Equal(t, float64(600), samples[0].WriteLatency)
+	assert.Equal(t, float64(4194304), samples[0].ReadBandwidth)
+	assert.Equal(t, float64(2097152), samples[0].WriteBandwidth)
+
+	_, err = C.GetVolumePerformanceMetrics(context.Background(),
+		&MetricsRequest{Entity: MetricsEntityEnumPerformanceByNode, EntityID: volID, Interval: MetricsIntervalEnumFiveMins})
+	assert.NotNil(t, err)
+
+	_, err = C.GetVolumePerformanceMetrics(context.Background(), nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_GetVolumePerformanceMetrics_NotAvailable(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		httpmock.NewStringResponder(400, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`, MetricsNotAvailableErrorCode)))
+	_, err := C.GetVolumePerformanceMetrics(context.Background(),
+		&MetricsRequest{EntityID: volID, Interval: MetricsIntervalEnumOneDay})
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.MetricNotAvailable())
+}
+
+func TestClientIMPL_GetVolumeSpaceMetrics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"timestamp": "2020-05-06T00:00:00Z", "volume_id": "%s",
+"logical_provisioned": 107374182400, "logical_used": 21474836480, "thin_savings": 5.0}]`, volID)
+	var reqBody MetricsRequest
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	samples, err := C.GetVolumeSpaceMetrics(context.Background(), volID, MetricsIntervalEnumOneDay)
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsRequest{Entity: MetricsEntityEnumSpaceByVolume, EntityID: volID,
+		Interval: MetricsIntervalEnumOneDay}, reqBody)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, int64(107374182400), samples[0].LogicalProvisioned)
+	assert.Equal(t, int64(21474836480), samples[0].LogicalUsed)
+	assert.Equal(t, 5.0, samples[0].ThinSavings)
+	assert.Equal(t, "2020-05-06T00:00:00Z", samples[0].Timestamp)
+}
+
+func TestClientIMPL_GetVolumeSpaceMetrics_NoHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	for _, body := range []string{`[]`, ``} {
+		httpmock.RegisterResponder("POST", metricsMockURL,
+			httpmock.NewStringResponder(200, body))
+		samples, err := C.GetVolumeSpaceMetrics(context.Background(), volID, MetricsIntervalEnumOneDay)
+		assert.Nil(t, err)
+		assert.NotNil(t, samples)
+		assert.Len(t, samples, 0)
+	}
+}
+
+func TestClientIMPL_GetLatencyHistogramByVolume_BadInterval(t *testing.T) {
+	_, err := C.GetLatencyHistogramByVolume(context.Background(), volID, "Five_Years")
+	assert.NotNil(t, err)
+}
+
+func spaceMetricsResp(used []int64) string {
+	var samples []string
+	for i, u := range used {
+		samples = append(samples, fmt.Sprintf(
+			`{"timestamp": "2020-05-%02dT00:00:00Z", "physical_total": 10000, "physical_used": %d}`, i+1, u))
+	}
+	return "[" + strings.Join(samples, ",") + "]"
+}
+
+func TestClientIMPL_GetCapacityForecast(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody MetricsRequest
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200,
+				spaceMetricsResp([]int64{1000, 1100, 1200, 1300, 1400, 1500, 1600, 1700})), nil
+		})
+	resp, err := C.GetCapacityForecast(context.Background(), "A1")
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsEntityEnumSpaceByAppliance, reqBody.Entity)
+	assert.Equal(t, MetricsIntervalEnumOneDay, reqBody.Interval)
+	assert.Equal(t, CapacityForecastStateEnumOK, resp.State)
+	assert.Equal(t, 8, resp.Samples)
+	assert.Equal(t, int64(1700), resp.PhysicalUsed)
+	assert.InDelta(t, 100, resp.GrowthPerDay, 0.001)
+	assert.InDelta(t, 83, resp.DaysToFull, 0.001)
+}
+
+func TestClientIMPL_GetCapacityForecast_NoTrend(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		httpmock.NewStringResponder(200, spaceMetricsResp([]int64{1000, 1100, 1200})))
+	resp, err := C.GetCapacityForecast(context.Background(), "A1")
+	assert.Nil(t, err)
+	assert.Equal(t, CapacityForecastStateEnumInsufficientData, resp.State)
+	assert.Equal(t, int64(1200), resp.PhysicalUsed)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		httpmock.NewStringResponder(200,
+			spaceMetricsResp([]int64{1700, 1600, 1500, 1400, 1300, 1200, 1100})))
+	resp, err = C.GetCapacityForecast(context.Background(), "A1")
+	assert.Nil(t, err)
+	assert.Equal(t, CapacityForecastStateEnumNotGrowing, resp.State)
+	assert.Equal(t, float64(0), resp.DaysToFull)
+}
+
+func TestClientIMPL_GetReplicationThroughput(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	remoteSystemID := "db11b1f2-7bd5-4ff9-a3a1-9e8c6d6e7a0c"
+	respData := `[{"timestamp": "2020-05-06T10:00:00Z", "data_transferred": 3000, "transfer_rate": 10},
+{"timestamp": "2020-05-06T10:05:00Z", "data_transferred": 6000, "transfer_rate": 20}]`
+	var reqBody MetricsRequest
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	var sessionFilter string
+	httpmock.RegisterResponder("GET", APIMockURL+"replication_session",
+		func(req *http.Request) (*http.Response, error) {
+			sessionFilter = req.URL.Query().Get("remote_system_id")
+			return httpmock.NewStringResponse(200, `[{"id": "s1"}, {"id": "s2"}]`), nil
+		})
+
+	resp, err := C.GetReplicationThroughput(context.Background(), remoteSystemID, MetricsIntervalEnumFiveMins)
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsEntityEnumCopyByRemoteSystem, reqBody.Entity)
+	assert.Equal(t, remoteSystemID, reqBody.EntityID)
+	assert.Equal(t, "eq."+remoteSystemID, sessionFilter)
+	assert.Equal(t, 2, resp.ActiveSessions)
+	assert.Len(t, resp.Samples, 2)
+	assert.Equal(t, int64(9000), resp.TotalTransferred)
+	assert.Equal(t, float64(20), resp.MaxTransferRate)
+
+	_, err = C.GetReplicationThroughput(context.Background(), remoteSystemID, "Five_Years")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_PerformanceMetricsByApplianceAndNode(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody MetricsRequest
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			if reqBody.Entity == MetricsEntityEnumPerformanceByNode {
+				return httpmock.NewStringResponse(200, `[{"timestamp": "2020-05-06T10:00:00Z",
+"node_id": "N1", "appliance_id": "A1", "total_iops": 1500, "avg_latency": 400, "current_logins": 4}]`), nil
+			}
+			return httpmock.NewStringResponse(200, `[{"timestamp": "2020-05-06T10:00:00Z",
+"appliance_id": "A1", "total_iops": 3000, "total_bandwidth": 8388608, "io_workload_cpu_utilization": 35.5}]`), nil
+		})
+
+	applianceSamples, err := C.PerformanceMetricsByAppliance(context.Background(), "A1", MetricsIntervalEnumOneHour)
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsEntityEnumPerformanceByAppliance, reqBody.Entity)
+	assert.Equal(t, "A1", reqBody.EntityID)
+	assert.Equal(t, MetricsIntervalEnumOneHour, reqBody.Interval)
+	assert.Len(t, applianceSamples, 1)
+	assert.Equal(t, float64(3000), applianceSamples[0].TotalIops)
+	assert.Equal(t, 35.5, applianceSamples[0].IoWorkloadCPUUtilization)
+
+	nodeSamples, err := C.PerformanceMetricsByNode(context.Background(), "N1", MetricsIntervalEnumFiveMins)
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsEntityEnumPerformanceByNode, reqBody.Entity)
+	assert.Len(t, nodeSamples, 1)
+	assert.Equal(t, "A1", nodeSamples[0].ApplianceID)
+	assert.Equal(t, int64(4), nodeSamples[0].CurrentLogins)
+
+	_, err = C.PerformanceMetricsByNode(context.Background(), "N1", "Ten_Mins")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_SpaceMetricsByCluster(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody MetricsRequest
+	httpmock.RegisterResponder("POST", metricsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, `[{"timestamp": "2020-05-06T00:00:00Z", "cluster_id": "0",
+"physical_total": 10995116277760, "physical_used": 1099511627776, "logical_used": 4398046511104,
+"data_reduction": 3.2, "efficiency_ratio": 8.5}]`), nil
+		})
+	samples, err := C.SpaceMetricsByCluster(context.Background(), "0", MetricsIntervalEnumOneDay)
+	assert.Nil(t, err)
+	assert.Equal(t, MetricsEntityEnumSpaceByCluster, reqBody.Entity)
+	assert.Equal(t, "0", reqBody.EntityID)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, int64(1099511627776), samples[0].PhysicalUsed)
+	assert.Equal(t, 3.2, samples[0].DataReduction)
+}
+
+func Test_latestSamplesStart(t *testing.T) {
+	assert.Equal(t, 0, latestSamplesStart(0))
+	assert.Equal(t, 0, latestSamplesStart(metricsMaxSamples))
+	assert.Equal(t, 5, latestSamplesStart(metricsMaxSamples+5))
+}
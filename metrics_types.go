@@ -0,0 +1,325 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// MetricsIntervalEnum Granularity of metrics samples.
+type MetricsIntervalEnum string
+
+const (
+	// MetricsIntervalEnumTwentySec - samples are collected every twenty seconds
+	MetricsIntervalEnumTwentySec MetricsIntervalEnum = "Twenty_Sec"
+	// MetricsIntervalEnumFiveMins - samples are collected every five minutes
+	MetricsIntervalEnumFiveMins MetricsIntervalEnum = "Five_Mins"
+	// MetricsIntervalEnumOneHour - samples are collected every hour
+	MetricsIntervalEnumOneHour MetricsIntervalEnum = "One_Hour"
+	// MetricsIntervalEnumOneDay - samples are collected every day
+	MetricsIntervalEnumOneDay MetricsIntervalEnum = "One_Day"
+)
+
+// MetricsEntityEnum Type of entity to generate metrics for.
+type MetricsEntityEnum string
+
+const (
+	// MetricsEntityEnumPerformanceByVolume captures enum value "performance_metrics_by_volume"
+	MetricsEntityEnumPerformanceByVolume MetricsEntityEnum = "performance_metrics_by_volume"
+	// MetricsEntityEnumPerformanceByAppliance captures enum value "performance_metrics_by_appliance"
+	MetricsEntityEnumPerformanceByAppliance MetricsEntityEnum = "performance_metrics_by_appliance"
+	// MetricsEntityEnumPerformanceByNode captures enum value "performance_metrics_by_node"
+	MetricsEntityEnumPerformanceByNode MetricsEntityEnum = "performance_metrics_by_node"
+	// MetricsEntityEnumSpaceByVolume captures enum value "space_metrics_by_volume"
+	MetricsEntityEnumSpaceByVolume MetricsEntityEnum = "space_metrics_by_volume"
+	// MetricsEntityEnumSpaceByAppliance captures enum value "space_metrics_by_appliance"
+	MetricsEntityEnumSpaceByAppliance MetricsEntityEnum = "space_metrics_by_appliance"
+	// MetricsEntityEnumSpaceByCluster captures enum value "space_metrics_by_cluster"
+	MetricsEntityEnumSpaceByCluster MetricsEntityEnum = "space_metrics_by_cluster"
+	// MetricsEntityEnumCopyByRemoteSystem captures enum value "copy_metrics_by_remote_system"
+	MetricsEntityEnumCopyByRemoteSystem MetricsEntityEnum = "copy_metrics_by_remote_system"
+	// MetricsEntityEnumWearByDrive captures enum value "wear_metrics_by_drive"
+	MetricsEntityEnumWearByDrive MetricsEntityEnum = "wear_metrics_by_drive"
+)
+
+// MetricsRequest body of metrics/generate request
+type MetricsRequest struct {
+	// Metrics entity type.
+	Entity MetricsEntityEnum `json:"entity"`
+	// Unique identifier of the entity instance.
+	EntityID string `json:"entity_id"`
+	// Requested sampling interval.
+	Interval MetricsIntervalEnum `json:"interval"`
+}
+
+// VolumePerformanceMetric performance sample of the volume, latencies are in microseconds
+// and bandwidth is in bytes per second
+type VolumePerformanceMetric struct {
+	// End time of the sample period.
+	Timestamp string `json:"timestamp"`
+	// Unique identifier of the volume.
+	VolumeID string `json:"volume_id,omitempty"`
+	// Read operations per second.
+	ReadIops float64 `json:"read_iops"`
+	// Write operations per second.
+	WriteIops float64 `json:"write_iops"`
+	// Total operations per second.
+	TotalIops float64 `json:"total_iops"`
+	// Average read latency.
+	ReadLatency float64 `json:"avg_read_latency"`
+	// Average write latency.
+	WriteLatency float64 `json:"avg_write_latency"`
+	// Average latency of all operations.
+	AvgLatency float64 `json:"avg_latency"`
+	// Read rate.
+	ReadBandwidth float64 `json:"read_bandwidth"`
+	// Write rate.
+	WriteBandwidth float64 `json:"write_bandwidth"`
+	// Total rate.
+	TotalBandwidth float64 `json:"total_bandwidth"`
+}
+
+// AppliancePerformanceMetric performance sample of the appliance, latencies are in microseconds
+// and bandwidth is in bytes per second
+type AppliancePerformanceMetric struct {
+	// End time of the sample period.
+	Timestamp string `json:"timestamp"`
+	// Unique identifier of the appliance.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// Read operations per second.
+	ReadIops float64 `json:"read_iops"`
+	// Write operations per second.
+	WriteIops float64 `json:"write_iops"`
+	// Total operations per second.
+	TotalIops float64 `json:"total_iops"`
+	// Average read latency.
+	ReadLatency float64 `json:"avg_read_latency"`
+	// Average write latency.
+	WriteLatency float64 `json:"avg_write_latency"`
+	// Average latency of all operations.
+	AvgLatency float64 `json:"avg_latency"`
+	// Read rate.
+	ReadBandwidth float64 `json:"read_bandwidth"`
+	// Write rate.
+	WriteBandwidth float64 `json:"write_bandwidth"`
+	// Total rate.
+	TotalBandwidth float64 `json:"total_bandwidth"`
+	// CPU utilization of IO workload in percents.
+	IoWorkloadCPUUtilization float64 `json:"io_workload_cpu_utilization"`
+}
+
+// NodePerformanceMetric performance sample of the node, latencies are in microseconds
+// and bandwidth is in bytes per second
+type NodePerformanceMetric struct {
+	// End time of the sample period.
+	Timestamp string `json:"timestamp"`
+	// Unique identifier of the node.
+	NodeID string `json:"node_id,omitempty"`
+	// Unique identifier of the appliance the node belongs to.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// Read operations per second.
+	ReadIops float64 `json:"read_iops"`
+	// Write operations per second.
+	WriteIops float64 `json:"write_iops"`
+	// Total operations per second.
+	TotalIops float64 `json:"total_iops"`
+	// Average read latency.
+	ReadLatency float64 `json:"avg_read_latency"`
+	// Average write latency.
+	WriteLatency float64 `json:"avg_write_latency"`
+	// Average latency of all operations.
+	AvgLatency float64 `json:"avg_latency"`
+	// Read rate.
+	ReadBandwidth float64 `json:"read_bandwidth"`
+	// Write rate.
+	WriteBandwidth float64 `json:"write_bandwidth"`
+	// Total rate.
+	TotalBandwidth float64 `json:"total_bandwidth"`
+	// Number of initiators logged in to the node.
+	CurrentLogins int64 `json:"current_logins"`
+}
+
+// VolumeSpaceMetric space usage sample of the volume, values are in bytes
+type VolumeSpaceMetric struct {
+	// End time of the sample period.
+	Timestamp string `json:"timestamp"`
+	// Unique identifier of the volume.
+	VolumeID string `json:"volume_id,omitempty"`
+	// Size of the volume as seen by hosts.
+	LogicalProvisioned int64 `json:"logical_provisioned"`
+	// Amount of data written by hosts to the volume.
+	LogicalUsed int64 `json:"logical_used"`
+	// Ratio of logical provisioned space to logical used space.
+	ThinSavings float64 `json:"thin_savings"`
+}
+
+// ClusterSpaceMetric space usage sample of the cluster, values are in bytes
+type ClusterSpaceMetric struct {
+	// End time of the sample period.
+	Timestamp string `json:"timestamp"`
+	// Unique identifier of the cluster.
+	ClusterID string `json:"cluster_id,omitempty"`
+	// Total physical space of the cluster.
+	PhysicalTotal int64 `json:"physical_total"`
+	// Used physical space of the cluster.
+	PhysicalUsed int64 `json:"physical_used"`
+	// Size of all storage objects as seen by hosts.
+	LogicalProvisioned int64 `json:"logical_provisioned"`
+	// Amount of data written by hosts to all storage objects.
+	LogicalUsed int64 `json:"logical_used"`
+	// Ratio of logical used space to physical used space of data reduced by compression and deduplication.
+	DataReduction float64 `json:"data_reduction"`
+	// Ratio of logical provisioned space to physical used space.
+	EfficiencyRatio float64 `json:"efficiency_ratio"`
+	// Ratio of space which would be used by snapshots without sharing to the space they use.
+	SnapshotSavings float64 `json:"snapshot_savings"`
+	// Ratio of logical provisioned space to logical used space.
+	ThinSavings float64 `json:"thin_savings"`
+}
+
+// DriveWearMetric endurance sample of the SSD drive
+type DriveWearMetric struct {
+	// End time of the sample period.
+	Timestamp string `json:"timestamp"`
+	// Unique identifier of the drive.
+	DriveID string `json:"drive_id,omitempty"`
+	// Unique identifier of the appliance the drive belongs to.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// Percentage of the drive write endurance which is left.
+	PercentEnduranceRemaining float64 `json:"percent_endurance_remaining"`
+}
+
+// WearPercent returns percentage of the drive write endurance which is used up
+func (m *DriveWearMetric) WearPercent() float64 {
+	return 100 - m.PercentEnduranceRemaining
+}
+
+// latencyMetric holds latency fields which are common for all performance metrics entities
+type latencyMetric struct {
+	Timestamp string `json:"timestamp"`
+	// Average latency of all IO operations in microseconds.
+	AvgLatency float64 `json:"avg_latency"`
+}
+
+// LatencyBucket number of samples which average latency falls into the bucket range
+type LatencyBucket struct {
+	// Inclusive lower bound of the bucket in microseconds.
+	LowerBound float64
+	// Exclusive upper bound of the bucket in microseconds. Zero means the bucket is unbounded.
+	UpperBound float64
+	// Number of samples in the bucket.
+	Count int
+}
+
+// LatencyHistogram distribution of average latency samples collected for an entity.
+// PowerStore metrics report average latency per sample, so histogram is built from
+// the per-sample averages and tail latency is approximated on the sample granularity.
+type LatencyHistogram struct {
+	// Sampling interval of source metrics.
+	Interval MetricsIntervalEnum
+	// Number of samples used to build the histogram.
+	Samples int
+	// Maximum average latency among samples in microseconds.
+	MaxLatency float64
+	// Latency buckets sorted by bounds.
+	Buckets []LatencyBucket
+}
+
+// Percentile returns upper bound of the bucket which holds p-th percentile (0 < p <= 100) of samples.
+// MaxLatency is returned when percentile falls into the unbounded bucket.
+func (h *LatencyHistogram) Percentile(p float64) float64 {
+	if h.Samples == 0 {
+		return 0
+	}
+	threshold := p / 100 * float64(h.Samples)
+	seen := 0
+	for _, b := range h.Buckets {
+		seen += b.Count
+		if float64(seen) >= threshold {
+			if b.UpperBound == 0 {
+				return h.MaxLatency
+			}
+			return b.UpperBound
+		}
+	}
+	return h.MaxLatency
+}
+
+// spaceMetric holds appliance physical space sample
+type spaceMetric struct {
+	Timestamp string `json:"timestamp"`
+	// Total physical space in bytes.
+	PhysicalTotal int64 `json:"physical_total"`
+	// Used physical space in bytes.
+	PhysicalUsed int64 `json:"physical_used"`
+}
+
+// CapacityForecastStateEnum state of capacity forecast
+type CapacityForecastStateEnum string
+
+const (
+	// CapacityForecastStateEnumOK - trend is established and DaysToFull is valid
+	CapacityForecastStateEnumOK CapacityForecastStateEnum = "OK"
+	// CapacityForecastStateEnumNotGrowing - used space is flat or shrinking, appliance is not projected to fill up
+	CapacityForecastStateEnumNotGrowing CapacityForecastStateEnum = "Not_Growing"
+	// CapacityForecastStateEnumInsufficientData - not enough samples to establish the trend
+	CapacityForecastStateEnumInsufficientData CapacityForecastStateEnum = "Insufficient_Data"
+)
+
+// CapacityForecast projected days-to-full of an appliance.
+// PowerStore doesn't expose forecast over REST, so it is computed client-side
+// by a least squares linear regression of daily physical used space samples
+// over the last 30 days.
+type CapacityForecast struct {
+	// Unique identifier of the appliance.
+	ApplianceID string
+	// State of the forecast. DaysToFull and GrowthPerDay are valid only for OK state.
+	State CapacityForecastStateEnum
+	// Number of samples used to compute the trend.
+	Samples int
+	// Total physical space from the latest sample in bytes.
+	PhysicalTotal int64
+	// Used physical space from the latest sample in bytes.
+	PhysicalUsed int64
+	// Growth of used physical space in bytes per day.
+	GrowthPerDay float64
+	// Projected number of days until physical space is exhausted.
+	DaysToFull float64
+}
+
+// ReplicationThroughputSample data transfer to remote system during one sampling interval
+type ReplicationThroughputSample struct {
+	Timestamp string `json:"timestamp"`
+	// Amount of data transferred to remote system in bytes.
+	DataTransferred int64 `json:"data_transferred"`
+	// Transfer rate in bytes per second.
+	TransferRate float64 `json:"transfer_rate"`
+}
+
+// ReplicationThroughput replication traffic of the link to remote system, aggregated across its sessions
+type ReplicationThroughput struct {
+	// Unique identifier of the remote system.
+	RemoteSystemID string
+	// Sampling interval of source metrics.
+	Interval MetricsIntervalEnum
+	// Number of active replication sessions using the link.
+	ActiveSessions int
+	// Samples sorted by time.
+	Samples []ReplicationThroughputSample
+	// Total amount of data transferred over samples in bytes.
+	TotalTransferred int64
+	// Maximum transfer rate among samples in bytes per second.
+	MaxTransferRate float64
+}
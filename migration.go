@@ -0,0 +1,216 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	migrationSessionURL        = "migration_session"
+	migrationRecommendationURL = "migration_recommendation"
+)
+
+func getMigrationSessionDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	session := MigrationSession{}
+	return c.APIClient().QueryParamsWithFields(&session)
+}
+
+func getMigrationRecommendationDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	recommendation := MigrationRecommendation{}
+	return c.APIClient().QueryParamsWithFields(&recommendation)
+}
+
+// MigrateVolume starts moving the volume to another appliance of the cluster, hosts keep access
+// to the volume during migration. Id of the created migration session is returned, use
+// GetMigrationSession to track progress
+func (c *ClientIMPL) MigrateVolume(ctx context.Context, volID, applianceID string) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeURL,
+			ID:       volID,
+			Action:   "migrate",
+			Body:     &VolumeMigrate{ApplianceID: &applianceID}},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetMigrationSession query and return specific migration session by id
+func (c *ClientIMPL) GetMigrationSession(ctx context.Context, id string) (resp MigrationSession, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    migrationSessionURL,
+			ID:          id,
+			QueryParams: getMigrationSessionDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetMigrationSessions returns all migration sessions
+func (c *ClientIMPL) GetMigrationSessions(ctx context.Context) ([]MigrationSession, error) {
+	return c.getMigrationSessions(ctx, "")
+}
+
+// GetMigrationSessionsByResourceID returns migration sessions of the resource, e.g. volume
+func (c *ClientIMPL) GetMigrationSessionsByResourceID(ctx context.Context,
+	resourceID string) ([]MigrationSession, error) {
+	return c.getMigrationSessions(ctx, resourceID)
+}
+
+func (c *ClientIMPL) getMigrationSessions(ctx context.Context, resourceID string) ([]MigrationSession, error) {
+	result := []MigrationSession{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []MigrationSession
+		qp := getMigrationSessionDefaultQueryParams(c)
+		if resourceID != "" {
+			qp.RawArg("resource_id", fmt.Sprintf("eq.%s", resourceID))
+		}
+		qp.Order("created_timestamp,id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    migrationSessionURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CutoverMigrationSession completes migration session in Cutover_Required state.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) CutoverMigrationSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    migrationSessionURL,
+		ID:          id,
+		Action:      "cutover",
+		QueryParams: qp})
+}
+
+// CancelMigrationSession stops migration and deletes the session, the resource stays on the source appliance.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) CancelMigrationSession(ctx context.Context,
+	id string, deleteParams *MigrationSessionDelete) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "DELETE",
+		Endpoint:    migrationSessionURL,
+		ID:          id,
+		QueryParams: qp,
+		Body:        deleteParams})
+}
+
+// GetMigrationRecommendation query and return specific migration recommendation by id
+func (c *ClientIMPL) GetMigrationRecommendation(ctx context.Context,
+	id string) (resp MigrationRecommendation, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    migrationRecommendationURL,
+			ID:          id,
+			QueryParams: getMigrationRecommendationDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetMigrationRecommendations returns all migration recommendations
+func (c *ClientIMPL) GetMigrationRecommendations(ctx context.Context) ([]MigrationRecommendation, error) {
+	result := []MigrationRecommendation{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []MigrationRecommendation
+		qp := getMigrationRecommendationDefaultQueryParams(c)
+		qp.Order("created_timestamp,id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    migrationRecommendationURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GenerateMigrationRecommendation asks the array which resources should be moved from the appliance
+// to balance used capacity of the cluster, returned id identifies the new recommendation
+func (c *ClientIMPL) GenerateMigrationRecommendation(ctx context.Context,
+	generateParams *MigrationRecommendationGenerate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: migrationRecommendationURL,
+			Action:   "generate",
+			Body:     generateParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CreateMigrationSessionsFromRecommendation creates migration session for every resource
+// of the recommendation, sessions don't start until StartMigrationSessionsFromRecommendation is called
+func (c *ClientIMPL) CreateMigrationSessionsFromRecommendation(ctx context.Context,
+	id string) (resp []CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: migrationRecommendationURL,
+			ID:       id,
+			Action:   "create_migration_sessions"},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// StartMigrationSessionsFromRecommendation starts migration sessions created from the recommendation.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) StartMigrationSessionsFromRecommendation(ctx context.Context,
+	id string) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    migrationRecommendationURL,
+		ID:          id,
+		Action:      "start_migration_sessions",
+		QueryParams: qp})
+}
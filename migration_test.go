@@ -0,0 +1,172 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	migrationSessionMockURL        = APIMockURL + migrationSessionURL
+	migrationRecommendationMockURL = APIMockURL + migrationRecommendationURL
+)
+
+var (
+	migrationSessionID        = "b8e5c3d1-2a4f-4b6e-8d7c-9f0a1b2c3d4e"
+	migrationRecommendationID = "6c2d9e1f-3b5a-4c7d-9e8f-0a1b2c3d4e5f"
+)
+
+func TestClientIMPL_MigrateVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/migrate", volumeMockURL, volID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, migrationSessionID)), nil
+		})
+	resp, err := C.MigrateVolume(context.Background(), volID, "A2")
+	assert.Nil(t, err)
+	assert.Equal(t, migrationSessionID, resp.ID)
+	assert.Equal(t, "A2", reqBody["appliance_id"])
+}
+
+func TestClientIMPL_GetMigrationSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "resource_id": "%s", "resource_type": "volume",
+"source_appliance_id": "A1", "destination_appliance_id": "A2", "state": "Synchronizing", "progress_percentage": 40}`,
+		migrationSessionID, volID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", migrationSessionMockURL, migrationSessionID),
+		httpmock.NewStringResponder(200, respData))
+	session, err := C.GetMigrationSession(context.Background(), migrationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, ResourceTypeEnumVolume, session.ResourceType)
+	assert.Equal(t, MigrationSessionStateEnumSynchronizing, session.State)
+	assert.Equal(t, int32(40), session.ProgressPercentage)
+	assert.Equal(t, "A2", session.DestinationApplianceID)
+	assert.False(t, session.IsTerminal())
+}
+
+func TestClientIMPL_GetMigrationSessionsByResourceID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var resourceFilter string
+	httpmock.RegisterResponder("GET", migrationSessionMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			resourceFilter = req.URL.Query().Get("resource_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "state": "Completed"}]`,
+				migrationSessionID)), nil
+		})
+	sessions, err := C.GetMigrationSessionsByResourceID(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 1)
+	assert.True(t, sessions[0].IsTerminal())
+	assert.Equal(t, "eq."+volID, resourceFilter)
+
+	sessions, err = C.GetMigrationSessions(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "", resourceFilter)
+}
+
+func TestClientIMPL_CutoverCancelMigrationSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/cutover", migrationSessionMockURL, migrationSessionID),
+		httpmock.NewStringResponder(202, fmt.Sprintf(`{"id": "%s"}`, jobID)))
+	var reqBody map[string]interface{}
+	var isAsync string
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", migrationSessionMockURL, migrationSessionID),
+		func(req *http.Request) (*http.Response, error) {
+			isAsync = req.URL.Query().Get("is_async")
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+		})
+	resp, err := C.CutoverMigrationSession(context.Background(), migrationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+
+	force := true
+	resp, err = C.CancelMigrationSession(context.Background(), migrationSessionID,
+		&MigrationSessionDelete{Force: &force})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+	assert.Equal(t, "true", isAsync)
+	assert.Equal(t, true, reqBody["force"])
+}
+
+func TestClientIMPL_MigrationRecommendation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", migrationRecommendationMockURL+"/generate",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, migrationRecommendationID)), nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", migrationRecommendationMockURL, migrationRecommendationID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "source_appliance_id": "A1",
+"destination_appliance_id": "A2", "resources": [{"resource_id": "%s", "resource_type": "volume"}]}`,
+			migrationRecommendationID, volID)))
+	httpmock.RegisterResponder("GET", migrationRecommendationMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}]`, migrationRecommendationID)))
+	httpmock.RegisterResponder("POST",
+		fmt.Sprintf("%s/%s/create_migration_sessions", migrationRecommendationMockURL, migrationRecommendationID),
+		httpmock.NewStringResponder(201, fmt.Sprintf(`[{"id": "%s"}]`, migrationSessionID)))
+	httpmock.RegisterResponder("POST",
+		fmt.Sprintf("%s/%s/start_migration_sessions", migrationRecommendationMockURL, migrationRecommendationID),
+		httpmock.NewStringResponder(202, fmt.Sprintf(`{"id": "%s"}`, jobID)))
+
+	ctx := context.Background()
+	applianceID := "A1"
+	created, err := C.GenerateMigrationRecommendation(ctx, &MigrationRecommendationGenerate{ApplianceID: &applianceID})
+	assert.Nil(t, err)
+	assert.Equal(t, migrationRecommendationID, created.ID)
+	assert.Equal(t, "A1", reqBody["appliance_id"])
+
+	recommendation, err := C.GetMigrationRecommendation(ctx, created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "A2", recommendation.DestinationApplianceID)
+	assert.Equal(t, volID, recommendation.Resources[0].ResourceID)
+
+	recommendations, err := C.GetMigrationRecommendations(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, recommendations, 1)
+
+	sessions, err := C.CreateMigrationSessionsFromRecommendation(ctx, created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []CreateResponse{{ID: migrationSessionID}}, sessions)
+
+	job, err := C.StartMigrationSessionsFromRecommendation(ctx, created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, job.ID)
+}
@@ -0,0 +1,137 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// MigrationSessionStateEnum state of the migration session
+type MigrationSessionStateEnum string
+
+const (
+	// MigrationSessionStateEnumInitializing captures enum value "Initializing"
+	MigrationSessionStateEnumInitializing MigrationSessionStateEnum = "Initializing"
+	// MigrationSessionStateEnumSynchronizing captures enum value "Synchronizing"
+	MigrationSessionStateEnumSynchronizing MigrationSessionStateEnum = "Synchronizing"
+	// MigrationSessionStateEnumIdle captures enum value "Idle"
+	MigrationSessionStateEnumIdle MigrationSessionStateEnum = "Idle"
+	// MigrationSessionStateEnumCutoverRequired captures enum value "Cutover_Required"
+	MigrationSessionStateEnumCutoverRequired MigrationSessionStateEnum = "Cutover_Required"
+	// MigrationSessionStateEnumCuttingOver captures enum value "Cutting_Over"
+	MigrationSessionStateEnumCuttingOver MigrationSessionStateEnum = "Cutting_Over"
+	// MigrationSessionStateEnumPaused captures enum value "Paused"
+	MigrationSessionStateEnumPaused MigrationSessionStateEnum = "Paused"
+	// MigrationSessionStateEnumSystemPaused captures enum value "System_Paused"
+	MigrationSessionStateEnumSystemPaused MigrationSessionStateEnum = "System_Paused"
+	// MigrationSessionStateEnumDeleting captures enum value "Deleting"
+	MigrationSessionStateEnumDeleting MigrationSessionStateEnum = "Deleting"
+	// MigrationSessionStateEnumCompleted captures enum value "Completed"
+	MigrationSessionStateEnumCompleted MigrationSessionStateEnum = "Completed"
+	// MigrationSessionStateEnumFailed captures enum value "Failed"
+	MigrationSessionStateEnumFailed MigrationSessionStateEnum = "Failed"
+)
+
+// VolumeMigrate migrate volume request
+type VolumeMigrate struct {
+	// Unique identifier of the appliance the volume is migrated to.
+	ApplianceID *string `json:"appliance_id"`
+}
+
+// MigrationSessionDelete delete migration session request
+type MigrationSessionDelete struct {
+	// Delete the session even if its resources can't be cleaned up.
+	Force *bool `json:"force,omitempty"`
+}
+
+// MigrationSession details about the session moving storage resource between appliances of the cluster
+type MigrationSession struct {
+	// Unique identifier of the migration session.
+	ID string `json:"id,omitempty"`
+	// Name of the migration session.
+	Name string `json:"name,omitempty"`
+	// Description of the migration session.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the migrated resource.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Type of the migrated resource.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Unique identifier of the appliance the resource is migrated from.
+	SourceApplianceID string `json:"source_appliance_id,omitempty"`
+	// Unique identifier of the appliance the resource is migrated to.
+	DestinationApplianceID string `json:"destination_appliance_id,omitempty"`
+	// State of the migration session.
+	State MigrationSessionStateEnum `json:"state,omitempty"`
+	// Progress of the data copy in percents.
+	ProgressPercentage int32 `json:"progress_percentage,omitempty"`
+	// Estimated time the data copy completes at.
+	EstimatedCompletionTimestamp string `json:"estimated_completion_timestamp,omitempty"`
+	// Cutover starts automatically once the data is copied.
+	AutomaticCutover bool `json:"automatic_cutover,omitempty"`
+	// Time the session was created at.
+	CreatedTimestamp string `json:"created_timestamp,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *MigrationSession) Fields() []string {
+	return []string{"id", "name", "description", "resource_id", "resource_type",
+		"source_appliance_id", "destination_appliance_id", "state", "progress_percentage",
+		"estimated_completion_timestamp", "automatic_cutover", "created_timestamp"}
+}
+
+// IsTerminal returns true if migration session is finished and its state won't change
+func (s *MigrationSession) IsTerminal() bool {
+	return s.State == MigrationSessionStateEnumCompleted || s.State == MigrationSessionStateEnumFailed
+}
+
+// MigrationRecommendationGenerate request for generating recommendation of resources
+// to move from the appliance
+type MigrationRecommendationGenerate struct {
+	// Unique identifier of the appliance to move resources from.
+	ApplianceID *string `json:"appliance_id"`
+}
+
+// MigrationRecommendationResource resource recommended to be migrated
+type MigrationRecommendationResource struct {
+	// Unique identifier of the resource.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Type of the resource.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Name of the resource.
+	ResourceName string `json:"resource_name,omitempty"`
+}
+
+// MigrationRecommendation set of resources the array recommends to move between appliances
+// to balance used capacity
+type MigrationRecommendation struct {
+	// Unique identifier of the recommendation.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the appliance resources are moved from.
+	SourceApplianceID string `json:"source_appliance_id,omitempty"`
+	// Unique identifier of the appliance resources are moved to.
+	DestinationApplianceID string `json:"destination_appliance_id,omitempty"`
+	// Resources recommended to be migrated.
+	Resources []MigrationRecommendationResource `json:"resources,omitempty"`
+	// Unique identifiers of migration sessions created from the recommendation.
+	MigrationSessionIDs []string `json:"migration_session_ids,omitempty"`
+	// Time the recommendation was generated at.
+	CreatedTimestamp string `json:"created_timestamp,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (r *MigrationRecommendation) Fields() []string {
+	return []string{"id", "source_appliance_id", "destination_appliance_id", "resources",
+		"migration_session_ids", "created_timestamp"}
+}
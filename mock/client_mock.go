@@ -9,8 +9,10 @@ import (
 	gopowerstore "github.com/dell/gopowerstore"
 	api "github.com/dell/gopowerstore/api"
 	gomock "github.com/golang/mock/gomock"
+	io "io"
 	http "net/http"
 	reflect "reflect"
+	time "time"
 )
 
 // MockClient is a mock of Client interface
@@ -50,6 +52,33 @@ func (mr *MockClientMockRecorder) APIClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIClient", reflect.TypeOf((*MockClient)(nil).APIClient))
 }
 
+// Do mocks base method
+func (m *MockClient) Do(ctx context.Context, cfg gopowerstore.RequestConfig, resp interface{}) (api.RespMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, cfg, resp)
+	ret0, _ := ret[0].(api.RespMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do
+func (mr *MockClientMockRecorder) Do(ctx, cfg, resp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockClient)(nil).Do), ctx, cfg, resp)
+}
+
+// InvalidateCache mocks base method
+func (m *MockClient) InvalidateCache() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateCache")
+}
+
+// InvalidateCache indicates an expected call of InvalidateCache
+func (mr *MockClientMockRecorder) InvalidateCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateCache", reflect.TypeOf((*MockClient)(nil).InvalidateCache))
+}
+
 // SetTraceID mocks base method
 func (m *MockClient) SetTraceID(ctx context.Context, value string) context.Context {
 	m.ctrl.T.Helper()
@@ -76,6 +105,34 @@ func (mr *MockClientMockRecorder) SetCustomHTTPHeaders(headers interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomHTTPHeaders", reflect.TypeOf((*MockClient)(nil).SetCustomHTTPHeaders), headers)
 }
 
+// Config mocks base method
+func (m *MockClient) Config() gopowerstore.ClientConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Config")
+	ret0, _ := ret[0].(gopowerstore.ClientConfig)
+	return ret0
+}
+
+// Config indicates an expected call of Config
+func (mr *MockClientMockRecorder) Config() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockClient)(nil).Config))
+}
+
+// Logout mocks base method
+func (m *MockClient) Logout(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout
+func (mr *MockClientMockRecorder) Logout(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockClient)(nil).Logout), ctx)
+}
+
 // GetVolume mocks base method
 func (m *MockClient) GetVolume(ctx context.Context, id string) (gopowerstore.Volume, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +178,67 @@ func (mr *MockClientMockRecorder) GetVolumes(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumes", reflect.TypeOf((*MockClient)(nil).GetVolumes), ctx)
 }
 
+// GetVolumesFiltered mocks base method
+func (m *MockClient) GetVolumesFiltered(ctx context.Context, filter *gopowerstore.QueryFilter) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumesFiltered", ctx, filter)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumesFiltered indicates an expected call of GetVolumesFiltered
+func (mr *MockClientMockRecorder) GetVolumesFiltered(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesFiltered", reflect.TypeOf((*MockClient)(nil).GetVolumesFiltered), ctx, filter)
+}
+
+// GetSnapshotsFiltered mocks base method
+func (m *MockClient) GetSnapshotsFiltered(ctx context.Context, filter *gopowerstore.QueryFilter) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotsFiltered", ctx, filter)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotsFiltered indicates an expected call of GetSnapshotsFiltered
+func (mr *MockClientMockRecorder) GetSnapshotsFiltered(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotsFiltered", reflect.TypeOf((*MockClient)(nil).GetSnapshotsFiltered), ctx, filter)
+}
+
+// GetVolumesWithPagination mocks base method
+func (m *MockClient) GetVolumesWithPagination(ctx context.Context, offset int, limit int) ([]gopowerstore.Volume, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumesWithPagination", ctx, offset, limit)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesWithPagination indicates an expected call of GetVolumesWithPagination
+func (mr *MockClientMockRecorder) GetVolumesWithPagination(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesWithPagination", reflect.TypeOf((*MockClient)(nil).GetVolumesWithPagination), ctx, offset, limit)
+}
+
+// GetVolumesExceedingLogicalUsed mocks base method
+func (m *MockClient) GetVolumesExceedingLogicalUsed(ctx context.Context, thresholdPercent float64) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumesExceedingLogicalUsed", ctx, thresholdPercent)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumesExceedingLogicalUsed indicates an expected call of GetVolumesExceedingLogicalUsed
+func (mr *MockClientMockRecorder) GetVolumesExceedingLogicalUsed(ctx, thresholdPercent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesExceedingLogicalUsed", reflect.TypeOf((*MockClient)(nil).GetVolumesExceedingLogicalUsed), ctx, thresholdPercent)
+}
+
 // CreateVolume mocks base method
 func (m *MockClient) CreateVolume(ctx context.Context, createParams *gopowerstore.VolumeCreate) (gopowerstore.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +254,21 @@ func (mr *MockClientMockRecorder) CreateVolume(ctx, createParams interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockClient)(nil).CreateVolume), ctx, createParams)
 }
 
+// EnsureVolume mocks base method
+func (m *MockClient) EnsureVolume(ctx context.Context, createParams *gopowerstore.VolumeCreate) (gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureVolume", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureVolume indicates an expected call of EnsureVolume
+func (mr *MockClientMockRecorder) EnsureVolume(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureVolume", reflect.TypeOf((*MockClient)(nil).EnsureVolume), ctx, createParams)
+}
+
 // DeleteVolume mocks base method
 func (m *MockClient) DeleteVolume(ctx context.Context, deleteParams *gopowerstore.VolumeDelete, id string) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +344,21 @@ func (mr *MockClientMockRecorder) CreateHost(ctx, createParams interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHost", reflect.TypeOf((*MockClient)(nil).CreateHost), ctx, createParams)
 }
 
+// EnsureHost mocks base method
+func (m *MockClient) EnsureHost(ctx context.Context, createParams *gopowerstore.HostCreate) (gopowerstore.Host, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureHost", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureHost indicates an expected call of EnsureHost
+func (mr *MockClientMockRecorder) EnsureHost(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureHost", reflect.TypeOf((*MockClient)(nil).EnsureHost), ctx, createParams)
+}
+
 // DeleteHost mocks base method
 func (m *MockClient) DeleteHost(ctx context.Context, deleteParams *gopowerstore.HostDelete, id string) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
@@ -241,254 +389,4643 @@ func (mr *MockClientMockRecorder) ModifyHost(ctx, modifyParams, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyHost", reflect.TypeOf((*MockClient)(nil).ModifyHost), ctx, modifyParams, id)
 }
 
-// GetHostVolumeMappings mocks base method
-func (m *MockClient) GetHostVolumeMappings(ctx context.Context) ([]gopowerstore.HostVolumeMapping, error) {
+// AddHostInitiators mocks base method
+func (m *MockClient) AddHostInitiators(ctx context.Context, hostID string, initiators []gopowerstore.InitiatorCreateModify) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostVolumeMappings", ctx)
-	ret0, _ := ret[0].([]gopowerstore.HostVolumeMapping)
+	ret := m.ctrl.Call(m, "AddHostInitiators", ctx, hostID, initiators)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHostVolumeMappings indicates an expected call of GetHostVolumeMappings
-func (mr *MockClientMockRecorder) GetHostVolumeMappings(ctx interface{}) *gomock.Call {
+// AddHostInitiators indicates an expected call of AddHostInitiators
+func (mr *MockClientMockRecorder) AddHostInitiators(ctx, hostID, initiators interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVolumeMappings", reflect.TypeOf((*MockClient)(nil).GetHostVolumeMappings), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHostInitiators", reflect.TypeOf((*MockClient)(nil).AddHostInitiators), ctx, hostID, initiators)
 }
 
-// GetHostVolumeMapping mocks base method
-func (m *MockClient) GetHostVolumeMapping(ctx context.Context, id string) (gopowerstore.HostVolumeMapping, error) {
+// RemoveHostInitiators mocks base method
+func (m *MockClient) RemoveHostInitiators(ctx context.Context, hostID string, portNames []string) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostVolumeMapping", ctx, id)
-	ret0, _ := ret[0].(gopowerstore.HostVolumeMapping)
+	ret := m.ctrl.Call(m, "RemoveHostInitiators", ctx, hostID, portNames)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHostVolumeMapping indicates an expected call of GetHostVolumeMapping
-func (mr *MockClientMockRecorder) GetHostVolumeMapping(ctx, id interface{}) *gomock.Call {
+// RemoveHostInitiators indicates an expected call of RemoveHostInitiators
+func (mr *MockClientMockRecorder) RemoveHostInitiators(ctx, hostID, portNames interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVolumeMapping", reflect.TypeOf((*MockClient)(nil).GetHostVolumeMapping), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHostInitiators", reflect.TypeOf((*MockClient)(nil).RemoveHostInitiators), ctx, hostID, portNames)
 }
 
-// GetHostVolumeMappingByVolumeID mocks base method
-func (m *MockClient) GetHostVolumeMappingByVolumeID(ctx context.Context, volumeID string) ([]gopowerstore.HostVolumeMapping, error) {
+// GetHostInitiatorPaths mocks base method
+func (m *MockClient) GetHostInitiatorPaths(ctx context.Context, hostID string) ([]gopowerstore.InitiatorPath, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostVolumeMappingByVolumeID", ctx, volumeID)
-	ret0, _ := ret[0].([]gopowerstore.HostVolumeMapping)
+	ret := m.ctrl.Call(m, "GetHostInitiatorPaths", ctx, hostID)
+	ret0, _ := ret[0].([]gopowerstore.InitiatorPath)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHostVolumeMappingByVolumeID indicates an expected call of GetHostVolumeMappingByVolumeID
-func (mr *MockClientMockRecorder) GetHostVolumeMappingByVolumeID(ctx, volumeID interface{}) *gomock.Call {
+// GetHostInitiatorPaths indicates an expected call of GetHostInitiatorPaths
+func (mr *MockClientMockRecorder) GetHostInitiatorPaths(ctx, hostID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVolumeMappingByVolumeID", reflect.TypeOf((*MockClient)(nil).GetHostVolumeMappingByVolumeID), ctx, volumeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInitiatorPaths", reflect.TypeOf((*MockClient)(nil).GetHostInitiatorPaths), ctx, hostID)
 }
 
-// AttachVolumeToHost mocks base method
-func (m *MockClient) AttachVolumeToHost(ctx context.Context, hostID string, attachParams *gopowerstore.HostVolumeAttach) (gopowerstore.EmptyResponse, error) {
+// GetHostConnectivity mocks base method
+func (m *MockClient) GetHostConnectivity(ctx context.Context, hostID string) (gopowerstore.HostConnectivity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AttachVolumeToHost", ctx, hostID, attachParams)
-	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret := m.ctrl.Call(m, "GetHostConnectivity", ctx, hostID)
+	ret0, _ := ret[0].(gopowerstore.HostConnectivity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AttachVolumeToHost indicates an expected call of AttachVolumeToHost
-func (mr *MockClientMockRecorder) AttachVolumeToHost(ctx, hostID, attachParams interface{}) *gomock.Call {
+// GetHostConnectivity indicates an expected call of GetHostConnectivity
+func (mr *MockClientMockRecorder) GetHostConnectivity(ctx, hostID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeToHost", reflect.TypeOf((*MockClient)(nil).AttachVolumeToHost), ctx, hostID, attachParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostConnectivity", reflect.TypeOf((*MockClient)(nil).GetHostConnectivity), ctx, hostID)
 }
 
-// DetachVolumeFromHost mocks base method
-func (m *MockClient) DetachVolumeFromHost(ctx context.Context, hostID string, detachParams *gopowerstore.HostVolumeDetach) (gopowerstore.EmptyResponse, error) {
+// GetTargetPortInitiatorPaths mocks base method
+func (m *MockClient) GetTargetPortInitiatorPaths(ctx context.Context, targetPortName string) ([]gopowerstore.InitiatorPath, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetachVolumeFromHost", ctx, hostID, detachParams)
+	ret := m.ctrl.Call(m, "GetTargetPortInitiatorPaths", ctx, targetPortName)
+	ret0, _ := ret[0].([]gopowerstore.InitiatorPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTargetPortInitiatorPaths indicates an expected call of GetTargetPortInitiatorPaths
+func (mr *MockClientMockRecorder) GetTargetPortInitiatorPaths(ctx, targetPortName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetPortInitiatorPaths", reflect.TypeOf((*MockClient)(nil).GetTargetPortInitiatorPaths), ctx, targetPortName)
+}
+
+// SetHostInitiatorsChap mocks base method
+func (m *MockClient) SetHostInitiatorsChap(ctx context.Context, hostID string, credentials []gopowerstore.UpdateInitiatorInHost) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHostInitiatorsChap", ctx, hostID, credentials)
 	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DetachVolumeFromHost indicates an expected call of DetachVolumeFromHost
-func (mr *MockClientMockRecorder) DetachVolumeFromHost(ctx, hostID, detachParams interface{}) *gomock.Call {
+// SetHostInitiatorsChap indicates an expected call of SetHostInitiatorsChap
+func (mr *MockClientMockRecorder) SetHostInitiatorsChap(ctx, hostID, credentials interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolumeFromHost", reflect.TypeOf((*MockClient)(nil).DetachVolumeFromHost), ctx, hostID, detachParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHostInitiatorsChap", reflect.TypeOf((*MockClient)(nil).SetHostInitiatorsChap), ctx, hostID, credentials)
 }
 
-// GetStorageISCSITargetAddresses mocks base method
-func (m *MockClient) GetStorageISCSITargetAddresses(ctx context.Context) ([]gopowerstore.IPPoolAddress, error) {
+// GetHostGroup mocks base method
+func (m *MockClient) GetHostGroup(ctx context.Context, id string) (gopowerstore.HostGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStorageISCSITargetAddresses", ctx)
-	ret0, _ := ret[0].([]gopowerstore.IPPoolAddress)
+	ret := m.ctrl.Call(m, "GetHostGroup", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.HostGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStorageISCSITargetAddresses indicates an expected call of GetStorageISCSITargetAddresses
-func (mr *MockClientMockRecorder) GetStorageISCSITargetAddresses(ctx interface{}) *gomock.Call {
+// GetHostGroup indicates an expected call of GetHostGroup
+func (mr *MockClientMockRecorder) GetHostGroup(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageISCSITargetAddresses", reflect.TypeOf((*MockClient)(nil).GetStorageISCSITargetAddresses), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostGroup", reflect.TypeOf((*MockClient)(nil).GetHostGroup), ctx, id)
 }
 
-// GetApplianceListCMA mocks base method
-func (m *MockClient) GetApplianceListCMA(ctx context.Context) ([]gopowerstore.Appliance, error) {
+// GetHostGroupByName mocks base method
+func (m *MockClient) GetHostGroupByName(ctx context.Context, name string) (gopowerstore.HostGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetApplianceListCMA", ctx)
-	ret0, _ := ret[0].([]gopowerstore.Appliance)
+	ret := m.ctrl.Call(m, "GetHostGroupByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.HostGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetApplianceListCMA indicates an expected call of GetApplianceListCMA
-func (mr *MockClientMockRecorder) GetApplianceListCMA(ctx interface{}) *gomock.Call {
+// GetHostGroupByName indicates an expected call of GetHostGroupByName
+func (mr *MockClientMockRecorder) GetHostGroupByName(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplianceListCMA", reflect.TypeOf((*MockClient)(nil).GetApplianceListCMA), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostGroupByName", reflect.TypeOf((*MockClient)(nil).GetHostGroupByName), ctx, name)
 }
 
-// GetCapacity mocks base method
-func (m *MockClient) GetCapacity(ctx context.Context) (int64, error) {
+// GetHostGroups mocks base method
+func (m *MockClient) GetHostGroups(ctx context.Context) ([]gopowerstore.HostGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCapacity", ctx)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetHostGroups", ctx)
+	ret0, _ := ret[0].([]gopowerstore.HostGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCapacity indicates an expected call of GetCapacity
-func (mr *MockClientMockRecorder) GetCapacity(ctx interface{}) *gomock.Call {
+// GetHostGroups indicates an expected call of GetHostGroups
+func (mr *MockClientMockRecorder) GetHostGroups(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockClient)(nil).GetCapacity), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostGroups", reflect.TypeOf((*MockClient)(nil).GetHostGroups), ctx)
 }
 
-// GetFCPorts mocks base method
-func (m *MockClient) GetFCPorts(ctx context.Context) ([]gopowerstore.FcPort, error) {
+// CreateHostGroup mocks base method
+func (m *MockClient) CreateHostGroup(ctx context.Context, createParams *gopowerstore.HostGroupCreate) (gopowerstore.CreateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFCPorts", ctx)
-	ret0, _ := ret[0].([]gopowerstore.FcPort)
+	ret := m.ctrl.Call(m, "CreateHostGroup", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFCPorts indicates an expected call of GetFCPorts
-func (mr *MockClientMockRecorder) GetFCPorts(ctx interface{}) *gomock.Call {
+// CreateHostGroup indicates an expected call of CreateHostGroup
+func (mr *MockClientMockRecorder) CreateHostGroup(ctx, createParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFCPorts", reflect.TypeOf((*MockClient)(nil).GetFCPorts), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHostGroup", reflect.TypeOf((*MockClient)(nil).CreateHostGroup), ctx, createParams)
 }
 
-// GetFCPort mocks base method
-func (m *MockClient) GetFCPort(ctx context.Context, id string) (gopowerstore.FcPort, error) {
+// ModifyHostGroup mocks base method
+func (m *MockClient) ModifyHostGroup(ctx context.Context, modifyParams *gopowerstore.HostGroupModify, id string) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFCPort", ctx, id)
-	ret0, _ := ret[0].(gopowerstore.FcPort)
+	ret := m.ctrl.Call(m, "ModifyHostGroup", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFCPort indicates an expected call of GetFCPort
-func (mr *MockClientMockRecorder) GetFCPort(ctx, id interface{}) *gomock.Call {
+// ModifyHostGroup indicates an expected call of ModifyHostGroup
+func (mr *MockClientMockRecorder) ModifyHostGroup(ctx, modifyParams, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFCPort", reflect.TypeOf((*MockClient)(nil).GetFCPort), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyHostGroup", reflect.TypeOf((*MockClient)(nil).ModifyHostGroup), ctx, modifyParams, id)
 }
 
-// SetLogger mocks base method
-func (m *MockClient) SetLogger(logger gopowerstore.Logger) {
+// DeleteHostGroup mocks base method
+func (m *MockClient) DeleteHostGroup(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetLogger", logger)
+	ret := m.ctrl.Call(m, "DeleteHostGroup", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetLogger indicates an expected call of SetLogger
-func (mr *MockClientMockRecorder) SetLogger(logger interface{}) *gomock.Call {
+// DeleteHostGroup indicates an expected call of DeleteHostGroup
+func (mr *MockClientMockRecorder) DeleteHostGroup(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogger", reflect.TypeOf((*MockClient)(nil).SetLogger), logger)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHostGroup", reflect.TypeOf((*MockClient)(nil).DeleteHostGroup), ctx, id)
 }
 
-// CreateSnapshot mocks base method
-func (m *MockClient) CreateSnapshot(ctx context.Context, createSnapParams *gopowerstore.SnapshotCreate, id string) (gopowerstore.CreateResponse, error) {
+// AttachVolumeToHostGroup mocks base method
+func (m *MockClient) AttachVolumeToHostGroup(ctx context.Context, hostGroupID string, attachParams *gopowerstore.HostVolumeAttach) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, createSnapParams, id)
-	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret := m.ctrl.Call(m, "AttachVolumeToHostGroup", ctx, hostGroupID, attachParams)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSnapshot indicates an expected call of CreateSnapshot
-func (mr *MockClientMockRecorder) CreateSnapshot(ctx, createSnapParams, id interface{}) *gomock.Call {
+// AttachVolumeToHostGroup indicates an expected call of AttachVolumeToHostGroup
+func (mr *MockClientMockRecorder) AttachVolumeToHostGroup(ctx, hostGroupID, attachParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockClient)(nil).CreateSnapshot), ctx, createSnapParams, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeToHostGroup", reflect.TypeOf((*MockClient)(nil).AttachVolumeToHostGroup), ctx, hostGroupID, attachParams)
 }
 
-// DeleteSnapshot mocks base method
-func (m *MockClient) DeleteSnapshot(ctx context.Context, deleteParams *gopowerstore.VolumeDelete, id string) (gopowerstore.EmptyResponse, error) {
+// DetachVolumeFromHostGroup mocks base method
+func (m *MockClient) DetachVolumeFromHostGroup(ctx context.Context, hostGroupID string, detachParams *gopowerstore.HostVolumeDetach) (gopowerstore.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, deleteParams, id)
+	ret := m.ctrl.Call(m, "DetachVolumeFromHostGroup", ctx, hostGroupID, detachParams)
 	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSnapshot indicates an expected call of DeleteSnapshot
-func (mr *MockClientMockRecorder) DeleteSnapshot(ctx, deleteParams, id interface{}) *gomock.Call {
+// DetachVolumeFromHostGroup indicates an expected call of DetachVolumeFromHostGroup
+func (mr *MockClientMockRecorder) DetachVolumeFromHostGroup(ctx, hostGroupID, detachParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockClient)(nil).DeleteSnapshot), ctx, deleteParams, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolumeFromHostGroup", reflect.TypeOf((*MockClient)(nil).DetachVolumeFromHostGroup), ctx, hostGroupID, detachParams)
 }
 
-// GetSnapshotsByVolumeID mocks base method
-func (m *MockClient) GetSnapshotsByVolumeID(ctx context.Context, volID string) ([]gopowerstore.Volume, error) {
+// GetHostVolumeMappings mocks base method
+func (m *MockClient) GetHostVolumeMappings(ctx context.Context) ([]gopowerstore.HostVolumeMapping, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSnapshotsByVolumeID", ctx, volID)
-	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret := m.ctrl.Call(m, "GetHostVolumeMappings", ctx)
+	ret0, _ := ret[0].([]gopowerstore.HostVolumeMapping)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSnapshotsByVolumeID indicates an expected call of GetSnapshotsByVolumeID
-func (mr *MockClientMockRecorder) GetSnapshotsByVolumeID(ctx, volID interface{}) *gomock.Call {
+// GetHostVolumeMappings indicates an expected call of GetHostVolumeMappings
+func (mr *MockClientMockRecorder) GetHostVolumeMappings(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotsByVolumeID", reflect.TypeOf((*MockClient)(nil).GetSnapshotsByVolumeID), ctx, volID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVolumeMappings", reflect.TypeOf((*MockClient)(nil).GetHostVolumeMappings), ctx)
 }
 
-// GetSnapshots mocks base method
-func (m *MockClient) GetSnapshots(ctx context.Context) ([]gopowerstore.Volume, error) {
+// GetHostVolumeMapping mocks base method
+func (m *MockClient) GetHostVolumeMapping(ctx context.Context, id string) (gopowerstore.HostVolumeMapping, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSnapshots", ctx)
-	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret := m.ctrl.Call(m, "GetHostVolumeMapping", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.HostVolumeMapping)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSnapshots indicates an expected call of GetSnapshots
-func (mr *MockClientMockRecorder) GetSnapshots(ctx interface{}) *gomock.Call {
+// GetHostVolumeMapping indicates an expected call of GetHostVolumeMapping
+func (mr *MockClientMockRecorder) GetHostVolumeMapping(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshots", reflect.TypeOf((*MockClient)(nil).GetSnapshots), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVolumeMapping", reflect.TypeOf((*MockClient)(nil).GetHostVolumeMapping), ctx, id)
 }
 
-// GetSnapshot mocks base method
-func (m *MockClient) GetSnapshot(ctx context.Context, snapID string) (gopowerstore.Volume, error) {
+// GetHostVolumeMappingByVolumeID mocks base method
+func (m *MockClient) GetHostVolumeMappingByVolumeID(ctx context.Context, volumeID string) ([]gopowerstore.HostVolumeMapping, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSnapshot", ctx, snapID)
-	ret0, _ := ret[0].(gopowerstore.Volume)
+	ret := m.ctrl.Call(m, "GetHostVolumeMappingByVolumeID", ctx, volumeID)
+	ret0, _ := ret[0].([]gopowerstore.HostVolumeMapping)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSnapshot indicates an expected call of GetSnapshot
-func (mr *MockClientMockRecorder) GetSnapshot(ctx, snapID interface{}) *gomock.Call {
+// GetHostVolumeMappingByVolumeID indicates an expected call of GetHostVolumeMappingByVolumeID
+func (mr *MockClientMockRecorder) GetHostVolumeMappingByVolumeID(ctx, volumeID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockClient)(nil).GetSnapshot), ctx, snapID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVolumeMappingByVolumeID", reflect.TypeOf((*MockClient)(nil).GetHostVolumeMappingByVolumeID), ctx, volumeID)
 }
 
-// CreateVolumeFromSnapshot mocks base method
-func (m *MockClient) CreateVolumeFromSnapshot(ctx context.Context, createParams *gopowerstore.VolumeClone, snapID string) (gopowerstore.CreateResponse, error) {
+// GetVolumeMappingHistory mocks base method
+func (m *MockClient) GetVolumeMappingHistory(ctx context.Context, volID string) ([]gopowerstore.VolumeMappingEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVolumeFromSnapshot", ctx, createParams, snapID)
-	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret := m.ctrl.Call(m, "GetVolumeMappingHistory", ctx, volID)
+	ret0, _ := ret[0].([]gopowerstore.VolumeMappingEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVolumeFromSnapshot indicates an expected call of CreateVolumeFromSnapshot
-func (mr *MockClientMockRecorder) CreateVolumeFromSnapshot(ctx, createParams, snapID interface{}) *gomock.Call {
+// GetVolumeMappingHistory indicates an expected call of GetVolumeMappingHistory
+func (mr *MockClientMockRecorder) GetVolumeMappingHistory(ctx, volID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeFromSnapshot", reflect.TypeOf((*MockClient)(nil).CreateVolumeFromSnapshot), ctx, createParams, snapID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeMappingHistory", reflect.TypeOf((*MockClient)(nil).GetVolumeMappingHistory), ctx, volID)
+}
+
+// GetEventsSince mocks base method
+func (m *MockClient) GetEventsSince(ctx context.Context, cursor string) ([]gopowerstore.Event, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsSince", ctx, cursor)
+	ret0, _ := ret[0].([]gopowerstore.Event)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventsSince indicates an expected call of GetEventsSince
+func (mr *MockClientMockRecorder) GetEventsSince(ctx, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsSince", reflect.TypeOf((*MockClient)(nil).GetEventsSince), ctx, cursor)
+}
+
+// GetEvents mocks base method
+func (m *MockClient) GetEvents(ctx context.Context, query *gopowerstore.EventQuery) ([]gopowerstore.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, query)
+	ret0, _ := ret[0].([]gopowerstore.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents
+func (mr *MockClientMockRecorder) GetEvents(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockClient)(nil).GetEvents), ctx, query)
+}
+
+// GetAlerts mocks base method
+func (m *MockClient) GetAlerts(ctx context.Context, query *gopowerstore.AlertQuery) ([]gopowerstore.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlerts", ctx, query)
+	ret0, _ := ret[0].([]gopowerstore.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlerts indicates an expected call of GetAlerts
+func (mr *MockClientMockRecorder) GetAlerts(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlerts", reflect.TypeOf((*MockClient)(nil).GetAlerts), ctx, query)
+}
+
+// GetAlert mocks base method
+func (m *MockClient) GetAlert(ctx context.Context, id string) (gopowerstore.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlert", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlert indicates an expected call of GetAlert
+func (mr *MockClientMockRecorder) GetAlert(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlert", reflect.TypeOf((*MockClient)(nil).GetAlert), ctx, id)
+}
+
+// AcknowledgeAlert mocks base method
+func (m *MockClient) AcknowledgeAlert(ctx context.Context, alertID string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeAlert", ctx, alertID)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeAlert indicates an expected call of AcknowledgeAlert
+func (mr *MockClientMockRecorder) AcknowledgeAlert(ctx, alertID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlert", reflect.TypeOf((*MockClient)(nil).AcknowledgeAlert), ctx, alertID)
+}
+
+// ClearAlert mocks base method
+func (m *MockClient) ClearAlert(ctx context.Context, alertID string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearAlert", ctx, alertID)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearAlert indicates an expected call of ClearAlert
+func (mr *MockClientMockRecorder) ClearAlert(ctx, alertID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearAlert", reflect.TypeOf((*MockClient)(nil).ClearAlert), ctx, alertID)
+}
+
+// GetEffectiveHostAccess mocks base method
+func (m *MockClient) GetEffectiveHostAccess(ctx context.Context, volID string) ([]gopowerstore.HostAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveHostAccess", ctx, volID)
+	ret0, _ := ret[0].([]gopowerstore.HostAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveHostAccess indicates an expected call of GetEffectiveHostAccess
+func (mr *MockClientMockRecorder) GetEffectiveHostAccess(ctx, volID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveHostAccess", reflect.TypeOf((*MockClient)(nil).GetEffectiveHostAccess), ctx, volID)
+}
+
+// AttachVolumeToHost mocks base method
+func (m *MockClient) AttachVolumeToHost(ctx context.Context, hostID string, attachParams *gopowerstore.HostVolumeAttach) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolumeToHost", ctx, hostID, attachParams)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVolumeToHost indicates an expected call of AttachVolumeToHost
+func (mr *MockClientMockRecorder) AttachVolumeToHost(ctx, hostID, attachParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeToHost", reflect.TypeOf((*MockClient)(nil).AttachVolumeToHost), ctx, hostID, attachParams)
+}
+
+// DetachVolumeFromHost mocks base method
+func (m *MockClient) DetachVolumeFromHost(ctx context.Context, hostID string, detachParams *gopowerstore.HostVolumeDetach) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolumeFromHost", ctx, hostID, detachParams)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachVolumeFromHost indicates an expected call of DetachVolumeFromHost
+func (mr *MockClientMockRecorder) DetachVolumeFromHost(ctx, hostID, detachParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolumeFromHost", reflect.TypeOf((*MockClient)(nil).DetachVolumeFromHost), ctx, hostID, detachParams)
+}
+
+// GetStorageISCSITargetAddresses mocks base method
+func (m *MockClient) GetStorageISCSITargetAddresses(ctx context.Context) ([]gopowerstore.IPPoolAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageISCSITargetAddresses", ctx)
+	ret0, _ := ret[0].([]gopowerstore.IPPoolAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageISCSITargetAddresses indicates an expected call of GetStorageISCSITargetAddresses
+func (mr *MockClientMockRecorder) GetStorageISCSITargetAddresses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageISCSITargetAddresses", reflect.TypeOf((*MockClient)(nil).GetStorageISCSITargetAddresses), ctx)
+}
+
+// GetISCSITargetIQNs mocks base method
+func (m *MockClient) GetISCSITargetIQNs(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetISCSITargetIQNs", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetISCSITargetIQNs indicates an expected call of GetISCSITargetIQNs
+func (mr *MockClientMockRecorder) GetISCSITargetIQNs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetISCSITargetIQNs", reflect.TypeOf((*MockClient)(nil).GetISCSITargetIQNs), ctx)
+}
+
+// GetStorageNVMeTCPTargetAddresses mocks base method
+func (m *MockClient) GetStorageNVMeTCPTargetAddresses(ctx context.Context) ([]gopowerstore.IPPoolAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageNVMeTCPTargetAddresses", ctx)
+	ret0, _ := ret[0].([]gopowerstore.IPPoolAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageNVMeTCPTargetAddresses indicates an expected call of GetStorageNVMeTCPTargetAddresses
+func (mr *MockClientMockRecorder) GetStorageNVMeTCPTargetAddresses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageNVMeTCPTargetAddresses", reflect.TypeOf((*MockClient)(nil).GetStorageNVMeTCPTargetAddresses), ctx)
+}
+
+// GetIPPoolAddresses mocks base method
+func (m *MockClient) GetIPPoolAddresses(ctx context.Context, purpose gopowerstore.IPPurposeTypeEnum) ([]gopowerstore.IPPoolAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPPoolAddresses", ctx, purpose)
+	ret0, _ := ret[0].([]gopowerstore.IPPoolAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPPoolAddresses indicates an expected call of GetIPPoolAddresses
+func (mr *MockClientMockRecorder) GetIPPoolAddresses(ctx, purpose interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPPoolAddresses", reflect.TypeOf((*MockClient)(nil).GetIPPoolAddresses), ctx, purpose)
+}
+
+// GetManagementIPs mocks base method
+func (m *MockClient) GetManagementIPs(ctx context.Context) (gopowerstore.ManagementIPs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagementIPs", ctx)
+	ret0, _ := ret[0].(gopowerstore.ManagementIPs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagementIPs indicates an expected call of GetManagementIPs
+func (mr *MockClientMockRecorder) GetManagementIPs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementIPs", reflect.TypeOf((*MockClient)(nil).GetManagementIPs), ctx)
+}
+
+// GetNVMeSubsystem mocks base method
+func (m *MockClient) GetNVMeSubsystem(ctx context.Context) ([]gopowerstore.NVMeSubsystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNVMeSubsystem", ctx)
+	ret0, _ := ret[0].([]gopowerstore.NVMeSubsystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNVMeSubsystem indicates an expected call of GetNVMeSubsystem
+func (mr *MockClientMockRecorder) GetNVMeSubsystem(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNVMeSubsystem", reflect.TypeOf((*MockClient)(nil).GetNVMeSubsystem), ctx)
+}
+
+// GetNVMeNamespaces mocks base method
+func (m *MockClient) GetNVMeNamespaces(ctx context.Context, filter api.QueryParamsEncoder) ([]gopowerstore.NVMeNamespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNVMeNamespaces", ctx, filter)
+	ret0, _ := ret[0].([]gopowerstore.NVMeNamespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNVMeNamespaces indicates an expected call of GetNVMeNamespaces
+func (mr *MockClientMockRecorder) GetNVMeNamespaces(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNVMeNamespaces", reflect.TypeOf((*MockClient)(nil).GetNVMeNamespaces), ctx, filter)
+}
+
+// GetStorageContainer mocks base method
+func (m *MockClient) GetStorageContainer(ctx context.Context, id string) (gopowerstore.StorageContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageContainer", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.StorageContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageContainer indicates an expected call of GetStorageContainer
+func (mr *MockClientMockRecorder) GetStorageContainer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageContainer", reflect.TypeOf((*MockClient)(nil).GetStorageContainer), ctx, id)
+}
+
+// GetStorageContainerByName mocks base method
+func (m *MockClient) GetStorageContainerByName(ctx context.Context, name string) (gopowerstore.StorageContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageContainerByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.StorageContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageContainerByName indicates an expected call of GetStorageContainerByName
+func (mr *MockClientMockRecorder) GetStorageContainerByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageContainerByName", reflect.TypeOf((*MockClient)(nil).GetStorageContainerByName), ctx, name)
+}
+
+// GetStorageContainers mocks base method
+func (m *MockClient) GetStorageContainers(ctx context.Context) ([]gopowerstore.StorageContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageContainers", ctx)
+	ret0, _ := ret[0].([]gopowerstore.StorageContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageContainers indicates an expected call of GetStorageContainers
+func (mr *MockClientMockRecorder) GetStorageContainers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageContainers", reflect.TypeOf((*MockClient)(nil).GetStorageContainers), ctx)
+}
+
+// CreateStorageContainer mocks base method
+func (m *MockClient) CreateStorageContainer(ctx context.Context, createParams *gopowerstore.StorageContainerCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageContainer", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageContainer indicates an expected call of CreateStorageContainer
+func (mr *MockClientMockRecorder) CreateStorageContainer(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageContainer", reflect.TypeOf((*MockClient)(nil).CreateStorageContainer), ctx, createParams)
+}
+
+// ModifyStorageContainer mocks base method
+func (m *MockClient) ModifyStorageContainer(ctx context.Context, modifyParams *gopowerstore.StorageContainerModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyStorageContainer", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyStorageContainer indicates an expected call of ModifyStorageContainer
+func (mr *MockClientMockRecorder) ModifyStorageContainer(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyStorageContainer", reflect.TypeOf((*MockClient)(nil).ModifyStorageContainer), ctx, modifyParams, id)
+}
+
+// DeleteStorageContainer mocks base method
+func (m *MockClient) DeleteStorageContainer(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageContainer", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStorageContainer indicates an expected call of DeleteStorageContainer
+func (mr *MockClientMockRecorder) DeleteStorageContainer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageContainer", reflect.TypeOf((*MockClient)(nil).DeleteStorageContainer), ctx, id)
+}
+
+// GetVirtualVolume mocks base method
+func (m *MockClient) GetVirtualVolume(ctx context.Context, id string) (gopowerstore.VirtualVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualVolume", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.VirtualVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualVolume indicates an expected call of GetVirtualVolume
+func (mr *MockClientMockRecorder) GetVirtualVolume(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualVolume", reflect.TypeOf((*MockClient)(nil).GetVirtualVolume), ctx, id)
+}
+
+// GetVirtualVolumeByName mocks base method
+func (m *MockClient) GetVirtualVolumeByName(ctx context.Context, name string) (gopowerstore.VirtualVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualVolumeByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.VirtualVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualVolumeByName indicates an expected call of GetVirtualVolumeByName
+func (mr *MockClientMockRecorder) GetVirtualVolumeByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualVolumeByName", reflect.TypeOf((*MockClient)(nil).GetVirtualVolumeByName), ctx, name)
+}
+
+// GetVirtualVolumes mocks base method
+func (m *MockClient) GetVirtualVolumes(ctx context.Context) ([]gopowerstore.VirtualVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualVolumes", ctx)
+	ret0, _ := ret[0].([]gopowerstore.VirtualVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualVolumes indicates an expected call of GetVirtualVolumes
+func (mr *MockClientMockRecorder) GetVirtualVolumes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualVolumes", reflect.TypeOf((*MockClient)(nil).GetVirtualVolumes), ctx)
+}
+
+// GetVirtualVolumeSnapshots mocks base method
+func (m *MockClient) GetVirtualVolumeSnapshots(ctx context.Context, vvolID string) ([]gopowerstore.VirtualVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualVolumeSnapshots", ctx, vvolID)
+	ret0, _ := ret[0].([]gopowerstore.VirtualVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualVolumeSnapshots indicates an expected call of GetVirtualVolumeSnapshots
+func (mr *MockClientMockRecorder) GetVirtualVolumeSnapshots(ctx, vvolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualVolumeSnapshots", reflect.TypeOf((*MockClient)(nil).GetVirtualVolumeSnapshots), ctx, vvolID)
+}
+
+// GetVirtualVolumesByVirtualMachineUUID mocks base method
+func (m *MockClient) GetVirtualVolumesByVirtualMachineUUID(ctx context.Context, instanceUUID string) ([]gopowerstore.VirtualVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualVolumesByVirtualMachineUUID", ctx, instanceUUID)
+	ret0, _ := ret[0].([]gopowerstore.VirtualVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualVolumesByVirtualMachineUUID indicates an expected call of GetVirtualVolumesByVirtualMachineUUID
+func (mr *MockClientMockRecorder) GetVirtualVolumesByVirtualMachineUUID(ctx, instanceUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualVolumesByVirtualMachineUUID", reflect.TypeOf((*MockClient)(nil).GetVirtualVolumesByVirtualMachineUUID), ctx, instanceUUID)
+}
+
+// GetHostVirtualVolumeMappings mocks base method
+func (m *MockClient) GetHostVirtualVolumeMappings(ctx context.Context) ([]gopowerstore.HostVirtualVolumeMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostVirtualVolumeMappings", ctx)
+	ret0, _ := ret[0].([]gopowerstore.HostVirtualVolumeMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostVirtualVolumeMappings indicates an expected call of GetHostVirtualVolumeMappings
+func (mr *MockClientMockRecorder) GetHostVirtualVolumeMappings(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVirtualVolumeMappings", reflect.TypeOf((*MockClient)(nil).GetHostVirtualVolumeMappings), ctx)
+}
+
+// GetHostVirtualVolumeMappingsByHostID mocks base method
+func (m *MockClient) GetHostVirtualVolumeMappingsByHostID(ctx context.Context, hostID string) ([]gopowerstore.HostVirtualVolumeMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostVirtualVolumeMappingsByHostID", ctx, hostID)
+	ret0, _ := ret[0].([]gopowerstore.HostVirtualVolumeMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostVirtualVolumeMappingsByHostID indicates an expected call of GetHostVirtualVolumeMappingsByHostID
+func (mr *MockClientMockRecorder) GetHostVirtualVolumeMappingsByHostID(ctx, hostID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVirtualVolumeMappingsByHostID", reflect.TypeOf((*MockClient)(nil).GetHostVirtualVolumeMappingsByHostID), ctx, hostID)
+}
+
+// GetHostVirtualVolumeMappingsByVirtualVolumeID mocks base method
+func (m *MockClient) GetHostVirtualVolumeMappingsByVirtualVolumeID(ctx context.Context, vvolID string) ([]gopowerstore.HostVirtualVolumeMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostVirtualVolumeMappingsByVirtualVolumeID", ctx, vvolID)
+	ret0, _ := ret[0].([]gopowerstore.HostVirtualVolumeMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostVirtualVolumeMappingsByVirtualVolumeID indicates an expected call of GetHostVirtualVolumeMappingsByVirtualVolumeID
+func (mr *MockClientMockRecorder) GetHostVirtualVolumeMappingsByVirtualVolumeID(ctx, vvolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostVirtualVolumeMappingsByVirtualVolumeID", reflect.TypeOf((*MockClient)(nil).GetHostVirtualVolumeMappingsByVirtualVolumeID), ctx, vvolID)
+}
+
+// GetVirtualMachine mocks base method
+func (m *MockClient) GetVirtualMachine(ctx context.Context, id string) (gopowerstore.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachine", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachine indicates an expected call of GetVirtualMachine
+func (mr *MockClientMockRecorder) GetVirtualMachine(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachine", reflect.TypeOf((*MockClient)(nil).GetVirtualMachine), ctx, id)
+}
+
+// GetVirtualMachineByInstanceUUID mocks base method
+func (m *MockClient) GetVirtualMachineByInstanceUUID(ctx context.Context, instanceUUID string) (gopowerstore.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineByInstanceUUID", ctx, instanceUUID)
+	ret0, _ := ret[0].(gopowerstore.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineByInstanceUUID indicates an expected call of GetVirtualMachineByInstanceUUID
+func (mr *MockClientMockRecorder) GetVirtualMachineByInstanceUUID(ctx, instanceUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByInstanceUUID", reflect.TypeOf((*MockClient)(nil).GetVirtualMachineByInstanceUUID), ctx, instanceUUID)
+}
+
+// GetVirtualMachines mocks base method
+func (m *MockClient) GetVirtualMachines(ctx context.Context) ([]gopowerstore.VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachines", ctx)
+	ret0, _ := ret[0].([]gopowerstore.VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachines indicates an expected call of GetVirtualMachines
+func (mr *MockClientMockRecorder) GetVirtualMachines(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachines", reflect.TypeOf((*MockClient)(nil).GetVirtualMachines), ctx)
+}
+
+// GetRemoteSystem mocks base method
+func (m *MockClient) GetRemoteSystem(ctx context.Context, id string) (gopowerstore.RemoteSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteSystem", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.RemoteSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteSystem indicates an expected call of GetRemoteSystem
+func (mr *MockClientMockRecorder) GetRemoteSystem(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteSystem", reflect.TypeOf((*MockClient)(nil).GetRemoteSystem), ctx, id)
+}
+
+// GetRemoteSystems mocks base method
+func (m *MockClient) GetRemoteSystems(ctx context.Context) ([]gopowerstore.RemoteSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteSystems", ctx)
+	ret0, _ := ret[0].([]gopowerstore.RemoteSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteSystems indicates an expected call of GetRemoteSystems
+func (mr *MockClientMockRecorder) GetRemoteSystems(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteSystems", reflect.TypeOf((*MockClient)(nil).GetRemoteSystems), ctx)
+}
+
+// GetRemoteSystemByName mocks base method
+func (m *MockClient) GetRemoteSystemByName(ctx context.Context, name string) (gopowerstore.RemoteSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteSystemByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.RemoteSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteSystemByName indicates an expected call of GetRemoteSystemByName
+func (mr *MockClientMockRecorder) GetRemoteSystemByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteSystemByName", reflect.TypeOf((*MockClient)(nil).GetRemoteSystemByName), ctx, name)
+}
+
+// GetRemoteSystemByMgmtAddress mocks base method
+func (m *MockClient) GetRemoteSystemByMgmtAddress(ctx context.Context, address string) (gopowerstore.RemoteSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteSystemByMgmtAddress", ctx, address)
+	ret0, _ := ret[0].(gopowerstore.RemoteSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteSystemByMgmtAddress indicates an expected call of GetRemoteSystemByMgmtAddress
+func (mr *MockClientMockRecorder) GetRemoteSystemByMgmtAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteSystemByMgmtAddress", reflect.TypeOf((*MockClient)(nil).GetRemoteSystemByMgmtAddress), ctx, address)
+}
+
+// CreateRemoteSystem mocks base method
+func (m *MockClient) CreateRemoteSystem(ctx context.Context, createParams *gopowerstore.RemoteSystemCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRemoteSystem", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRemoteSystem indicates an expected call of CreateRemoteSystem
+func (mr *MockClientMockRecorder) CreateRemoteSystem(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemoteSystem", reflect.TypeOf((*MockClient)(nil).CreateRemoteSystem), ctx, createParams)
+}
+
+// ModifyRemoteSystem mocks base method
+func (m *MockClient) ModifyRemoteSystem(ctx context.Context, modifyParams *gopowerstore.RemoteSystemModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyRemoteSystem", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyRemoteSystem indicates an expected call of ModifyRemoteSystem
+func (mr *MockClientMockRecorder) ModifyRemoteSystem(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyRemoteSystem", reflect.TypeOf((*MockClient)(nil).ModifyRemoteSystem), ctx, modifyParams, id)
+}
+
+// DeleteRemoteSystem mocks base method
+func (m *MockClient) DeleteRemoteSystem(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemoteSystem", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemoteSystem indicates an expected call of DeleteRemoteSystem
+func (mr *MockClientMockRecorder) DeleteRemoteSystem(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemoteSystem", reflect.TypeOf((*MockClient)(nil).DeleteRemoteSystem), ctx, id)
+}
+
+// VerifyRemoteSystem mocks base method
+func (m *MockClient) VerifyRemoteSystem(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyRemoteSystem", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRemoteSystem indicates an expected call of VerifyRemoteSystem
+func (mr *MockClientMockRecorder) VerifyRemoteSystem(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRemoteSystem", reflect.TypeOf((*MockClient)(nil).VerifyRemoteSystem), ctx, id)
+}
+
+// GetImportSession mocks base method
+func (m *MockClient) GetImportSession(ctx context.Context, id string) (gopowerstore.ImportSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.ImportSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportSession indicates an expected call of GetImportSession
+func (mr *MockClientMockRecorder) GetImportSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportSession", reflect.TypeOf((*MockClient)(nil).GetImportSession), ctx, id)
+}
+
+// GetImportSessions mocks base method
+func (m *MockClient) GetImportSessions(ctx context.Context) ([]gopowerstore.ImportSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportSessions", ctx)
+	ret0, _ := ret[0].([]gopowerstore.ImportSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportSessions indicates an expected call of GetImportSessions
+func (mr *MockClientMockRecorder) GetImportSessions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportSessions", reflect.TypeOf((*MockClient)(nil).GetImportSessions), ctx)
+}
+
+// GetImportSessionsByRemoteSystemID mocks base method
+func (m *MockClient) GetImportSessionsByRemoteSystemID(ctx context.Context, remoteSystemID string) ([]gopowerstore.ImportSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportSessionsByRemoteSystemID", ctx, remoteSystemID)
+	ret0, _ := ret[0].([]gopowerstore.ImportSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportSessionsByRemoteSystemID indicates an expected call of GetImportSessionsByRemoteSystemID
+func (mr *MockClientMockRecorder) GetImportSessionsByRemoteSystemID(ctx, remoteSystemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportSessionsByRemoteSystemID", reflect.TypeOf((*MockClient)(nil).GetImportSessionsByRemoteSystemID), ctx, remoteSystemID)
+}
+
+// CreateImportSession mocks base method
+func (m *MockClient) CreateImportSession(ctx context.Context, createParams *gopowerstore.ImportSessionCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportSession", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImportSession indicates an expected call of CreateImportSession
+func (mr *MockClientMockRecorder) CreateImportSession(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportSession", reflect.TypeOf((*MockClient)(nil).CreateImportSession), ctx, createParams)
+}
+
+// ModifyImportSession mocks base method
+func (m *MockClient) ModifyImportSession(ctx context.Context, modifyParams *gopowerstore.ImportSessionModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyImportSession", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyImportSession indicates an expected call of ModifyImportSession
+func (mr *MockClientMockRecorder) ModifyImportSession(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyImportSession", reflect.TypeOf((*MockClient)(nil).ModifyImportSession), ctx, modifyParams, id)
+}
+
+// DeleteImportSession mocks base method
+func (m *MockClient) DeleteImportSession(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImportSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImportSession indicates an expected call of DeleteImportSession
+func (mr *MockClientMockRecorder) DeleteImportSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImportSession", reflect.TypeOf((*MockClient)(nil).DeleteImportSession), ctx, id)
+}
+
+// CutoverImportSession mocks base method
+func (m *MockClient) CutoverImportSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CutoverImportSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CutoverImportSession indicates an expected call of CutoverImportSession
+func (mr *MockClientMockRecorder) CutoverImportSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CutoverImportSession", reflect.TypeOf((*MockClient)(nil).CutoverImportSession), ctx, id)
+}
+
+// CancelImportSession mocks base method
+func (m *MockClient) CancelImportSession(ctx context.Context, id string, cancelParams *gopowerstore.ImportSessionCancel) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelImportSession", ctx, id, cancelParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelImportSession indicates an expected call of CancelImportSession
+func (mr *MockClientMockRecorder) CancelImportSession(ctx, id, cancelParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelImportSession", reflect.TypeOf((*MockClient)(nil).CancelImportSession), ctx, id, cancelParams)
+}
+
+// PauseImportSession mocks base method
+func (m *MockClient) PauseImportSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseImportSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseImportSession indicates an expected call of PauseImportSession
+func (mr *MockClientMockRecorder) PauseImportSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseImportSession", reflect.TypeOf((*MockClient)(nil).PauseImportSession), ctx, id)
+}
+
+// ResumeImportSession mocks base method
+func (m *MockClient) ResumeImportSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeImportSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeImportSession indicates an expected call of ResumeImportSession
+func (mr *MockClientMockRecorder) ResumeImportSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeImportSession", reflect.TypeOf((*MockClient)(nil).ResumeImportSession), ctx, id)
+}
+
+// CleanupImportSession mocks base method
+func (m *MockClient) CleanupImportSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupImportSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupImportSession indicates an expected call of CleanupImportSession
+func (mr *MockClientMockRecorder) CleanupImportSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupImportSession", reflect.TypeOf((*MockClient)(nil).CleanupImportSession), ctx, id)
+}
+
+// MigrateVolume mocks base method
+func (m *MockClient) MigrateVolume(ctx context.Context, volID string, applianceID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVolume", ctx, volID, applianceID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVolume indicates an expected call of MigrateVolume
+func (mr *MockClientMockRecorder) MigrateVolume(ctx, volID, applianceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolume", reflect.TypeOf((*MockClient)(nil).MigrateVolume), ctx, volID, applianceID)
+}
+
+// GetMigrationSession mocks base method
+func (m *MockClient) GetMigrationSession(ctx context.Context, id string) (gopowerstore.MigrationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.MigrationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationSession indicates an expected call of GetMigrationSession
+func (mr *MockClientMockRecorder) GetMigrationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationSession", reflect.TypeOf((*MockClient)(nil).GetMigrationSession), ctx, id)
+}
+
+// GetMigrationSessions mocks base method
+func (m *MockClient) GetMigrationSessions(ctx context.Context) ([]gopowerstore.MigrationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationSessions", ctx)
+	ret0, _ := ret[0].([]gopowerstore.MigrationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationSessions indicates an expected call of GetMigrationSessions
+func (mr *MockClientMockRecorder) GetMigrationSessions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationSessions", reflect.TypeOf((*MockClient)(nil).GetMigrationSessions), ctx)
+}
+
+// GetMigrationSessionsByResourceID mocks base method
+func (m *MockClient) GetMigrationSessionsByResourceID(ctx context.Context, resourceID string) ([]gopowerstore.MigrationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationSessionsByResourceID", ctx, resourceID)
+	ret0, _ := ret[0].([]gopowerstore.MigrationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationSessionsByResourceID indicates an expected call of GetMigrationSessionsByResourceID
+func (mr *MockClientMockRecorder) GetMigrationSessionsByResourceID(ctx, resourceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationSessionsByResourceID", reflect.TypeOf((*MockClient)(nil).GetMigrationSessionsByResourceID), ctx, resourceID)
+}
+
+// CutoverMigrationSession mocks base method
+func (m *MockClient) CutoverMigrationSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CutoverMigrationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CutoverMigrationSession indicates an expected call of CutoverMigrationSession
+func (mr *MockClientMockRecorder) CutoverMigrationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CutoverMigrationSession", reflect.TypeOf((*MockClient)(nil).CutoverMigrationSession), ctx, id)
+}
+
+// CancelMigrationSession mocks base method
+func (m *MockClient) CancelMigrationSession(ctx context.Context, id string, deleteParams *gopowerstore.MigrationSessionDelete) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMigrationSession", ctx, id, deleteParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMigrationSession indicates an expected call of CancelMigrationSession
+func (mr *MockClientMockRecorder) CancelMigrationSession(ctx, id, deleteParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMigrationSession", reflect.TypeOf((*MockClient)(nil).CancelMigrationSession), ctx, id, deleteParams)
+}
+
+// GetMigrationRecommendation mocks base method
+func (m *MockClient) GetMigrationRecommendation(ctx context.Context, id string) (gopowerstore.MigrationRecommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationRecommendation", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.MigrationRecommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationRecommendation indicates an expected call of GetMigrationRecommendation
+func (mr *MockClientMockRecorder) GetMigrationRecommendation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationRecommendation", reflect.TypeOf((*MockClient)(nil).GetMigrationRecommendation), ctx, id)
+}
+
+// GetMigrationRecommendations mocks base method
+func (m *MockClient) GetMigrationRecommendations(ctx context.Context) ([]gopowerstore.MigrationRecommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationRecommendations", ctx)
+	ret0, _ := ret[0].([]gopowerstore.MigrationRecommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationRecommendations indicates an expected call of GetMigrationRecommendations
+func (mr *MockClientMockRecorder) GetMigrationRecommendations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationRecommendations", reflect.TypeOf((*MockClient)(nil).GetMigrationRecommendations), ctx)
+}
+
+// GenerateMigrationRecommendation mocks base method
+func (m *MockClient) GenerateMigrationRecommendation(ctx context.Context, generateParams *gopowerstore.MigrationRecommendationGenerate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateMigrationRecommendation", ctx, generateParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateMigrationRecommendation indicates an expected call of GenerateMigrationRecommendation
+func (mr *MockClientMockRecorder) GenerateMigrationRecommendation(ctx, generateParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateMigrationRecommendation", reflect.TypeOf((*MockClient)(nil).GenerateMigrationRecommendation), ctx, generateParams)
+}
+
+// CreateMigrationSessionsFromRecommendation mocks base method
+func (m *MockClient) CreateMigrationSessionsFromRecommendation(ctx context.Context, id string) ([]gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMigrationSessionsFromRecommendation", ctx, id)
+	ret0, _ := ret[0].([]gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMigrationSessionsFromRecommendation indicates an expected call of CreateMigrationSessionsFromRecommendation
+func (mr *MockClientMockRecorder) CreateMigrationSessionsFromRecommendation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMigrationSessionsFromRecommendation", reflect.TypeOf((*MockClient)(nil).CreateMigrationSessionsFromRecommendation), ctx, id)
+}
+
+// StartMigrationSessionsFromRecommendation mocks base method
+func (m *MockClient) StartMigrationSessionsFromRecommendation(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMigrationSessionsFromRecommendation", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMigrationSessionsFromRecommendation indicates an expected call of StartMigrationSessionsFromRecommendation
+func (mr *MockClientMockRecorder) StartMigrationSessionsFromRecommendation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMigrationSessionsFromRecommendation", reflect.TypeOf((*MockClient)(nil).StartMigrationSessionsFromRecommendation), ctx, id)
+}
+
+// GetReplicationSession mocks base method
+func (m *MockClient) GetReplicationSession(ctx context.Context, id string) (gopowerstore.ReplicationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.ReplicationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationSession indicates an expected call of GetReplicationSession
+func (mr *MockClientMockRecorder) GetReplicationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationSession", reflect.TypeOf((*MockClient)(nil).GetReplicationSession), ctx, id)
+}
+
+// GetReplicationSessionByLocalResourceID mocks base method
+func (m *MockClient) GetReplicationSessionByLocalResourceID(ctx context.Context, resourceID string) (gopowerstore.ReplicationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationSessionByLocalResourceID", ctx, resourceID)
+	ret0, _ := ret[0].(gopowerstore.ReplicationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationSessionByLocalResourceID indicates an expected call of GetReplicationSessionByLocalResourceID
+func (mr *MockClientMockRecorder) GetReplicationSessionByLocalResourceID(ctx, resourceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationSessionByLocalResourceID", reflect.TypeOf((*MockClient)(nil).GetReplicationSessionByLocalResourceID), ctx, resourceID)
+}
+
+// FailoverReplicationSession mocks base method
+func (m *MockClient) FailoverReplicationSession(ctx context.Context, id string, failoverParams *gopowerstore.ReplicationSessionFailover) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailoverReplicationSession", ctx, id, failoverParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailoverReplicationSession indicates an expected call of FailoverReplicationSession
+func (mr *MockClientMockRecorder) FailoverReplicationSession(ctx, id, failoverParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverReplicationSession", reflect.TypeOf((*MockClient)(nil).FailoverReplicationSession), ctx, id, failoverParams)
+}
+
+// ReprotectReplicationSession mocks base method
+func (m *MockClient) ReprotectReplicationSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReprotectReplicationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReprotectReplicationSession indicates an expected call of ReprotectReplicationSession
+func (mr *MockClientMockRecorder) ReprotectReplicationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprotectReplicationSession", reflect.TypeOf((*MockClient)(nil).ReprotectReplicationSession), ctx, id)
+}
+
+// ResumeReplicationSession mocks base method
+func (m *MockClient) ResumeReplicationSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeReplicationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeReplicationSession indicates an expected call of ResumeReplicationSession
+func (mr *MockClientMockRecorder) ResumeReplicationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeReplicationSession", reflect.TypeOf((*MockClient)(nil).ResumeReplicationSession), ctx, id)
+}
+
+// PauseReplicationSession mocks base method
+func (m *MockClient) PauseReplicationSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseReplicationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseReplicationSession indicates an expected call of PauseReplicationSession
+func (mr *MockClientMockRecorder) PauseReplicationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseReplicationSession", reflect.TypeOf((*MockClient)(nil).PauseReplicationSession), ctx, id)
+}
+
+// SyncReplicationSession mocks base method
+func (m *MockClient) SyncReplicationSession(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncReplicationSession", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncReplicationSession indicates an expected call of SyncReplicationSession
+func (mr *MockClientMockRecorder) SyncReplicationSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncReplicationSession", reflect.TypeOf((*MockClient)(nil).SyncReplicationSession), ctx, id)
+}
+
+// GetVolumeGroupReplicationSession mocks base method
+func (m *MockClient) GetVolumeGroupReplicationSession(ctx context.Context, groupID string) (gopowerstore.ReplicationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroupReplicationSession", ctx, groupID)
+	ret0, _ := ret[0].(gopowerstore.ReplicationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroupReplicationSession indicates an expected call of GetVolumeGroupReplicationSession
+func (mr *MockClientMockRecorder) GetVolumeGroupReplicationSession(ctx, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroupReplicationSession", reflect.TypeOf((*MockClient)(nil).GetVolumeGroupReplicationSession), ctx, groupID)
+}
+
+// FailoverVolumeGroup mocks base method
+func (m *MockClient) FailoverVolumeGroup(ctx context.Context, groupID string, failoverParams *gopowerstore.ReplicationSessionFailover) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailoverVolumeGroup", ctx, groupID, failoverParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailoverVolumeGroup indicates an expected call of FailoverVolumeGroup
+func (mr *MockClientMockRecorder) FailoverVolumeGroup(ctx, groupID, failoverParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverVolumeGroup", reflect.TypeOf((*MockClient)(nil).FailoverVolumeGroup), ctx, groupID, failoverParams)
+}
+
+// ReprotectVolumeGroup mocks base method
+func (m *MockClient) ReprotectVolumeGroup(ctx context.Context, groupID string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReprotectVolumeGroup", ctx, groupID)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReprotectVolumeGroup indicates an expected call of ReprotectVolumeGroup
+func (mr *MockClientMockRecorder) ReprotectVolumeGroup(ctx, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprotectVolumeGroup", reflect.TypeOf((*MockClient)(nil).ReprotectVolumeGroup), ctx, groupID)
+}
+
+// StartReplicationSessionFailoverTest mocks base method
+func (m *MockClient) StartReplicationSessionFailoverTest(ctx context.Context, id string, testParams *gopowerstore.ReplicationSessionFailoverTest) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReplicationSessionFailoverTest", ctx, id, testParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReplicationSessionFailoverTest indicates an expected call of StartReplicationSessionFailoverTest
+func (mr *MockClientMockRecorder) StartReplicationSessionFailoverTest(ctx, id, testParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReplicationSessionFailoverTest", reflect.TypeOf((*MockClient)(nil).StartReplicationSessionFailoverTest), ctx, id, testParams)
+}
+
+// StopReplicationSessionFailoverTest mocks base method
+func (m *MockClient) StopReplicationSessionFailoverTest(ctx context.Context, id string) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopReplicationSessionFailoverTest", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopReplicationSessionFailoverTest indicates an expected call of StopReplicationSessionFailoverTest
+func (mr *MockClientMockRecorder) StopReplicationSessionFailoverTest(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopReplicationSessionFailoverTest", reflect.TypeOf((*MockClient)(nil).StopReplicationSessionFailoverTest), ctx, id)
+}
+
+// RunReplicationSessionFailoverTest mocks base method
+func (m *MockClient) RunReplicationSessionFailoverTest(ctx context.Context, id string, testParams *gopowerstore.ReplicationSessionFailoverTest, test func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunReplicationSessionFailoverTest", ctx, id, testParams, test)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunReplicationSessionFailoverTest indicates an expected call of RunReplicationSessionFailoverTest
+func (mr *MockClientMockRecorder) RunReplicationSessionFailoverTest(ctx, id, testParams, test interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReplicationSessionFailoverTest", reflect.TypeOf((*MockClient)(nil).RunReplicationSessionFailoverTest), ctx, id, testParams, test)
+}
+
+// GetNASServers mocks base method
+func (m *MockClient) GetNASServers(ctx context.Context) ([]gopowerstore.NAS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNASServers", ctx)
+	ret0, _ := ret[0].([]gopowerstore.NAS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNASServers indicates an expected call of GetNASServers
+func (mr *MockClientMockRecorder) GetNASServers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNASServers", reflect.TypeOf((*MockClient)(nil).GetNASServers), ctx)
+}
+
+// GetNAS mocks base method
+func (m *MockClient) GetNAS(ctx context.Context, id string) (gopowerstore.NAS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNAS", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.NAS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNAS indicates an expected call of GetNAS
+func (mr *MockClientMockRecorder) GetNAS(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNAS", reflect.TypeOf((*MockClient)(nil).GetNAS), ctx, id)
+}
+
+// GetNASByName mocks base method
+func (m *MockClient) GetNASByName(ctx context.Context, name string) (gopowerstore.NAS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNASByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.NAS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNASByName indicates an expected call of GetNASByName
+func (mr *MockClientMockRecorder) GetNASByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNASByName", reflect.TypeOf((*MockClient)(nil).GetNASByName), ctx, name)
+}
+
+// GetFS mocks base method
+func (m *MockClient) GetFS(ctx context.Context, id string) (gopowerstore.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFS", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFS indicates an expected call of GetFS
+func (mr *MockClientMockRecorder) GetFS(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFS", reflect.TypeOf((*MockClient)(nil).GetFS), ctx, id)
+}
+
+// GetFSByName mocks base method
+func (m *MockClient) GetFSByName(ctx context.Context, name string) (gopowerstore.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFSByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFSByName indicates an expected call of GetFSByName
+func (mr *MockClientMockRecorder) GetFSByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFSByName", reflect.TypeOf((*MockClient)(nil).GetFSByName), ctx, name)
+}
+
+// CreateFS mocks base method
+func (m *MockClient) CreateFS(ctx context.Context, createParams *gopowerstore.FsCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFS", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFS indicates an expected call of CreateFS
+func (mr *MockClientMockRecorder) CreateFS(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFS", reflect.TypeOf((*MockClient)(nil).CreateFS), ctx, createParams)
+}
+
+// ModifyFS mocks base method
+func (m *MockClient) ModifyFS(ctx context.Context, modifyParams *gopowerstore.FsModify, id string) (gopowerstore.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyFS", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyFS indicates an expected call of ModifyFS
+func (mr *MockClientMockRecorder) ModifyFS(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyFS", reflect.TypeOf((*MockClient)(nil).ModifyFS), ctx, modifyParams, id)
+}
+
+// DeleteFS mocks base method
+func (m *MockClient) DeleteFS(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFS", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFS indicates an expected call of DeleteFS
+func (mr *MockClientMockRecorder) DeleteFS(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFS", reflect.TypeOf((*MockClient)(nil).DeleteFS), ctx, id)
+}
+
+// CloneFS mocks base method
+func (m *MockClient) CloneFS(ctx context.Context, cloneParams *gopowerstore.FsClone, sourceFsID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneFS", ctx, cloneParams, sourceFsID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneFS indicates an expected call of CloneFS
+func (mr *MockClientMockRecorder) CloneFS(ctx, cloneParams, sourceFsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneFS", reflect.TypeOf((*MockClient)(nil).CloneFS), ctx, cloneParams, sourceFsID)
+}
+
+// CreateFsSnapshot mocks base method
+func (m *MockClient) CreateFsSnapshot(ctx context.Context, createParams *gopowerstore.FsSnapshotCreate, fsID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFsSnapshot", ctx, createParams, fsID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFsSnapshot indicates an expected call of CreateFsSnapshot
+func (mr *MockClientMockRecorder) CreateFsSnapshot(ctx, createParams, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFsSnapshot", reflect.TypeOf((*MockClient)(nil).CreateFsSnapshot), ctx, createParams, fsID)
+}
+
+// CreateFsProtocolSnapshot mocks base method
+func (m *MockClient) CreateFsProtocolSnapshot(ctx context.Context, createParams *gopowerstore.FsSnapshotCreate, fsID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFsProtocolSnapshot", ctx, createParams, fsID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFsProtocolSnapshot indicates an expected call of CreateFsProtocolSnapshot
+func (mr *MockClientMockRecorder) CreateFsProtocolSnapshot(ctx, createParams, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFsProtocolSnapshot", reflect.TypeOf((*MockClient)(nil).CreateFsProtocolSnapshot), ctx, createParams, fsID)
+}
+
+// GetFsSnapshotAccessPaths mocks base method
+func (m *MockClient) GetFsSnapshotAccessPaths(ctx context.Context, snapID string) ([]gopowerstore.FsSnapshotAccessPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFsSnapshotAccessPaths", ctx, snapID)
+	ret0, _ := ret[0].([]gopowerstore.FsSnapshotAccessPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFsSnapshotAccessPaths indicates an expected call of GetFsSnapshotAccessPaths
+func (mr *MockClientMockRecorder) GetFsSnapshotAccessPaths(ctx, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFsSnapshotAccessPaths", reflect.TypeOf((*MockClient)(nil).GetFsSnapshotAccessPaths), ctx, snapID)
+}
+
+// GetFsSnapshots mocks base method
+func (m *MockClient) GetFsSnapshots(ctx context.Context) ([]gopowerstore.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFsSnapshots", ctx)
+	ret0, _ := ret[0].([]gopowerstore.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFsSnapshots indicates an expected call of GetFsSnapshots
+func (mr *MockClientMockRecorder) GetFsSnapshots(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFsSnapshots", reflect.TypeOf((*MockClient)(nil).GetFsSnapshots), ctx)
+}
+
+// CreateFsFromSnapshot mocks base method
+func (m *MockClient) CreateFsFromSnapshot(ctx context.Context, createParams *gopowerstore.FsClone, snapID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFsFromSnapshot", ctx, createParams, snapID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFsFromSnapshot indicates an expected call of CreateFsFromSnapshot
+func (mr *MockClientMockRecorder) CreateFsFromSnapshot(ctx, createParams, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFsFromSnapshot", reflect.TypeOf((*MockClient)(nil).CreateFsFromSnapshot), ctx, createParams, snapID)
+}
+
+// GetFsSnapshotsByFSID mocks base method
+func (m *MockClient) GetFsSnapshotsByFSID(ctx context.Context, fsID string) ([]gopowerstore.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFsSnapshotsByFSID", ctx, fsID)
+	ret0, _ := ret[0].([]gopowerstore.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFsSnapshotsByFSID indicates an expected call of GetFsSnapshotsByFSID
+func (mr *MockClientMockRecorder) GetFsSnapshotsByFSID(ctx, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFsSnapshotsByFSID", reflect.TypeOf((*MockClient)(nil).GetFsSnapshotsByFSID), ctx, fsID)
+}
+
+// DeleteFsSnapshot mocks base method
+func (m *MockClient) DeleteFsSnapshot(ctx context.Context, snapID string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFsSnapshot", ctx, snapID)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFsSnapshot indicates an expected call of DeleteFsSnapshot
+func (mr *MockClientMockRecorder) DeleteFsSnapshot(ctx, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFsSnapshot", reflect.TypeOf((*MockClient)(nil).DeleteFsSnapshot), ctx, snapID)
+}
+
+// RestoreFsSnapshot mocks base method
+func (m *MockClient) RestoreFsSnapshot(ctx context.Context, snapID string, restoreParams *gopowerstore.FsSnapshotRestore) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFsSnapshot", ctx, snapID, restoreParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreFsSnapshot indicates an expected call of RestoreFsSnapshot
+func (mr *MockClientMockRecorder) RestoreFsSnapshot(ctx, snapID, restoreParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFsSnapshot", reflect.TypeOf((*MockClient)(nil).RestoreFsSnapshot), ctx, snapID, restoreParams)
+}
+
+// GetTreeQuota mocks base method
+func (m *MockClient) GetTreeQuota(ctx context.Context, id string) (gopowerstore.TreeQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreeQuota", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.TreeQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreeQuota indicates an expected call of GetTreeQuota
+func (mr *MockClientMockRecorder) GetTreeQuota(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreeQuota", reflect.TypeOf((*MockClient)(nil).GetTreeQuota), ctx, id)
+}
+
+// GetTreeQuotasByFSID mocks base method
+func (m *MockClient) GetTreeQuotasByFSID(ctx context.Context, fsID string) ([]gopowerstore.TreeQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreeQuotasByFSID", ctx, fsID)
+	ret0, _ := ret[0].([]gopowerstore.TreeQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreeQuotasByFSID indicates an expected call of GetTreeQuotasByFSID
+func (mr *MockClientMockRecorder) GetTreeQuotasByFSID(ctx, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreeQuotasByFSID", reflect.TypeOf((*MockClient)(nil).GetTreeQuotasByFSID), ctx, fsID)
+}
+
+// CreateTreeQuota mocks base method
+func (m *MockClient) CreateTreeQuota(ctx context.Context, createParams *gopowerstore.TreeQuotaCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTreeQuota", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTreeQuota indicates an expected call of CreateTreeQuota
+func (mr *MockClientMockRecorder) CreateTreeQuota(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTreeQuota", reflect.TypeOf((*MockClient)(nil).CreateTreeQuota), ctx, createParams)
+}
+
+// ModifyTreeQuota mocks base method
+func (m *MockClient) ModifyTreeQuota(ctx context.Context, modifyParams *gopowerstore.TreeQuotaModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyTreeQuota", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyTreeQuota indicates an expected call of ModifyTreeQuota
+func (mr *MockClientMockRecorder) ModifyTreeQuota(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyTreeQuota", reflect.TypeOf((*MockClient)(nil).ModifyTreeQuota), ctx, modifyParams, id)
+}
+
+// DeleteTreeQuota mocks base method
+func (m *MockClient) DeleteTreeQuota(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTreeQuota", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTreeQuota indicates an expected call of DeleteTreeQuota
+func (mr *MockClientMockRecorder) DeleteTreeQuota(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTreeQuota", reflect.TypeOf((*MockClient)(nil).DeleteTreeQuota), ctx, id)
+}
+
+// GetUserQuota mocks base method
+func (m *MockClient) GetUserQuota(ctx context.Context, id string) (gopowerstore.UserQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserQuota", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.UserQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserQuota indicates an expected call of GetUserQuota
+func (mr *MockClientMockRecorder) GetUserQuota(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserQuota", reflect.TypeOf((*MockClient)(nil).GetUserQuota), ctx, id)
+}
+
+// GetUserQuotasByFSID mocks base method
+func (m *MockClient) GetUserQuotasByFSID(ctx context.Context, fsID string) ([]gopowerstore.UserQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserQuotasByFSID", ctx, fsID)
+	ret0, _ := ret[0].([]gopowerstore.UserQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserQuotasByFSID indicates an expected call of GetUserQuotasByFSID
+func (mr *MockClientMockRecorder) GetUserQuotasByFSID(ctx, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserQuotasByFSID", reflect.TypeOf((*MockClient)(nil).GetUserQuotasByFSID), ctx, fsID)
+}
+
+// CreateUserQuota mocks base method
+func (m *MockClient) CreateUserQuota(ctx context.Context, createParams *gopowerstore.UserQuotaCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserQuota", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserQuota indicates an expected call of CreateUserQuota
+func (mr *MockClientMockRecorder) CreateUserQuota(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserQuota", reflect.TypeOf((*MockClient)(nil).CreateUserQuota), ctx, createParams)
+}
+
+// ModifyUserQuota mocks base method
+func (m *MockClient) ModifyUserQuota(ctx context.Context, modifyParams *gopowerstore.UserQuotaModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyUserQuota", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyUserQuota indicates an expected call of ModifyUserQuota
+func (mr *MockClientMockRecorder) ModifyUserQuota(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyUserQuota", reflect.TypeOf((*MockClient)(nil).ModifyUserQuota), ctx, modifyParams, id)
+}
+
+// GetNFSExport mocks base method
+func (m *MockClient) GetNFSExport(ctx context.Context, id string) (gopowerstore.NFSExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNFSExport", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.NFSExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNFSExport indicates an expected call of GetNFSExport
+func (mr *MockClientMockRecorder) GetNFSExport(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNFSExport", reflect.TypeOf((*MockClient)(nil).GetNFSExport), ctx, id)
+}
+
+// GetNFSExportByName mocks base method
+func (m *MockClient) GetNFSExportByName(ctx context.Context, name string) (gopowerstore.NFSExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNFSExportByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.NFSExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNFSExportByName indicates an expected call of GetNFSExportByName
+func (mr *MockClientMockRecorder) GetNFSExportByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNFSExportByName", reflect.TypeOf((*MockClient)(nil).GetNFSExportByName), ctx, name)
+}
+
+// GetNFSExportByFileSystemID mocks base method
+func (m *MockClient) GetNFSExportByFileSystemID(ctx context.Context, fsID string) (gopowerstore.NFSExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNFSExportByFileSystemID", ctx, fsID)
+	ret0, _ := ret[0].(gopowerstore.NFSExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNFSExportByFileSystemID indicates an expected call of GetNFSExportByFileSystemID
+func (mr *MockClientMockRecorder) GetNFSExportByFileSystemID(ctx, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNFSExportByFileSystemID", reflect.TypeOf((*MockClient)(nil).GetNFSExportByFileSystemID), ctx, fsID)
+}
+
+// GetNFSExportsByFileSystemID mocks base method
+func (m *MockClient) GetNFSExportsByFileSystemID(ctx context.Context, fsID string) ([]gopowerstore.NFSExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNFSExportsByFileSystemID", ctx, fsID)
+	ret0, _ := ret[0].([]gopowerstore.NFSExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNFSExportsByFileSystemID indicates an expected call of GetNFSExportsByFileSystemID
+func (mr *MockClientMockRecorder) GetNFSExportsByFileSystemID(ctx, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNFSExportsByFileSystemID", reflect.TypeOf((*MockClient)(nil).GetNFSExportsByFileSystemID), ctx, fsID)
+}
+
+// CreateNFSExport mocks base method
+func (m *MockClient) CreateNFSExport(ctx context.Context, createParams *gopowerstore.NFSExportCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNFSExport", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNFSExport indicates an expected call of CreateNFSExport
+func (mr *MockClientMockRecorder) CreateNFSExport(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNFSExport", reflect.TypeOf((*MockClient)(nil).CreateNFSExport), ctx, createParams)
+}
+
+// CreateNFSExportOnFsSnapshot mocks base method
+func (m *MockClient) CreateNFSExportOnFsSnapshot(ctx context.Context, createParams *gopowerstore.NFSExportCreate, snapID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNFSExportOnFsSnapshot", ctx, createParams, snapID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNFSExportOnFsSnapshot indicates an expected call of CreateNFSExportOnFsSnapshot
+func (mr *MockClientMockRecorder) CreateNFSExportOnFsSnapshot(ctx, createParams, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNFSExportOnFsSnapshot", reflect.TypeOf((*MockClient)(nil).CreateNFSExportOnFsSnapshot), ctx, createParams, snapID)
+}
+
+// EnsureNFSExport mocks base method
+func (m *MockClient) EnsureNFSExport(ctx context.Context, createParams *gopowerstore.NFSExportCreate) (gopowerstore.NFSExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureNFSExport", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.NFSExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureNFSExport indicates an expected call of EnsureNFSExport
+func (mr *MockClientMockRecorder) EnsureNFSExport(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureNFSExport", reflect.TypeOf((*MockClient)(nil).EnsureNFSExport), ctx, createParams)
+}
+
+// ModifyNFSExport mocks base method
+func (m *MockClient) ModifyNFSExport(ctx context.Context, modifyParams *gopowerstore.NFSExportModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNFSExport", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyNFSExport indicates an expected call of ModifyNFSExport
+func (mr *MockClientMockRecorder) ModifyNFSExport(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNFSExport", reflect.TypeOf((*MockClient)(nil).ModifyNFSExport), ctx, modifyParams, id)
+}
+
+// DeleteNFSExport mocks base method
+func (m *MockClient) DeleteNFSExport(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNFSExport", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNFSExport indicates an expected call of DeleteNFSExport
+func (mr *MockClientMockRecorder) DeleteNFSExport(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNFSExport", reflect.TypeOf((*MockClient)(nil).DeleteNFSExport), ctx, id)
+}
+
+// GetSMBShare mocks base method
+func (m *MockClient) GetSMBShare(ctx context.Context, id string) (gopowerstore.SMBShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMBShare", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.SMBShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMBShare indicates an expected call of GetSMBShare
+func (mr *MockClientMockRecorder) GetSMBShare(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMBShare", reflect.TypeOf((*MockClient)(nil).GetSMBShare), ctx, id)
+}
+
+// GetSMBShareByName mocks base method
+func (m *MockClient) GetSMBShareByName(ctx context.Context, name string) (gopowerstore.SMBShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMBShareByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.SMBShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMBShareByName indicates an expected call of GetSMBShareByName
+func (mr *MockClientMockRecorder) GetSMBShareByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMBShareByName", reflect.TypeOf((*MockClient)(nil).GetSMBShareByName), ctx, name)
+}
+
+// GetSMBSharesByFileSystemID mocks base method
+func (m *MockClient) GetSMBSharesByFileSystemID(ctx context.Context, fsID string) ([]gopowerstore.SMBShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMBSharesByFileSystemID", ctx, fsID)
+	ret0, _ := ret[0].([]gopowerstore.SMBShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMBSharesByFileSystemID indicates an expected call of GetSMBSharesByFileSystemID
+func (mr *MockClientMockRecorder) GetSMBSharesByFileSystemID(ctx, fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMBSharesByFileSystemID", reflect.TypeOf((*MockClient)(nil).GetSMBSharesByFileSystemID), ctx, fsID)
+}
+
+// CreateSMBShare mocks base method
+func (m *MockClient) CreateSMBShare(ctx context.Context, createParams *gopowerstore.SMBShareCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSMBShare", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSMBShare indicates an expected call of CreateSMBShare
+func (mr *MockClientMockRecorder) CreateSMBShare(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSMBShare", reflect.TypeOf((*MockClient)(nil).CreateSMBShare), ctx, createParams)
+}
+
+// ModifySMBShare mocks base method
+func (m *MockClient) ModifySMBShare(ctx context.Context, modifyParams *gopowerstore.SMBShareModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySMBShare", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySMBShare indicates an expected call of ModifySMBShare
+func (mr *MockClientMockRecorder) ModifySMBShare(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySMBShare", reflect.TypeOf((*MockClient)(nil).ModifySMBShare), ctx, modifyParams, id)
+}
+
+// DeleteSMBShare mocks base method
+func (m *MockClient) DeleteSMBShare(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSMBShare", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSMBShare indicates an expected call of DeleteSMBShare
+func (mr *MockClientMockRecorder) DeleteSMBShare(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSMBShare", reflect.TypeOf((*MockClient)(nil).DeleteSMBShare), ctx, id)
+}
+
+// GetLDAPConfig mocks base method
+func (m *MockClient) GetLDAPConfig(ctx context.Context, nasServerID string) (gopowerstore.FileLDAP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLDAPConfig", ctx, nasServerID)
+	ret0, _ := ret[0].(gopowerstore.FileLDAP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLDAPConfig indicates an expected call of GetLDAPConfig
+func (mr *MockClientMockRecorder) GetLDAPConfig(ctx, nasServerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLDAPConfig", reflect.TypeOf((*MockClient)(nil).GetLDAPConfig), ctx, nasServerID)
+}
+
+// CreateLDAPConfig mocks base method
+func (m *MockClient) CreateLDAPConfig(ctx context.Context, createParams *gopowerstore.FileLDAPCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLDAPConfig", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLDAPConfig indicates an expected call of CreateLDAPConfig
+func (mr *MockClientMockRecorder) CreateLDAPConfig(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLDAPConfig", reflect.TypeOf((*MockClient)(nil).CreateLDAPConfig), ctx, createParams)
+}
+
+// ModifyLDAPConfig mocks base method
+func (m *MockClient) ModifyLDAPConfig(ctx context.Context, modifyParams *gopowerstore.FileLDAPModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyLDAPConfig", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyLDAPConfig indicates an expected call of ModifyLDAPConfig
+func (mr *MockClientMockRecorder) ModifyLDAPConfig(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyLDAPConfig", reflect.TypeOf((*MockClient)(nil).ModifyLDAPConfig), ctx, modifyParams, id)
+}
+
+// VerifyLDAPConfig mocks base method
+func (m *MockClient) VerifyLDAPConfig(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLDAPConfig", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLDAPConfig indicates an expected call of VerifyLDAPConfig
+func (mr *MockClientMockRecorder) VerifyLDAPConfig(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLDAPConfig", reflect.TypeOf((*MockClient)(nil).VerifyLDAPConfig), ctx, id)
+}
+
+// GetADConfig mocks base method
+func (m *MockClient) GetADConfig(ctx context.Context, nasServerID string) (gopowerstore.SMBServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetADConfig", ctx, nasServerID)
+	ret0, _ := ret[0].(gopowerstore.SMBServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetADConfig indicates an expected call of GetADConfig
+func (mr *MockClientMockRecorder) GetADConfig(ctx, nasServerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetADConfig", reflect.TypeOf((*MockClient)(nil).GetADConfig), ctx, nasServerID)
+}
+
+// CreateADConfig mocks base method
+func (m *MockClient) CreateADConfig(ctx context.Context, createParams *gopowerstore.SMBServerCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateADConfig", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateADConfig indicates an expected call of CreateADConfig
+func (mr *MockClientMockRecorder) CreateADConfig(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateADConfig", reflect.TypeOf((*MockClient)(nil).CreateADConfig), ctx, createParams)
+}
+
+// ModifyADConfig mocks base method
+func (m *MockClient) ModifyADConfig(ctx context.Context, modifyParams *gopowerstore.SMBServerModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyADConfig", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyADConfig indicates an expected call of ModifyADConfig
+func (mr *MockClientMockRecorder) ModifyADConfig(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyADConfig", reflect.TypeOf((*MockClient)(nil).ModifyADConfig), ctx, modifyParams, id)
+}
+
+// JoinADDomain mocks base method
+func (m *MockClient) JoinADDomain(ctx context.Context, joinParams *gopowerstore.SMBServerJoin, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinADDomain", ctx, joinParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinADDomain indicates an expected call of JoinADDomain
+func (mr *MockClientMockRecorder) JoinADDomain(ctx, joinParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinADDomain", reflect.TypeOf((*MockClient)(nil).JoinADDomain), ctx, joinParams, id)
+}
+
+// DeleteADConfig mocks base method
+func (m *MockClient) DeleteADConfig(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteADConfig", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteADConfig indicates an expected call of DeleteADConfig
+func (mr *MockClientMockRecorder) DeleteADConfig(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteADConfig", reflect.TypeOf((*MockClient)(nil).DeleteADConfig), ctx, id)
+}
+
+// GetNFSServer mocks base method
+func (m *MockClient) GetNFSServer(ctx context.Context, id string) (gopowerstore.NFSServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNFSServer", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.NFSServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNFSServer indicates an expected call of GetNFSServer
+func (mr *MockClientMockRecorder) GetNFSServer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNFSServer", reflect.TypeOf((*MockClient)(nil).GetNFSServer), ctx, id)
+}
+
+// GetNFSServerByNASServerID mocks base method
+func (m *MockClient) GetNFSServerByNASServerID(ctx context.Context, nasServerID string) (gopowerstore.NFSServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNFSServerByNASServerID", ctx, nasServerID)
+	ret0, _ := ret[0].(gopowerstore.NFSServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNFSServerByNASServerID indicates an expected call of GetNFSServerByNASServerID
+func (mr *MockClientMockRecorder) GetNFSServerByNASServerID(ctx, nasServerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNFSServerByNASServerID", reflect.TypeOf((*MockClient)(nil).GetNFSServerByNASServerID), ctx, nasServerID)
+}
+
+// CreateNFSServer mocks base method
+func (m *MockClient) CreateNFSServer(ctx context.Context, createParams *gopowerstore.NFSServerCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNFSServer", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNFSServer indicates an expected call of CreateNFSServer
+func (mr *MockClientMockRecorder) CreateNFSServer(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNFSServer", reflect.TypeOf((*MockClient)(nil).CreateNFSServer), ctx, createParams)
+}
+
+// ModifyNFSServer mocks base method
+func (m *MockClient) ModifyNFSServer(ctx context.Context, modifyParams *gopowerstore.NFSServerModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNFSServer", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyNFSServer indicates an expected call of ModifyNFSServer
+func (mr *MockClientMockRecorder) ModifyNFSServer(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNFSServer", reflect.TypeOf((*MockClient)(nil).ModifyNFSServer), ctx, modifyParams, id)
+}
+
+// DeleteNFSServer mocks base method
+func (m *MockClient) DeleteNFSServer(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNFSServer", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNFSServer indicates an expected call of DeleteNFSServer
+func (mr *MockClientMockRecorder) DeleteNFSServer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNFSServer", reflect.TypeOf((*MockClient)(nil).DeleteNFSServer), ctx, id)
+}
+
+// GetFileInterface mocks base method
+func (m *MockClient) GetFileInterface(ctx context.Context, id string) (gopowerstore.FileInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileInterface", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.FileInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileInterface indicates an expected call of GetFileInterface
+func (mr *MockClientMockRecorder) GetFileInterface(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInterface", reflect.TypeOf((*MockClient)(nil).GetFileInterface), ctx, id)
+}
+
+// GetFileInterfacesByNASServerID mocks base method
+func (m *MockClient) GetFileInterfacesByNASServerID(ctx context.Context, nasServerID string) ([]gopowerstore.FileInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileInterfacesByNASServerID", ctx, nasServerID)
+	ret0, _ := ret[0].([]gopowerstore.FileInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileInterfacesByNASServerID indicates an expected call of GetFileInterfacesByNASServerID
+func (mr *MockClientMockRecorder) GetFileInterfacesByNASServerID(ctx, nasServerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileInterfacesByNASServerID", reflect.TypeOf((*MockClient)(nil).GetFileInterfacesByNASServerID), ctx, nasServerID)
+}
+
+// CreateFileInterface mocks base method
+func (m *MockClient) CreateFileInterface(ctx context.Context, createParams *gopowerstore.FileInterfaceCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFileInterface", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFileInterface indicates an expected call of CreateFileInterface
+func (mr *MockClientMockRecorder) CreateFileInterface(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFileInterface", reflect.TypeOf((*MockClient)(nil).CreateFileInterface), ctx, createParams)
+}
+
+// ModifyFileInterface mocks base method
+func (m *MockClient) ModifyFileInterface(ctx context.Context, modifyParams *gopowerstore.FileInterfaceModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyFileInterface", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyFileInterface indicates an expected call of ModifyFileInterface
+func (mr *MockClientMockRecorder) ModifyFileInterface(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyFileInterface", reflect.TypeOf((*MockClient)(nil).ModifyFileInterface), ctx, modifyParams, id)
+}
+
+// DeleteFileInterface mocks base method
+func (m *MockClient) DeleteFileInterface(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFileInterface", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFileInterface indicates an expected call of DeleteFileInterface
+func (mr *MockClientMockRecorder) DeleteFileInterface(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFileInterface", reflect.TypeOf((*MockClient)(nil).DeleteFileInterface), ctx, id)
+}
+
+// GetCluster mocks base method
+func (m *MockClient) GetCluster(ctx context.Context) (gopowerstore.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCluster", ctx)
+	ret0, _ := ret[0].(gopowerstore.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCluster indicates an expected call of GetCluster
+func (mr *MockClientMockRecorder) GetCluster(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockClient)(nil).GetCluster), ctx)
+}
+
+// GetX509Certificate mocks base method
+func (m *MockClient) GetX509Certificate(ctx context.Context, id string) (gopowerstore.X509Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetX509Certificate", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.X509Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetX509Certificate indicates an expected call of GetX509Certificate
+func (mr *MockClientMockRecorder) GetX509Certificate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetX509Certificate", reflect.TypeOf((*MockClient)(nil).GetX509Certificate), ctx, id)
+}
+
+// GetX509Certificates mocks base method
+func (m *MockClient) GetX509Certificates(ctx context.Context) ([]gopowerstore.X509Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetX509Certificates", ctx)
+	ret0, _ := ret[0].([]gopowerstore.X509Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetX509Certificates indicates an expected call of GetX509Certificates
+func (mr *MockClientMockRecorder) GetX509Certificates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetX509Certificates", reflect.TypeOf((*MockClient)(nil).GetX509Certificates), ctx)
+}
+
+// GetX509CertificatesByService mocks base method
+func (m *MockClient) GetX509CertificatesByService(ctx context.Context, service gopowerstore.X509CertificateServiceEnum) ([]gopowerstore.X509Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetX509CertificatesByService", ctx, service)
+	ret0, _ := ret[0].([]gopowerstore.X509Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetX509CertificatesByService indicates an expected call of GetX509CertificatesByService
+func (mr *MockClientMockRecorder) GetX509CertificatesByService(ctx, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetX509CertificatesByService", reflect.TypeOf((*MockClient)(nil).GetX509CertificatesByService), ctx, service)
+}
+
+// CreateX509Certificate mocks base method
+func (m *MockClient) CreateX509Certificate(ctx context.Context, createParams *gopowerstore.X509CertificateCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateX509Certificate", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateX509Certificate indicates an expected call of CreateX509Certificate
+func (mr *MockClientMockRecorder) CreateX509Certificate(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateX509Certificate", reflect.TypeOf((*MockClient)(nil).CreateX509Certificate), ctx, createParams)
+}
+
+// ImportManagementCertificate mocks base method
+func (m *MockClient) ImportManagementCertificate(ctx context.Context, certificatePEM string, privateKeyPEM string, keyPassphrase string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportManagementCertificate", ctx, certificatePEM, privateKeyPEM, keyPassphrase)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportManagementCertificate indicates an expected call of ImportManagementCertificate
+func (mr *MockClientMockRecorder) ImportManagementCertificate(ctx, certificatePEM, privateKeyPEM, keyPassphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportManagementCertificate", reflect.TypeOf((*MockClient)(nil).ImportManagementCertificate), ctx, certificatePEM, privateKeyPEM, keyPassphrase)
+}
+
+// ModifyX509Certificate mocks base method
+func (m *MockClient) ModifyX509Certificate(ctx context.Context, modifyParams *gopowerstore.X509CertificateModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyX509Certificate", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyX509Certificate indicates an expected call of ModifyX509Certificate
+func (mr *MockClientMockRecorder) ModifyX509Certificate(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyX509Certificate", reflect.TypeOf((*MockClient)(nil).ModifyX509Certificate), ctx, modifyParams, id)
+}
+
+// DeleteX509Certificate mocks base method
+func (m *MockClient) DeleteX509Certificate(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteX509Certificate", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteX509Certificate indicates an expected call of DeleteX509Certificate
+func (mr *MockClientMockRecorder) DeleteX509Certificate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteX509Certificate", reflect.TypeOf((*MockClient)(nil).DeleteX509Certificate), ctx, id)
+}
+
+// ExchangeX509Certificates mocks base method
+func (m *MockClient) ExchangeX509Certificates(ctx context.Context, exchangeParams *gopowerstore.X509CertificateExchange) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeX509Certificates", ctx, exchangeParams)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeX509Certificates indicates an expected call of ExchangeX509Certificates
+func (mr *MockClientMockRecorder) ExchangeX509Certificates(ctx, exchangeParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeX509Certificates", reflect.TypeOf((*MockClient)(nil).ExchangeX509Certificates), ctx, exchangeParams)
+}
+
+// GetLocalUser mocks base method
+func (m *MockClient) GetLocalUser(ctx context.Context, id string) (gopowerstore.LocalUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalUser", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.LocalUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocalUser indicates an expected call of GetLocalUser
+func (mr *MockClientMockRecorder) GetLocalUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalUser", reflect.TypeOf((*MockClient)(nil).GetLocalUser), ctx, id)
+}
+
+// GetLocalUserByName mocks base method
+func (m *MockClient) GetLocalUserByName(ctx context.Context, name string) (gopowerstore.LocalUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalUserByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.LocalUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocalUserByName indicates an expected call of GetLocalUserByName
+func (mr *MockClientMockRecorder) GetLocalUserByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalUserByName", reflect.TypeOf((*MockClient)(nil).GetLocalUserByName), ctx, name)
+}
+
+// GetLocalUsers mocks base method
+func (m *MockClient) GetLocalUsers(ctx context.Context) ([]gopowerstore.LocalUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocalUsers", ctx)
+	ret0, _ := ret[0].([]gopowerstore.LocalUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocalUsers indicates an expected call of GetLocalUsers
+func (mr *MockClientMockRecorder) GetLocalUsers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalUsers", reflect.TypeOf((*MockClient)(nil).GetLocalUsers), ctx)
+}
+
+// CreateLocalUser mocks base method
+func (m *MockClient) CreateLocalUser(ctx context.Context, createParams *gopowerstore.LocalUserCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocalUser", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocalUser indicates an expected call of CreateLocalUser
+func (mr *MockClientMockRecorder) CreateLocalUser(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocalUser", reflect.TypeOf((*MockClient)(nil).CreateLocalUser), ctx, createParams)
+}
+
+// ModifyLocalUser mocks base method
+func (m *MockClient) ModifyLocalUser(ctx context.Context, modifyParams *gopowerstore.LocalUserModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyLocalUser", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyLocalUser indicates an expected call of ModifyLocalUser
+func (mr *MockClientMockRecorder) ModifyLocalUser(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyLocalUser", reflect.TypeOf((*MockClient)(nil).ModifyLocalUser), ctx, modifyParams, id)
+}
+
+// DeleteLocalUser mocks base method
+func (m *MockClient) DeleteLocalUser(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLocalUser", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLocalUser indicates an expected call of DeleteLocalUser
+func (mr *MockClientMockRecorder) DeleteLocalUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLocalUser", reflect.TypeOf((*MockClient)(nil).DeleteLocalUser), ctx, id)
+}
+
+// GetRole mocks base method
+func (m *MockClient) GetRole(ctx context.Context, id string) (gopowerstore.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole
+func (mr *MockClientMockRecorder) GetRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockClient)(nil).GetRole), ctx, id)
+}
+
+// GetRoleByName mocks base method
+func (m *MockClient) GetRoleByName(ctx context.Context, name string) (gopowerstore.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleByName indicates an expected call of GetRoleByName
+func (mr *MockClientMockRecorder) GetRoleByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByName", reflect.TypeOf((*MockClient)(nil).GetRoleByName), ctx, name)
+}
+
+// GetRoles mocks base method
+func (m *MockClient) GetRoles(ctx context.Context) ([]gopowerstore.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]gopowerstore.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles
+func (mr *MockClientMockRecorder) GetRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockClient)(nil).GetRoles), ctx)
+}
+
+// GetLDAPDomain mocks base method
+func (m *MockClient) GetLDAPDomain(ctx context.Context, id string) (gopowerstore.LDAPDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLDAPDomain", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.LDAPDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLDAPDomain indicates an expected call of GetLDAPDomain
+func (mr *MockClientMockRecorder) GetLDAPDomain(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLDAPDomain", reflect.TypeOf((*MockClient)(nil).GetLDAPDomain), ctx, id)
+}
+
+// GetLDAPDomains mocks base method
+func (m *MockClient) GetLDAPDomains(ctx context.Context) ([]gopowerstore.LDAPDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLDAPDomains", ctx)
+	ret0, _ := ret[0].([]gopowerstore.LDAPDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLDAPDomains indicates an expected call of GetLDAPDomains
+func (mr *MockClientMockRecorder) GetLDAPDomains(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLDAPDomains", reflect.TypeOf((*MockClient)(nil).GetLDAPDomains), ctx)
+}
+
+// CreateLDAPDomain mocks base method
+func (m *MockClient) CreateLDAPDomain(ctx context.Context, createParams *gopowerstore.LDAPDomainCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLDAPDomain", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLDAPDomain indicates an expected call of CreateLDAPDomain
+func (mr *MockClientMockRecorder) CreateLDAPDomain(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLDAPDomain", reflect.TypeOf((*MockClient)(nil).CreateLDAPDomain), ctx, createParams)
+}
+
+// ModifyLDAPDomain mocks base method
+func (m *MockClient) ModifyLDAPDomain(ctx context.Context, modifyParams *gopowerstore.LDAPDomainModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyLDAPDomain", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyLDAPDomain indicates an expected call of ModifyLDAPDomain
+func (mr *MockClientMockRecorder) ModifyLDAPDomain(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyLDAPDomain", reflect.TypeOf((*MockClient)(nil).ModifyLDAPDomain), ctx, modifyParams, id)
+}
+
+// DeleteLDAPDomain mocks base method
+func (m *MockClient) DeleteLDAPDomain(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLDAPDomain", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLDAPDomain indicates an expected call of DeleteLDAPDomain
+func (mr *MockClientMockRecorder) DeleteLDAPDomain(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLDAPDomain", reflect.TypeOf((*MockClient)(nil).DeleteLDAPDomain), ctx, id)
+}
+
+// VerifyLDAPDomain mocks base method
+func (m *MockClient) VerifyLDAPDomain(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLDAPDomain", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLDAPDomain indicates an expected call of VerifyLDAPDomain
+func (mr *MockClientMockRecorder) VerifyLDAPDomain(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLDAPDomain", reflect.TypeOf((*MockClient)(nil).VerifyLDAPDomain), ctx, id)
+}
+
+// GetLDAPAccount mocks base method
+func (m *MockClient) GetLDAPAccount(ctx context.Context, id string) (gopowerstore.LDAPAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLDAPAccount", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.LDAPAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLDAPAccount indicates an expected call of GetLDAPAccount
+func (mr *MockClientMockRecorder) GetLDAPAccount(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLDAPAccount", reflect.TypeOf((*MockClient)(nil).GetLDAPAccount), ctx, id)
+}
+
+// GetLDAPAccounts mocks base method
+func (m *MockClient) GetLDAPAccounts(ctx context.Context) ([]gopowerstore.LDAPAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLDAPAccounts", ctx)
+	ret0, _ := ret[0].([]gopowerstore.LDAPAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLDAPAccounts indicates an expected call of GetLDAPAccounts
+func (mr *MockClientMockRecorder) GetLDAPAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLDAPAccounts", reflect.TypeOf((*MockClient)(nil).GetLDAPAccounts), ctx)
+}
+
+// CreateLDAPAccount mocks base method
+func (m *MockClient) CreateLDAPAccount(ctx context.Context, createParams *gopowerstore.LDAPAccountCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLDAPAccount", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLDAPAccount indicates an expected call of CreateLDAPAccount
+func (mr *MockClientMockRecorder) CreateLDAPAccount(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLDAPAccount", reflect.TypeOf((*MockClient)(nil).CreateLDAPAccount), ctx, createParams)
+}
+
+// ModifyLDAPAccount mocks base method
+func (m *MockClient) ModifyLDAPAccount(ctx context.Context, modifyParams *gopowerstore.LDAPAccountModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyLDAPAccount", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyLDAPAccount indicates an expected call of ModifyLDAPAccount
+func (mr *MockClientMockRecorder) ModifyLDAPAccount(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyLDAPAccount", reflect.TypeOf((*MockClient)(nil).ModifyLDAPAccount), ctx, modifyParams, id)
+}
+
+// DeleteLDAPAccount mocks base method
+func (m *MockClient) DeleteLDAPAccount(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLDAPAccount", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLDAPAccount indicates an expected call of DeleteLDAPAccount
+func (mr *MockClientMockRecorder) DeleteLDAPAccount(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLDAPAccount", reflect.TypeOf((*MockClient)(nil).DeleteLDAPAccount), ctx, id)
+}
+
+// GetNetwork mocks base method
+func (m *MockClient) GetNetwork(ctx context.Context, id string) (gopowerstore.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetwork", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetwork indicates an expected call of GetNetwork
+func (mr *MockClientMockRecorder) GetNetwork(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockClient)(nil).GetNetwork), ctx, id)
+}
+
+// GetNetworks mocks base method
+func (m *MockClient) GetNetworks(ctx context.Context) ([]gopowerstore.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworks", ctx)
+	ret0, _ := ret[0].([]gopowerstore.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworks indicates an expected call of GetNetworks
+func (mr *MockClientMockRecorder) GetNetworks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworks", reflect.TypeOf((*MockClient)(nil).GetNetworks), ctx)
+}
+
+// GetNetworksByType mocks base method
+func (m *MockClient) GetNetworksByType(ctx context.Context, networkType gopowerstore.NetworkTypeEnum) ([]gopowerstore.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworksByType", ctx, networkType)
+	ret0, _ := ret[0].([]gopowerstore.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworksByType indicates an expected call of GetNetworksByType
+func (mr *MockClientMockRecorder) GetNetworksByType(ctx, networkType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworksByType", reflect.TypeOf((*MockClient)(nil).GetNetworksByType), ctx, networkType)
+}
+
+// ModifyNetwork mocks base method
+func (m *MockClient) ModifyNetwork(ctx context.Context, modifyParams *gopowerstore.NetworkModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNetwork", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyNetwork indicates an expected call of ModifyNetwork
+func (mr *MockClientMockRecorder) ModifyNetwork(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNetwork", reflect.TypeOf((*MockClient)(nil).ModifyNetwork), ctx, modifyParams, id)
+}
+
+// GetDNS mocks base method
+func (m *MockClient) GetDNS(ctx context.Context) (gopowerstore.DNS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNS", ctx)
+	ret0, _ := ret[0].(gopowerstore.DNS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNS indicates an expected call of GetDNS
+func (mr *MockClientMockRecorder) GetDNS(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNS", reflect.TypeOf((*MockClient)(nil).GetDNS), ctx)
+}
+
+// ModifyDNS mocks base method
+func (m *MockClient) ModifyDNS(ctx context.Context, addresses []string, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyDNS", ctx, addresses, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyDNS indicates an expected call of ModifyDNS
+func (mr *MockClientMockRecorder) ModifyDNS(ctx, addresses, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDNS", reflect.TypeOf((*MockClient)(nil).ModifyDNS), ctx, addresses, id)
+}
+
+// GetNTP mocks base method
+func (m *MockClient) GetNTP(ctx context.Context) (gopowerstore.NTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNTP", ctx)
+	ret0, _ := ret[0].(gopowerstore.NTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNTP indicates an expected call of GetNTP
+func (mr *MockClientMockRecorder) GetNTP(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNTP", reflect.TypeOf((*MockClient)(nil).GetNTP), ctx)
+}
+
+// ModifyNTP mocks base method
+func (m *MockClient) ModifyNTP(ctx context.Context, addresses []string, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNTP", ctx, addresses, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyNTP indicates an expected call of ModifyNTP
+func (mr *MockClientMockRecorder) ModifyNTP(ctx, addresses, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNTP", reflect.TypeOf((*MockClient)(nil).ModifyNTP), ctx, addresses, id)
+}
+
+// GetSMTPConfig mocks base method
+func (m *MockClient) GetSMTPConfig(ctx context.Context) (gopowerstore.SMTPConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSMTPConfig", ctx)
+	ret0, _ := ret[0].(gopowerstore.SMTPConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSMTPConfig indicates an expected call of GetSMTPConfig
+func (mr *MockClientMockRecorder) GetSMTPConfig(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSMTPConfig", reflect.TypeOf((*MockClient)(nil).GetSMTPConfig), ctx)
+}
+
+// ModifySMTPConfig mocks base method
+func (m *MockClient) ModifySMTPConfig(ctx context.Context, modifyParams *gopowerstore.SMTPConfigModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySMTPConfig", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySMTPConfig indicates an expected call of ModifySMTPConfig
+func (mr *MockClientMockRecorder) ModifySMTPConfig(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySMTPConfig", reflect.TypeOf((*MockClient)(nil).ModifySMTPConfig), ctx, modifyParams, id)
+}
+
+// TestSMTPConfig mocks base method
+func (m *MockClient) TestSMTPConfig(ctx context.Context, id string, recipientEmail string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestSMTPConfig", ctx, id, recipientEmail)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestSMTPConfig indicates an expected call of TestSMTPConfig
+func (mr *MockClientMockRecorder) TestSMTPConfig(ctx, id, recipientEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestSMTPConfig", reflect.TypeOf((*MockClient)(nil).TestSMTPConfig), ctx, id, recipientEmail)
+}
+
+// GetSecurityConfig mocks base method
+func (m *MockClient) GetSecurityConfig(ctx context.Context) (gopowerstore.SecurityConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityConfig", ctx)
+	ret0, _ := ret[0].(gopowerstore.SecurityConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityConfig indicates an expected call of GetSecurityConfig
+func (mr *MockClientMockRecorder) GetSecurityConfig(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityConfig", reflect.TypeOf((*MockClient)(nil).GetSecurityConfig), ctx)
+}
+
+// ModifySecurityConfig mocks base method
+func (m *MockClient) ModifySecurityConfig(ctx context.Context, modifyParams *gopowerstore.SecurityConfigModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySecurityConfig", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySecurityConfig indicates an expected call of ModifySecurityConfig
+func (mr *MockClientMockRecorder) ModifySecurityConfig(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityConfig", reflect.TypeOf((*MockClient)(nil).ModifySecurityConfig), ctx, modifyParams, id)
+}
+
+// ModifyCluster mocks base method
+func (m *MockClient) ModifyCluster(ctx context.Context, modifyParams *gopowerstore.ClusterModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyCluster", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyCluster indicates an expected call of ModifyCluster
+func (mr *MockClientMockRecorder) ModifyCluster(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyCluster", reflect.TypeOf((*MockClient)(nil).ModifyCluster), ctx, modifyParams, id)
+}
+
+// GetSoftwareInstalled mocks base method
+func (m *MockClient) GetSoftwareInstalled(ctx context.Context) ([]gopowerstore.SoftwareInstalled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSoftwareInstalled", ctx)
+	ret0, _ := ret[0].([]gopowerstore.SoftwareInstalled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSoftwareInstalled indicates an expected call of GetSoftwareInstalled
+func (mr *MockClientMockRecorder) GetSoftwareInstalled(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSoftwareInstalled", reflect.TypeOf((*MockClient)(nil).GetSoftwareInstalled), ctx)
+}
+
+// GetSoftwarePackage mocks base method
+func (m *MockClient) GetSoftwarePackage(ctx context.Context, id string) (gopowerstore.SoftwarePackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSoftwarePackage", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.SoftwarePackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSoftwarePackage indicates an expected call of GetSoftwarePackage
+func (mr *MockClientMockRecorder) GetSoftwarePackage(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSoftwarePackage", reflect.TypeOf((*MockClient)(nil).GetSoftwarePackage), ctx, id)
+}
+
+// GetSoftwarePackages mocks base method
+func (m *MockClient) GetSoftwarePackages(ctx context.Context) ([]gopowerstore.SoftwarePackage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSoftwarePackages", ctx)
+	ret0, _ := ret[0].([]gopowerstore.SoftwarePackage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSoftwarePackages indicates an expected call of GetSoftwarePackages
+func (mr *MockClientMockRecorder) GetSoftwarePackages(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSoftwarePackages", reflect.TypeOf((*MockClient)(nil).GetSoftwarePackages), ctx)
+}
+
+// UploadSoftwarePackage mocks base method
+func (m *MockClient) UploadSoftwarePackage(ctx context.Context, fileName string, content io.ReadSeeker) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSoftwarePackage", ctx, fileName, content)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSoftwarePackage indicates an expected call of UploadSoftwarePackage
+func (mr *MockClientMockRecorder) UploadSoftwarePackage(ctx, fileName, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSoftwarePackage", reflect.TypeOf((*MockClient)(nil).UploadSoftwarePackage), ctx, fileName, content)
+}
+
+// DeleteSoftwarePackage mocks base method
+func (m *MockClient) DeleteSoftwarePackage(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSoftwarePackage", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSoftwarePackage indicates an expected call of DeleteSoftwarePackage
+func (mr *MockClientMockRecorder) DeleteSoftwarePackage(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSoftwarePackage", reflect.TypeOf((*MockClient)(nil).DeleteSoftwarePackage), ctx, id)
+}
+
+// RunSoftwarePackageHealthCheck mocks base method
+func (m *MockClient) RunSoftwarePackageHealthCheck(ctx context.Context, id string, checkParams *gopowerstore.SoftwarePackageHealthCheck) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunSoftwarePackageHealthCheck", ctx, id, checkParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunSoftwarePackageHealthCheck indicates an expected call of RunSoftwarePackageHealthCheck
+func (mr *MockClientMockRecorder) RunSoftwarePackageHealthCheck(ctx, id, checkParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSoftwarePackageHealthCheck", reflect.TypeOf((*MockClient)(nil).RunSoftwarePackageHealthCheck), ctx, id, checkParams)
+}
+
+// InstallSoftwarePackage mocks base method
+func (m *MockClient) InstallSoftwarePackage(ctx context.Context, id string, installParams *gopowerstore.SoftwarePackageInstall) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallSoftwarePackage", ctx, id, installParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallSoftwarePackage indicates an expected call of InstallSoftwarePackage
+func (mr *MockClientMockRecorder) InstallSoftwarePackage(ctx, id, installParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallSoftwarePackage", reflect.TypeOf((*MockClient)(nil).InstallSoftwarePackage), ctx, id, installParams)
+}
+
+// GetSupportMaterial mocks base method
+func (m *MockClient) GetSupportMaterial(ctx context.Context, id string) (gopowerstore.SupportMaterial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportMaterial", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.SupportMaterial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportMaterial indicates an expected call of GetSupportMaterial
+func (mr *MockClientMockRecorder) GetSupportMaterial(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportMaterial", reflect.TypeOf((*MockClient)(nil).GetSupportMaterial), ctx, id)
+}
+
+// GetSupportMaterials mocks base method
+func (m *MockClient) GetSupportMaterials(ctx context.Context) ([]gopowerstore.SupportMaterial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportMaterials", ctx)
+	ret0, _ := ret[0].([]gopowerstore.SupportMaterial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportMaterials indicates an expected call of GetSupportMaterials
+func (mr *MockClientMockRecorder) GetSupportMaterials(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportMaterials", reflect.TypeOf((*MockClient)(nil).GetSupportMaterials), ctx)
+}
+
+// CollectSupportMaterial mocks base method
+func (m *MockClient) CollectSupportMaterial(ctx context.Context, collectParams *gopowerstore.SupportMaterialCollect) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectSupportMaterial", ctx, collectParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectSupportMaterial indicates an expected call of CollectSupportMaterial
+func (mr *MockClientMockRecorder) CollectSupportMaterial(ctx, collectParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectSupportMaterial", reflect.TypeOf((*MockClient)(nil).CollectSupportMaterial), ctx, collectParams)
+}
+
+// DeleteSupportMaterial mocks base method
+func (m *MockClient) DeleteSupportMaterial(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSupportMaterial", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSupportMaterial indicates an expected call of DeleteSupportMaterial
+func (mr *MockClientMockRecorder) DeleteSupportMaterial(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSupportMaterial", reflect.TypeOf((*MockClient)(nil).DeleteSupportMaterial), ctx, id)
+}
+
+// DownloadSupportMaterial mocks base method
+func (m *MockClient) DownloadSupportMaterial(ctx context.Context, id string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadSupportMaterial", ctx, id)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadSupportMaterial indicates an expected call of DownloadSupportMaterial
+func (mr *MockClientMockRecorder) DownloadSupportMaterial(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadSupportMaterial", reflect.TypeOf((*MockClient)(nil).DownloadSupportMaterial), ctx, id)
+}
+
+// GetSoftwareVersion mocks base method
+func (m *MockClient) GetSoftwareVersion(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSoftwareVersion", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSoftwareVersion indicates an expected call of GetSoftwareVersion
+func (mr *MockClientMockRecorder) GetSoftwareVersion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSoftwareVersion", reflect.TypeOf((*MockClient)(nil).GetSoftwareVersion), ctx)
+}
+
+// GetSoftwareMajorMinorVersion mocks base method
+func (m *MockClient) GetSoftwareMajorMinorVersion(ctx context.Context) (gopowerstore.SoftwareVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSoftwareMajorMinorVersion", ctx)
+	ret0, _ := ret[0].(gopowerstore.SoftwareVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSoftwareMajorMinorVersion indicates an expected call of GetSoftwareMajorMinorVersion
+func (mr *MockClientMockRecorder) GetSoftwareMajorMinorVersion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSoftwareMajorMinorVersion", reflect.TypeOf((*MockClient)(nil).GetSoftwareMajorMinorVersion), ctx)
+}
+
+// GetCapabilities mocks base method
+func (m *MockClient) GetCapabilities(ctx context.Context) (gopowerstore.Capabilities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapabilities", ctx)
+	ret0, _ := ret[0].(gopowerstore.Capabilities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapabilities indicates an expected call of GetCapabilities
+func (mr *MockClientMockRecorder) GetCapabilities(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapabilities", reflect.TypeOf((*MockClient)(nil).GetCapabilities), ctx)
+}
+
+// GetAppliances mocks base method
+func (m *MockClient) GetAppliances(ctx context.Context) ([]gopowerstore.ApplianceInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppliances", ctx)
+	ret0, _ := ret[0].([]gopowerstore.ApplianceInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppliances indicates an expected call of GetAppliances
+func (mr *MockClientMockRecorder) GetAppliances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppliances", reflect.TypeOf((*MockClient)(nil).GetAppliances), ctx)
+}
+
+// GetApplianceByName mocks base method
+func (m *MockClient) GetApplianceByName(ctx context.Context, name string) (gopowerstore.ApplianceInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplianceByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.ApplianceInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplianceByName indicates an expected call of GetApplianceByName
+func (mr *MockClientMockRecorder) GetApplianceByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplianceByName", reflect.TypeOf((*MockClient)(nil).GetApplianceByName), ctx, name)
+}
+
+// GetApplianceListCMA mocks base method
+func (m *MockClient) GetApplianceListCMA(ctx context.Context) ([]gopowerstore.Appliance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplianceListCMA", ctx)
+	ret0, _ := ret[0].([]gopowerstore.Appliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplianceListCMA indicates an expected call of GetApplianceListCMA
+func (mr *MockClientMockRecorder) GetApplianceListCMA(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplianceListCMA", reflect.TypeOf((*MockClient)(nil).GetApplianceListCMA), ctx)
+}
+
+// GetCapacity mocks base method
+func (m *MockClient) GetCapacity(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacity", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacity indicates an expected call of GetCapacity
+func (mr *MockClientMockRecorder) GetCapacity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockClient)(nil).GetCapacity), ctx)
+}
+
+// GetCapacityByAppliance mocks base method
+func (m *MockClient) GetCapacityByAppliance(ctx context.Context) ([]gopowerstore.ApplianceCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacityByAppliance", ctx)
+	ret0, _ := ret[0].([]gopowerstore.ApplianceCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacityByAppliance indicates an expected call of GetCapacityByAppliance
+func (mr *MockClientMockRecorder) GetCapacityByAppliance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacityByAppliance", reflect.TypeOf((*MockClient)(nil).GetCapacityByAppliance), ctx)
+}
+
+// GetApplianceMostFreeSpace mocks base method
+func (m *MockClient) GetApplianceMostFreeSpace(ctx context.Context) (gopowerstore.ApplianceCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplianceMostFreeSpace", ctx)
+	ret0, _ := ret[0].(gopowerstore.ApplianceCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplianceMostFreeSpace indicates an expected call of GetApplianceMostFreeSpace
+func (mr *MockClientMockRecorder) GetApplianceMostFreeSpace(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplianceMostFreeSpace", reflect.TypeOf((*MockClient)(nil).GetApplianceMostFreeSpace), ctx)
+}
+
+// GetFCPorts mocks base method
+func (m *MockClient) GetFCPorts(ctx context.Context) ([]gopowerstore.FcPort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFCPorts", ctx)
+	ret0, _ := ret[0].([]gopowerstore.FcPort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFCPorts indicates an expected call of GetFCPorts
+func (mr *MockClientMockRecorder) GetFCPorts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFCPorts", reflect.TypeOf((*MockClient)(nil).GetFCPorts), ctx)
+}
+
+// GetFCPort mocks base method
+func (m *MockClient) GetFCPort(ctx context.Context, id string) (gopowerstore.FcPort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFCPort", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.FcPort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFCPort indicates an expected call of GetFCPort
+func (mr *MockClientMockRecorder) GetFCPort(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFCPort", reflect.TypeOf((*MockClient)(nil).GetFCPort), ctx, id)
+}
+
+// GetFCTargetPorts mocks base method
+func (m *MockClient) GetFCTargetPorts(ctx context.Context) ([]gopowerstore.FcPort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFCTargetPorts", ctx)
+	ret0, _ := ret[0].([]gopowerstore.FcPort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFCTargetPorts indicates an expected call of GetFCTargetPorts
+func (mr *MockClientMockRecorder) GetFCTargetPorts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFCTargetPorts", reflect.TypeOf((*MockClient)(nil).GetFCTargetPorts), ctx)
+}
+
+// SetLogger mocks base method
+func (m *MockClient) SetLogger(logger gopowerstore.Logger) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLogger", logger)
+}
+
+// SetLogger indicates an expected call of SetLogger
+func (mr *MockClientMockRecorder) SetLogger(logger interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogger", reflect.TypeOf((*MockClient)(nil).SetLogger), logger)
+}
+
+// CreateSnapshot mocks base method
+func (m *MockClient) CreateSnapshot(ctx context.Context, createSnapParams *gopowerstore.SnapshotCreate, id string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, createSnapParams, id)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot
+func (mr *MockClientMockRecorder) CreateSnapshot(ctx, createSnapParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockClient)(nil).CreateSnapshot), ctx, createSnapParams, id)
+}
+
+// DeleteSnapshot mocks base method
+func (m *MockClient) DeleteSnapshot(ctx context.Context, deleteParams *gopowerstore.VolumeDelete, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, deleteParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot
+func (mr *MockClientMockRecorder) DeleteSnapshot(ctx, deleteParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockClient)(nil).DeleteSnapshot), ctx, deleteParams, id)
+}
+
+// GetSnapshotsByVolumeID mocks base method
+func (m *MockClient) GetSnapshotsByVolumeID(ctx context.Context, volID string) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotsByVolumeID", ctx, volID)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotsByVolumeID indicates an expected call of GetSnapshotsByVolumeID
+func (mr *MockClientMockRecorder) GetSnapshotsByVolumeID(ctx, volID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotsByVolumeID", reflect.TypeOf((*MockClient)(nil).GetSnapshotsByVolumeID), ctx, volID)
+}
+
+// GetSnapshots mocks base method
+func (m *MockClient) GetSnapshots(ctx context.Context) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshots", ctx)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshots indicates an expected call of GetSnapshots
+func (mr *MockClientMockRecorder) GetSnapshots(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshots", reflect.TypeOf((*MockClient)(nil).GetSnapshots), ctx)
+}
+
+// GetSnapshot mocks base method
+func (m *MockClient) GetSnapshot(ctx context.Context, snapID string) (gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx, snapID)
+	ret0, _ := ret[0].(gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot
+func (mr *MockClientMockRecorder) GetSnapshot(ctx, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockClient)(nil).GetSnapshot), ctx, snapID)
+}
+
+// CreateVolumeFromSnapshot mocks base method
+func (m *MockClient) CreateVolumeFromSnapshot(ctx context.Context, createParams *gopowerstore.VolumeClone, snapID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeFromSnapshot", ctx, createParams, snapID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeFromSnapshot indicates an expected call of CreateVolumeFromSnapshot
+func (mr *MockClientMockRecorder) CreateVolumeFromSnapshot(ctx, createParams, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeFromSnapshot", reflect.TypeOf((*MockClient)(nil).CreateVolumeFromSnapshot), ctx, createParams, snapID)
+}
+
+// CloneVolume mocks base method
+func (m *MockClient) CloneVolume(ctx context.Context, cloneParams *gopowerstore.VolumeClone, sourceVolID string) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneVolume", ctx, cloneParams, sourceVolID)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneVolume indicates an expected call of CloneVolume
+func (mr *MockClientMockRecorder) CloneVolume(ctx, cloneParams, sourceVolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneVolume", reflect.TypeOf((*MockClient)(nil).CloneVolume), ctx, cloneParams, sourceVolID)
+}
+
+// ConfigureMetroVolume mocks base method
+func (m *MockClient) ConfigureMetroVolume(ctx context.Context, volID string, config *gopowerstore.MetroConfig) (gopowerstore.MetroSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureMetroVolume", ctx, volID, config)
+	ret0, _ := ret[0].(gopowerstore.MetroSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureMetroVolume indicates an expected call of ConfigureMetroVolume
+func (mr *MockClientMockRecorder) ConfigureMetroVolume(ctx, volID, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureMetroVolume", reflect.TypeOf((*MockClient)(nil).ConfigureMetroVolume), ctx, volID, config)
+}
+
+// EndMetroVolume mocks base method
+func (m *MockClient) EndMetroVolume(ctx context.Context, volID string, options *gopowerstore.EndMetroVolumeOptions) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndMetroVolume", ctx, volID, options)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndMetroVolume indicates an expected call of EndMetroVolume
+func (mr *MockClientMockRecorder) EndMetroVolume(ctx, volID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndMetroVolume", reflect.TypeOf((*MockClient)(nil).EndMetroVolume), ctx, volID, options)
+}
+
+// GetMetroSessionByVolumeID mocks base method
+func (m *MockClient) GetMetroSessionByVolumeID(ctx context.Context, volID string) (gopowerstore.ReplicationSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetroSessionByVolumeID", ctx, volID)
+	ret0, _ := ret[0].(gopowerstore.ReplicationSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetroSessionByVolumeID indicates an expected call of GetMetroSessionByVolumeID
+func (mr *MockClientMockRecorder) GetMetroSessionByVolumeID(ctx, volID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetroSessionByVolumeID", reflect.TypeOf((*MockClient)(nil).GetMetroSessionByVolumeID), ctx, volID)
+}
+
+// NewVolumeIterator mocks base method
+func (m *MockClient) NewVolumeIterator(ctx context.Context, pageSize int) *gopowerstore.VolumeIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewVolumeIterator", ctx, pageSize)
+	ret0, _ := ret[0].(*gopowerstore.VolumeIterator)
+	return ret0
+}
+
+// NewVolumeIterator indicates an expected call of NewVolumeIterator
+func (mr *MockClientMockRecorder) NewVolumeIterator(ctx, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVolumeIterator", reflect.TypeOf((*MockClient)(nil).NewVolumeIterator), ctx, pageSize)
+}
+
+// NewSnapshotIterator mocks base method
+func (m *MockClient) NewSnapshotIterator(ctx context.Context, pageSize int) *gopowerstore.VolumeIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSnapshotIterator", ctx, pageSize)
+	ret0, _ := ret[0].(*gopowerstore.VolumeIterator)
+	return ret0
+}
+
+// NewSnapshotIterator indicates an expected call of NewSnapshotIterator
+func (mr *MockClientMockRecorder) NewSnapshotIterator(ctx, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSnapshotIterator", reflect.TypeOf((*MockClient)(nil).NewSnapshotIterator), ctx, pageSize)
+}
+
+// RefreshVolume mocks base method
+func (m *MockClient) RefreshVolume(ctx context.Context, volID string, refreshParams *gopowerstore.VolumeRefresh) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshVolume", ctx, volID, refreshParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshVolume indicates an expected call of RefreshVolume
+func (mr *MockClientMockRecorder) RefreshVolume(ctx, volID, refreshParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshVolume", reflect.TypeOf((*MockClient)(nil).RefreshVolume), ctx, volID, refreshParams)
+}
+
+// RestoreVolumeFromSnapshot mocks base method
+func (m *MockClient) RestoreVolumeFromSnapshot(ctx context.Context, volID string, restoreParams *gopowerstore.VolumeRestore) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVolumeFromSnapshot", ctx, volID, restoreParams)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVolumeFromSnapshot indicates an expected call of RestoreVolumeFromSnapshot
+func (mr *MockClientMockRecorder) RestoreVolumeFromSnapshot(ctx, volID, restoreParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeFromSnapshot", reflect.TypeOf((*MockClient)(nil).RestoreVolumeFromSnapshot), ctx, volID, restoreParams)
+}
+
+// RestoreVolumeFromSnapshotAsync mocks base method
+func (m *MockClient) RestoreVolumeFromSnapshotAsync(ctx context.Context, volID string, restoreParams *gopowerstore.VolumeRestore) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVolumeFromSnapshotAsync", ctx, volID, restoreParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVolumeFromSnapshotAsync indicates an expected call of RestoreVolumeFromSnapshotAsync
+func (mr *MockClientMockRecorder) RestoreVolumeFromSnapshotAsync(ctx, volID, restoreParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeFromSnapshotAsync", reflect.TypeOf((*MockClient)(nil).RestoreVolumeFromSnapshotAsync), ctx, volID, restoreParams)
+}
+
+// GetTopSpaceConsumers mocks base method
+func (m *MockClient) GetTopSpaceConsumers(ctx context.Context, n int) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopSpaceConsumers", ctx, n)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopSpaceConsumers indicates an expected call of GetTopSpaceConsumers
+func (mr *MockClientMockRecorder) GetTopSpaceConsumers(ctx, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopSpaceConsumers", reflect.TypeOf((*MockClient)(nil).GetTopSpaceConsumers), ctx, n)
+}
+
+// GetVolumeByMetadata mocks base method
+func (m *MockClient) GetVolumeByMetadata(ctx context.Context, key string, value string) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeByMetadata", ctx, key, value)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeByMetadata indicates an expected call of GetVolumeByMetadata
+func (mr *MockClientMockRecorder) GetVolumeByMetadata(ctx, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeByMetadata", reflect.TypeOf((*MockClient)(nil).GetVolumeByMetadata), ctx, key, value)
+}
+
+// GetUnmanagedVolumes mocks base method
+func (m *MockClient) GetUnmanagedVolumes(ctx context.Context, metadataKey string) ([]gopowerstore.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnmanagedVolumes", ctx, metadataKey)
+	ret0, _ := ret[0].([]gopowerstore.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnmanagedVolumes indicates an expected call of GetUnmanagedVolumes
+func (mr *MockClientMockRecorder) GetUnmanagedVolumes(ctx, metadataKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnmanagedVolumes", reflect.TypeOf((*MockClient)(nil).GetUnmanagedVolumes), ctx, metadataKey)
+}
+
+// DeleteSnapshotsOlderThan mocks base method
+func (m *MockClient) DeleteSnapshotsOlderThan(ctx context.Context, cutoff time.Time, filter api.QueryParamsEncoder) []error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotsOlderThan", ctx, cutoff, filter)
+	ret0, _ := ret[0].([]error)
+	return ret0
+}
+
+// DeleteSnapshotsOlderThan indicates an expected call of DeleteSnapshotsOlderThan
+func (mr *MockClientMockRecorder) DeleteSnapshotsOlderThan(ctx, cutoff, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotsOlderThan", reflect.TypeOf((*MockClient)(nil).DeleteSnapshotsOlderThan), ctx, cutoff, filter)
+}
+
+// DeleteSnapshotsByVolumeID mocks base method
+func (m *MockClient) DeleteSnapshotsByVolumeID(ctx context.Context, volID string) (gopowerstore.DeleteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotsByVolumeID", ctx, volID)
+	ret0, _ := ret[0].(gopowerstore.DeleteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotsByVolumeID indicates an expected call of DeleteSnapshotsByVolumeID
+func (mr *MockClientMockRecorder) DeleteSnapshotsByVolumeID(ctx, volID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotsByVolumeID", reflect.TypeOf((*MockClient)(nil).DeleteSnapshotsByVolumeID), ctx, volID)
+}
+
+// CreateVolumes mocks base method
+func (m *MockClient) CreateVolumes(ctx context.Context, createParams []gopowerstore.VolumeCreate, concurrency int) []gopowerstore.BatchResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumes", ctx, createParams, concurrency)
+	ret0, _ := ret[0].([]gopowerstore.BatchResult)
+	return ret0
+}
+
+// CreateVolumes indicates an expected call of CreateVolumes
+func (mr *MockClientMockRecorder) CreateVolumes(ctx, createParams, concurrency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumes", reflect.TypeOf((*MockClient)(nil).CreateVolumes), ctx, createParams, concurrency)
+}
+
+// DeleteVolumes mocks base method
+func (m *MockClient) DeleteVolumes(ctx context.Context, ids []string, concurrency int) []gopowerstore.BatchResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumes", ctx, ids, concurrency)
+	ret0, _ := ret[0].([]gopowerstore.BatchResult)
+	return ret0
+}
+
+// DeleteVolumes indicates an expected call of DeleteVolumes
+func (mr *MockClientMockRecorder) DeleteVolumes(ctx, ids, concurrency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumes", reflect.TypeOf((*MockClient)(nil).DeleteVolumes), ctx, ids, concurrency)
+}
+
+// ModifyVolume mocks base method
+func (m *MockClient) ModifyVolume(ctx context.Context, modifyParams *gopowerstore.VolumeModify, volID string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVolume", ctx, modifyParams, volID)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVolume indicates an expected call of ModifyVolume
+func (mr *MockClientMockRecorder) ModifyVolume(ctx, modifyParams, volID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolume", reflect.TypeOf((*MockClient)(nil).ModifyVolume), ctx, modifyParams, volID)
+}
+
+// ModifySnapshot mocks base method
+func (m *MockClient) ModifySnapshot(ctx context.Context, modifyParams *gopowerstore.SnapshotModify, snapID string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySnapshot", ctx, modifyParams, snapID)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySnapshot indicates an expected call of ModifySnapshot
+func (mr *MockClientMockRecorder) ModifySnapshot(ctx, modifyParams, snapID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySnapshot", reflect.TypeOf((*MockClient)(nil).ModifySnapshot), ctx, modifyParams, snapID)
+}
+
+// GetSnapshotRule mocks base method
+func (m *MockClient) GetSnapshotRule(ctx context.Context, id string) (gopowerstore.SnapshotRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotRule", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.SnapshotRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotRule indicates an expected call of GetSnapshotRule
+func (mr *MockClientMockRecorder) GetSnapshotRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotRule", reflect.TypeOf((*MockClient)(nil).GetSnapshotRule), ctx, id)
+}
+
+// CreateSnapshotRule mocks base method
+func (m *MockClient) CreateSnapshotRule(ctx context.Context, createParams *gopowerstore.SnapshotRuleCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotRule", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotRule indicates an expected call of CreateSnapshotRule
+func (mr *MockClientMockRecorder) CreateSnapshotRule(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotRule", reflect.TypeOf((*MockClient)(nil).CreateSnapshotRule), ctx, createParams)
+}
+
+// DeleteSnapshotRule mocks base method
+func (m *MockClient) DeleteSnapshotRule(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotRule", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotRule indicates an expected call of DeleteSnapshotRule
+func (mr *MockClientMockRecorder) DeleteSnapshotRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotRule", reflect.TypeOf((*MockClient)(nil).DeleteSnapshotRule), ctx, id)
+}
+
+// GetIoLimitPolicy mocks base method
+func (m *MockClient) GetIoLimitPolicy(ctx context.Context, id string) (gopowerstore.IoLimitPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIoLimitPolicy", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.IoLimitPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIoLimitPolicy indicates an expected call of GetIoLimitPolicy
+func (mr *MockClientMockRecorder) GetIoLimitPolicy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIoLimitPolicy", reflect.TypeOf((*MockClient)(nil).GetIoLimitPolicy), ctx, id)
+}
+
+// GetIoLimitPolicyByName mocks base method
+func (m *MockClient) GetIoLimitPolicyByName(ctx context.Context, name string) (gopowerstore.IoLimitPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIoLimitPolicyByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.IoLimitPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIoLimitPolicyByName indicates an expected call of GetIoLimitPolicyByName
+func (mr *MockClientMockRecorder) GetIoLimitPolicyByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIoLimitPolicyByName", reflect.TypeOf((*MockClient)(nil).GetIoLimitPolicyByName), ctx, name)
+}
+
+// CreateIoLimitPolicy mocks base method
+func (m *MockClient) CreateIoLimitPolicy(ctx context.Context, createParams *gopowerstore.IoLimitPolicyCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIoLimitPolicy", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIoLimitPolicy indicates an expected call of CreateIoLimitPolicy
+func (mr *MockClientMockRecorder) CreateIoLimitPolicy(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIoLimitPolicy", reflect.TypeOf((*MockClient)(nil).CreateIoLimitPolicy), ctx, createParams)
+}
+
+// GetIoLimitPolicies mocks base method
+func (m *MockClient) GetIoLimitPolicies(ctx context.Context) ([]gopowerstore.IoLimitPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIoLimitPolicies", ctx)
+	ret0, _ := ret[0].([]gopowerstore.IoLimitPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIoLimitPolicies indicates an expected call of GetIoLimitPolicies
+func (mr *MockClientMockRecorder) GetIoLimitPolicies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIoLimitPolicies", reflect.TypeOf((*MockClient)(nil).GetIoLimitPolicies), ctx)
+}
+
+// ModifyIoLimitPolicy mocks base method
+func (m *MockClient) ModifyIoLimitPolicy(ctx context.Context, modifyParams *gopowerstore.IoLimitPolicyModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyIoLimitPolicy", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyIoLimitPolicy indicates an expected call of ModifyIoLimitPolicy
+func (mr *MockClientMockRecorder) ModifyIoLimitPolicy(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyIoLimitPolicy", reflect.TypeOf((*MockClient)(nil).ModifyIoLimitPolicy), ctx, modifyParams, id)
+}
+
+// DeleteIoLimitPolicy mocks base method
+func (m *MockClient) DeleteIoLimitPolicy(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIoLimitPolicy", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIoLimitPolicy indicates an expected call of DeleteIoLimitPolicy
+func (mr *MockClientMockRecorder) DeleteIoLimitPolicy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIoLimitPolicy", reflect.TypeOf((*MockClient)(nil).DeleteIoLimitPolicy), ctx, id)
+}
+
+// GetPerformancePolicy mocks base method
+func (m *MockClient) GetPerformancePolicy(ctx context.Context, id string) (gopowerstore.PerformancePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerformancePolicy", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.PerformancePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerformancePolicy indicates an expected call of GetPerformancePolicy
+func (mr *MockClientMockRecorder) GetPerformancePolicy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerformancePolicy", reflect.TypeOf((*MockClient)(nil).GetPerformancePolicy), ctx, id)
+}
+
+// GetPerformancePolicies mocks base method
+func (m *MockClient) GetPerformancePolicies(ctx context.Context) ([]gopowerstore.PerformancePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerformancePolicies", ctx)
+	ret0, _ := ret[0].([]gopowerstore.PerformancePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerformancePolicies indicates an expected call of GetPerformancePolicies
+func (mr *MockClientMockRecorder) GetPerformancePolicies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerformancePolicies", reflect.TypeOf((*MockClient)(nil).GetPerformancePolicies), ctx)
+}
+
+// GetProtectionPolicy mocks base method
+func (m *MockClient) GetProtectionPolicy(ctx context.Context, id string) (gopowerstore.ProtectionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProtectionPolicy", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.ProtectionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProtectionPolicy indicates an expected call of GetProtectionPolicy
+func (mr *MockClientMockRecorder) GetProtectionPolicy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProtectionPolicy", reflect.TypeOf((*MockClient)(nil).GetProtectionPolicy), ctx, id)
+}
+
+// GetProtectionPolicyByName mocks base method
+func (m *MockClient) GetProtectionPolicyByName(ctx context.Context, name string) (gopowerstore.ProtectionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProtectionPolicyByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.ProtectionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProtectionPolicyByName indicates an expected call of GetProtectionPolicyByName
+func (mr *MockClientMockRecorder) GetProtectionPolicyByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProtectionPolicyByName", reflect.TypeOf((*MockClient)(nil).GetProtectionPolicyByName), ctx, name)
+}
+
+// CreateProtectionPolicy mocks base method
+func (m *MockClient) CreateProtectionPolicy(ctx context.Context, createParams *gopowerstore.ProtectionPolicyCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProtectionPolicy", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProtectionPolicy indicates an expected call of CreateProtectionPolicy
+func (mr *MockClientMockRecorder) CreateProtectionPolicy(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProtectionPolicy", reflect.TypeOf((*MockClient)(nil).CreateProtectionPolicy), ctx, createParams)
+}
+
+// ModifyProtectionPolicy mocks base method
+func (m *MockClient) ModifyProtectionPolicy(ctx context.Context, modifyParams *gopowerstore.ProtectionPolicyModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyProtectionPolicy", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyProtectionPolicy indicates an expected call of ModifyProtectionPolicy
+func (mr *MockClientMockRecorder) ModifyProtectionPolicy(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyProtectionPolicy", reflect.TypeOf((*MockClient)(nil).ModifyProtectionPolicy), ctx, modifyParams, id)
+}
+
+// DeleteProtectionPolicy mocks base method
+func (m *MockClient) DeleteProtectionPolicy(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProtectionPolicy", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProtectionPolicy indicates an expected call of DeleteProtectionPolicy
+func (mr *MockClientMockRecorder) DeleteProtectionPolicy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProtectionPolicy", reflect.TypeOf((*MockClient)(nil).DeleteProtectionPolicy), ctx, id)
+}
+
+// GetReplicationRule mocks base method
+func (m *MockClient) GetReplicationRule(ctx context.Context, id string) (gopowerstore.ReplicationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationRule", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.ReplicationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationRule indicates an expected call of GetReplicationRule
+func (mr *MockClientMockRecorder) GetReplicationRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationRule", reflect.TypeOf((*MockClient)(nil).GetReplicationRule), ctx, id)
+}
+
+// GetReplicationRuleByName mocks base method
+func (m *MockClient) GetReplicationRuleByName(ctx context.Context, name string) (gopowerstore.ReplicationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationRuleByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.ReplicationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationRuleByName indicates an expected call of GetReplicationRuleByName
+func (mr *MockClientMockRecorder) GetReplicationRuleByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationRuleByName", reflect.TypeOf((*MockClient)(nil).GetReplicationRuleByName), ctx, name)
+}
+
+// CreateReplicationRule mocks base method
+func (m *MockClient) CreateReplicationRule(ctx context.Context, createParams *gopowerstore.ReplicationRuleCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReplicationRule", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReplicationRule indicates an expected call of CreateReplicationRule
+func (mr *MockClientMockRecorder) CreateReplicationRule(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplicationRule", reflect.TypeOf((*MockClient)(nil).CreateReplicationRule), ctx, createParams)
+}
+
+// ModifyReplicationRule mocks base method
+func (m *MockClient) ModifyReplicationRule(ctx context.Context, modifyParams *gopowerstore.ReplicationRuleModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyReplicationRule", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyReplicationRule indicates an expected call of ModifyReplicationRule
+func (mr *MockClientMockRecorder) ModifyReplicationRule(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyReplicationRule", reflect.TypeOf((*MockClient)(nil).ModifyReplicationRule), ctx, modifyParams, id)
+}
+
+// DeleteReplicationRule mocks base method
+func (m *MockClient) DeleteReplicationRule(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReplicationRule", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReplicationRule indicates an expected call of DeleteReplicationRule
+func (mr *MockClientMockRecorder) DeleteReplicationRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplicationRule", reflect.TypeOf((*MockClient)(nil).DeleteReplicationRule), ctx, id)
+}
+
+// ModifySnapshotRule mocks base method
+func (m *MockClient) ModifySnapshotRule(ctx context.Context, modifyParams *gopowerstore.SnapshotRuleModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifySnapshotRule", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifySnapshotRule indicates an expected call of ModifySnapshotRule
+func (mr *MockClientMockRecorder) ModifySnapshotRule(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySnapshotRule", reflect.TypeOf((*MockClient)(nil).ModifySnapshotRule), ctx, modifyParams, id)
+}
+
+// GetSnapshotCreationRate mocks base method
+func (m *MockClient) GetSnapshotCreationRate(ctx context.Context, interval gopowerstore.MetricsIntervalEnum) (gopowerstore.CreationRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotCreationRate", ctx, interval)
+	ret0, _ := ret[0].(gopowerstore.CreationRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotCreationRate indicates an expected call of GetSnapshotCreationRate
+func (mr *MockClientMockRecorder) GetSnapshotCreationRate(ctx, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotCreationRate", reflect.TypeOf((*MockClient)(nil).GetSnapshotCreationRate), ctx, interval)
+}
+
+// GetVolumeCreationRate mocks base method
+func (m *MockClient) GetVolumeCreationRate(ctx context.Context, interval gopowerstore.MetricsIntervalEnum) (gopowerstore.CreationRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeCreationRate", ctx, interval)
+	ret0, _ := ret[0].(gopowerstore.CreationRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeCreationRate indicates an expected call of GetVolumeCreationRate
+func (mr *MockClientMockRecorder) GetVolumeCreationRate(ctx, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeCreationRate", reflect.TypeOf((*MockClient)(nil).GetVolumeCreationRate), ctx, interval)
+}
+
+// GetVolumePerformanceMetrics mocks base method
+func (m *MockClient) GetVolumePerformanceMetrics(ctx context.Context, request *gopowerstore.MetricsRequest) ([]gopowerstore.VolumePerformanceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumePerformanceMetrics", ctx, request)
+	ret0, _ := ret[0].([]gopowerstore.VolumePerformanceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumePerformanceMetrics indicates an expected call of GetVolumePerformanceMetrics
+func (mr *MockClientMockRecorder) GetVolumePerformanceMetrics(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumePerformanceMetrics", reflect.TypeOf((*MockClient)(nil).GetVolumePerformanceMetrics), ctx, request)
+}
+
+// GetVolumeSpaceMetrics mocks base method
+func (m *MockClient) GetVolumeSpaceMetrics(ctx context.Context, volID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.VolumeSpaceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSpaceMetrics", ctx, volID, interval)
+	ret0, _ := ret[0].([]gopowerstore.VolumeSpaceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSpaceMetrics indicates an expected call of GetVolumeSpaceMetrics
+func (mr *MockClientMockRecorder) GetVolumeSpaceMetrics(ctx, volID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSpaceMetrics", reflect.TypeOf((*MockClient)(nil).GetVolumeSpaceMetrics), ctx, volID, interval)
+}
+
+// PerformanceMetricsByVolume mocks base method
+func (m *MockClient) PerformanceMetricsByVolume(ctx context.Context, volID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.VolumePerformanceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PerformanceMetricsByVolume", ctx, volID, interval)
+	ret0, _ := ret[0].([]gopowerstore.VolumePerformanceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PerformanceMetricsByVolume indicates an expected call of PerformanceMetricsByVolume
+func (mr *MockClientMockRecorder) PerformanceMetricsByVolume(ctx, volID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformanceMetricsByVolume", reflect.TypeOf((*MockClient)(nil).PerformanceMetricsByVolume), ctx, volID, interval)
+}
+
+// PerformanceMetricsByAppliance mocks base method
+func (m *MockClient) PerformanceMetricsByAppliance(ctx context.Context, applianceID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.AppliancePerformanceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PerformanceMetricsByAppliance", ctx, applianceID, interval)
+	ret0, _ := ret[0].([]gopowerstore.AppliancePerformanceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PerformanceMetricsByAppliance indicates an expected call of PerformanceMetricsByAppliance
+func (mr *MockClientMockRecorder) PerformanceMetricsByAppliance(ctx, applianceID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformanceMetricsByAppliance", reflect.TypeOf((*MockClient)(nil).PerformanceMetricsByAppliance), ctx, applianceID, interval)
+}
+
+// PerformanceMetricsByNode mocks base method
+func (m *MockClient) PerformanceMetricsByNode(ctx context.Context, nodeID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.NodePerformanceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PerformanceMetricsByNode", ctx, nodeID, interval)
+	ret0, _ := ret[0].([]gopowerstore.NodePerformanceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PerformanceMetricsByNode indicates an expected call of PerformanceMetricsByNode
+func (mr *MockClientMockRecorder) PerformanceMetricsByNode(ctx, nodeID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformanceMetricsByNode", reflect.TypeOf((*MockClient)(nil).PerformanceMetricsByNode), ctx, nodeID, interval)
+}
+
+// SpaceMetricsByVolume mocks base method
+func (m *MockClient) SpaceMetricsByVolume(ctx context.Context, volID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.VolumeSpaceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpaceMetricsByVolume", ctx, volID, interval)
+	ret0, _ := ret[0].([]gopowerstore.VolumeSpaceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpaceMetricsByVolume indicates an expected call of SpaceMetricsByVolume
+func (mr *MockClientMockRecorder) SpaceMetricsByVolume(ctx, volID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpaceMetricsByVolume", reflect.TypeOf((*MockClient)(nil).SpaceMetricsByVolume), ctx, volID, interval)
+}
+
+// SpaceMetricsByCluster mocks base method
+func (m *MockClient) SpaceMetricsByCluster(ctx context.Context, clusterID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.ClusterSpaceMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpaceMetricsByCluster", ctx, clusterID, interval)
+	ret0, _ := ret[0].([]gopowerstore.ClusterSpaceMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpaceMetricsByCluster indicates an expected call of SpaceMetricsByCluster
+func (mr *MockClientMockRecorder) SpaceMetricsByCluster(ctx, clusterID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpaceMetricsByCluster", reflect.TypeOf((*MockClient)(nil).SpaceMetricsByCluster), ctx, clusterID, interval)
+}
+
+// WearMetricsByDrive mocks base method
+func (m *MockClient) WearMetricsByDrive(ctx context.Context, driveID string, interval gopowerstore.MetricsIntervalEnum) ([]gopowerstore.DriveWearMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WearMetricsByDrive", ctx, driveID, interval)
+	ret0, _ := ret[0].([]gopowerstore.DriveWearMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WearMetricsByDrive indicates an expected call of WearMetricsByDrive
+func (mr *MockClientMockRecorder) WearMetricsByDrive(ctx, driveID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WearMetricsByDrive", reflect.TypeOf((*MockClient)(nil).WearMetricsByDrive), ctx, driveID, interval)
+}
+
+// GetHardware mocks base method
+func (m *MockClient) GetHardware(ctx context.Context, id string) (gopowerstore.Hardware, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHardware", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.Hardware)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHardware indicates an expected call of GetHardware
+func (mr *MockClientMockRecorder) GetHardware(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardware", reflect.TypeOf((*MockClient)(nil).GetHardware), ctx, id)
+}
+
+// GetHardwareList mocks base method
+func (m *MockClient) GetHardwareList(ctx context.Context) ([]gopowerstore.Hardware, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHardwareList", ctx)
+	ret0, _ := ret[0].([]gopowerstore.Hardware)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHardwareList indicates an expected call of GetHardwareList
+func (mr *MockClientMockRecorder) GetHardwareList(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareList", reflect.TypeOf((*MockClient)(nil).GetHardwareList), ctx)
+}
+
+// GetHardwareByType mocks base method
+func (m *MockClient) GetHardwareByType(ctx context.Context, hwType gopowerstore.HardwareTypeEnum) ([]gopowerstore.Hardware, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHardwareByType", ctx, hwType)
+	ret0, _ := ret[0].([]gopowerstore.Hardware)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHardwareByType indicates an expected call of GetHardwareByType
+func (mr *MockClientMockRecorder) GetHardwareByType(ctx, hwType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareByType", reflect.TypeOf((*MockClient)(nil).GetHardwareByType), ctx, hwType)
+}
+
+// GetFailedHardware mocks base method
+func (m *MockClient) GetFailedHardware(ctx context.Context) ([]gopowerstore.Hardware, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedHardware", ctx)
+	ret0, _ := ret[0].([]gopowerstore.Hardware)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedHardware indicates an expected call of GetFailedHardware
+func (mr *MockClientMockRecorder) GetFailedHardware(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedHardware", reflect.TypeOf((*MockClient)(nil).GetFailedHardware), ctx)
+}
+
+// GetLatencyHistogramByVolume mocks base method
+func (m *MockClient) GetLatencyHistogramByVolume(ctx context.Context, volID string, interval gopowerstore.MetricsIntervalEnum) (gopowerstore.LatencyHistogram, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatencyHistogramByVolume", ctx, volID, interval)
+	ret0, _ := ret[0].(gopowerstore.LatencyHistogram)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatencyHistogramByVolume indicates an expected call of GetLatencyHistogramByVolume
+func (mr *MockClientMockRecorder) GetLatencyHistogramByVolume(ctx, volID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatencyHistogramByVolume", reflect.TypeOf((*MockClient)(nil).GetLatencyHistogramByVolume), ctx, volID, interval)
+}
+
+// GetLatencyHistogramByAppliance mocks base method
+func (m *MockClient) GetLatencyHistogramByAppliance(ctx context.Context, applianceID string, interval gopowerstore.MetricsIntervalEnum) (gopowerstore.LatencyHistogram, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatencyHistogramByAppliance", ctx, applianceID, interval)
+	ret0, _ := ret[0].(gopowerstore.LatencyHistogram)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatencyHistogramByAppliance indicates an expected call of GetLatencyHistogramByAppliance
+func (mr *MockClientMockRecorder) GetLatencyHistogramByAppliance(ctx, applianceID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatencyHistogramByAppliance", reflect.TypeOf((*MockClient)(nil).GetLatencyHistogramByAppliance), ctx, applianceID, interval)
+}
+
+// GetLatencyHistogramByNode mocks base method
+func (m *MockClient) GetLatencyHistogramByNode(ctx context.Context, nodeID string, interval gopowerstore.MetricsIntervalEnum) (gopowerstore.LatencyHistogram, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatencyHistogramByNode", ctx, nodeID, interval)
+	ret0, _ := ret[0].(gopowerstore.LatencyHistogram)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatencyHistogramByNode indicates an expected call of GetLatencyHistogramByNode
+func (mr *MockClientMockRecorder) GetLatencyHistogramByNode(ctx, nodeID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatencyHistogramByNode", reflect.TypeOf((*MockClient)(nil).GetLatencyHistogramByNode), ctx, nodeID, interval)
+}
+
+// GetCapacityForecast mocks base method
+func (m *MockClient) GetCapacityForecast(ctx context.Context, applianceID string) (gopowerstore.CapacityForecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacityForecast", ctx, applianceID)
+	ret0, _ := ret[0].(gopowerstore.CapacityForecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacityForecast indicates an expected call of GetCapacityForecast
+func (mr *MockClientMockRecorder) GetCapacityForecast(ctx, applianceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacityForecast", reflect.TypeOf((*MockClient)(nil).GetCapacityForecast), ctx, applianceID)
+}
+
+// GetReplicationThroughput mocks base method
+func (m *MockClient) GetReplicationThroughput(ctx context.Context, remoteSystemID string, interval gopowerstore.MetricsIntervalEnum) (gopowerstore.ReplicationThroughput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationThroughput", ctx, remoteSystemID, interval)
+	ret0, _ := ret[0].(gopowerstore.ReplicationThroughput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationThroughput indicates an expected call of GetReplicationThroughput
+func (mr *MockClientMockRecorder) GetReplicationThroughput(ctx, remoteSystemID, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationThroughput", reflect.TypeOf((*MockClient)(nil).GetReplicationThroughput), ctx, remoteSystemID, interval)
+}
+
+// GetLicenses mocks base method
+func (m *MockClient) GetLicenses(ctx context.Context) ([]gopowerstore.License, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLicenses", ctx)
+	ret0, _ := ret[0].([]gopowerstore.License)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLicenses indicates an expected call of GetLicenses
+func (mr *MockClientMockRecorder) GetLicenses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicenses", reflect.TypeOf((*MockClient)(nil).GetLicenses), ctx)
+}
+
+// GetJob mocks base method
+func (m *MockClient) GetJob(ctx context.Context, id string) (gopowerstore.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob
+func (mr *MockClientMockRecorder) GetJob(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockClient)(nil).GetJob), ctx, id)
+}
+
+// WatchJobs mocks base method
+func (m *MockClient) WatchJobs(ctx context.Context, jobIDs []string) <-chan gopowerstore.JobResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchJobs", ctx, jobIDs)
+	ret0, _ := ret[0].(<-chan gopowerstore.JobResult)
+	return ret0
+}
+
+// WatchJobs indicates an expected call of WatchJobs
+func (mr *MockClientMockRecorder) WatchJobs(ctx, jobIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchJobs", reflect.TypeOf((*MockClient)(nil).WatchJobs), ctx, jobIDs)
+}
+
+// WatchVolumes mocks base method
+func (m *MockClient) WatchVolumes(ctx context.Context, interval time.Duration, filter *gopowerstore.QueryFilter) (<-chan gopowerstore.VolumeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchVolumes", ctx, interval, filter)
+	ret0, _ := ret[0].(<-chan gopowerstore.VolumeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchVolumes indicates an expected call of WatchVolumes
+func (mr *MockClientMockRecorder) WatchVolumes(ctx, interval, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchVolumes", reflect.TypeOf((*MockClient)(nil).WatchVolumes), ctx, interval, filter)
+}
+
+// WatchHosts mocks base method
+func (m *MockClient) WatchHosts(ctx context.Context, interval time.Duration) (<-chan gopowerstore.HostEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchHosts", ctx, interval)
+	ret0, _ := ret[0].(<-chan gopowerstore.HostEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchHosts indicates an expected call of WatchHosts
+func (mr *MockClientMockRecorder) WatchHosts(ctx, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchHosts", reflect.TypeOf((*MockClient)(nil).WatchHosts), ctx, interval)
+}
+
+// WaitForJob mocks base method
+func (m *MockClient) WaitForJob(ctx context.Context, id string) (gopowerstore.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForJob", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForJob indicates an expected call of WaitForJob
+func (mr *MockClientMockRecorder) WaitForJob(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForJob", reflect.TypeOf((*MockClient)(nil).WaitForJob), ctx, id)
+}
+
+// WaitForJobCompletion mocks base method
+func (m *MockClient) WaitForJobCompletion(ctx context.Context, id string, pollInterval time.Duration) (gopowerstore.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForJobCompletion", ctx, id, pollInterval)
+	ret0, _ := ret[0].(gopowerstore.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForJobCompletion indicates an expected call of WaitForJobCompletion
+func (mr *MockClientMockRecorder) WaitForJobCompletion(ctx, id, pollInterval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForJobCompletion", reflect.TypeOf((*MockClient)(nil).WaitForJobCompletion), ctx, id, pollInterval)
+}
+
+// GetVolumeGroup mocks base method
+func (m *MockClient) GetVolumeGroup(ctx context.Context, id string) (gopowerstore.VolumeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroup", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.VolumeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroup indicates an expected call of GetVolumeGroup
+func (mr *MockClientMockRecorder) GetVolumeGroup(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroup", reflect.TypeOf((*MockClient)(nil).GetVolumeGroup), ctx, id)
+}
+
+// GetVolumeGroupByName mocks base method
+func (m *MockClient) GetVolumeGroupByName(ctx context.Context, name string) (gopowerstore.VolumeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroupByName", ctx, name)
+	ret0, _ := ret[0].(gopowerstore.VolumeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroupByName indicates an expected call of GetVolumeGroupByName
+func (mr *MockClientMockRecorder) GetVolumeGroupByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroupByName", reflect.TypeOf((*MockClient)(nil).GetVolumeGroupByName), ctx, name)
+}
+
+// GetVolumeGroups mocks base method
+func (m *MockClient) GetVolumeGroups(ctx context.Context) ([]gopowerstore.VolumeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroups", ctx)
+	ret0, _ := ret[0].([]gopowerstore.VolumeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroups indicates an expected call of GetVolumeGroups
+func (mr *MockClientMockRecorder) GetVolumeGroups(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroups", reflect.TypeOf((*MockClient)(nil).GetVolumeGroups), ctx)
+}
+
+// GetVolumeGroupsByVolumeID mocks base method
+func (m *MockClient) GetVolumeGroupsByVolumeID(ctx context.Context, volID string) ([]gopowerstore.VolumeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroupsByVolumeID", ctx, volID)
+	ret0, _ := ret[0].([]gopowerstore.VolumeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroupsByVolumeID indicates an expected call of GetVolumeGroupsByVolumeID
+func (mr *MockClientMockRecorder) GetVolumeGroupsByVolumeID(ctx, volID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroupsByVolumeID", reflect.TypeOf((*MockClient)(nil).GetVolumeGroupsByVolumeID), ctx, volID)
+}
+
+// CreateVolumeGroup mocks base method
+func (m *MockClient) CreateVolumeGroup(ctx context.Context, createParams *gopowerstore.VolumeGroupCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeGroup", ctx, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeGroup indicates an expected call of CreateVolumeGroup
+func (mr *MockClientMockRecorder) CreateVolumeGroup(ctx, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeGroup", reflect.TypeOf((*MockClient)(nil).CreateVolumeGroup), ctx, createParams)
+}
+
+// ModifyVolumeGroup mocks base method
+func (m *MockClient) ModifyVolumeGroup(ctx context.Context, modifyParams *gopowerstore.VolumeGroupModify, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVolumeGroup", ctx, modifyParams, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVolumeGroup indicates an expected call of ModifyVolumeGroup
+func (mr *MockClientMockRecorder) ModifyVolumeGroup(ctx, modifyParams, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolumeGroup", reflect.TypeOf((*MockClient)(nil).ModifyVolumeGroup), ctx, modifyParams, id)
+}
+
+// DeleteVolumeGroup mocks base method
+func (m *MockClient) DeleteVolumeGroup(ctx context.Context, id string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeGroup", ctx, id)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolumeGroup indicates an expected call of DeleteVolumeGroup
+func (mr *MockClientMockRecorder) DeleteVolumeGroup(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeGroup", reflect.TypeOf((*MockClient)(nil).DeleteVolumeGroup), ctx, id)
+}
+
+// AddMembersToVolumeGroup mocks base method
+func (m *MockClient) AddMembersToVolumeGroup(ctx context.Context, groupID string, volumeIDs []string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMembersToVolumeGroup", ctx, groupID, volumeIDs)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMembersToVolumeGroup indicates an expected call of AddMembersToVolumeGroup
+func (mr *MockClientMockRecorder) AddMembersToVolumeGroup(ctx, groupID, volumeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembersToVolumeGroup", reflect.TypeOf((*MockClient)(nil).AddMembersToVolumeGroup), ctx, groupID, volumeIDs)
+}
+
+// RemoveMembersFromVolumeGroup mocks base method
+func (m *MockClient) RemoveMembersFromVolumeGroup(ctx context.Context, groupID string, volumeIDs []string) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMembersFromVolumeGroup", ctx, groupID, volumeIDs)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMembersFromVolumeGroup indicates an expected call of RemoveMembersFromVolumeGroup
+func (mr *MockClientMockRecorder) RemoveMembersFromVolumeGroup(ctx, groupID, volumeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembersFromVolumeGroup", reflect.TypeOf((*MockClient)(nil).RemoveMembersFromVolumeGroup), ctx, groupID, volumeIDs)
+}
+
+// GetVolumeGroupSnapshots mocks base method
+func (m *MockClient) GetVolumeGroupSnapshots(ctx context.Context, groupID string) ([]gopowerstore.VolumeGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroupSnapshots", ctx, groupID)
+	ret0, _ := ret[0].([]gopowerstore.VolumeGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroupSnapshots indicates an expected call of GetVolumeGroupSnapshots
+func (mr *MockClientMockRecorder) GetVolumeGroupSnapshots(ctx, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroupSnapshots", reflect.TypeOf((*MockClient)(nil).GetVolumeGroupSnapshots), ctx, groupID)
+}
+
+// RestoreVolumeGroupFromSnapshot mocks base method
+func (m *MockClient) RestoreVolumeGroupFromSnapshot(ctx context.Context, groupID string, restoreParams *gopowerstore.VolumeGroupRestore) (gopowerstore.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVolumeGroupFromSnapshot", ctx, groupID, restoreParams)
+	ret0, _ := ret[0].(gopowerstore.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVolumeGroupFromSnapshot indicates an expected call of RestoreVolumeGroupFromSnapshot
+func (mr *MockClientMockRecorder) RestoreVolumeGroupFromSnapshot(ctx, groupID, restoreParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeGroupFromSnapshot", reflect.TypeOf((*MockClient)(nil).RestoreVolumeGroupFromSnapshot), ctx, groupID, restoreParams)
+}
+
+// CreateVolumeGroupSnapshot mocks base method
+func (m *MockClient) CreateVolumeGroupSnapshot(ctx context.Context, groupID string, createParams *gopowerstore.VolumeGroupSnapshotCreate) (gopowerstore.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeGroupSnapshot", ctx, groupID, createParams)
+	ret0, _ := ret[0].(gopowerstore.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeGroupSnapshot indicates an expected call of CreateVolumeGroupSnapshot
+func (mr *MockClientMockRecorder) CreateVolumeGroupSnapshot(ctx, groupID, createParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeGroupSnapshot", reflect.TypeOf((*MockClient)(nil).CreateVolumeGroupSnapshot), ctx, groupID, createParams)
+}
+
+// CloneVolumeGroup mocks base method
+func (m *MockClient) CloneVolumeGroup(ctx context.Context, groupID string, cloneParams *gopowerstore.VolumeGroupClone) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneVolumeGroup", ctx, groupID, cloneParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneVolumeGroup indicates an expected call of CloneVolumeGroup
+func (mr *MockClientMockRecorder) CloneVolumeGroup(ctx, groupID, cloneParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneVolumeGroup", reflect.TypeOf((*MockClient)(nil).CloneVolumeGroup), ctx, groupID, cloneParams)
+}
+
+// RefreshVolumeGroup mocks base method
+func (m *MockClient) RefreshVolumeGroup(ctx context.Context, groupID string, refreshParams *gopowerstore.VolumeGroupRefresh) (gopowerstore.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshVolumeGroup", ctx, groupID, refreshParams)
+	ret0, _ := ret[0].(gopowerstore.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshVolumeGroup indicates an expected call of RefreshVolumeGroup
+func (mr *MockClientMockRecorder) RefreshVolumeGroup(ctx, groupID, refreshParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshVolumeGroup", reflect.TypeOf((*MockClient)(nil).RefreshVolumeGroup), ctx, groupID, refreshParams)
+}
+
+// GetVolumeGroupCloneMapping mocks base method
+func (m *MockClient) GetVolumeGroupCloneMapping(ctx context.Context, cloneGroupID string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeGroupCloneMapping", ctx, cloneGroupID)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeGroupCloneMapping indicates an expected call of GetVolumeGroupCloneMapping
+func (mr *MockClientMockRecorder) GetVolumeGroupCloneMapping(ctx, cloneGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeGroupCloneMapping", reflect.TypeOf((*MockClient)(nil).GetVolumeGroupCloneMapping), ctx, cloneGroupID)
+}
+
+// DescribeDeletion mocks base method
+func (m *MockClient) DescribeDeletion(ctx context.Context, resourceType gopowerstore.ResourceTypeEnum, id string) (gopowerstore.DeletionImpact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeletion", ctx, resourceType, id)
+	ret0, _ := ret[0].(gopowerstore.DeletionImpact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeletion indicates an expected call of DescribeDeletion
+func (mr *MockClientMockRecorder) DescribeDeletion(ctx, resourceType, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeletion", reflect.TypeOf((*MockClient)(nil).DescribeDeletion), ctx, resourceType, id)
 }
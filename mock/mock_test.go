@@ -26,6 +26,9 @@ import (
 	"testing"
 )
 
+// generated mock must be regenerated with `make mock-gen` every time Client interface changes
+var _ gopowerstore.Client = (*MockClient)(nil)
+
 type testStruct struct {
 	Client gopowerstore.Client
 }
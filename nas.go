@@ -0,0 +1,92 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const nasURL = "nas_server"
+
+func getNASDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	nas := NAS{}
+	return c.APIClient().QueryParamsWithFields(&nas)
+}
+
+// GetNASServers returns a list of all NAS servers
+func (c *ClientIMPL) GetNASServers(ctx context.Context) ([]NAS, error) {
+	result := []NAS{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []NAS
+		qp := getNASDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    nasURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetNAS query and return specific NAS server by id
+func (c *ClientIMPL) GetNAS(ctx context.Context, id string) (resp NAS, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nasURL,
+			ID:          id,
+			QueryParams: getNASDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetNASByName query and return specific NAS server by name
+func (c *ClientIMPL) GetNASByName(ctx context.Context, name string) (resp NAS, err error) {
+	var nasList []NAS
+	qp := getNASDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nasURL,
+			QueryParams: qp},
+		&nasList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(nasList) != 1 {
+		return resp, notExistError()
+	}
+	return nasList[0], nil
+}
@@ -0,0 +1,75 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const nasMockURL = APIMockURL + nasURL
+
+var nasServerID2 = "5ea3b7cc-f5ad-4a63-a717-4b1d2d9a6b4b"
+
+func TestClientIMPL_GetNASServers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "operational_status": "Started"}, {"id": "%s"}]`, nasServerID, nasServerID2)
+	httpmock.RegisterResponder("GET", nasMockURL,
+		httpmock.NewStringResponder(200, respData))
+	servers, err := C.GetNASServers(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, servers, 2)
+	assert.Equal(t, NASServerOperationalStatusEnumStarted, servers[0].OperationalStatus)
+}
+
+func TestClientIMPL_GetNAS(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "current_node_id": "N1", "file_interfaces": [{"id": "if1",
+"ip_address": "10.0.0.5"}], "nfs_servers": [{"id": "nfs1"}]}`, nasServerID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", nasMockURL, nasServerID),
+		httpmock.NewStringResponder(200, respData))
+	nas, err := C.GetNAS(context.Background(), nasServerID)
+	assert.Nil(t, err)
+	assert.Equal(t, "N1", nas.CurrentNodeID)
+	assert.Equal(t, "10.0.0.5", nas.FileInterfaces[0].IPAddress)
+	assert.Equal(t, "nfs1", nas.NFSServers[0].ID)
+}
+
+func TestClientIMPL_GetNASByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", nasMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "nas1"}]`, nasServerID)))
+	nas, err := C.GetNASByName(context.Background(), "nas1")
+	assert.Nil(t, err)
+	assert.Equal(t, nasServerID, nas.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", nasMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetNASByName(context.Background(), "unknown")
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.NasIsNotExist())
+}
@@ -0,0 +1,65 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// NASServerOperationalStatusEnum operational status of NAS server
+type NASServerOperationalStatusEnum string
+
+const (
+	// NASServerOperationalStatusEnumStopped captures enum value "Stopped"
+	NASServerOperationalStatusEnumStopped NASServerOperationalStatusEnum = "Stopped"
+	// NASServerOperationalStatusEnumStarting captures enum value "Starting"
+	NASServerOperationalStatusEnumStarting NASServerOperationalStatusEnum = "Starting"
+	// NASServerOperationalStatusEnumStarted captures enum value "Started"
+	NASServerOperationalStatusEnumStarted NASServerOperationalStatusEnum = "Started"
+	// NASServerOperationalStatusEnumStopping captures enum value "Stopping"
+	NASServerOperationalStatusEnumStopping NASServerOperationalStatusEnum = "Stopping"
+	// NASServerOperationalStatusEnumFailover captures enum value "Failover"
+	NASServerOperationalStatusEnumFailover NASServerOperationalStatusEnum = "Failover"
+	// NASServerOperationalStatusEnumDegraded captures enum value "Degraded"
+	NASServerOperationalStatusEnumDegraded NASServerOperationalStatusEnum = "Degraded"
+	// NASServerOperationalStatusEnumUnknown captures enum value "Unknown"
+	NASServerOperationalStatusEnumUnknown NASServerOperationalStatusEnum = "Unknown"
+)
+
+// NAS details about NAS server
+type NAS struct {
+	// Unique identifier of the NAS server.
+	ID string `json:"id,omitempty"`
+	// Name of the NAS server.
+	Name string `json:"name,omitempty"`
+	// Description of the NAS server.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the node on which the NAS server is running.
+	CurrentNodeID string `json:"current_node_id,omitempty"`
+	// Operational status of the NAS server.
+	OperationalStatus NASServerOperationalStatusEnum `json:"operational_status,omitempty"`
+	// Unique identifier of the preferred IPv4 production interface.
+	CurrentPreferredIPv4InterfaceID string `json:"current_preferred_IPv4_interface_id,omitempty"`
+	// File interfaces of the NAS server.
+	FileInterfaces []FileInterface `json:"file_interfaces,omitempty"`
+	// NFS servers of the NAS server.
+	NFSServers []NFSServer `json:"nfs_servers,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *NAS) Fields() []string {
+	return []string{"id", "name", "description", "current_node_id", "operational_status",
+		"current_preferred_IPv4_interface_id", "file_interfaces(id,ip_address)", "nfs_servers(id)"}
+}
@@ -0,0 +1,207 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	networkURL    = "network"
+	dnsURL        = "dns"
+	ntpURL        = "ntp"
+	smtpConfigURL = "smtp_config"
+)
+
+func getNetworkDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	network := Network{}
+	return c.APIClient().QueryParamsWithFields(&network)
+}
+
+// GetNetwork query and return specific network by id
+func (c *ClientIMPL) GetNetwork(ctx context.Context, id string) (resp Network, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    networkURL,
+			ID:          id,
+			QueryParams: getNetworkDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetNetworks returns all networks of the cluster
+func (c *ClientIMPL) GetNetworks(ctx context.Context) ([]Network, error) {
+	return c.getNetworks(ctx, nil)
+}
+
+// GetNetworksByType returns networks of the type, e.g. the cluster management network
+func (c *ClientIMPL) GetNetworksByType(ctx context.Context, networkType NetworkTypeEnum) ([]Network, error) {
+	return c.getNetworks(ctx, map[string]string{"type": fmt.Sprintf("eq.%s", networkType)})
+}
+
+func (c *ClientIMPL) getNetworks(ctx context.Context, filters map[string]string) (resp []Network, err error) {
+	qp := getNetworkDefaultQueryParams(c)
+	for k, v := range filters {
+		qp.RawArg(k, v)
+	}
+	qp.Order("id")
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    networkURL,
+			QueryParams: qp},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyNetwork modifies existing network, e.g. changes cluster management address or VLAN
+func (c *ClientIMPL) ModifyNetwork(ctx context.Context,
+	modifyParams *NetworkModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: networkURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetDNS returns DNS servers configuration of the cluster
+func (c *ClientIMPL) GetDNS(ctx context.Context) (resp DNS, err error) {
+	var configList []DNS
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    dnsURL,
+			QueryParams: c.APIClient().QueryParamsWithFields(&resp)},
+		&configList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(configList) != 1 {
+		return resp, notExistError()
+	}
+	return configList[0], nil
+}
+
+// ModifyDNS replaces DNS servers of the cluster, empty list removes all servers
+func (c *ClientIMPL) ModifyDNS(ctx context.Context,
+	addresses []string, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: dnsURL,
+			ID:       id,
+			Body:     &serverAddresses{Addresses: addresses}},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetNTP returns NTP servers configuration of the cluster
+func (c *ClientIMPL) GetNTP(ctx context.Context) (resp NTP, err error) {
+	var configList []NTP
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    ntpURL,
+			QueryParams: c.APIClient().QueryParamsWithFields(&resp)},
+		&configList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(configList) != 1 {
+		return resp, notExistError()
+	}
+	return configList[0], nil
+}
+
+// ModifyNTP replaces NTP servers of the cluster, empty list removes all servers
+func (c *ClientIMPL) ModifyNTP(ctx context.Context,
+	addresses []string, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: ntpURL,
+			ID:       id,
+			Body:     &serverAddresses{Addresses: addresses}},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetSMTPConfig returns SMTP server configuration used for email notifications
+func (c *ClientIMPL) GetSMTPConfig(ctx context.Context) (resp SMTPConfig, err error) {
+	var configList []SMTPConfig
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    smtpConfigURL,
+			QueryParams: c.APIClient().QueryParamsWithFields(&resp)},
+		&configList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(configList) != 1 {
+		return resp, notExistError()
+	}
+	return configList[0], nil
+}
+
+// ModifySMTPConfig modifies SMTP server configuration
+func (c *ClientIMPL) ModifySMTPConfig(ctx context.Context,
+	modifyParams *SMTPConfigModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: smtpConfigURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// TestSMTPConfig sends test email to the recipient using configured SMTP server
+func (c *ClientIMPL) TestSMTPConfig(ctx context.Context, id, recipientEmail string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: smtpConfigURL,
+			ID:       id,
+			Action:   "test",
+			Body:     &smtpConfigTest{RecipientEmail: recipientEmail}},
+		&resp)
+	return resp, WrapErr(err)
+}
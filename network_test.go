@@ -0,0 +1,153 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	networkMockURL    = APIMockURL + networkURL
+	dnsMockURL        = APIMockURL + dnsURL
+	ntpMockURL        = APIMockURL + ntpURL
+	smtpConfigMockURL = APIMockURL + smtpConfigURL
+)
+
+var networkID = "NW1"
+
+func TestClientIMPL_GetNetwork(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "type": "Management", "ip_version": "IPv4", "vlan_id": 10,
+"gateway": "10.0.0.1", "prefix_length": 24, "cluster_mgmt_address": "10.0.0.10"}`, networkID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", networkMockURL, networkID),
+		httpmock.NewStringResponder(200, respData))
+	network, err := C.GetNetwork(context.Background(), networkID)
+	assert.Nil(t, err)
+	assert.Equal(t, NetworkTypeEnumManagement, network.Type)
+	assert.Equal(t, int32(10), network.VlanID)
+	assert.Equal(t, "10.0.0.10", network.ClusterMgmtAddress)
+}
+
+func TestClientIMPL_GetNetworks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter string
+	httpmock.RegisterResponder("GET", networkMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "type": "Management"}]`, networkID)), nil
+		})
+	networks, err := C.GetNetworks(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, networks, 1)
+	assert.Empty(t, typeFilter)
+
+	networks, err = C.GetNetworksByType(context.Background(), NetworkTypeEnumManagement)
+	assert.Nil(t, err)
+	assert.Len(t, networks, 1)
+	assert.Equal(t, "eq.Management", typeFilter)
+}
+
+func TestClientIMPL_ModifyNetwork(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", networkMockURL, networkID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	mtu := int32(9000)
+	_, err := C.ModifyNetwork(context.Background(), &NetworkModify{Mtu: &mtu}, networkID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"mtu": float64(9000)}, reqBody)
+}
+
+func TestClientIMPL_DNSAndNTP(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", dnsMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "0", "addresses": ["10.0.0.2", "10.0.0.3"]}]`))
+	httpmock.RegisterResponder("GET", ntpMockURL,
+		httpmock.NewStringResponder(200, `[{"id": "0", "addresses": ["ntp.example.com"]}]`))
+	var reqBody map[string][]string
+	responder := func(req *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(204, ""), nil
+	}
+	httpmock.RegisterResponder("PATCH", dnsMockURL+"/0", responder)
+	httpmock.RegisterResponder("PATCH", ntpMockURL+"/0", responder)
+
+	dns, err := C.GetDNS(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.0.2", "10.0.0.3"}, dns.Addresses)
+	_, err = C.ModifyDNS(context.Background(), []string{"10.0.0.4"}, dns.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.0.4"}, reqBody["addresses"])
+
+	ntp, err := C.GetNTP(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ntp.example.com"}, ntp.Addresses)
+	_, err = C.ModifyNTP(context.Background(), []string{}, ntp.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, reqBody["addresses"])
+}
+
+func TestClientIMPL_SMTPConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", smtpConfigMockURL,
+		httpmock.NewStringResponder(200,
+			`[{"id": "0", "address": "smtp.example.com", "port": 25, "source_email": "array@example.com"}]`))
+	var reqBody map[string]interface{}
+	responder := func(req *http.Request) (*http.Response, error) {
+		reqBody = nil
+		if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(204, ""), nil
+	}
+	httpmock.RegisterResponder("PATCH", smtpConfigMockURL+"/0", responder)
+	httpmock.RegisterResponder("POST", smtpConfigMockURL+"/0/test", responder)
+
+	smtp, err := C.GetSMTPConfig(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "smtp.example.com", smtp.Address)
+	assert.Equal(t, int32(25), smtp.Port)
+
+	port := int32(587)
+	_, err = C.ModifySMTPConfig(context.Background(), &SMTPConfigModify{Port: &port}, smtp.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"port": float64(587)}, reqBody)
+
+	_, err = C.TestSMTPConfig(context.Background(), smtp.ID, "admin@example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"recipient_email": "admin@example.com"}, reqBody)
+}
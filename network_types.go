@@ -0,0 +1,156 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// NetworkTypeEnum type of the network
+type NetworkTypeEnum string
+
+const (
+	// NetworkTypeEnumManagement captures enum value "Management"
+	NetworkTypeEnumManagement NetworkTypeEnum = "Management"
+	// NetworkTypeEnumIntraClusterManagement captures enum value "Intra_Cluster_Management"
+	NetworkTypeEnumIntraClusterManagement NetworkTypeEnum = "Intra_Cluster_Management"
+	// NetworkTypeEnumIntraClusterData captures enum value "Intra_Cluster_Data"
+	NetworkTypeEnumIntraClusterData NetworkTypeEnum = "Intra_Cluster_Data"
+	// NetworkTypeEnumStorage captures enum value "Storage"
+	NetworkTypeEnumStorage NetworkTypeEnum = "Storage"
+	// NetworkTypeEnumVMotion captures enum value "VMotion"
+	NetworkTypeEnumVMotion NetworkTypeEnum = "VMotion"
+	// NetworkTypeEnumFileMobility captures enum value "File_Mobility"
+	NetworkTypeEnumFileMobility NetworkTypeEnum = "File_Mobility"
+)
+
+// IPVersionEnum version of IP protocol
+type IPVersionEnum string
+
+const (
+	// IPVersionEnumIPv4 captures enum value "IPv4"
+	IPVersionEnumIPv4 IPVersionEnum = "IPv4"
+	// IPVersionEnumIPv6 captures enum value "IPv6"
+	IPVersionEnumIPv6 IPVersionEnum = "IPv6"
+)
+
+// Network network of the cluster
+type Network struct {
+	// Unique identifier of the network.
+	ID string `json:"id,omitempty"`
+	// Name of the network.
+	Name string `json:"name,omitempty"`
+	// Type of the network.
+	Type NetworkTypeEnum `json:"type,omitempty"`
+	// IP version of the network.
+	IPVersion IPVersionEnum `json:"ip_version,omitempty"`
+	// Purposes the network is used for, e.g. Storage_Iscsi_Target.
+	Purposes []string `json:"purposes,omitempty"`
+	// VLAN identifier of the network, 0 if the network is untagged.
+	VlanID int32 `json:"vlan_id,omitempty"`
+	// Gateway of the network.
+	Gateway string `json:"gateway,omitempty"`
+	// Network prefix length.
+	PrefixLength int32 `json:"prefix_length,omitempty"`
+	// Maximum transmission unit of the network.
+	Mtu int32 `json:"mtu,omitempty"`
+	// Cluster management address, set for Management network only.
+	ClusterMgmtAddress string `json:"cluster_mgmt_address,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *Network) Fields() []string {
+	return []string{"id", "name", "type", "ip_version", "purposes", "vlan_id",
+		"gateway", "prefix_length", "mtu", "cluster_mgmt_address"}
+}
+
+// NetworkModify modify network request, unset fields are not changed
+type NetworkModify struct {
+	// Name of the network.
+	Name *string `json:"name,omitempty"`
+	// VLAN identifier of the network.
+	VlanID *int32 `json:"vlan_id,omitempty"`
+	// Gateway of the network.
+	Gateway *string `json:"gateway,omitempty"`
+	// Network prefix length.
+	PrefixLength *int32 `json:"prefix_length,omitempty"`
+	// Maximum transmission unit of the network.
+	Mtu *int32 `json:"mtu,omitempty"`
+	// Cluster management address, applicable to Management network only.
+	ClusterMgmtAddress *string `json:"cluster_mgmt_address,omitempty"`
+}
+
+// DNS DNS servers used by the cluster
+type DNS struct {
+	// Unique identifier of the DNS configuration.
+	ID string `json:"id,omitempty"`
+	// IP addresses of DNS servers.
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (d *DNS) Fields() []string {
+	return []string{"id", "addresses"}
+}
+
+// NTP NTP servers used by the cluster
+type NTP struct {
+	// Unique identifier of the NTP configuration.
+	ID string `json:"id,omitempty"`
+	// IP addresses or FQDNs of NTP servers.
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *NTP) Fields() []string {
+	return []string{"id", "addresses"}
+}
+
+// serverAddresses body of DNS and NTP modify requests, empty list removes all servers
+type serverAddresses struct {
+	Addresses []string `json:"addresses"`
+}
+
+// SMTPConfig SMTP server used for email notifications
+type SMTPConfig struct {
+	// Unique identifier of the SMTP configuration.
+	ID string `json:"id,omitempty"`
+	// IP address or FQDN of SMTP server.
+	Address string `json:"address,omitempty"`
+	// Port of SMTP server.
+	Port int32 `json:"port,omitempty"`
+	// Email address notifications are sent from.
+	SourceEmail string `json:"source_email,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *SMTPConfig) Fields() []string {
+	return []string{"id", "address", "port", "source_email"}
+}
+
+// SMTPConfigModify modify SMTP configuration request, unset fields are not changed
+type SMTPConfigModify struct {
+	// IP address or FQDN of SMTP server, empty string disables email notifications.
+	Address *string `json:"address,omitempty"`
+	// Port of SMTP server.
+	Port *int32 `json:"port,omitempty"`
+	// Email address notifications are sent from.
+	SourceEmail *string `json:"source_email,omitempty"`
+}
+
+// smtpConfigTest body of test request
+type smtpConfigTest struct {
+	RecipientEmail string `json:"recipient_email"`
+}
@@ -0,0 +1,209 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const nfsURL = "nfs_export"
+
+func getNFSExportDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	nfs := NFSExport{}
+	return c.APIClient().QueryParamsWithFields(&nfs)
+}
+
+// GetNFSExport query and return specific NFS export by id
+func (c *ClientIMPL) GetNFSExport(ctx context.Context, id string) (resp NFSExport, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nfsURL,
+			ID:          id,
+			QueryParams: getNFSExportDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetNFSExportByName query and return specific NFS export by name
+func (c *ClientIMPL) GetNFSExportByName(ctx context.Context, name string) (resp NFSExport, err error) {
+	var exportList []NFSExport
+	qp := getNFSExportDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nfsURL,
+			QueryParams: qp},
+		&exportList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(exportList) != 1 {
+		return resp, notExistError()
+	}
+	return exportList[0], nil
+}
+
+// GetNFSExportByFileSystemID query and return NFS export of the file system which has single export.
+// Not found error is returned if the file system has no exports or more than one export,
+// use GetNFSExportsByFileSystemID to get all exports of the file system
+func (c *ClientIMPL) GetNFSExportByFileSystemID(ctx context.Context, fsID string) (resp NFSExport, err error) {
+	var exportList []NFSExport
+	qp := getNFSExportDefaultQueryParams(c)
+	qp.RawArg("file_system_id", fmt.Sprintf("eq.%s", fsID))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nfsURL,
+			QueryParams: qp},
+		&exportList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(exportList) != 1 {
+		return resp, notExistError()
+	}
+	return exportList[0], nil
+}
+
+// GetNFSExportsByFileSystemID returns all NFS exports of the file system
+func (c *ClientIMPL) GetNFSExportsByFileSystemID(ctx context.Context, fsID string) ([]NFSExport, error) {
+	result := []NFSExport{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []NFSExport
+		qp := getNFSExportDefaultQueryParams(c)
+		qp.RawArg("file_system_id", fmt.Sprintf("eq.%s", fsID))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    nfsURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateNFSExport creates new NFS export of the file system path
+func (c *ClientIMPL) CreateNFSExport(ctx context.Context,
+	createParams *NFSExportCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: nfsURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CreateNFSExportOnFsSnapshot creates NFS export of the file system snapshot, exported content is read-only.
+// Only snapshots with Protocol access type can be exported, other file systems are rejected client-side.
+// Name defaults to the snapshot name and path to the snapshot name under the root, e.g. "/daily"
+func (c *ClientIMPL) CreateNFSExportOnFsSnapshot(ctx context.Context,
+	createParams *NFSExportCreate, snapID string) (resp CreateResponse, err error) {
+	snap, err := c.GetFS(ctx, snapID)
+	if err != nil {
+		return resp, err
+	}
+	if snap.FilesystemType != FileSystemTypeEnumSnapshot ||
+		snap.AccessType != FileSystemSnapshotAccessTypeEnumProtocol {
+		return resp, fmt.Errorf("file system %s is not a snapshot with %s access type",
+			snapID, FileSystemSnapshotAccessTypeEnumProtocol)
+	}
+	params := NFSExportCreate{}
+	if createParams != nil {
+		params = *createParams
+	}
+	params.FileSystemID = &snapID
+	if params.Name == nil {
+		params.Name = &snap.Name
+	}
+	if params.Path == nil {
+		path := "/" + snap.Name
+		params.Path = &path
+	}
+	return c.CreateNFSExport(ctx, &params)
+}
+
+// EnsureNFSExport creates the NFS export and returns it. If the create fails because export with the same
+// name exists, the existing export is returned when it exports the requested path of the same file system,
+// otherwise error matched by ErrNameInUse is returned
+func (c *ClientIMPL) EnsureNFSExport(ctx context.Context, createParams *NFSExportCreate) (NFSExport, error) {
+	resp, err := c.CreateNFSExport(ctx, createParams)
+	if err == nil {
+		return c.GetNFSExport(ctx, resp.ID)
+	}
+	if !errors.Is(err, ErrNameInUse) || createParams.Name == nil {
+		return NFSExport{}, err
+	}
+	existing, getErr := c.GetNFSExportByName(ctx, *createParams.Name)
+	if getErr != nil {
+		return NFSExport{}, err
+	}
+	if createParams.FileSystemID != nil && existing.FileSystemID != *createParams.FileSystemID ||
+		createParams.Path != nil && existing.Path != *createParams.Path {
+		return NFSExport{}, fmt.Errorf("%w: NFS export %s exists for path %s of file system %s",
+			ErrNameInUse, existing.Name, existing.Path, existing.FileSystemID)
+	}
+	return existing, nil
+}
+
+// ModifyNFSExport modifies existing NFS export, set host lists replace current lists
+func (c *ClientIMPL) ModifyNFSExport(ctx context.Context,
+	modifyParams *NFSExportModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: nfsURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteNFSExport deletes existing NFS export
+func (c *ClientIMPL) DeleteNFSExport(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: nfsURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,216 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const nfsMockURL = APIMockURL + nfsURL
+
+var nfsID = "5e8d8e8e-671b-336f-db4e-cee0fbdc981f"
+
+func TestClientIMPL_GetNFSExport(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "file_system_id": "%s", "path": "/fs1", "rw_hosts": ["10.0.0.0/24"]}`,
+		nfsID, fsID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", nfsMockURL, nfsID),
+		httpmock.NewStringResponder(200, respData))
+	nfs, err := C.GetNFSExport(context.Background(), nfsID)
+	assert.Nil(t, err)
+	assert.Equal(t, fsID, nfs.FileSystemID)
+	assert.Equal(t, []string{"10.0.0.0/24"}, nfs.RwHosts)
+}
+
+func TestClientIMPL_GetNFSExportByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "export1"}]`, nfsID)))
+	nfs, err := C.GetNFSExportByName(context.Background(), "export1")
+	assert.Nil(t, err)
+	assert.Equal(t, nfsID, nfs.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetNFSExportByName(context.Background(), "export1")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetNFSExportByFileSystemID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var fsFilter string
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			fsFilter = req.URL.Query().Get("file_system_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "file_system_id": "%s"}]`, nfsID, fsID)), nil
+		})
+	nfs, err := C.GetNFSExportByFileSystemID(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Equal(t, nfsID, nfs.ID)
+	assert.Equal(t, "eq."+fsID, fsFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetNFSExportByFileSystemID(context.Background(), fsID)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_CreateNFSExport(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", nfsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, nfsID)), nil
+		})
+	name := "export1"
+	path := "/fs1"
+	roHosts := []string{"10.0.0.0/24"}
+	resp, err := C.CreateNFSExport(context.Background(),
+		&NFSExportCreate{Name: &name, FileSystemID: &fsID, Path: &path, RoHosts: &roHosts})
+	assert.Nil(t, err)
+	assert.Equal(t, nfsID, resp.ID)
+	assert.Equal(t, fsID, reqBody["file_system_id"])
+	assert.Equal(t, path, reqBody["path"])
+	assert.Equal(t, []interface{}{"10.0.0.0/24"}, reqBody["ro_hosts"])
+	assert.NotContains(t, reqBody, "rw_hosts")
+}
+
+func TestClientIMPL_ModifyNFSExport(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", nfsMockURL, nfsID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	rwHosts := []string{"10.0.0.1", "10.0.0.2"}
+	rootHosts := []string{}
+	_, err := C.ModifyNFSExport(context.Background(),
+		&NFSExportModify{RwHosts: &rwHosts, RootHosts: &rootHosts}, nfsID)
+	assert.Nil(t, err)
+	assert.Len(t, reqBody["rw_hosts"], 2)
+	assert.Equal(t, []interface{}{}, reqBody["root_hosts"])
+	assert.NotContains(t, reqBody, "ro_hosts")
+}
+
+func TestClientIMPL_DeleteNFSExport(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", nfsMockURL, nfsID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteNFSExport(context.Background(), nfsID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_EnsureNFSExport(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", nfsMockURL,
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE08010080449"}]}`))
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "export1", "file_system_id": "fs1",
+"path": "/fs1"}]`, nfsID)))
+	name := "export1"
+	fsID := "fs1"
+	path := "/fs1"
+
+	export, err := C.EnsureNFSExport(context.Background(), &NFSExportCreate{Name: &name, FileSystemID: &fsID,
+		Path: &path})
+	assert.Nil(t, err)
+	assert.Equal(t, nfsID, export.ID)
+
+	otherPath := "/fs1/data"
+	_, err = C.EnsureNFSExport(context.Background(), &NFSExportCreate{Name: &name, FileSystemID: &fsID,
+		Path: &otherPath})
+	assert.True(t, errors.Is(err, ErrNameInUse))
+
+	// server error is returned as is, even if export with the name exists
+	httpmock.RegisterResponder("POST", nfsMockURL,
+		httpmock.NewStringResponder(500, `{"messages": [{"code": "0xE04040010001"}]}`))
+	_, err = C.EnsureNFSExport(context.Background(), &NFSExportCreate{Name: &name, FileSystemID: &fsID,
+		Path: &path})
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, err.(APIError).StatusCode)
+}
+
+func TestClientIMPL_GetNFSExportsByFileSystemID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", nfsMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "export-2"}]`, nfsID)))
+	exports, err := C.GetNFSExportsByFileSystemID(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Len(t, exports, 2)
+}
+
+func TestClientIMPL_CreateNFSExportOnFsSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", nfsMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			reqBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, nfsID)), nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsSnapID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "name": "daily", "filesystem_type": "Snapshot",
+"access_type": "Protocol"}`, fsSnapID)))
+	name := "daily-backup"
+	hosts := []string{"10.0.0.5"}
+	resp, err := C.CreateNFSExportOnFsSnapshot(context.Background(),
+		&NFSExportCreate{Name: &name, RoHosts: &hosts}, fsSnapID)
+	assert.Nil(t, err)
+	assert.Equal(t, nfsID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": "daily-backup", "file_system_id": fsSnapID, "path": "/daily",
+		"ro_hosts": []interface{}{"10.0.0.5"}}, reqBody)
+
+	_, err = C.CreateNFSExportOnFsSnapshot(context.Background(), nil, fsSnapID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "daily", "file_system_id": fsSnapID, "path": "/daily"}, reqBody)
+
+	// snapshot accessed through parent file system can't be exported
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", fsMockURL, fsSnapID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "name": "daily", "filesystem_type": "Snapshot",
+"access_type": "Snapshot"}`, fsSnapID)))
+	_, err = C.CreateNFSExportOnFsSnapshot(context.Background(), &NFSExportCreate{Name: &name}, fsSnapID)
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["POST "+nfsMockURL])
+}
@@ -0,0 +1,96 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// NFSExportDefaultAccessEnum access level of hosts which are not in any host list of the export
+type NFSExportDefaultAccessEnum string
+
+const (
+	// NFSExportDefaultAccessEnumNoAccess captures enum value "No_Access"
+	NFSExportDefaultAccessEnumNoAccess NFSExportDefaultAccessEnum = "No_Access"
+	// NFSExportDefaultAccessEnumReadOnly captures enum value "Read_Only"
+	NFSExportDefaultAccessEnumReadOnly NFSExportDefaultAccessEnum = "Read_Only"
+	// NFSExportDefaultAccessEnumReadWrite captures enum value "Read_Write"
+	NFSExportDefaultAccessEnumReadWrite NFSExportDefaultAccessEnum = "Read_Write"
+	// NFSExportDefaultAccessEnumRoot captures enum value "Root"
+	NFSExportDefaultAccessEnumRoot NFSExportDefaultAccessEnum = "Root"
+)
+
+// NFSExportCreate create NFS export request
+type NFSExportCreate struct {
+	// Name of the NFS export, unique on the NAS server.
+	Name *string `json:"name"`
+	// Description of the NFS export.
+	Description *string `json:"description,omitempty"`
+	// Unique identifier of the file system on which the export is created.
+	FileSystemID *string `json:"file_system_id"`
+	// Local path to export relative to the file system root, e.g. "/fs_name".
+	Path *string `json:"path"`
+	// Access level of hosts which are not in any host list.
+	DefaultAccess *NFSExportDefaultAccessEnum `json:"default_access,omitempty"`
+	// Hosts with read-only access.
+	RoHosts *[]string `json:"ro_hosts,omitempty"`
+	// Hosts with read-write access.
+	RwHosts *[]string `json:"rw_hosts,omitempty"`
+	// Hosts with read-write and root access.
+	RootHosts *[]string `json:"root_hosts,omitempty"`
+}
+
+// NFSExportModify modify NFS export request, unset fields are not changed.
+// Host lists contain IP addresses, subnets, netgroups or host names
+type NFSExportModify struct {
+	// Description of the NFS export.
+	Description *string `json:"description,omitempty"`
+	// Access level of hosts which are not in any host list.
+	DefaultAccess *NFSExportDefaultAccessEnum `json:"default_access,omitempty"`
+	// Hosts with read-only access.
+	RoHosts *[]string `json:"ro_hosts,omitempty"`
+	// Hosts with read-write access.
+	RwHosts *[]string `json:"rw_hosts,omitempty"`
+	// Hosts with read-write and root access.
+	RootHosts *[]string `json:"root_hosts,omitempty"`
+}
+
+// NFSExport details about NFS export
+type NFSExport struct {
+	// Unique identifier of the NFS export.
+	ID string `json:"id,omitempty"`
+	// Name of the NFS export.
+	Name string `json:"name,omitempty"`
+	// Description of the NFS export.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the exported file system.
+	FileSystemID string `json:"file_system_id,omitempty"`
+	// Local path of the export relative to the file system root.
+	Path string `json:"path,omitempty"`
+	// Access level of hosts which are not in any host list.
+	DefaultAccess NFSExportDefaultAccessEnum `json:"default_access,omitempty"`
+	// Hosts with read-only access.
+	RoHosts []string `json:"ro_hosts,omitempty"`
+	// Hosts with read-write access.
+	RwHosts []string `json:"rw_hosts,omitempty"`
+	// Hosts with read-write and root access.
+	RootHosts []string `json:"root_hosts,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *NFSExport) Fields() []string {
+	return []string{"id", "name", "description", "file_system_id", "path",
+		"default_access", "ro_hosts", "rw_hosts", "root_hosts"}
+}
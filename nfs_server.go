@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const nfsServerURL = "nfs_server"
+
+func getNFSServerDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	server := NFSServer{}
+	return c.APIClient().QueryParamsWithFields(&server)
+}
+
+// GetNFSServer query and return specific NFS server by id
+func (c *ClientIMPL) GetNFSServer(ctx context.Context, id string) (resp NFSServer, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nfsServerURL,
+			ID:          id,
+			QueryParams: getNFSServerDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetNFSServerByNASServerID returns NFS server of NAS server, NAS server has at most one NFS server
+func (c *ClientIMPL) GetNFSServerByNASServerID(ctx context.Context, nasServerID string) (resp NFSServer, err error) {
+	var serverList []NFSServer
+	qp := getNFSServerDefaultQueryParams(c)
+	qp.RawArg("nas_server_id", fmt.Sprintf("eq.%s", nasServerID))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    nfsServerURL,
+			QueryParams: qp},
+		&serverList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(serverList) != 1 {
+		return resp, notExistError()
+	}
+	return serverList[0], nil
+}
+
+// CreateNFSServer creates NFS server for NAS server
+func (c *ClientIMPL) CreateNFSServer(ctx context.Context,
+	createParams *NFSServerCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: nfsServerURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyNFSServer modifies existing NFS server
+func (c *ClientIMPL) ModifyNFSServer(ctx context.Context,
+	modifyParams *NFSServerModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: nfsServerURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteNFSServer deletes existing NFS server, NFS exports of the NAS server become unavailable
+func (c *ClientIMPL) DeleteNFSServer(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: nfsServerURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
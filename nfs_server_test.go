@@ -0,0 +1,101 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	nfsServerMockURL = APIMockURL + nfsServerURL
+	nfsServerID      = "5e8d8f3b-9a5c-1f6e-8a3b-cee0fbdc981e"
+)
+
+func TestClientIMPL_GetNFSServer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", nfsServerMockURL, nfsServerID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "is_nfsv4_enabled": true}`, nfsServerID)))
+	resp, err := C.GetNFSServer(context.Background(), nfsServerID)
+	assert.Nil(t, err)
+	assert.Equal(t, nfsServerID, resp.ID)
+	assert.True(t, resp.IsNFSv4Enabled)
+}
+
+func TestClientIMPL_GetNFSServerByNASServerID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var nasFilter string
+	httpmock.RegisterResponder("GET", nfsServerMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			nasFilter = req.URL.Query().Get("nas_server_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, nfsServerID)), nil
+		})
+	resp, err := C.GetNFSServerByNASServerID(context.Background(), nasServerID)
+	assert.Nil(t, err)
+	assert.Equal(t, nfsServerID, resp.ID)
+	assert.Equal(t, fmt.Sprintf("eq.%s", nasServerID), nasFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", nfsServerMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetNFSServerByNASServerID(context.Background(), nasServerID)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.NotFound())
+}
+
+func TestClientIMPL_CreateNFSServer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", nfsServerMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, nfsServerID)), nil
+		})
+	enabled := true
+	resp, err := C.CreateNFSServer(context.Background(),
+		&NFSServerCreate{NasServerID: nasServerID, IsNFSv3Enabled: &enabled})
+	assert.Nil(t, err)
+	assert.Equal(t, nfsServerID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"nas_server_id": nasServerID, "is_nfsv3_enabled": true}, reqBody)
+}
+
+func TestClientIMPL_ModifyDeleteNFSServer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", nfsServerMockURL, nfsServerID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", nfsServerMockURL, nfsServerID),
+		httpmock.NewStringResponder(204, ""))
+	enabled := true
+	_, err := C.ModifyNFSServer(context.Background(), &NFSServerModify{IsNFSv4Enabled: &enabled}, nfsServerID)
+	assert.Nil(t, err)
+	_, err = C.DeleteNFSServer(context.Background(), nfsServerID)
+	assert.Nil(t, err)
+}
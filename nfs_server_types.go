@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// NFSServerCreate create NFS server params
+type NFSServerCreate struct {
+	// Unique identifier of the NAS server the NFS server is created on.
+	NasServerID string `json:"nas_server_id"`
+	// Host name of the NFS server, required for secure NFS.
+	HostName *string `json:"host_name,omitempty"`
+	// Indicates whether NFSv3 is enabled.
+	IsNFSv3Enabled *bool `json:"is_nfsv3_enabled,omitempty"`
+	// Indicates whether NFSv4 is enabled.
+	IsNFSv4Enabled *bool `json:"is_nfsv4_enabled,omitempty"`
+	// Indicates whether secure NFS with Kerberos is enabled.
+	IsSecureEnabled *bool `json:"is_secure_enabled,omitempty"`
+	// Indicates whether the Kerberos configuration of the SMB server is used for secure NFS.
+	IsUseSMBConfigEnabled *bool `json:"is_use_smb_config_enabled,omitempty"`
+	// Indicates whether NFS server supports more than 16 groups in UNIX credentials.
+	IsExtendedCredentialsEnabled *bool `json:"is_extended_credentials_enabled,omitempty"`
+}
+
+// NFSServerModify modify NFS server params, unset fields are not changed
+type NFSServerModify struct {
+	// Host name of the NFS server.
+	HostName *string `json:"host_name,omitempty"`
+	// Indicates whether NFSv3 is enabled.
+	IsNFSv3Enabled *bool `json:"is_nfsv3_enabled,omitempty"`
+	// Indicates whether NFSv4 is enabled.
+	IsNFSv4Enabled *bool `json:"is_nfsv4_enabled,omitempty"`
+	// Indicates whether secure NFS with Kerberos is enabled.
+	IsSecureEnabled *bool `json:"is_secure_enabled,omitempty"`
+	// Indicates whether the Kerberos configuration of the SMB server is used for secure NFS.
+	IsUseSMBConfigEnabled *bool `json:"is_use_smb_config_enabled,omitempty"`
+	// Indicates whether NFS server supports more than 16 groups in UNIX credentials.
+	IsExtendedCredentialsEnabled *bool `json:"is_extended_credentials_enabled,omitempty"`
+}
+
+// NFSServer NFS server configured on NAS server
+type NFSServer struct {
+	// Unique identifier of the NFS server.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the NAS server the NFS server belongs to.
+	NasServerID string `json:"nas_server_id,omitempty"`
+	// Host name of the NFS server.
+	HostName string `json:"host_name,omitempty"`
+	// Indicates whether NFSv3 is enabled.
+	IsNFSv3Enabled bool `json:"is_nfsv3_enabled,omitempty"`
+	// Indicates whether NFSv4 is enabled.
+	IsNFSv4Enabled bool `json:"is_nfsv4_enabled,omitempty"`
+	// Indicates whether secure NFS with Kerberos is enabled.
+	IsSecureEnabled bool `json:"is_secure_enabled,omitempty"`
+	// Indicates whether the Kerberos configuration of the SMB server is used for secure NFS.
+	IsUseSMBConfigEnabled bool `json:"is_use_smb_config_enabled,omitempty"`
+	// Indicates whether NFS server supports more than 16 groups in UNIX credentials.
+	IsExtendedCredentialsEnabled bool `json:"is_extended_credentials_enabled,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *NFSServer) Fields() []string {
+	return []string{"id", "nas_server_id", "host_name", "is_nfsv3_enabled", "is_nfsv4_enabled",
+		"is_secure_enabled", "is_use_smb_config_enabled", "is_extended_credentials_enabled"}
+}
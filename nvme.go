@@ -0,0 +1,76 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+// GetNVMeSubsystem returns NVMe subsystems of the cluster
+func (c *ClientIMPL) GetNVMeSubsystem(ctx context.Context) (resp []NVMeSubsystem, err error) {
+	var subsystem NVMeSubsystem
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    clusterURL,
+			QueryParams: c.APIClient().QueryParamsWithFields(&subsystem)},
+		&resp)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(resp) == 0 {
+		return resp, errors.New("can't get NVMe subsystem")
+	}
+	return resp, nil
+}
+
+// GetNVMeNamespaces returns NVMe namespaces of volumes. Optional filter allows to narrow
+// the list with additional query params, e.g. RawArg("appliance_id", "eq.A1").
+// Filter created with APIClient().QueryParams() is not changed
+func (c *ClientIMPL) GetNVMeNamespaces(ctx context.Context,
+	filter api.QueryParamsEncoder) (resp []NVMeNamespace, err error) {
+	var namespace NVMeNamespace
+	qp := copyFilter(c, filter)
+	qp.Select(namespace.Fields()...)
+	qp.RawArg("type", fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot))
+	qp.Order("nsid")
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []NVMeNamespace
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	return resp, err
+}
@@ -0,0 +1,69 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestClientIMPL_GetNVMeSubsystem(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	nqn := "nqn.1988-11.com.dell:powerstore:00:a1b2c3d4e5f6"
+	respData := fmt.Sprintf(`[{"id": "0", "name": "cluster", "nvm_subsystem_nqn": "%s"}]`, nqn)
+	httpmock.RegisterResponder("GET", clusterMockURL,
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetNVMeSubsystem(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, resp, 1)
+	assert.Equal(t, nqn, resp[0].NQN)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", clusterMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetNVMeSubsystem(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetNVMeNamespaces(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "nsid": 1}, {"id": "%s", "nsid": 2}]`, volID, volID2)
+	var applianceFilter string
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			applianceFilter = req.URL.Query().Get("appliance_id")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	filter := C.APIClient().QueryParams().RawArg("appliance_id", "eq.A1")
+	resp, err := C.GetNVMeNamespaces(context.Background(), filter)
+	assert.Nil(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, int64(2), resp[1].NSID)
+	assert.Equal(t, "eq.A1", applianceFilter)
+	assert.Equal(t, "appliance_id=eq.A1", filter.Encode())
+
+	_, err = C.GetNVMeNamespaces(context.Background(), nil)
+	assert.Nil(t, err)
+}
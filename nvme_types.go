@@ -0,0 +1,53 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// NVMeSubsystem NVMe subsystem exposed by the cluster
+type NVMeSubsystem struct {
+	// Unique identifier of the cluster.
+	ClusterID string `json:"id"`
+	// Name of the cluster.
+	ClusterName string `json:"name"`
+	// NVMe qualified name of the subsystem. Hosts use it to connect over NVMe-oF.
+	NQN string `json:"nvm_subsystem_nqn"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *NVMeSubsystem) Fields() []string {
+	return []string{"id", "name", "nvm_subsystem_nqn"}
+}
+
+// NVMeNamespace NVMe namespace which exposes a volume
+type NVMeNamespace struct {
+	// Unique identifier of the volume.
+	VolumeID string `json:"id"`
+	// Name of the volume.
+	VolumeName string `json:"name"`
+	// Unique identifier of the appliance on which the volume is provisioned.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// NVMe namespace ID of the volume.
+	NSID int64 `json:"nsid"`
+	// NVMe namespace globally unique identifier of the volume.
+	NGUID string `json:"nguid,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (n *NVMeNamespace) Fields() []string {
+	return []string{"id", "name", "appliance_id", "nsid", "nguid"}
+}
@@ -0,0 +1,108 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const policyURL = "policy"
+
+func getProtectionPolicyDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	policy := ProtectionPolicy{}
+	return c.APIClient().QueryParamsWithFields(&policy)
+}
+
+// GetProtectionPolicy query and return specific protection policy by id
+func (c *ClientIMPL) GetProtectionPolicy(ctx context.Context, id string) (resp ProtectionPolicy, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    policyURL,
+			ID:          id,
+			QueryParams: getProtectionPolicyDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetProtectionPolicyByName query and return specific protection policy by name
+func (c *ClientIMPL) GetProtectionPolicyByName(ctx context.Context, name string) (resp ProtectionPolicy, err error) {
+	var policyList []ProtectionPolicy
+	qp := getProtectionPolicyDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	qp.RawArg("type", fmt.Sprintf("eq.%s", PolicyTypeEnumProtection))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    policyURL,
+			QueryParams: qp},
+		&policyList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(policyList) != 1 {
+		return resp, notExistError()
+	}
+	return policyList[0], nil
+}
+
+// CreateProtectionPolicy creates new protection policy from existing snapshot and replication rules
+func (c *ClientIMPL) CreateProtectionPolicy(ctx context.Context,
+	createParams *ProtectionPolicyCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: policyURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyProtectionPolicy modifies existing protection policy, rules are added and removed by id
+func (c *ClientIMPL) ModifyProtectionPolicy(ctx context.Context,
+	modifyParams *ProtectionPolicyModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: policyURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteProtectionPolicy deletes existing protection policy, rules of the policy are not deleted
+func (c *ClientIMPL) DeleteProtectionPolicy(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: policyURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,117 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const policyMockURL = APIMockURL + policyURL
+
+var protectionPolicyID = "3c1a8e9f-4a2b-4c6d-8e0f-5b7a9c1d3e5f"
+
+func TestClientIMPL_GetProtectionPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "snapshot_rules": [{"id": "%s"}]}`, protectionPolicyID, snapshotRuleID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", policyMockURL, protectionPolicyID),
+		httpmock.NewStringResponder(200, respData))
+	policy, err := C.GetProtectionPolicy(context.Background(), protectionPolicyID)
+	assert.Nil(t, err)
+	assert.Equal(t, protectionPolicyID, policy.ID)
+	assert.Equal(t, snapshotRuleID, policy.SnapshotRules[0].ID)
+}
+
+func TestClientIMPL_GetProtectionPolicyByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var nameFilter string
+	httpmock.RegisterResponder("GET", policyMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			nameFilter = req.URL.Query().Get("name")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, protectionPolicyID)), nil
+		})
+	policy, err := C.GetProtectionPolicyByName(context.Background(), "gold")
+	assert.Nil(t, err)
+	assert.Equal(t, protectionPolicyID, policy.ID)
+	assert.Equal(t, "eq.gold", nameFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", policyMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetProtectionPolicyByName(context.Background(), "gold")
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.Equal(t, http.StatusNotFound, apiError.StatusCode)
+}
+
+func TestClientIMPL_CreateProtectionPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", policyMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, protectionPolicyID)), nil
+		})
+	name := "gold"
+	resp, err := C.CreateProtectionPolicy(context.Background(),
+		&ProtectionPolicyCreate{Name: &name, SnapshotRuleIDs: []string{snapshotRuleID}})
+	assert.Nil(t, err)
+	assert.Equal(t, protectionPolicyID, resp.ID)
+	assert.Len(t, reqBody["snapshot_rule_ids"], 1)
+	assert.NotContains(t, reqBody, "replication_rule_ids")
+}
+
+func TestClientIMPL_ModifyProtectionPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", policyMockURL, protectionPolicyID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	_, err := C.ModifyProtectionPolicy(context.Background(), &ProtectionPolicyModify{
+		AddReplicationRuleIDs: []string{replicationRuleID},
+		RemoveSnapshotRuleIDs: []string{snapshotRuleID}}, protectionPolicyID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"add_replication_rule_ids": []interface{}{replicationRuleID},
+		"remove_snapshot_rule_ids": []interface{}{snapshotRuleID}}, reqBody)
+}
+
+func TestClientIMPL_DeleteProtectionPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", policyMockURL, protectionPolicyID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteProtectionPolicy(context.Background(), protectionPolicyID)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,80 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// PolicyTypeEnum type of the policy
+type PolicyTypeEnum string
+
+const (
+	// PolicyTypeEnumProtection captures enum value "Protection"
+	PolicyTypeEnumProtection PolicyTypeEnum = "Protection"
+	// PolicyTypeEnumQoS captures enum value "QoS"
+	PolicyTypeEnumQoS PolicyTypeEnum = "QoS"
+)
+
+// ProtectionPolicyCreate create protection policy request
+type ProtectionPolicyCreate struct {
+	// Name of the protection policy.
+	Name *string `json:"name"`
+	// Description of the protection policy.
+	Description *string `json:"description,omitempty"`
+	// Snapshot rules of the protection policy.
+	SnapshotRuleIDs []string `json:"snapshot_rule_ids,omitempty"`
+	// Replication rules of the protection policy.
+	ReplicationRuleIDs []string `json:"replication_rule_ids,omitempty"`
+}
+
+// ProtectionPolicyModify modify protection policy request, unset fields are not changed
+type ProtectionPolicyModify struct {
+	// Name of the protection policy.
+	Name *string `json:"name,omitempty"`
+	// Description of the protection policy.
+	Description *string `json:"description,omitempty"`
+	// Snapshot rules to add to the protection policy.
+	AddSnapshotRuleIDs []string `json:"add_snapshot_rule_ids,omitempty"`
+	// Snapshot rules to remove from the protection policy.
+	RemoveSnapshotRuleIDs []string `json:"remove_snapshot_rule_ids,omitempty"`
+	// Replication rules to add to the protection policy.
+	AddReplicationRuleIDs []string `json:"add_replication_rule_ids,omitempty"`
+	// Replication rules to remove from the protection policy.
+	RemoveReplicationRuleIDs []string `json:"remove_replication_rule_ids,omitempty"`
+}
+
+// ProtectionPolicy details about a protection policy
+type ProtectionPolicy struct {
+	// Unique identifier of the protection policy.
+	ID string `json:"id,omitempty"`
+	// Name of the protection policy.
+	Name string `json:"name,omitempty"`
+	// Description of the protection policy.
+	Description string `json:"description,omitempty"`
+	// Type of the policy.
+	Type PolicyTypeEnum `json:"type,omitempty"`
+	// Snapshot rules of the protection policy.
+	SnapshotRules []SnapshotRule `json:"snapshot_rules,omitempty"`
+	// Replication rules of the protection policy.
+	ReplicationRules []ReplicationRule `json:"replication_rules,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (p *ProtectionPolicy) Fields() []string {
+	return []string{"id", "name", "description", "type",
+		"snapshot_rules(id,name,interval,time_of_day,days_of_week,desired_retention)",
+		"replication_rules(id,name)"}
+}
@@ -0,0 +1,180 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+// FilterOperatorEnum comparison operator of QueryFilter condition
+type FilterOperatorEnum string
+
+const (
+	// FilterOperatorEnumEq - field is equal to the value
+	FilterOperatorEnumEq FilterOperatorEnum = "eq"
+	// FilterOperatorEnumNeq - field is not equal to the value
+	FilterOperatorEnumNeq FilterOperatorEnum = "neq"
+	// FilterOperatorEnumGt - field is greater than the value
+	FilterOperatorEnumGt FilterOperatorEnum = "gt"
+	// FilterOperatorEnumGte - field is greater than or equal to the value
+	FilterOperatorEnumGte FilterOperatorEnum = "gte"
+	// FilterOperatorEnumLt - field is less than the value
+	FilterOperatorEnumLt FilterOperatorEnum = "lt"
+	// FilterOperatorEnumLte - field is less than or equal to the value
+	FilterOperatorEnumLte FilterOperatorEnum = "lte"
+	// FilterOperatorEnumIn - field is equal to one of the values
+	FilterOperatorEnumIn FilterOperatorEnum = "in"
+	// FilterOperatorEnumIlike - field matches case-insensitive pattern, * matches any sequence of characters
+	FilterOperatorEnumIlike FilterOperatorEnum = "ilike"
+)
+
+type queryFilterContextKey struct{}
+
+// QueryFilter narrows results of list requests, e.g. GetVolumesFiltered.
+// Unset parts of the filter keep default behavior of the list request.
+// Filter is not safe for concurrent modification
+type QueryFilter struct {
+	fields     []string
+	conditions map[string]string
+	order      []string
+	limit      int
+	offset     int
+	err        error
+}
+
+// NewQueryFilter returns pointer to a new empty QueryFilter
+func NewQueryFilter() *QueryFilter {
+	return &QueryFilter{}
+}
+
+// Select sets fields returned by the array instead of the default field set,
+// fields which are not selected stay empty in the result
+func (f *QueryFilter) Select(fields ...string) *QueryFilter {
+	f.fields = append(f.fields, fields...)
+	return f
+}
+
+// Where adds condition on the field, e.g. Where("size", FilterOperatorEnumGt, "1048576").
+// FilterOperatorEnumIn accepts any number of values, other operators accept exactly one.
+// Only one condition can be set per field, a later condition replaces the earlier one
+// and conditions replace default filters of the list request on the same field
+func (f *QueryFilter) Where(field string, op FilterOperatorEnum, values ...string) *QueryFilter {
+	var condition string
+	switch op {
+	case FilterOperatorEnumIn:
+		if len(values) == 0 {
+			f.err = fmt.Errorf("no values for %s condition on %s", op, field)
+			return f
+		}
+		quoted := make([]string, len(values))
+		for i, v := range values {
+			quoted[i] = quoteFilterValue(v)
+		}
+		condition = fmt.Sprintf("%s.(%s)", op, strings.Join(quoted, ","))
+	case FilterOperatorEnumEq, FilterOperatorEnumNeq, FilterOperatorEnumGt,
+		FilterOperatorEnumGte, FilterOperatorEnumLt, FilterOperatorEnumLte, FilterOperatorEnumIlike:
+		if len(values) != 1 {
+			f.err = fmt.Errorf("%s condition on %s requires exactly one value", op, field)
+			return f
+		}
+		condition = fmt.Sprintf("%s.%s", op, values[0])
+	default:
+		f.err = fmt.Errorf("unsupported filter operator: %s", op)
+		return f
+	}
+	if f.conditions == nil {
+		f.conditions = make(map[string]string)
+	}
+	f.conditions[field] = condition
+	return f
+}
+
+// OrderBy sets sort order instead of the default one, e.g. OrderBy("size.desc", "name")
+func (f *QueryFilter) OrderBy(fields ...string) *QueryFilter {
+	f.order = append(f.order, fields...)
+	return f
+}
+
+// Limit sets maximum number of returned items, only one page is requested when limit is set
+func (f *QueryFilter) Limit(value int) *QueryFilter {
+	f.limit = value
+	return f
+}
+
+// Offset sets number of skipped items
+func (f *QueryFilter) Offset(value int) *QueryFilter {
+	f.offset = value
+	return f
+}
+
+// WithQueryFilter returns copy of the context carrying the filter. List requests which support filtering,
+// e.g. GetVolumes and GetSnapshots, apply the filter from the context as if it was passed to
+// GetVolumesFiltered or GetSnapshotsFiltered
+func WithQueryFilter(ctx context.Context, filter *QueryFilter) context.Context {
+	return context.WithValue(ctx, queryFilterContextKey{}, filter)
+}
+
+// queryFilterFromContext returns filter attached to the context by WithQueryFilter, nil if there is none
+func queryFilterFromContext(ctx context.Context) *QueryFilter {
+	filter, _ := ctx.Value(queryFilterContextKey{}).(*QueryFilter)
+	return filter
+}
+
+// quoteFilterValue quotes value of in list if it contains reserved characters
+func quoteFilterValue(value string) string {
+	if !strings.ContainsAny(value, `,()"\ `) {
+		return value
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func (f *QueryFilter) validate() error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.offset < 0 || f.limit < 0 || f.limit > paginationDefaultPageSize {
+		return fmt.Errorf("invalid filter: offset must be non-negative and limit in range 0-%d",
+			paginationDefaultPageSize)
+	}
+	return nil
+}
+
+// queryParams returns query params with selected or default fields
+func (f *QueryFilter) queryParams(c Client, provider api.FieldProvider) api.QueryParamsEncoder {
+	if len(f.fields) > 0 {
+		return c.APIClient().QueryParams().Select(f.fields...)
+	}
+	return c.APIClient().QueryParamsWithFields(provider)
+}
+
+// applyTo adds conditions and order to query params, defaultOrder is used if order is not set
+func (f *QueryFilter) applyTo(qp api.QueryParamsEncoder, defaultOrder ...string) {
+	for field, condition := range f.conditions {
+		qp.RawArg(field, condition)
+	}
+	if len(f.order) > 0 {
+		qp.Order(f.order...)
+	} else {
+		qp.Order(defaultOrder...)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func encodeFilter(filter *QueryFilter) string {
+	qp := filter.queryParams(C, &Volume{})
+	filter.applyTo(qp, "name")
+	return qp.Encode()
+}
+
+func TestQueryFilter_Encode(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *QueryFilter
+		query  string
+	}{
+		{"eq", NewQueryFilter().Select("id").Where("name", FilterOperatorEnumEq, "vol1"),
+			"name=eq.vol1&order=name&select=id"},
+		{"neq", NewQueryFilter().Select("id").Where("state", FilterOperatorEnumNeq, "Ready"),
+			"order=name&select=id&state=neq.Ready"},
+		{"gt and lt", NewQueryFilter().Select("id", "size").
+			Where("size", FilterOperatorEnumGt, "1048576").
+			Where("creation_timestamp", FilterOperatorEnumLt, "2020-05-06T10:00:00+00:00"),
+			"creation_timestamp=lt.2020-05-06T10%3A00%3A00%2B00%3A00&order=name&select=id%2Csize&size=gt.1048576"},
+		{"in", NewQueryFilter().Select("id").Where("id", FilterOperatorEnumIn, "a", "b", "c"),
+			"id=in.%28a%2Cb%2Cc%29&order=name&select=id"},
+		{"in with reserved characters", NewQueryFilter().Select("id").
+			Where("name", FilterOperatorEnumIn, "a,b", `say "hi"`, "c&d"),
+			"name=in.%28%22a%2Cb%22%2C%22say+%5C%22hi%5C%22%22%2Cc%26d%29&order=name&select=id"},
+		{"ilike", NewQueryFilter().Select("id").Where("name", FilterOperatorEnumIlike, "*DB*"),
+			"name=ilike.%2ADB%2A&order=name&select=id"},
+		{"order", NewQueryFilter().Select("id").OrderBy("size.desc", "name"),
+			"order=size.desc%2Cname&select=id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Nil(t, tt.filter.validate())
+			assert.Equal(t, tt.query, encodeFilter(tt.filter))
+		})
+	}
+}
+
+func TestQueryFilter_Invalid(t *testing.T) {
+	assert.NotNil(t, NewQueryFilter().Where("id", FilterOperatorEnumIn).validate())
+	assert.NotNil(t, NewQueryFilter().Where("id", FilterOperatorEnumEq, "a", "b").validate())
+	assert.NotNil(t, NewQueryFilter().Where("id", "like", "a").validate())
+	assert.NotNil(t, NewQueryFilter().Limit(paginationDefaultPageSize+1).validate())
+	assert.NotNil(t, NewQueryFilter().Offset(-1).validate())
+	_, err := C.GetVolumesFiltered(context.Background(), NewQueryFilter().Offset(-1))
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetVolumesFiltered(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var queries []url.Values
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			queries = append(queries, req.URL.Query())
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, volID)), nil
+		})
+
+	// nil filter keeps default query
+	_, err := C.GetVolumes(context.Background())
+	assert.Nil(t, err)
+	_, err = C.GetVolumesFiltered(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, queries[0], queries[1])
+
+	vols, err := C.GetVolumesFiltered(context.Background(),
+		NewQueryFilter().Select("id", "name").Where("size", FilterOperatorEnumGte, "1048576").
+			Limit(10).Offset(20))
+	assert.Nil(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, "id,name", queries[2].Get("select"))
+	assert.Equal(t, "gte.1048576", queries[2].Get("size"))
+	assert.Equal(t, "not.eq.Snapshot", queries[2].Get("type"))
+	assert.Equal(t, "10", queries[2].Get("limit"))
+	assert.Equal(t, "20", queries[2].Get("offset"))
+
+	_, err = C.GetSnapshotsFiltered(context.Background(),
+		NewQueryFilter().Where("protection_data->>source_id", FilterOperatorEnumIn, volID, volID2))
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.Snapshot", queries[3].Get("type"))
+	assert.Equal(t, fmt.Sprintf("in.(%s,%s)", volID, volID2), queries[3].Get("protection_data->>source_id"))
+	assert.Equal(t, "name", queries[3].Get("order"))
+	assert.Equal(t, "1000", queries[3].Get("limit"))
+}
+
+func TestClientIMPL_GetVolumesFiltered_Pagination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var offsets []string
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			offset := req.URL.Query().Get("offset")
+			offsets = append(offsets, offset)
+			if offset == "5" {
+				return pageResponse(`[{"id": "v5"}]`, "5-5/7"), nil
+			}
+			return pageResponse(`[{"id": "v6"}]`, "6-6/7"), nil
+		})
+	vols, err := C.GetVolumesFiltered(context.Background(), NewQueryFilter().Offset(5))
+	assert.Nil(t, err)
+	assert.Len(t, vols, 2)
+	assert.Equal(t, []string{"5", "6"}, offsets)
+}
+
+func TestClientIMPL_GetVolumes_FilterFromContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var query url.Values
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, volID)), nil
+		})
+	ctx := WithQueryFilter(context.Background(),
+		NewQueryFilter().Select("id").Where("name", FilterOperatorEnumIlike, "pvc-*").OrderBy("size.desc"))
+	volumes, err := C.GetVolumes(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, volumes, 1)
+	assert.Equal(t, "id", query.Get("select"))
+	assert.Equal(t, "ilike.pvc-*", query.Get("name"))
+	assert.Equal(t, "size.desc", query.Get("order"))
+	assert.Equal(t, "not.eq.Snapshot", query.Get("type"))
+
+	_, err = C.GetSnapshots(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.Snapshot", query.Get("type"))
+
+	// invalid filter from context fails the request
+	_, err = C.GetVolumes(WithQueryFilter(context.Background(), NewQueryFilter().Offset(-1)))
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
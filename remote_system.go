@@ -0,0 +1,155 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const remoteSystemURL = "remote_system"
+
+func getRemoteSystemDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	system := RemoteSystem{}
+	return c.APIClient().QueryParamsWithFields(&system)
+}
+
+// GetRemoteSystem query and return specific remote system by id
+func (c *ClientIMPL) GetRemoteSystem(ctx context.Context, id string) (resp RemoteSystem, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    remoteSystemURL,
+			ID:          id,
+			QueryParams: getRemoteSystemDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetRemoteSystems returns all remote systems registered on the array
+func (c *ClientIMPL) GetRemoteSystems(ctx context.Context) ([]RemoteSystem, error) {
+	result := []RemoteSystem{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []RemoteSystem
+		qp := getRemoteSystemDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    remoteSystemURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetRemoteSystemByName query and return specific remote system by name
+func (c *ClientIMPL) GetRemoteSystemByName(ctx context.Context, name string) (RemoteSystem, error) {
+	return c.getRemoteSystemByField(ctx, "name", name)
+}
+
+// GetRemoteSystemByMgmtAddress query and return specific remote system by its management IP address
+func (c *ClientIMPL) GetRemoteSystemByMgmtAddress(ctx context.Context, address string) (RemoteSystem, error) {
+	return c.getRemoteSystemByField(ctx, "management_address", address)
+}
+
+func (c *ClientIMPL) getRemoteSystemByField(ctx context.Context,
+	field, value string) (resp RemoteSystem, err error) {
+	var systemList []RemoteSystem
+	qp := getRemoteSystemDefaultQueryParams(c)
+	qp.RawArg(field, fmt.Sprintf("eq.%s", value))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    remoteSystemURL,
+			QueryParams: qp},
+		&systemList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(systemList) != 1 {
+		return resp, notExistError()
+	}
+	return systemList[0], nil
+}
+
+// CreateRemoteSystem registers the remote system and establishes management and data connections to it
+func (c *ClientIMPL) CreateRemoteSystem(ctx context.Context,
+	createParams *RemoteSystemCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: remoteSystemURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyRemoteSystem modifies existing remote system
+func (c *ClientIMPL) ModifyRemoteSystem(ctx context.Context,
+	modifyParams *RemoteSystemModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: remoteSystemURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteRemoteSystem removes the remote system pairing, remote systems used by replication sessions can't be deleted
+func (c *ClientIMPL) DeleteRemoteSystem(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: remoteSystemURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// VerifyRemoteSystem verifies management and data connections to the remote system and updates
+// its data connection state, use GetRemoteSystem to read the result
+func (c *ClientIMPL) VerifyRemoteSystem(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: remoteSystemURL,
+			ID:       id,
+			Action:   "verify"},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,138 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const remoteSystemMockURL = APIMockURL + remoteSystemURL
+
+var remoteSystemID = "a3c9d2e4-6b1f-4e7a-9c0d-5e8f1a2b3c4d"
+
+func TestClientIMPL_GetRemoteSystem(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "name": "PS2", "type": "PowerStore",
+"management_address": "10.0.0.2", "data_connection_state": "OK"}`, remoteSystemID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", remoteSystemMockURL, remoteSystemID),
+		httpmock.NewStringResponder(200, respData))
+	system, err := C.GetRemoteSystem(context.Background(), remoteSystemID)
+	assert.Nil(t, err)
+	assert.Equal(t, RemoteSystemTypeEnumPowerStore, system.Type)
+	assert.Equal(t, "10.0.0.2", system.ManagementAddress)
+	assert.Equal(t, RemoteSystemDataConnectionStateEnumOK, system.DataConnectionState)
+}
+
+func TestClientIMPL_GetRemoteSystems(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", remoteSystemMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "remote2"}]`, remoteSystemID)))
+	systems, err := C.GetRemoteSystems(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, systems, 2)
+	assert.Equal(t, remoteSystemID, systems[0].ID)
+}
+
+func TestClientIMPL_GetRemoteSystemByNameAndMgmtAddress(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var nameFilter, addressFilter string
+	httpmock.RegisterResponder("GET", remoteSystemMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			nameFilter = req.URL.Query().Get("name")
+			addressFilter = req.URL.Query().Get("management_address")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, remoteSystemID)), nil
+		})
+	system, err := C.GetRemoteSystemByName(context.Background(), "PS2")
+	assert.Nil(t, err)
+	assert.Equal(t, remoteSystemID, system.ID)
+	assert.Equal(t, "eq.PS2", nameFilter)
+	assert.Empty(t, addressFilter)
+
+	system, err = C.GetRemoteSystemByMgmtAddress(context.Background(), "10.0.0.2")
+	assert.Nil(t, err)
+	assert.Equal(t, remoteSystemID, system.ID)
+	assert.Equal(t, "eq.10.0.0.2", addressFilter)
+	assert.Empty(t, nameFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", remoteSystemMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetRemoteSystemByMgmtAddress(context.Background(), "10.0.0.3")
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.NotFound())
+}
+
+func TestClientIMPL_CreateRemoteSystem(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", remoteSystemMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, remoteSystemID)), nil
+		})
+	address := "10.0.0.2"
+	latency := RemoteSystemDataNetworkLatencyEnumLow
+	resp, err := C.CreateRemoteSystem(context.Background(),
+		&RemoteSystemCreate{ManagementAddress: &address, DataNetworkLatency: &latency})
+	assert.Nil(t, err)
+	assert.Equal(t, remoteSystemID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"management_address": address, "data_network_latency": "Low"}, reqBody)
+}
+
+func TestClientIMPL_ModifyRemoteSystem(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", remoteSystemMockURL, remoteSystemID),
+		httpmock.NewStringResponder(204, ""))
+	description := "DR site"
+	_, err := C.ModifyRemoteSystem(context.Background(), &RemoteSystemModify{Description: &description}, remoteSystemID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_DeleteRemoteSystem(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", remoteSystemMockURL, remoteSystemID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteRemoteSystem(context.Background(), remoteSystemID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_VerifyRemoteSystem(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/verify", remoteSystemMockURL, remoteSystemID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.VerifyRemoteSystem(context.Background(), remoteSystemID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,126 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// RemoteSystemTypeEnum type of the remote system
+type RemoteSystemTypeEnum string
+
+const (
+	// RemoteSystemTypeEnumPowerStore captures enum value "PowerStore"
+	RemoteSystemTypeEnumPowerStore RemoteSystemTypeEnum = "PowerStore"
+	// RemoteSystemTypeEnumUnity captures enum value "Unity"
+	RemoteSystemTypeEnumUnity RemoteSystemTypeEnum = "Unity"
+	// RemoteSystemTypeEnumVNX captures enum value "VNX"
+	RemoteSystemTypeEnumVNX RemoteSystemTypeEnum = "VNX"
+	// RemoteSystemTypeEnumPowerMax captures enum value "PowerMax"
+	RemoteSystemTypeEnumPowerMax RemoteSystemTypeEnum = "PowerMax"
+	// RemoteSystemTypeEnumStorageCenter captures enum value "Storage_Center", SC Series system
+	RemoteSystemTypeEnumStorageCenter RemoteSystemTypeEnum = "Storage_Center"
+	// RemoteSystemTypeEnumPSEqualLogic captures enum value "PS_Equallogic", PS Series system
+	RemoteSystemTypeEnumPSEqualLogic RemoteSystemTypeEnum = "PS_Equallogic"
+)
+
+// RemoteSystemDataConnectionStateEnum state of data connections to the remote system
+type RemoteSystemDataConnectionStateEnum string
+
+const (
+	// RemoteSystemDataConnectionStateEnumInitializing captures enum value "Initializing"
+	RemoteSystemDataConnectionStateEnumInitializing RemoteSystemDataConnectionStateEnum = "Initializing"
+	// RemoteSystemDataConnectionStateEnumOK captures enum value "OK"
+	RemoteSystemDataConnectionStateEnumOK RemoteSystemDataConnectionStateEnum = "OK"
+	// RemoteSystemDataConnectionStateEnumPartialDataConnectionLoss captures enum value "Partial_Data_Connection_Loss"
+	RemoteSystemDataConnectionStateEnumPartialDataConnectionLoss RemoteSystemDataConnectionStateEnum = "Partial_Data_Connection_Loss"
+	// RemoteSystemDataConnectionStateEnumCompleteDataConnectionLoss captures enum value "Complete_Data_Connection_Loss"
+	RemoteSystemDataConnectionStateEnumCompleteDataConnectionLoss RemoteSystemDataConnectionStateEnum = "Complete_Data_Connection_Loss"
+	// RemoteSystemDataConnectionStateEnumStatusNotAvailable captures enum value "Status_Not_Available"
+	RemoteSystemDataConnectionStateEnumStatusNotAvailable RemoteSystemDataConnectionStateEnum = "Status_Not_Available"
+	// RemoteSystemDataConnectionStateEnumNoTargetsDiscovered captures enum value "No_Targets_Discovered"
+	RemoteSystemDataConnectionStateEnumNoTargetsDiscovered RemoteSystemDataConnectionStateEnum = "No_Targets_Discovered"
+)
+
+// RemoteSystemDataNetworkLatencyEnum network latency between the local and the remote system
+type RemoteSystemDataNetworkLatencyEnum string
+
+const (
+	// RemoteSystemDataNetworkLatencyEnumLow captures enum value "Low"
+	RemoteSystemDataNetworkLatencyEnumLow RemoteSystemDataNetworkLatencyEnum = "Low"
+	// RemoteSystemDataNetworkLatencyEnumHigh captures enum value "High"
+	RemoteSystemDataNetworkLatencyEnumHigh RemoteSystemDataNetworkLatencyEnum = "High"
+)
+
+// RemoteSystemCreate create remote system request
+type RemoteSystemCreate struct {
+	// Management IP address of the remote system.
+	ManagementAddress *string `json:"management_address"`
+	// Type of the remote system, PowerStore if not set.
+	Type *RemoteSystemTypeEnum `json:"type,omitempty"`
+	// Description of the remote system.
+	Description *string `json:"description,omitempty"`
+	// Username used to access the remote system.
+	RemoteUsername *string `json:"remote_username,omitempty"`
+	// Password used to access the remote system.
+	RemotePassword *string `json:"remote_password,omitempty"`
+	// Network latency between the local and the remote system.
+	DataNetworkLatency *RemoteSystemDataNetworkLatencyEnum `json:"data_network_latency,omitempty"`
+	// iSCSI addresses of the remote system used for data transfer, required for import
+	// from systems other than PowerStore.
+	ISCSIAddresses []string `json:"iscsi_addresses,omitempty"`
+}
+
+// RemoteSystemModify modify remote system request, unset fields are not changed
+type RemoteSystemModify struct {
+	// Name of the remote system.
+	Name *string `json:"name,omitempty"`
+	// Description of the remote system.
+	Description *string `json:"description,omitempty"`
+	// Management IP address of the remote system.
+	ManagementAddress *string `json:"management_address,omitempty"`
+	// Username used to access the remote system.
+	RemoteUsername *string `json:"remote_username,omitempty"`
+	// Password used to access the remote system.
+	RemotePassword *string `json:"remote_password,omitempty"`
+	// Network latency between the local and the remote system.
+	DataNetworkLatency *RemoteSystemDataNetworkLatencyEnum `json:"data_network_latency,omitempty"`
+}
+
+// RemoteSystem details about remote system
+type RemoteSystem struct {
+	// Unique identifier of the remote system.
+	ID string `json:"id,omitempty"`
+	// Name of the remote system.
+	Name string `json:"name,omitempty"`
+	// Description of the remote system.
+	Description string `json:"description,omitempty"`
+	// Serial number of the remote system.
+	SerialNumber string `json:"serial_number,omitempty"`
+	// Type of the remote system.
+	Type RemoteSystemTypeEnum `json:"type,omitempty"`
+	// Management IP address of the remote system.
+	ManagementAddress string `json:"management_address,omitempty"`
+	// State of data connections to the remote system.
+	DataConnectionState RemoteSystemDataConnectionStateEnum `json:"data_connection_state,omitempty"`
+	// Network latency between the local and the remote system.
+	DataNetworkLatency RemoteSystemDataNetworkLatencyEnum `json:"data_network_latency,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (r *RemoteSystem) Fields() []string {
+	return []string{"id", "name", "description", "serial_number", "type",
+		"management_address", "data_connection_state", "data_network_latency"}
+}
@@ -0,0 +1,210 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const replicationSessionURL = "replication_session"
+
+// upper bound of time the failover test cleanup may take when context of the test is already done
+var failoverTestCleanupTimeout = 10 * time.Minute
+
+func getReplicationSessionDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	session := ReplicationSession{}
+	return c.APIClient().QueryParamsWithFields(&session)
+}
+
+// GetReplicationSession query and return specific replication session by id
+func (c *ClientIMPL) GetReplicationSession(ctx context.Context, id string) (resp ReplicationSession, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    replicationSessionURL,
+			ID:          id,
+			QueryParams: getReplicationSessionDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetReplicationSessionByLocalResourceID query and return replication session of the local
+// volume, volume group, NAS server or file system
+func (c *ClientIMPL) GetReplicationSessionByLocalResourceID(ctx context.Context,
+	resourceID string) (resp ReplicationSession, err error) {
+	var sessionList []ReplicationSession
+	qp := getReplicationSessionDefaultQueryParams(c)
+	qp.RawArg("local_resource_id", fmt.Sprintf("eq.%s", resourceID))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    replicationSessionURL,
+			QueryParams: qp},
+		&sessionList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(sessionList) != 1 {
+		return resp, notExistError()
+	}
+	return sessionList[0], nil
+}
+
+// GetVolumeGroupReplicationSession query and return replication session of the volume group,
+// all member volumes are replicated by this session as a single consistency group
+func (c *ClientIMPL) GetVolumeGroupReplicationSession(ctx context.Context,
+	groupID string) (resp ReplicationSession, err error) {
+	var sessionList []ReplicationSession
+	qp := getReplicationSessionDefaultQueryParams(c)
+	qp.RawArg("local_resource_id", fmt.Sprintf("eq.%s", groupID))
+	qp.RawArg("resource_type", fmt.Sprintf("eq.%s", ResourceTypeEnumVolumeGroup))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    replicationSessionURL,
+			QueryParams: qp},
+		&sessionList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(sessionList) != 1 {
+		return resp, notExistError()
+	}
+	return sessionList[0], nil
+}
+
+// FailoverVolumeGroup fails over replication session of the volume group to the destination system.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) FailoverVolumeGroup(ctx context.Context,
+	groupID string, failoverParams *ReplicationSessionFailover) (resp JobResponse, err error) {
+	session, err := c.GetVolumeGroupReplicationSession(ctx, groupID)
+	if err != nil {
+		return resp, err
+	}
+	return c.FailoverReplicationSession(ctx, session.ID, failoverParams)
+}
+
+// ReprotectVolumeGroup starts replication of the volume group in the reverse direction after failover.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) ReprotectVolumeGroup(ctx context.Context, groupID string) (resp JobResponse, err error) {
+	session, err := c.GetVolumeGroupReplicationSession(ctx, groupID)
+	if err != nil {
+		return resp, err
+	}
+	return c.ReprotectReplicationSession(ctx, session.ID)
+}
+
+// StartReplicationSessionFailoverTest makes destination copies of the replicated resources available
+// to hosts for testing, replication from the source continues during the test.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) StartReplicationSessionFailoverTest(ctx context.Context,
+	id string, testParams *ReplicationSessionFailoverTest) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "start_failover_test", testParams)
+}
+
+// StopReplicationSessionFailoverTest ends failover test and discards data written to destination copies.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) StopReplicationSessionFailoverTest(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "stop_failover_test", nil)
+}
+
+// RunReplicationSessionFailoverTest starts failover test, waits until destination copies are available
+// and calls test. The test is stopped once test returns, also when it fails or ctx is done, so no test
+// copies are left behind. Error of test is returned first, error of the cleanup otherwise
+func (c *ClientIMPL) RunReplicationSessionFailoverTest(ctx context.Context, id string,
+	testParams *ReplicationSessionFailoverTest, test func(ctx context.Context) error) error {
+	job, err := c.StartReplicationSessionFailoverTest(ctx, id, testParams)
+	if err != nil {
+		return err
+	}
+	_, testErr := c.WaitForJob(ctx, job.ID)
+	if testErr == nil {
+		testErr = test(ctx)
+	}
+	cleanupCtx := ctx
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		cleanupCtx, cancel = context.WithTimeout(context.Background(), failoverTestCleanupTimeout)
+		defer cancel()
+	}
+	job, err = c.StopReplicationSessionFailoverTest(cleanupCtx, id)
+	if err == nil {
+		_, err = c.WaitForJob(cleanupCtx, job.ID)
+	}
+	if testErr != nil {
+		if err != nil {
+			return fmt.Errorf("%s, failover test wasn't stopped: %s", testErr.Error(), err.Error())
+		}
+		return testErr
+	}
+	return err
+}
+
+// FailoverReplicationSession fails over replication session to the destination system.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) FailoverReplicationSession(ctx context.Context,
+	id string, failoverParams *ReplicationSessionFailover) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "failover", failoverParams)
+}
+
+// ReprotectReplicationSession starts replication in the reverse direction after failover.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) ReprotectReplicationSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "reprotect", nil)
+}
+
+// ResumeReplicationSession resumes paused replication session.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) ResumeReplicationSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "resume", nil)
+}
+
+// PauseReplicationSession pauses replication session, data is not replicated until the session is resumed.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) PauseReplicationSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "pause", nil)
+}
+
+// SyncReplicationSession synchronizes destination resource with the source outside of the RPO schedule.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) SyncReplicationSession(ctx context.Context, id string) (resp JobResponse, err error) {
+	return c.replicationSessionAction(ctx, id, "sync", nil)
+}
+
+func (c *ClientIMPL) replicationSessionAction(ctx context.Context,
+	id, action string, body interface{}) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    replicationSessionURL,
+		ID:          id,
+		Action:      action,
+		QueryParams: qp,
+		Body:        body})
+}
@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const replicationRuleURL = "replication_rule"
+
+func getReplicationRuleDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	rule := ReplicationRule{}
+	return c.APIClient().QueryParamsWithFields(&rule)
+}
+
+// GetReplicationRule query and return specific replication rule by id
+func (c *ClientIMPL) GetReplicationRule(ctx context.Context, id string) (resp ReplicationRule, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    replicationRuleURL,
+			ID:          id,
+			QueryParams: getReplicationRuleDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetReplicationRuleByName query and return specific replication rule by name
+func (c *ClientIMPL) GetReplicationRuleByName(ctx context.Context, name string) (resp ReplicationRule, err error) {
+	var ruleList []ReplicationRule
+	qp := getReplicationRuleDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    replicationRuleURL,
+			QueryParams: qp},
+		&ruleList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(ruleList) != 1 {
+		return resp, notExistError()
+	}
+	return ruleList[0], nil
+}
+
+// CreateReplicationRule creates new replication rule to the remote system
+func (c *ClientIMPL) CreateReplicationRule(ctx context.Context,
+	createParams *ReplicationRuleCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: replicationRuleURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyReplicationRule modifies existing replication rule
+func (c *ClientIMPL) ModifyReplicationRule(ctx context.Context,
+	modifyParams *ReplicationRuleModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: replicationRuleURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteReplicationRule deletes existing replication rule, rule which is used by protection policy can't be deleted
+func (c *ClientIMPL) DeleteReplicationRule(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: replicationRuleURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,110 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const replicationRuleMockURL = APIMockURL + replicationRuleURL
+
+var replicationRuleID = "6b930711-46bc-4a4b-9d6a-22c77a7838c4"
+
+func TestClientIMPL_GetReplicationRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "rpo": "Fifteen_Minutes", "alert_threshold": 30}`, replicationRuleID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", replicationRuleMockURL, replicationRuleID),
+		httpmock.NewStringResponder(200, respData))
+	rule, err := C.GetReplicationRule(context.Background(), replicationRuleID)
+	assert.Nil(t, err)
+	assert.Equal(t, RPOEnumFifteenMinutes, rule.Rpo)
+	assert.Equal(t, int32(30), rule.AlertThreshold)
+}
+
+func TestClientIMPL_GetReplicationRuleByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", replicationRuleMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "dr"}]`, replicationRuleID)))
+	rule, err := C.GetReplicationRuleByName(context.Background(), "dr")
+	assert.Nil(t, err)
+	assert.Equal(t, replicationRuleID, rule.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", replicationRuleMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetReplicationRuleByName(context.Background(), "dr")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_CreateReplicationRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", replicationRuleMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, replicationRuleID)), nil
+		})
+	name := "dr"
+	rpo := RPOEnumOneHour
+	remoteSystemID := "remote1"
+	resp, err := C.CreateReplicationRule(context.Background(),
+		&ReplicationRuleCreate{Name: &name, Rpo: &rpo, RemoteSystemID: &remoteSystemID})
+	assert.Nil(t, err)
+	assert.Equal(t, replicationRuleID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "rpo": "One_Hour", "remote_system_id": remoteSystemID},
+		reqBody)
+}
+
+func TestClientIMPL_ModifyReplicationRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", replicationRuleMockURL, replicationRuleID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	threshold := int32(0)
+	_, err := C.ModifyReplicationRule(context.Background(),
+		&ReplicationRuleModify{AlertThreshold: &threshold}, replicationRuleID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"alert_threshold": float64(0)}, reqBody)
+}
+
+func TestClientIMPL_DeleteReplicationRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", replicationRuleMockURL, replicationRuleID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteReplicationRule(context.Background(), replicationRuleID)
+	assert.Nil(t, err)
+}
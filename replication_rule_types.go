@@ -0,0 +1,82 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// RPOEnum recovery point objective of replication rule
+type RPOEnum string
+
+const (
+	// RPOEnumFiveMinutes captures enum value "Five_Minutes"
+	RPOEnumFiveMinutes RPOEnum = "Five_Minutes"
+	// RPOEnumFifteenMinutes captures enum value "Fifteen_Minutes"
+	RPOEnumFifteenMinutes RPOEnum = "Fifteen_Minutes"
+	// RPOEnumThirtyMinutes captures enum value "Thirty_Minutes"
+	RPOEnumThirtyMinutes RPOEnum = "Thirty_Minutes"
+	// RPOEnumOneHour captures enum value "One_Hour"
+	RPOEnumOneHour RPOEnum = "One_Hour"
+	// RPOEnumSixHours captures enum value "Six_Hours"
+	RPOEnumSixHours RPOEnum = "Six_Hours"
+	// RPOEnumTwelveHours captures enum value "Twelve_Hours"
+	RPOEnumTwelveHours RPOEnum = "Twelve_Hours"
+	// RPOEnumOneDay captures enum value "One_Day"
+	RPOEnumOneDay RPOEnum = "One_Day"
+)
+
+// ReplicationRuleCreate create replication rule request
+type ReplicationRuleCreate struct {
+	// Name of the replication rule.
+	Name *string `json:"name"`
+	// Recovery point objective of the rule.
+	Rpo *RPOEnum `json:"rpo"`
+	// Unique identifier of the remote system the data is replicated to.
+	RemoteSystemID *string `json:"remote_system_id"`
+	// Minutes of replication lag after which an alert is generated, zero disables alerts.
+	AlertThreshold *int32 `json:"alert_threshold,omitempty"`
+}
+
+// ReplicationRuleModify modify replication rule request, unset fields are not changed
+type ReplicationRuleModify struct {
+	// Name of the replication rule.
+	Name *string `json:"name,omitempty"`
+	// Recovery point objective of the rule.
+	Rpo *RPOEnum `json:"rpo,omitempty"`
+	// Unique identifier of the remote system the data is replicated to.
+	RemoteSystemID *string `json:"remote_system_id,omitempty"`
+	// Minutes of replication lag after which an alert is generated, zero disables alerts.
+	AlertThreshold *int32 `json:"alert_threshold,omitempty"`
+}
+
+// ReplicationRule replication rule of protection policy
+type ReplicationRule struct {
+	// Unique identifier of the replication rule.
+	ID string `json:"id,omitempty"`
+	// Name of the replication rule.
+	Name string `json:"name,omitempty"`
+	// Recovery point objective of the rule.
+	Rpo RPOEnum `json:"rpo,omitempty"`
+	// Unique identifier of the remote system the data is replicated to.
+	RemoteSystemID string `json:"remote_system_id,omitempty"`
+	// Minutes of replication lag after which an alert is generated.
+	AlertThreshold int32 `json:"alert_threshold,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (r *ReplicationRule) Fields() []string {
+	return []string{"id", "name", "rpo", "remote_system_id", "alert_threshold"}
+}
@@ -0,0 +1,268 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const replicationSessionMockURL = APIMockURL + replicationSessionURL
+
+var replicationSessionID = "9d2f4a1c-7b3e-4c5d-8e6f-0a1b2c3d4e5f"
+
+func TestClientIMPL_GetReplicationSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "state": "OK", "role": "Source", "remote_system_id": "RS1",
+"last_sync_timestamp": "2020-05-06T10:00:00Z"}`, replicationSessionID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", replicationSessionMockURL, replicationSessionID),
+		httpmock.NewStringResponder(200, respData))
+	session, err := C.GetReplicationSession(context.Background(), replicationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, ReplicationSessionStateEnumOK, session.State)
+	assert.Equal(t, ReplicationSessionRoleEnumSource, session.Role)
+	assert.Equal(t, "RS1", session.RemoteSystemID)
+	assert.Equal(t, "2020-05-06T10:00:00Z", session.LastSyncTimestamp)
+}
+
+func TestClientIMPL_GetReplicationSessionByLocalResourceID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var resourceFilter string
+	httpmock.RegisterResponder("GET", replicationSessionMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			resourceFilter = req.URL.Query().Get("local_resource_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s", "local_resource_id": "%s"}]`,
+				replicationSessionID, volID)), nil
+		})
+	session, err := C.GetReplicationSessionByLocalResourceID(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Equal(t, replicationSessionID, session.ID)
+	assert.Equal(t, "eq."+volID, resourceFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", replicationSessionMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetReplicationSessionByLocalResourceID(context.Background(), volID)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_ReplicationSessionStateTransitions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	state := ReplicationSessionStateEnumOK
+	transitions := map[string]struct {
+		from ReplicationSessionStateEnum
+		to   ReplicationSessionStateEnum
+	}{
+		"failover":  {ReplicationSessionStateEnumOK, ReplicationSessionStateEnumFailedOver},
+		"reprotect": {ReplicationSessionStateEnumFailedOver, ReplicationSessionStateEnumSystemPaused},
+		"resume":    {ReplicationSessionStateEnumSystemPaused, ReplicationSessionStateEnumOK},
+	}
+	var failoverBody map[string]interface{}
+	for action, transition := range transitions {
+		action, transition := action, transition
+		httpmock.RegisterResponder("POST",
+			fmt.Sprintf("%s/%s/%s", replicationSessionMockURL, replicationSessionID, action),
+			func(req *http.Request) (*http.Response, error) {
+				if req.URL.Query().Get("is_async") != "true" {
+					return httpmock.NewStringResponse(400, ""), nil
+				}
+				if action == "failover" {
+					if err := json.NewDecoder(req.Body).Decode(&failoverBody); err != nil {
+						return nil, err
+					}
+				}
+				if state != transition.from {
+					return httpmock.NewStringResponse(400, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`,
+						ReplicationSessionInvalidStateErrorCode)), nil
+				}
+				state = transition.to
+				return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+			})
+	}
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", replicationSessionMockURL, replicationSessionID),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`{"id": "%s", "state": "%s"}`, replicationSessionID, state)), nil
+		})
+	ctx := context.Background()
+
+	planned := true
+	job, err := C.FailoverReplicationSession(ctx, replicationSessionID,
+		&ReplicationSessionFailover{IsPlanned: &planned})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, job.ID)
+	assert.Equal(t, true, failoverBody["is_planned"])
+	session, err := C.GetReplicationSession(ctx, replicationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, ReplicationSessionStateEnumFailedOver, session.State)
+
+	// session is already failed over
+	_, err = C.FailoverReplicationSession(ctx, replicationSessionID, &ReplicationSessionFailover{})
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.ReplicationSessionInvalidState())
+
+	_, err = C.ReprotectReplicationSession(ctx, replicationSessionID)
+	assert.Nil(t, err)
+	_, err = C.ResumeReplicationSession(ctx, replicationSessionID)
+	assert.Nil(t, err)
+	session, err = C.GetReplicationSession(ctx, replicationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, ReplicationSessionStateEnumOK, session.State)
+}
+
+func TestClientIMPL_PauseSyncReplicationSession(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	for _, action := range []string{"pause", "sync"} {
+		httpmock.RegisterResponder("POST",
+			fmt.Sprintf("%s/%s/%s", replicationSessionMockURL, replicationSessionID, action),
+			func(req *http.Request) (*http.Response, error) {
+				if req.URL.Query().Get("is_async") != "true" {
+					return httpmock.NewStringResponse(400, ""), nil
+				}
+				return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+			})
+	}
+	job, err := C.PauseReplicationSession(context.Background(), replicationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, job.ID)
+	job, err = C.SyncReplicationSession(context.Background(), replicationSessionID)
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, job.ID)
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info[fmt.Sprintf("POST %s/%s/pause", replicationSessionMockURL, replicationSessionID)])
+	assert.Equal(t, 1, info[fmt.Sprintf("POST %s/%s/sync", replicationSessionMockURL, replicationSessionID)])
+}
+
+func TestClientIMPL_VolumeGroupReplication(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var resourceFilter, typeFilter string
+	httpmock.RegisterResponder("GET", replicationSessionMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			resourceFilter = req.URL.Query().Get("local_resource_id")
+			typeFilter = req.URL.Query().Get("resource_type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(
+				`[{"id": "%s", "state": "OK", "resource_type": "volume_group", "local_resource_id": "%s"}]`,
+				replicationSessionID, volumeGroupID)), nil
+		})
+	var actions []string
+	for _, action := range []string{"failover", "reprotect"} {
+		action := action
+		httpmock.RegisterResponder("POST",
+			fmt.Sprintf("%s/%s/%s", replicationSessionMockURL, replicationSessionID, action),
+			func(req *http.Request) (*http.Response, error) {
+				actions = append(actions, action)
+				return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+			})
+	}
+	ctx := context.Background()
+
+	session, err := C.GetVolumeGroupReplicationSession(ctx, volumeGroupID)
+	assert.Nil(t, err)
+	assert.Equal(t, replicationSessionID, session.ID)
+	assert.Equal(t, ResourceTypeEnumVolumeGroup, session.ResourceType)
+	assert.Equal(t, "eq."+volumeGroupID, resourceFilter)
+	assert.Equal(t, "eq.volume_group", typeFilter)
+
+	job, err := C.FailoverVolumeGroup(ctx, volumeGroupID, &ReplicationSessionFailover{})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, job.ID)
+	_, err = C.ReprotectVolumeGroup(ctx, volumeGroupID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"failover", "reprotect"}, actions)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", replicationSessionMockURL, httpmock.NewStringResponder(200, "[]"))
+	_, err = C.FailoverVolumeGroup(ctx, volumeGroupID, &ReplicationSessionFailover{})
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClientIMPL_RunReplicationSessionFailoverTest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defaultInterval := jobPollInterval
+	jobPollInterval = 10 * time.Millisecond
+	defer func() { jobPollInterval = defaultInterval }()
+
+	state := ReplicationSessionStateEnumOK
+	var startBody map[string]interface{}
+	httpmock.RegisterResponder("POST",
+		fmt.Sprintf("%s/%s/start_failover_test", replicationSessionMockURL, replicationSessionID),
+		func(req *http.Request) (*http.Response, error) {
+			// request has no body when test params are not set
+			startBody = nil
+			if req.Body != nil {
+				if err := json.NewDecoder(req.Body).Decode(&startBody); err != nil {
+					return nil, err
+				}
+			}
+			state = ReplicationSessionStateEnumFailoverTestInProgress
+			return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+		})
+	httpmock.RegisterResponder("POST",
+		fmt.Sprintf("%s/%s/stop_failover_test", replicationSessionMockURL, replicationSessionID),
+		func(req *http.Request) (*http.Response, error) {
+			state = ReplicationSessionStateEnumOK
+			return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "state": "COMPLETED"}`, jobID)))
+	ctx := context.Background()
+
+	snapID := "snap-1"
+	var stateDuringTest ReplicationSessionStateEnum
+	err := C.RunReplicationSessionFailoverTest(ctx, replicationSessionID,
+		&ReplicationSessionFailoverTest{SnapshotID: &snapID}, func(ctx context.Context) error {
+			stateDuringTest = state
+			return nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, ReplicationSessionStateEnumFailoverTestInProgress, stateDuringTest)
+	assert.Equal(t, ReplicationSessionStateEnumOK, state)
+	assert.Equal(t, map[string]interface{}{"source_snapshot_id": "snap-1"}, startBody)
+
+	// the test is stopped even when it fails
+	testErr := errors.New("host can't read test copy")
+	err = C.RunReplicationSessionFailoverTest(ctx, replicationSessionID, nil, func(ctx context.Context) error {
+		return testErr
+	})
+	assert.Equal(t, testErr, err)
+	assert.Equal(t, ReplicationSessionStateEnumOK, state)
+
+	// the test is stopped when context is done during the test
+	cancelCtx, cancel := context.WithCancel(ctx)
+	err = C.RunReplicationSessionFailoverTest(cancelCtx, replicationSessionID, nil, func(ctx context.Context) error {
+		cancel()
+		return ctx.Err()
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, ReplicationSessionStateEnumOK, state)
+}
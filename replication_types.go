@@ -0,0 +1,102 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// ReplicationSessionStateEnum state of the replication session
+type ReplicationSessionStateEnum string
+
+const (
+	// ReplicationSessionStateEnumInitializing captures enum value "Initializing"
+	ReplicationSessionStateEnumInitializing ReplicationSessionStateEnum = "Initializing"
+	// ReplicationSessionStateEnumOK captures enum value "OK"
+	ReplicationSessionStateEnumOK ReplicationSessionStateEnum = "OK"
+	// ReplicationSessionStateEnumSynchronizing captures enum value "Synchronizing"
+	ReplicationSessionStateEnumSynchronizing ReplicationSessionStateEnum = "Synchronizing"
+	// ReplicationSessionStateEnumSystemPaused captures enum value "System_Paused"
+	ReplicationSessionStateEnumSystemPaused ReplicationSessionStateEnum = "System_Paused"
+	// ReplicationSessionStateEnumPaused captures enum value "Paused"
+	ReplicationSessionStateEnumPaused ReplicationSessionStateEnum = "Paused"
+	// ReplicationSessionStateEnumResuming captures enum value "Resuming"
+	ReplicationSessionStateEnumResuming ReplicationSessionStateEnum = "Resuming"
+	// ReplicationSessionStateEnumFailingOver captures enum value "Failing_Over"
+	ReplicationSessionStateEnumFailingOver ReplicationSessionStateEnum = "Failing_Over"
+	// ReplicationSessionStateEnumFailedOver captures enum value "Failed_Over"
+	ReplicationSessionStateEnumFailedOver ReplicationSessionStateEnum = "Failed_Over"
+	// ReplicationSessionStateEnumReprotecting captures enum value "Reprotecting"
+	ReplicationSessionStateEnumReprotecting ReplicationSessionStateEnum = "Reprotecting"
+	// ReplicationSessionStateEnumError captures enum value "Error"
+	ReplicationSessionStateEnumError ReplicationSessionStateEnum = "Error"
+	// ReplicationSessionStateEnumFailoverTestInProgress captures enum value "Failover_Test_In_Progress",
+	// destination copies are available to hosts for testing while replication continues
+	ReplicationSessionStateEnumFailoverTestInProgress ReplicationSessionStateEnum = "Failover_Test_In_Progress"
+)
+
+// ReplicationSessionRoleEnum role of the local system in the replication session
+type ReplicationSessionRoleEnum string
+
+const (
+	// ReplicationSessionRoleEnumSource captures enum value "Source"
+	ReplicationSessionRoleEnumSource ReplicationSessionRoleEnum = "Source"
+	// ReplicationSessionRoleEnumDestination captures enum value "Destination"
+	ReplicationSessionRoleEnumDestination ReplicationSessionRoleEnum = "Destination"
+)
+
+// ReplicationSession details about replication session
+type ReplicationSession struct {
+	// Unique identifier of the replication session.
+	ID string `json:"id,omitempty"`
+	// State of the replication session.
+	State ReplicationSessionStateEnum `json:"state,omitempty"`
+	// Role of the local system in the replication session.
+	Role ReplicationSessionRoleEnum `json:"role,omitempty"`
+	// Type of the replicated resource.
+	ResourceType ResourceTypeEnum `json:"resource_type,omitempty"`
+	// Unique identifier of the local replicated resource.
+	LocalResourceID string `json:"local_resource_id,omitempty"`
+	// Unique identifier of the remote replicated resource.
+	RemoteResourceID string `json:"remote_resource_id,omitempty"`
+	// Unique identifier of the remote system.
+	RemoteSystemID string `json:"remote_system_id,omitempty"`
+	// Unique identifier of the replication rule of the session.
+	ReplicationRuleID string `json:"replication_rule_id,omitempty"`
+	// Time of the last successful synchronization.
+	LastSyncTimestamp string `json:"last_sync_timestamp,omitempty"`
+	// Progress of the current synchronization or state transition.
+	ProgressPercentage int32 `json:"progress_percentage,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (r *ReplicationSession) Fields() []string {
+	return []string{"id", "state", "role", "resource_type", "local_resource_id", "remote_resource_id",
+		"remote_system_id", "replication_rule_id", "last_sync_timestamp", "progress_percentage"}
+}
+
+// ReplicationSessionFailover failover replication session request
+type ReplicationSessionFailover struct {
+	// Indicates whether the failover is planned. Planned failover synchronizes data before switching roles.
+	IsPlanned *bool `json:"is_planned,omitempty"`
+	// Indicates whether the failover is forced when the source system is not reachable.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ReplicationSessionFailoverTest start failover test request
+type ReplicationSessionFailoverTest struct {
+	// Unique identifier of the destination snapshot to test with, the last replicated data is used if not set.
+	SnapshotID *string `json:"source_snapshot_id,omitempty"`
+}
@@ -0,0 +1,111 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const smbServerURL = "smb_server"
+
+func getSMBServerDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	server := SMBServer{}
+	return c.APIClient().QueryParamsWithFields(&server)
+}
+
+// GetADConfig returns Active Directory configuration (SMB server) of NAS server.
+// IsJoined field reports whether NAS server is joined to the domain
+func (c *ClientIMPL) GetADConfig(ctx context.Context, nasServerID string) (resp SMBServer, err error) {
+	var serverList []SMBServer
+	qp := getSMBServerDefaultQueryParams(c)
+	qp.RawArg("nas_server_id", fmt.Sprintf("eq.%s", nasServerID))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    smbServerURL,
+			QueryParams: qp},
+		&serverList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(serverList) != 1 {
+		return resp, notExistError()
+	}
+	return serverList[0], nil
+}
+
+// CreateADConfig creates SMB server for NAS server
+func (c *ClientIMPL) CreateADConfig(ctx context.Context,
+	createParams *SMBServerCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: smbServerURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyADConfig modifies existing SMB server
+func (c *ClientIMPL) ModifyADConfig(ctx context.Context,
+	modifyParams *SMBServerModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: smbServerURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteADConfig deletes existing SMB server. SMB server joined to the domain must be unjoined first
+func (c *ClientIMPL) DeleteADConfig(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: smbServerURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// JoinADDomain joins SMB server to Active Directory domain.
+// Error is returned if domain controllers are unreachable or credentials are rejected
+func (c *ClientIMPL) JoinADDomain(ctx context.Context,
+	joinParams *SMBServerJoin, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: smbServerURL,
+			ID:       id,
+			Action:   "join",
+			Body:     joinParams},
+		&resp)
+	return resp, WrapErr(err)
+}
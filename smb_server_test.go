@@ -0,0 +1,92 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	smbServerMockURL = APIMockURL + smbServerURL
+	smbServerID      = "5e8d8ea6-3c1d-8a8e-4c5a-cee0fbdc981e"
+)
+
+func TestClientIMPL_GetADConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "nas_server_id": "%s", "domain": "example.com", "is_joined": true}]`,
+		smbServerID, nasServerID)
+	httpmock.RegisterResponder("GET", smbServerMockURL,
+		httpmock.NewStringResponder(200, respData))
+	resp, err := C.GetADConfig(context.Background(), nasServerID)
+	assert.Nil(t, err)
+	assert.Equal(t, smbServerID, resp.ID)
+	assert.True(t, resp.IsJoined)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", smbServerMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetADConfig(context.Background(), nasServerID)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_CreateADConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", smbServerMockURL,
+		httpmock.NewStringResponder(201, fmt.Sprintf(`{"id": "%s"}`, smbServerID)))
+	domain := "example.com"
+	resp, err := C.CreateADConfig(context.Background(),
+		&SMBServerCreate{NasServerID: nasServerID, Domain: &domain})
+	assert.Nil(t, err)
+	assert.Equal(t, smbServerID, resp.ID)
+}
+
+func TestClientIMPL_ModifyADConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", smbServerMockURL, smbServerID),
+		httpmock.NewStringResponder(204, ""))
+	description := "file services"
+	_, err := C.ModifyADConfig(context.Background(), &SMBServerModify{Description: &description}, smbServerID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_JoinADDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/join", smbServerMockURL, smbServerID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.JoinADDomain(context.Background(),
+		&SMBServerJoin{DomainUsername: "admin", DomainPassword: "secret"}, smbServerID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_DeleteADConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", smbServerMockURL, smbServerID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteADConfig(context.Background(), smbServerID)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,91 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// SMBServerCreate SMB server create params
+type SMBServerCreate struct {
+	// Unique identifier of the NAS server.
+	NasServerID string `json:"nas_server_id"`
+	// Indicates whether SMB server is standalone or joined to Active Directory domain.
+	IsStandalone bool `json:"is_standalone"`
+	// DNS name of the SMB server in the Active Directory domain. Required for joined SMB servers.
+	ComputerName *string `json:"computer_name,omitempty"`
+	// Active Directory domain name. Required for joined SMB servers.
+	Domain *string `json:"domain,omitempty"`
+	// NetBIOS name of the SMB server.
+	NetbiosName *string `json:"netbios_name,omitempty"`
+	// Windows workgroup name. Required for standalone SMB servers.
+	Workgroup *string `json:"workgroup,omitempty"`
+	// Description of the SMB server.
+	Description *string `json:"description,omitempty"`
+}
+
+// SMBServerModify SMB server modify params
+type SMBServerModify struct {
+	// DNS name of the SMB server in the Active Directory domain.
+	ComputerName *string `json:"computer_name,omitempty"`
+	// Active Directory domain name.
+	Domain *string `json:"domain,omitempty"`
+	// NetBIOS name of the SMB server.
+	NetbiosName *string `json:"netbios_name,omitempty"`
+	// Windows workgroup name.
+	Workgroup *string `json:"workgroup,omitempty"`
+	// Description of the SMB server.
+	Description *string `json:"description,omitempty"`
+}
+
+// SMBServerJoin params of joining SMB server to Active Directory domain
+type SMBServerJoin struct {
+	// Name of the domain user with rights to join computers to the domain.
+	DomainUsername string `json:"domain_username"`
+	// Password of the domain user. This value is not queriable.
+	DomainPassword string `json:"domain_password"`
+	// Organizational unit the computer account is created in.
+	DefaultOU *string `json:"default_ou,omitempty"`
+	// Indicates whether existing computer account with the same name is reused.
+	IsReuseEnabled *bool `json:"is_reuse_enabled,omitempty"`
+}
+
+// SMBServer Active Directory configuration of NAS server
+type SMBServer struct {
+	// Unique identifier of the SMB server.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the NAS server.
+	NasServerID string `json:"nas_server_id,omitempty"`
+	// DNS name of the SMB server in the Active Directory domain.
+	ComputerName string `json:"computer_name,omitempty"`
+	// Active Directory domain name.
+	Domain string `json:"domain,omitempty"`
+	// NetBIOS name of the SMB server.
+	NetbiosName string `json:"netbios_name,omitempty"`
+	// Windows workgroup name.
+	Workgroup string `json:"workgroup,omitempty"`
+	// Description of the SMB server.
+	Description string `json:"description,omitempty"`
+	// Indicates whether SMB server is standalone.
+	IsStandalone bool `json:"is_standalone,omitempty"`
+	// Indicates whether SMB server is joined to the Active Directory domain.
+	IsJoined bool `json:"is_joined,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *SMBServer) Fields() []string {
+	return []string{"id", "nas_server_id", "computer_name", "domain", "netbios_name",
+		"workgroup", "description", "is_standalone", "is_joined"}
+}
@@ -0,0 +1,132 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const smbShareURL = "smb_share"
+
+func getSMBShareDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	share := SMBShare{}
+	return c.APIClient().QueryParamsWithFields(&share)
+}
+
+// GetSMBShare query and return specific SMB share by id
+func (c *ClientIMPL) GetSMBShare(ctx context.Context, id string) (resp SMBShare, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    smbShareURL,
+			ID:          id,
+			QueryParams: getSMBShareDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetSMBShareByName query and return specific SMB share by name
+func (c *ClientIMPL) GetSMBShareByName(ctx context.Context, name string) (resp SMBShare, err error) {
+	var shareList []SMBShare
+	qp := getSMBShareDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    smbShareURL,
+			QueryParams: qp},
+		&shareList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(shareList) != 1 {
+		return resp, notExistError()
+	}
+	return shareList[0], nil
+}
+
+// GetSMBSharesByFileSystemID returns all SMB shares of the file system
+func (c *ClientIMPL) GetSMBSharesByFileSystemID(ctx context.Context, fsID string) ([]SMBShare, error) {
+	result := []SMBShare{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []SMBShare
+		qp := getSMBShareDefaultQueryParams(c)
+		qp.RawArg("file_system_id", fmt.Sprintf("eq.%s", fsID))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    smbShareURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateSMBShare creates new SMB share of the file system path
+func (c *ClientIMPL) CreateSMBShare(ctx context.Context,
+	createParams *SMBShareCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: smbShareURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifySMBShare modifies existing SMB share
+func (c *ClientIMPL) ModifySMBShare(ctx context.Context,
+	modifyParams *SMBShareModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: smbShareURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteSMBShare deletes existing SMB share
+func (c *ClientIMPL) DeleteSMBShare(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: smbShareURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
@@ -0,0 +1,125 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const smbShareMockURL = APIMockURL + smbShareURL
+
+var smbShareID = "5f4a3c2e-9d2b-71a4-2c88-aa03b3f6e7c1"
+
+func TestClientIMPL_GetSMBShare(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "file_system_id": "%s", "path": "/fs1", "is_encryption_enabled": true}`,
+		smbShareID, fsID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", smbShareMockURL, smbShareID),
+		httpmock.NewStringResponder(200, respData))
+	share, err := C.GetSMBShare(context.Background(), smbShareID)
+	assert.Nil(t, err)
+	assert.Equal(t, fsID, share.FileSystemID)
+	assert.True(t, share.IsEncryptionEnabled)
+}
+
+func TestClientIMPL_GetSMBShareByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", smbShareMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "share1"}]`, smbShareID)))
+	share, err := C.GetSMBShareByName(context.Background(), "share1")
+	assert.Nil(t, err)
+	assert.Equal(t, smbShareID, share.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", smbShareMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetSMBShareByName(context.Background(), "share1")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetSMBSharesByFileSystemID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var fsFilter string
+	httpmock.RegisterResponder("GET", smbShareMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			fsFilter = req.URL.Query().Get("file_system_id")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "%s"}, {"id": "share2"}]`, smbShareID)), nil
+		})
+	shares, err := C.GetSMBSharesByFileSystemID(context.Background(), fsID)
+	assert.Nil(t, err)
+	assert.Len(t, shares, 2)
+	assert.Equal(t, "eq."+fsID, fsFilter)
+}
+
+func TestClientIMPL_CreateSMBShare(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", smbShareMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, smbShareID)), nil
+		})
+	name := "share1"
+	path := "/fs1"
+	resp, err := C.CreateSMBShare(context.Background(),
+		&SMBShareCreate{Name: &name, FileSystemID: &fsID, Path: &path})
+	assert.Nil(t, err)
+	assert.Equal(t, smbShareID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "file_system_id": fsID, "path": path}, reqBody)
+}
+
+func TestClientIMPL_ModifySMBShare(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", smbShareMockURL, smbShareID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	enabled := false
+	_, err := C.ModifySMBShare(context.Background(),
+		&SMBShareModify{IsContinuousAvailabilityEnabled: &enabled}, smbShareID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"is_continuous_availability_enabled": false}, reqBody)
+}
+
+func TestClientIMPL_DeleteSMBShare(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", smbShareMockURL, smbShareID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteSMBShare(context.Background(), smbShareID)
+	assert.Nil(t, err)
+}
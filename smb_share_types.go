@@ -0,0 +1,75 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// SMBShareCreate create SMB share request
+type SMBShareCreate struct {
+	// Unique identifier of the file system on which the share is created.
+	FileSystemID *string `json:"file_system_id"`
+	// Name of the SMB share, unique on the NAS server.
+	Name *string `json:"name"`
+	// Local path to share relative to the file system root, e.g. "/fs_name".
+	Path *string `json:"path"`
+	// Description of the SMB share.
+	Description *string `json:"description,omitempty"`
+	// Indicates whether files and directories the user can't access are hidden.
+	IsABEEnabled *bool `json:"is_ABE_enabled,omitempty"`
+	// Indicates whether share is continuously available for SMB 3.0 failover.
+	IsContinuousAvailabilityEnabled *bool `json:"is_continuous_availability_enabled,omitempty"`
+	// Indicates whether SMB 3.0 encryption is required for the share.
+	IsEncryptionEnabled *bool `json:"is_encryption_enabled,omitempty"`
+}
+
+// SMBShareModify modify SMB share request, unset fields are not changed
+type SMBShareModify struct {
+	// Description of the SMB share.
+	Description *string `json:"description,omitempty"`
+	// Indicates whether files and directories the user can't access are hidden.
+	IsABEEnabled *bool `json:"is_ABE_enabled,omitempty"`
+	// Indicates whether share is continuously available for SMB 3.0 failover.
+	IsContinuousAvailabilityEnabled *bool `json:"is_continuous_availability_enabled,omitempty"`
+	// Indicates whether SMB 3.0 encryption is required for the share.
+	IsEncryptionEnabled *bool `json:"is_encryption_enabled,omitempty"`
+}
+
+// SMBShare details about SMB share
+type SMBShare struct {
+	// Unique identifier of the SMB share.
+	ID string `json:"id,omitempty"`
+	// Name of the SMB share.
+	Name string `json:"name,omitempty"`
+	// Description of the SMB share.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the shared file system.
+	FileSystemID string `json:"file_system_id,omitempty"`
+	// Local path of the share relative to the file system root.
+	Path string `json:"path,omitempty"`
+	// Indicates whether files and directories the user can't access are hidden.
+	IsABEEnabled bool `json:"is_ABE_enabled,omitempty"`
+	// Indicates whether share is continuously available for SMB 3.0 failover.
+	IsContinuousAvailabilityEnabled bool `json:"is_continuous_availability_enabled,omitempty"`
+	// Indicates whether SMB 3.0 encryption is required for the share.
+	IsEncryptionEnabled bool `json:"is_encryption_enabled,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *SMBShare) Fields() []string {
+	return []string{"id", "name", "description", "file_system_id", "path",
+		"is_ABE_enabled", "is_continuous_availability_enabled", "is_encryption_enabled"}
+}
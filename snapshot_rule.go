@@ -0,0 +1,140 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const snapshotRuleURL = "snapshot_rule"
+
+func getSnapshotRuleDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	rule := SnapshotRule{}
+	return c.APIClient().QueryParamsWithFields(&rule)
+}
+
+// GetSnapshotRule query and return specific snapshot rule by id
+func (c *ClientIMPL) GetSnapshotRule(ctx context.Context, id string) (resp SnapshotRule, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    snapshotRuleURL,
+			ID:          id,
+			QueryParams: getSnapshotRuleDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CreateSnapshotRule creates new snapshot rule
+func (c *ClientIMPL) CreateSnapshotRule(ctx context.Context,
+	createParams *SnapshotRuleCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: snapshotRuleURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteSnapshotRule deletes existing snapshot rule, rule which is used by protection policy can't be deleted
+func (c *ClientIMPL) DeleteSnapshotRule(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: snapshotRuleURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifySnapshotRule modifies existing snapshot rule.
+// By default new retention applies to future snapshots only. With ApplyToExistingSnapshots
+// expiration of volume snapshots created by the rule is set to their creation time plus new retention,
+// snapshots which can't be updated are reported by *SnapshotExpirationError
+func (c *ClientIMPL) ModifySnapshotRule(ctx context.Context,
+	modifyParams *SnapshotRuleModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: snapshotRuleURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	err = WrapErr(err)
+	if err != nil || !modifyParams.ApplyToExistingSnapshots || modifyParams.DesiredRetention == nil {
+		return resp, err
+	}
+	snapshots, err := c.getSnapshotsByRuleID(ctx, id)
+	if err != nil {
+		return resp, err
+	}
+	retention := time.Duration(*modifyParams.DesiredRetention) * time.Hour
+	failed := map[string]error{}
+	for _, snap := range snapshots {
+		created, err := time.Parse(time.RFC3339, snap.ProtectionData.SourceTimestamp)
+		if err != nil {
+			failed[snap.ID] = fmt.Errorf("can't parse snapshot creation time: %s", err.Error())
+			continue
+		}
+		expiration := created.Add(retention).UTC().Format(time.RFC3339)
+		_, err = c.ModifySnapshot(ctx, &SnapshotModify{ExpirationTimestamp: &expiration}, snap.ID)
+		if err != nil {
+			failed[snap.ID] = err
+		}
+	}
+	if len(failed) > 0 {
+		return resp, &SnapshotExpirationError{RuleID: id, Failed: failed}
+	}
+	return resp, nil
+}
+
+// getSnapshotsByRuleID returns volume snapshots created by snapshot rule
+func (c *ClientIMPL) getSnapshotsByRuleID(ctx context.Context, ruleID string) (resp []Volume, err error) {
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Volume
+		qp := getVolumeDefaultQueryParams(c)
+		qp.RawArg("type", fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot))
+		qp.RawArg("protection_data->>created_by_rule_id", fmt.Sprintf("eq.%s", ruleID))
+		qp.Order("id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
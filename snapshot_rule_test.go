@@ -0,0 +1,144 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const snapshotRuleMockURL = APIMockURL + snapshotRuleURL
+
+var snapshotRuleID = "f24a4b8c-2d3b-4b3e-97f0-17e1b2b0e0a1"
+
+func TestClientIMPL_GetSnapshotRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "interval": "One_Hour", "desired_retention": 24}`, snapshotRuleID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", snapshotRuleMockURL, snapshotRuleID),
+		httpmock.NewStringResponder(200, respData))
+	rule, err := C.GetSnapshotRule(context.Background(), snapshotRuleID)
+	assert.Nil(t, err)
+	assert.Equal(t, SnapshotRuleIntervalEnumOneHour, rule.Interval)
+	assert.Equal(t, int32(24), rule.DesiredRetention)
+}
+
+func TestClientIMPL_CreateSnapshotRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var ruleBody map[string]interface{}
+	httpmock.RegisterResponder("POST", snapshotRuleMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&ruleBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, snapshotRuleID)), nil
+		})
+	name := "daily"
+	timeOfDay := "02:00"
+	retention := int32(168)
+	resp, err := C.CreateSnapshotRule(context.Background(), &SnapshotRuleCreate{
+		Name:             &name,
+		TimeOfDay:        &timeOfDay,
+		DaysOfWeek:       []DaysOfWeekEnum{DaysOfWeekEnumMonday, DaysOfWeekEnumFriday},
+		DesiredRetention: &retention,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, snapshotRuleID, resp.ID)
+	assert.Equal(t, "02:00", ruleBody["time_of_day"])
+	assert.NotContains(t, ruleBody, "interval")
+	assert.Len(t, ruleBody["days_of_week"], 2)
+}
+
+func TestClientIMPL_DeleteSnapshotRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", snapshotRuleMockURL, snapshotRuleID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteSnapshotRule(context.Background(), snapshotRuleID)
+	assert.Nil(t, err)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", snapshotRuleMockURL, snapshotRuleID),
+		httpmock.NewStringResponder(422, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`, SnapshotRuleIsInUseErrorCode)))
+	_, err = C.DeleteSnapshotRule(context.Background(), snapshotRuleID)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.SnapshotRuleIsInUse())
+}
+
+func TestClientIMPL_ModifySnapshotRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var ruleBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", snapshotRuleMockURL, snapshotRuleID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&ruleBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	retention := int32(48)
+	_, err := C.ModifySnapshotRule(context.Background(), &SnapshotRuleModify{DesiredRetention: &retention}, snapshotRuleID)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(48), ruleBody["desired_retention"])
+	assert.NotContains(t, ruleBody, "ApplyToExistingSnapshots")
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_ModifySnapshotRule_ApplyToExistingSnapshots(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", snapshotRuleMockURL, snapshotRuleID),
+		httpmock.NewStringResponder(204, ""))
+	var ruleFilter string
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			ruleFilter = req.URL.Query().Get("protection_data->>created_by_rule_id")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[
+{"id": "%s", "protection_data": {"source_timestamp": "2020-05-06T10:00:00Z"}},
+{"id": "%s", "protection_data": {"source_timestamp": "2020-05-07T10:00:00Z"}}]`, volID, volID2)), nil
+		})
+	var expiration map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&expiration); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE0A08001000E"}]}`))
+
+	retention := int32(48)
+	_, err := C.ModifySnapshotRule(context.Background(),
+		&SnapshotRuleModify{DesiredRetention: &retention, ApplyToExistingSnapshots: true}, snapshotRuleID)
+	assert.NotNil(t, err)
+	assert.Equal(t, "eq."+snapshotRuleID, ruleFilter)
+	assert.Equal(t, "2020-05-08T10:00:00Z", expiration["expiration_timestamp"])
+	expErr, ok := err.(*SnapshotExpirationError)
+	assert.True(t, ok)
+	assert.Len(t, expErr.Failed, 1)
+	assert.Contains(t, expErr.Failed, volID2)
+}
@@ -0,0 +1,144 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SnapshotRuleIntervalEnum interval between snapshots taken by rule
+type SnapshotRuleIntervalEnum string
+
+const (
+	// SnapshotRuleIntervalEnumFiveMinutes captures enum value "Five_Minutes"
+	SnapshotRuleIntervalEnumFiveMinutes SnapshotRuleIntervalEnum = "Five_Minutes"
+	// SnapshotRuleIntervalEnumFifteenMinutes captures enum value "Fifteen_Minutes"
+	SnapshotRuleIntervalEnumFifteenMinutes SnapshotRuleIntervalEnum = "Fifteen_Minutes"
+	// SnapshotRuleIntervalEnumThirtyMinutes captures enum value "Thirty_Minutes"
+	SnapshotRuleIntervalEnumThirtyMinutes SnapshotRuleIntervalEnum = "Thirty_Minutes"
+	// SnapshotRuleIntervalEnumOneHour captures enum value "One_Hour"
+	SnapshotRuleIntervalEnumOneHour SnapshotRuleIntervalEnum = "One_Hour"
+	// SnapshotRuleIntervalEnumTwoHours captures enum value "Two_Hours"
+	SnapshotRuleIntervalEnumTwoHours SnapshotRuleIntervalEnum = "Two_Hours"
+	// SnapshotRuleIntervalEnumThreeHours captures enum value "Three_Hours"
+	SnapshotRuleIntervalEnumThreeHours SnapshotRuleIntervalEnum = "Three_Hours"
+	// SnapshotRuleIntervalEnumFourHours captures enum value "Four_Hours"
+	SnapshotRuleIntervalEnumFourHours SnapshotRuleIntervalEnum = "Four_Hours"
+	// SnapshotRuleIntervalEnumSixHours captures enum value "Six_Hours"
+	SnapshotRuleIntervalEnumSixHours SnapshotRuleIntervalEnum = "Six_Hours"
+	// SnapshotRuleIntervalEnumEightHours captures enum value "Eight_Hours"
+	SnapshotRuleIntervalEnumEightHours SnapshotRuleIntervalEnum = "Eight_Hours"
+	// SnapshotRuleIntervalEnumTwelveHours captures enum value "Twelve_Hours"
+	SnapshotRuleIntervalEnumTwelveHours SnapshotRuleIntervalEnum = "Twelve_Hours"
+	// SnapshotRuleIntervalEnumOneDay captures enum value "One_Day"
+	SnapshotRuleIntervalEnumOneDay SnapshotRuleIntervalEnum = "One_Day"
+)
+
+// DaysOfWeekEnum day of the week
+type DaysOfWeekEnum string
+
+const (
+	// DaysOfWeekEnumMonday captures enum value "Monday"
+	DaysOfWeekEnumMonday DaysOfWeekEnum = "Monday"
+	// DaysOfWeekEnumTuesday captures enum value "Tuesday"
+	DaysOfWeekEnumTuesday DaysOfWeekEnum = "Tuesday"
+	// DaysOfWeekEnumWednesday captures enum value "Wednesday"
+	DaysOfWeekEnumWednesday DaysOfWeekEnum = "Wednesday"
+	// DaysOfWeekEnumThursday captures enum value "Thursday"
+	DaysOfWeekEnumThursday DaysOfWeekEnum = "Thursday"
+	// DaysOfWeekEnumFriday captures enum value "Friday"
+	DaysOfWeekEnumFriday DaysOfWeekEnum = "Friday"
+	// DaysOfWeekEnumSaturday captures enum value "Saturday"
+	DaysOfWeekEnumSaturday DaysOfWeekEnum = "Saturday"
+	// DaysOfWeekEnumSunday captures enum value "Sunday"
+	DaysOfWeekEnumSunday DaysOfWeekEnum = "Sunday"
+)
+
+// SnapshotRule snapshot schedule of protection policy
+type SnapshotRule struct {
+	// Unique identifier of the snapshot rule.
+	ID string `json:"id,omitempty"`
+	// Name of the snapshot rule.
+	Name string `json:"name,omitempty"`
+	// Interval between snapshots. Either Interval or TimeOfDay is set.
+	Interval SnapshotRuleIntervalEnum `json:"interval,omitempty"`
+	// Time of the day to take a daily snapshot, in format "hh:mm".
+	TimeOfDay string `json:"time_of_day,omitempty"`
+	// Days of the week when the rule is applied.
+	DaysOfWeek []DaysOfWeekEnum `json:"days_of_week,omitempty"`
+	// Desired snapshot retention period in hours.
+	DesiredRetention int32 `json:"desired_retention,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (r *SnapshotRule) Fields() []string {
+	return []string{"id", "name", "interval", "time_of_day", "days_of_week", "desired_retention"}
+}
+
+// SnapshotRuleCreate create snapshot rule request
+type SnapshotRuleCreate struct {
+	// Name of the snapshot rule.
+	Name *string `json:"name"`
+	// Interval between snapshots. Either Interval or TimeOfDay must be set.
+	Interval *SnapshotRuleIntervalEnum `json:"interval,omitempty"`
+	// Time of the day to take a daily snapshot, in format "hh:mm".
+	TimeOfDay *string `json:"time_of_day,omitempty"`
+	// Days of the week when the rule is applied, all days if not set.
+	DaysOfWeek []DaysOfWeekEnum `json:"days_of_week,omitempty"`
+	// Desired snapshot retention period in hours.
+	DesiredRetention *int32 `json:"desired_retention"`
+}
+
+// SnapshotRuleModify modify snapshot rule params
+type SnapshotRuleModify struct {
+	// Name of the snapshot rule.
+	Name *string `json:"name,omitempty"`
+	// Interval between snapshots.
+	Interval *SnapshotRuleIntervalEnum `json:"interval,omitempty"`
+	// Time of the day to take a daily snapshot, in format "hh:mm".
+	TimeOfDay *string `json:"time_of_day,omitempty"`
+	// Days of the week when the rule is applied.
+	DaysOfWeek *[]DaysOfWeekEnum `json:"days_of_week,omitempty"`
+	// Desired snapshot retention period in hours.
+	DesiredRetention *int32 `json:"desired_retention,omitempty"`
+	// ApplyToExistingSnapshots makes ModifySnapshotRule set expiration of snapshots previously
+	// created by the rule according to the new DesiredRetention. It is not sent to the array
+	ApplyToExistingSnapshots bool `json:"-"`
+}
+
+// SnapshotExpirationError is returned by ModifySnapshotRule when expiration of some
+// existing snapshots can't be updated. Rule itself is modified
+type SnapshotExpirationError struct {
+	// Unique identifier of the snapshot rule.
+	RuleID string
+	// Errors by snapshot id.
+	Failed map[string]error
+}
+
+func (e *SnapshotExpirationError) Error() string {
+	var ids []string
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return fmt.Sprintf("can't update expiration of snapshots created by rule %s: %s",
+		e.RuleID, strings.Join(ids, ", "))
+}
@@ -0,0 +1,146 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const softwarePackageURL = "software_package"
+
+func getSoftwarePackageDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	pkg := SoftwarePackage{}
+	return c.APIClient().QueryParamsWithFields(&pkg)
+}
+
+// GetSoftwarePackage query and return specific software package by id
+func (c *ClientIMPL) GetSoftwarePackage(ctx context.Context, id string) (resp SoftwarePackage, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    softwarePackageURL,
+			ID:          id,
+			QueryParams: getSoftwarePackageDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetSoftwarePackages returns all software packages uploaded to the cluster, installed ones included
+func (c *ClientIMPL) GetSoftwarePackages(ctx context.Context) ([]SoftwarePackage, error) {
+	result := []SoftwarePackage{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []SoftwarePackage
+		qp := getSoftwarePackageDefaultQueryParams(c)
+		qp.Order("release_version,id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    softwarePackageURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// UploadSoftwarePackage uploads package file to the cluster, the file is validated by the array
+// before the package is created. Content is read from the start on every attempt of the request.
+// Upload of a large package takes longer than default timeout, set a longer one with WithRequestTimeout
+func (c *ClientIMPL) UploadSoftwarePackage(ctx context.Context,
+	fileName string, content io.ReadSeeker) (resp CreateResponse, err error) {
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	if _, err = form.CreateFormFile("file", fileName); err != nil {
+		return resp, err
+	}
+	header := append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+	if err = form.Close(); err != nil {
+		return resp, err
+	}
+	trailer := append([]byte(nil), buf.Bytes()...)
+	body := &api.RawBody{
+		ContentType: form.FormDataContentType(),
+		Open: func() (io.Reader, error) {
+			if _, err := content.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return io.MultiReader(bytes.NewReader(header), content, bytes.NewReader(trailer)), nil
+		}}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: softwarePackageURL,
+			Body:     body},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteSoftwarePackage deletes software package which is not installed
+func (c *ClientIMPL) DeleteSoftwarePackage(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: softwarePackageURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// RunSoftwarePackageHealthCheck runs pre-upgrade health check of the package. Operation runs
+// asynchronously, the returned JobResponse holds id of the job, failed checks are reported in the job response
+func (c *ClientIMPL) RunSoftwarePackageHealthCheck(ctx context.Context,
+	id string, checkParams *SoftwarePackageHealthCheck) (resp JobResponse, err error) {
+	return c.softwarePackageAction(ctx, id, "puhc", checkParams)
+}
+
+// InstallSoftwarePackage starts upgrade of the cluster with the package, appliances are upgraded
+// one by one. Operation runs asynchronously, the returned JobResponse holds id of the job,
+// use WaitForJob to wait until the upgrade is finished
+func (c *ClientIMPL) InstallSoftwarePackage(ctx context.Context,
+	id string, installParams *SoftwarePackageInstall) (resp JobResponse, err error) {
+	return c.softwarePackageAction(ctx, id, "install", installParams)
+}
+
+func (c *ClientIMPL) softwarePackageAction(ctx context.Context,
+	id, action string, body interface{}) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    softwarePackageURL,
+		ID:          id,
+		Action:      action,
+		QueryParams: qp,
+		Body:        body})
+}
@@ -0,0 +1,130 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const softwarePackageMockURL = APIMockURL + softwarePackageURL
+
+var softwarePackageID = "2.1.0.0-1234567"
+
+func TestClientIMPL_GetSoftwarePackage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "type": "Software", "state": "Downloaded", "release_version": "2.1.0.0",
+"size": 4294967296}`, softwarePackageID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", softwarePackageMockURL, softwarePackageID),
+		httpmock.NewStringResponder(200, respData))
+	pkg, err := C.GetSoftwarePackage(context.Background(), softwarePackageID)
+	assert.Nil(t, err)
+	assert.Equal(t, SoftwarePackageTypeEnumSoftware, pkg.Type)
+	assert.Equal(t, SoftwarePackageStateEnumDownloaded, pkg.State)
+	assert.Equal(t, "2.1.0.0", pkg.ReleaseVersion)
+	assert.Equal(t, int64(4294967296), pkg.Size)
+}
+
+func TestClientIMPL_GetSoftwarePackages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", softwarePackageMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "2.0.0.0-1"}]`, softwarePackageID)))
+	packages, err := C.GetSoftwarePackages(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, packages, 2)
+}
+
+func TestClientIMPL_UploadSoftwarePackage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var uploads []string
+	var fileName string
+	httpmock.RegisterResponder("POST", softwarePackageMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			file, header, err := req.FormFile("file")
+			if err != nil {
+				return nil, err
+			}
+			data, _ := ioutil.ReadAll(file)
+			uploads = append(uploads, string(data))
+			fileName = header.Filename
+			// the first attempt fails, so the package is uploaded again
+			if len(uploads) == 1 {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, softwarePackageID)), nil
+		})
+	resp, err := C.UploadSoftwarePackage(context.Background(), "PowerStoreT-2.1.0.0.tgz.bin",
+		strings.NewReader("package content"))
+	assert.Nil(t, err)
+	assert.Equal(t, softwarePackageID, resp.ID)
+	assert.Equal(t, "PowerStoreT-2.1.0.0.tgz.bin", fileName)
+	assert.Equal(t, []string{"package content", "package content"}, uploads)
+}
+
+func TestClientIMPL_DeleteSoftwarePackage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", softwarePackageMockURL, softwarePackageID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteSoftwarePackage(context.Background(), softwarePackageID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_SoftwarePackageUpgrade(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	var isAsync string
+	responder := func(req *http.Request) (*http.Response, error) {
+		isAsync = req.URL.Query().Get("is_async")
+		reqBody = nil
+		if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+	}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/puhc", softwarePackageMockURL, softwarePackageID),
+		responder)
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/install", softwarePackageMockURL, softwarePackageID),
+		responder)
+
+	resp, err := C.RunSoftwarePackageHealthCheck(context.Background(), softwarePackageID,
+		&SoftwarePackageHealthCheck{ApplianceIDs: []string{"A1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+	assert.Equal(t, "true", isAsync)
+	assert.Equal(t, []interface{}{"A1"}, reqBody["appliance_ids"])
+
+	isPrepare := true
+	resp, err = C.InstallSoftwarePackage(context.Background(), softwarePackageID,
+		&SoftwarePackageInstall{IsPrepare: &isPrepare})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+	assert.Equal(t, true, reqBody["is_prepare"])
+}
@@ -0,0 +1,96 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// SoftwarePackageStateEnum state of the software package
+type SoftwarePackageStateEnum string
+
+const (
+	// SoftwarePackageStateEnumUploading captures enum value "Uploading"
+	SoftwarePackageStateEnumUploading SoftwarePackageStateEnum = "Uploading"
+	// SoftwarePackageStateEnumUploadFailed captures enum value "Upload_Failed"
+	SoftwarePackageStateEnumUploadFailed SoftwarePackageStateEnum = "Upload_Failed"
+	// SoftwarePackageStateEnumDownloaded captures enum value "Downloaded"
+	SoftwarePackageStateEnumDownloaded SoftwarePackageStateEnum = "Downloaded"
+	// SoftwarePackageStateEnumPreparing captures enum value "Preparing"
+	SoftwarePackageStateEnumPreparing SoftwarePackageStateEnum = "Preparing"
+	// SoftwarePackageStateEnumPrepared captures enum value "Prepared"
+	SoftwarePackageStateEnumPrepared SoftwarePackageStateEnum = "Prepared"
+	// SoftwarePackageStateEnumPrepareFailed captures enum value "Prepare_Failed"
+	SoftwarePackageStateEnumPrepareFailed SoftwarePackageStateEnum = "Prepare_Failed"
+	// SoftwarePackageStateEnumInstalling captures enum value "Installing"
+	SoftwarePackageStateEnumInstalling SoftwarePackageStateEnum = "Installing"
+	// SoftwarePackageStateEnumInstalled captures enum value "Installed"
+	SoftwarePackageStateEnumInstalled SoftwarePackageStateEnum = "Installed"
+	// SoftwarePackageStateEnumInstallFailed captures enum value "Install_Failed"
+	SoftwarePackageStateEnumInstallFailed SoftwarePackageStateEnum = "Install_Failed"
+)
+
+// SoftwarePackageTypeEnum type of the software package
+type SoftwarePackageTypeEnum string
+
+const (
+	// SoftwarePackageTypeEnumSoftware captures enum value "Software", PowerStore OS upgrade
+	SoftwarePackageTypeEnumSoftware SoftwarePackageTypeEnum = "Software"
+	// SoftwarePackageTypeEnumFirmware captures enum value "Firmware", e.g. drive firmware
+	SoftwarePackageTypeEnumFirmware SoftwarePackageTypeEnum = "Firmware"
+	// SoftwarePackageTypeEnumHotfix captures enum value "Hotfix"
+	SoftwarePackageTypeEnumHotfix SoftwarePackageTypeEnum = "Hotfix"
+	// SoftwarePackageTypeEnumHealthCheck captures enum value "Health_Check", updated pre-upgrade health check
+	SoftwarePackageTypeEnumHealthCheck SoftwarePackageTypeEnum = "Health_Check"
+)
+
+// SoftwarePackage software or firmware package uploaded to the cluster
+type SoftwarePackage struct {
+	// Unique identifier of the package.
+	ID string `json:"id,omitempty"`
+	// Type of the package.
+	Type SoftwarePackageTypeEnum `json:"type,omitempty"`
+	// State of the package.
+	State SoftwarePackageStateEnum `json:"state,omitempty"`
+	// Release version of the package, e.g. "2.0.0.0".
+	ReleaseVersion string `json:"release_version,omitempty"`
+	// Build version of the package.
+	BuildVersion string `json:"build_version,omitempty"`
+	// Release timestamp of the package.
+	ReleaseTimestamp string `json:"release_timestamp,omitempty"`
+	// Size of the package in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Estimated duration of the installation in seconds.
+	DurationEstimate int64 `json:"duration_estimate,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (p *SoftwarePackage) Fields() []string {
+	return []string{"id", "type", "state", "release_version", "build_version",
+		"release_timestamp", "size", "duration_estimate"}
+}
+
+// SoftwarePackageInstall install software package request
+type SoftwarePackageInstall struct {
+	// Only stage the package on the appliances without activating it, so the following
+	// installation takes less time.
+	IsPrepare *bool `json:"is_prepare,omitempty"`
+}
+
+// SoftwarePackageHealthCheck pre-upgrade health check request
+type SoftwarePackageHealthCheck struct {
+	// Appliances to check, all appliances are checked if not set.
+	ApplianceIDs []string `json:"appliance_ids,omitempty"`
+}
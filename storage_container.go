@@ -0,0 +1,131 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const storageContainerURL = "storage_container"
+
+func getStorageContainerDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	container := StorageContainer{}
+	return c.APIClient().QueryParamsWithFields(&container)
+}
+
+// GetStorageContainer query and return specific storage container by id
+func (c *ClientIMPL) GetStorageContainer(ctx context.Context, id string) (resp StorageContainer, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    storageContainerURL,
+			ID:          id,
+			QueryParams: getStorageContainerDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetStorageContainerByName query and return specific storage container by name
+func (c *ClientIMPL) GetStorageContainerByName(ctx context.Context, name string) (resp StorageContainer, err error) {
+	var containerList []StorageContainer
+	qp := getStorageContainerDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    storageContainerURL,
+			QueryParams: qp},
+		&containerList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(containerList) != 1 {
+		return resp, notExistError()
+	}
+	return containerList[0], nil
+}
+
+// GetStorageContainers returns all storage containers
+func (c *ClientIMPL) GetStorageContainers(ctx context.Context) ([]StorageContainer, error) {
+	result := []StorageContainer{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []StorageContainer
+		qp := getStorageContainerDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    storageContainerURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CreateStorageContainer creates new storage container
+func (c *ClientIMPL) CreateStorageContainer(ctx context.Context,
+	createParams *StorageContainerCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: storageContainerURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyStorageContainer modifies existing storage container
+func (c *ClientIMPL) ModifyStorageContainer(ctx context.Context,
+	modifyParams *StorageContainerModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: storageContainerURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteStorageContainer deletes existing storage container, containers holding virtual volumes can't be deleted
+func (c *ClientIMPL) DeleteStorageContainer(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: storageContainerURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
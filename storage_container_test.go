@@ -0,0 +1,118 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const storageContainerMockURL = APIMockURL + storageContainerURL
+
+var storageContainerID = "e1b7c3a9-4d2f-4b8e-a6c1-3f9d7e2b5a04"
+
+func TestClientIMPL_GetStorageContainer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "name": "sc1", "quota": 1073741824, "storage_protocol": "NVMe"}`,
+		storageContainerID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", storageContainerMockURL, storageContainerID),
+		httpmock.NewStringResponder(200, respData))
+	container, err := C.GetStorageContainer(context.Background(), storageContainerID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1073741824), container.Quota)
+	assert.Equal(t, StorageContainerStorageProtocolEnumNVMe, container.StorageProtocol)
+}
+
+func TestClientIMPL_GetStorageContainerByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", storageContainerMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "sc1"}]`, storageContainerID)))
+	container, err := C.GetStorageContainerByName(context.Background(), "sc1")
+	assert.Nil(t, err)
+	assert.Equal(t, storageContainerID, container.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", storageContainerMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetStorageContainerByName(context.Background(), "sc1")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetStorageContainers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", storageContainerMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "sc2"}]`, storageContainerID)))
+	containers, err := C.GetStorageContainers(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, containers, 2)
+}
+
+func TestClientIMPL_CreateStorageContainer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", storageContainerMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, storageContainerID)), nil
+		})
+	name := "sc1"
+	protocol := StorageContainerStorageProtocolEnumSCSI
+	resp, err := C.CreateStorageContainer(context.Background(),
+		&StorageContainerCreate{Name: &name, StorageProtocol: &protocol})
+	assert.Nil(t, err)
+	assert.Equal(t, storageContainerID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "storage_protocol": "SCSI"}, reqBody)
+}
+
+func TestClientIMPL_ModifyStorageContainer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", storageContainerMockURL, storageContainerID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	quota := int64(0)
+	_, err := C.ModifyStorageContainer(context.Background(), &StorageContainerModify{Quota: &quota}, storageContainerID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"quota": float64(0)}, reqBody)
+}
+
+func TestClientIMPL_DeleteStorageContainer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", storageContainerMockURL, storageContainerID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteStorageContainer(context.Background(), storageContainerID)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,66 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// StorageContainerStorageProtocolEnum storage protocol of the storage container
+type StorageContainerStorageProtocolEnum string
+
+const (
+	// StorageContainerStorageProtocolEnumSCSI captures enum value "SCSI"
+	StorageContainerStorageProtocolEnumSCSI StorageContainerStorageProtocolEnum = "SCSI"
+	// StorageContainerStorageProtocolEnumNVMe captures enum value "NVMe"
+	StorageContainerStorageProtocolEnumNVMe StorageContainerStorageProtocolEnum = "NVMe"
+)
+
+// StorageContainerCreate create storage container request
+type StorageContainerCreate struct {
+	// Name of the storage container.
+	Name *string `json:"name"`
+	// Number of bytes that can be provisioned against the storage container, zero means no limit.
+	Quota *int64 `json:"quota,omitempty"`
+	// Storage protocol of virtual volumes of the storage container, SCSI if not set.
+	StorageProtocol *StorageContainerStorageProtocolEnum `json:"storage_protocol,omitempty"`
+}
+
+// StorageContainerModify modify storage container request, unset fields are not changed
+type StorageContainerModify struct {
+	// Name of the storage container.
+	Name *string `json:"name,omitempty"`
+	// Number of bytes that can be provisioned against the storage container, zero removes the limit.
+	Quota *int64 `json:"quota,omitempty"`
+	// Storage protocol of virtual volumes of the storage container.
+	StorageProtocol *StorageContainerStorageProtocolEnum `json:"storage_protocol,omitempty"`
+}
+
+// StorageContainer details about storage container used by vVols
+type StorageContainer struct {
+	// Unique identifier of the storage container.
+	ID string `json:"id,omitempty"`
+	// Name of the storage container.
+	Name string `json:"name,omitempty"`
+	// Number of bytes that can be provisioned against the storage container, zero means no limit.
+	Quota int64 `json:"quota,omitempty"`
+	// Storage protocol of virtual volumes of the storage container.
+	StorageProtocol StorageContainerStorageProtocolEnum `json:"storage_protocol,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (s *StorageContainer) Fields() []string {
+	return []string{"id", "name", "quota", "storage_protocol"}
+}
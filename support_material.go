@@ -0,0 +1,141 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const supportMaterialURL = "support_material"
+
+func getSupportMaterialDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	material := SupportMaterial{}
+	return c.APIClient().QueryParamsWithFields(&material)
+}
+
+// GetSupportMaterial query and return specific support materials bundle by id
+func (c *ClientIMPL) GetSupportMaterial(ctx context.Context, id string) (resp SupportMaterial, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    supportMaterialURL,
+			ID:          id,
+			QueryParams: getSupportMaterialDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetSupportMaterials returns all support materials bundles, the oldest first
+func (c *ClientIMPL) GetSupportMaterials(ctx context.Context) ([]SupportMaterial, error) {
+	result := []SupportMaterial{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []SupportMaterial
+		qp := getSupportMaterialDefaultQueryParams(c)
+		qp.Order("creation_timestamp,id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    supportMaterialURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// CollectSupportMaterial starts collection of support materials. Operation runs asynchronously,
+// the returned JobResponse holds id of the job, use WaitForJob to wait until the bundle is collected.
+// Id of the bundle is reported in the resource id of the job
+func (c *ClientIMPL) CollectSupportMaterial(ctx context.Context,
+	collectParams *SupportMaterialCollect) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    supportMaterialURL,
+		QueryParams: qp,
+		Body:        collectParams})
+}
+
+// DeleteSupportMaterial deletes collected support materials bundle
+func (c *ClientIMPL) DeleteSupportMaterial(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: supportMaterialURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DownloadSupportMaterial streams content of the collected bundle. Error is returned right away if
+// the array rejects the download, failure in the middle of the download is returned by Read.
+// Caller must close the returned reader, closing it before EOF aborts the download.
+// Download of a large bundle takes longer than default timeout, set a longer one with WithRequestTimeout
+func (c *ClientIMPL) DownloadSupportMaterial(ctx context.Context, id string) (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+	content := &startNotifyWriter{w: writer, started: make(chan struct{})}
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:   "GET",
+				Endpoint: supportMaterialURL,
+				ID:       id,
+				Action:   "download"},
+			content)
+		err = WrapErr(err)
+		writer.CloseWithError(err)
+		done <- err
+	}()
+	select {
+	case <-content.started:
+		return reader, nil
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+		return reader, nil
+	}
+}
+
+// startNotifyWriter closes started before the first write, so the download is known to be accepted
+type startNotifyWriter struct {
+	w       io.Writer
+	once    sync.Once
+	started chan struct{}
+}
+
+func (s *startNotifyWriter) Write(p []byte) (int, error) {
+	s.once.Do(func() { close(s.started) })
+	return s.w.Write(p)
+}
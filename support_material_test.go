@@ -0,0 +1,113 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const supportMaterialMockURL = APIMockURL + supportMaterialURL
+
+var supportMaterialID = "7ed5bd74-5a17-4ab6-9a34-a1b6f5e3a2b1"
+
+func TestClientIMPL_GetSupportMaterial(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "name": "powerstore_sm_1.tgz", "types": ["Essential"],
+"state": "Completed", "size": 1048576}`, supportMaterialID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", supportMaterialMockURL, supportMaterialID),
+		httpmock.NewStringResponder(200, respData))
+	material, err := C.GetSupportMaterial(context.Background(), supportMaterialID)
+	assert.Nil(t, err)
+	assert.Equal(t, "powerstore_sm_1.tgz", material.Name)
+	assert.Equal(t, []SupportMaterialTypeEnum{SupportMaterialTypeEnumEssential}, material.Types)
+	assert.Equal(t, SupportMaterialStateEnumCompleted, material.State)
+	assert.Equal(t, int64(1048576), material.Size)
+}
+
+func TestClientIMPL_GetSupportMaterials(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", supportMaterialMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "sm-2"}]`, supportMaterialID)))
+	materials, err := C.GetSupportMaterials(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, materials, 2)
+}
+
+func TestClientIMPL_CollectSupportMaterial(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	var isAsync string
+	httpmock.RegisterResponder("POST", supportMaterialMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			isAsync = req.URL.Query().Get("is_async")
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+		})
+	description := "SR 12345"
+	resp, err := C.CollectSupportMaterial(context.Background(), &SupportMaterialCollect{
+		Types:       []SupportMaterialTypeEnum{SupportMaterialTypeEnumDetailed},
+		Description: &description})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+	assert.Equal(t, "true", isAsync)
+	assert.Equal(t, map[string]interface{}{"types": []interface{}{"Detailed"}, "description": "SR 12345"}, reqBody)
+}
+
+func TestClientIMPL_DeleteSupportMaterial(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", supportMaterialMockURL, supportMaterialID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteSupportMaterial(context.Background(), supportMaterialID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_DownloadSupportMaterial(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s/download", supportMaterialMockURL, supportMaterialID),
+		httpmock.NewStringResponder(200, "bundle content"))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s/download", supportMaterialMockURL, "sm-2"),
+		httpmock.NewStringResponder(404, ""))
+
+	content, err := C.DownloadSupportMaterial(context.Background(), supportMaterialID)
+	assert.Nil(t, err)
+	data, err := ioutil.ReadAll(content)
+	assert.Nil(t, err)
+	assert.Nil(t, content.Close())
+	assert.Equal(t, "bundle content", string(data))
+
+	content, err = C.DownloadSupportMaterial(context.Background(), "sm-2")
+	assert.Nil(t, content)
+	apiError, ok := err.(APIError)
+	assert.True(t, ok)
+	assert.True(t, apiError.NotFound())
+}
@@ -0,0 +1,87 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// SupportMaterialTypeEnum type of support materials to collect
+type SupportMaterialTypeEnum string
+
+const (
+	// SupportMaterialTypeEnumEssential captures enum value "Essential", logs and configuration needed in most cases
+	SupportMaterialTypeEnumEssential SupportMaterialTypeEnum = "Essential"
+	// SupportMaterialTypeEnumDetailed captures enum value "Detailed", essential materials with extended logs
+	SupportMaterialTypeEnumDetailed SupportMaterialTypeEnum = "Detailed"
+	// SupportMaterialTypeEnumPerformance captures enum value "Performance", performance statistics
+	SupportMaterialTypeEnumPerformance SupportMaterialTypeEnum = "Performance"
+	// SupportMaterialTypeEnumControlPath captures enum value "Control_Path", management software logs only
+	SupportMaterialTypeEnumControlPath SupportMaterialTypeEnum = "Control_Path"
+)
+
+// SupportMaterialStateEnum state of the support materials collection
+type SupportMaterialStateEnum string
+
+const (
+	// SupportMaterialStateEnumCollecting captures enum value "Collecting"
+	SupportMaterialStateEnumCollecting SupportMaterialStateEnum = "Collecting"
+	// SupportMaterialStateEnumCompleted captures enum value "Completed"
+	SupportMaterialStateEnumCompleted SupportMaterialStateEnum = "Completed"
+	// SupportMaterialStateEnumFailed captures enum value "Failed"
+	SupportMaterialStateEnumFailed SupportMaterialStateEnum = "Failed"
+)
+
+// SupportMaterialCollect request for collecting support materials
+type SupportMaterialCollect struct {
+	// Types of materials to collect, essential materials are collected if not set.
+	Types []SupportMaterialTypeEnum `json:"types,omitempty"`
+	// Appliance to collect materials from, all appliances are included if not set.
+	ApplianceID *string `json:"appliance_id,omitempty"`
+	// Description of the collected bundle, e.g. number of the support case.
+	Description *string `json:"description,omitempty"`
+	// Automatically send the bundle to support once it is collected.
+	IsSupportNotified *bool `json:"is_support_notified,omitempty"`
+}
+
+// SupportMaterial bundle of logs and diagnostic data collected on the cluster
+type SupportMaterial struct {
+	// Unique identifier of the bundle.
+	ID string `json:"id,omitempty"`
+	// Name of the bundle file.
+	Name string `json:"name,omitempty"`
+	// Description of the bundle.
+	Description string `json:"description,omitempty"`
+	// Types of collected materials.
+	Types []SupportMaterialTypeEnum `json:"types,omitempty"`
+	// State of the collection.
+	State SupportMaterialStateEnum `json:"state,omitempty"`
+	// Unique identifier of the appliance the materials were collected from, empty for the whole cluster.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// Unique identifier of the job which collects the materials.
+	JobID string `json:"job_id,omitempty"`
+	// Size of the bundle file in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Time when the collection started.
+	CreationTimestamp string `json:"creation_timestamp,omitempty"`
+	// Time when the collection finished.
+	CompletionTimestamp string `json:"completion_timestamp,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (m *SupportMaterial) Fields() []string {
+	return []string{"id", "name", "description", "types", "state", "appliance_id",
+		"job_id", "size", "creation_timestamp", "completion_timestamp"}
+}
@@ -0,0 +1,147 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+// ErrNotSupported is returned, wrapped with the reason, when request uses a feature
+// which the array doesn't support in its PowerStore OS version
+var ErrNotSupported = errors.New("not supported by the array version")
+
+// versionCache holds major and minor version of the array once it is detected
+type versionCache struct {
+	mu      sync.Mutex
+	version SoftwareVersion
+}
+
+func (vc *versionCache) get() SoftwareVersion {
+	if vc == nil {
+		return SoftwareVersion{}
+	}
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	return vc.version
+}
+
+func (vc *versionCache) set(version SoftwareVersion) {
+	if vc == nil {
+		return
+	}
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	vc.version = version
+}
+
+// parseMajorMinorVersion returns major and minor part of release version, e.g. 2.1 for "2.1.0.0"
+func parseMajorMinorVersion(release string) (SoftwareVersion, error) {
+	parts := strings.SplitN(release, ".", 3)
+	if len(parts) < 2 {
+		return SoftwareVersion{}, fmt.Errorf("can't parse software version: %s", release)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major <= 0 {
+		return SoftwareVersion{}, fmt.Errorf("can't parse software version: %s", release)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return SoftwareVersion{}, fmt.Errorf("can't parse software version: %s", release)
+	}
+	return SoftwareVersion{Major: major, Minor: minor}, nil
+}
+
+// GetSoftwareMajorMinorVersion returns major and minor PowerStore OS version of the cluster, e.g. 2.1.
+// The version is detected once and reused by the client, InvalidateCache makes the client detect it
+// again, e.g. after the cluster is upgraded
+func (c *ClientIMPL) GetSoftwareMajorMinorVersion(ctx context.Context) (SoftwareVersion, error) {
+	if version := c.version.get(); version.Major != 0 {
+		return version, nil
+	}
+	release, err := c.GetSoftwareVersion(ctx)
+	if err != nil {
+		return SoftwareVersion{}, err
+	}
+	version, err := parseMajorMinorVersion(release)
+	if err != nil {
+		return SoftwareVersion{}, err
+	}
+	c.version.set(version)
+	return version, nil
+}
+
+// GetCapabilities returns features supported by the array, derived from its PowerStore OS version.
+// Request payloads aren't adapted to the array version, requests which set fields unsupported by
+// the array are rejected with ErrNotSupported, so callers use capabilities to decide which fields to set
+func (c *ClientIMPL) GetCapabilities(ctx context.Context) (Capabilities, error) {
+	version, err := c.GetSoftwareMajorMinorVersion(ctx)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	return Capabilities{Version: version}, nil
+}
+
+// logDebug logs message with logger of the API client, the message is dropped if the client has no logger
+func (c *ClientIMPL) logDebug(ctx context.Context, format string, args ...interface{}) {
+	if l, ok := c.API.(interface{ Logger() api.Logger }); ok {
+		l.Logger().Debug(ctx, format, args...)
+	}
+}
+
+// versionedField request field supported since the version of PowerStore OS
+type versionedField struct {
+	name  string
+	isSet bool
+	since SoftwareVersion
+}
+
+// checkVersionedFields rejects request which sets fields unsupported by the array, so the caller gets
+// ErrNotSupported instead of generic bad request. The payload isn't changed to fit older versions,
+// the caller has to drop such fields, e.g. based on GetCapabilities.
+// Version is detected only if such fields are set. When the version can't be detected, e.g. the user
+// has no access to software info, the failure is logged at debug level, the check is skipped and
+// request is sent as is, so the array decides whether the fields are supported
+func (c *ClientIMPL) checkVersionedFields(ctx context.Context, fields ...versionedField) error {
+	var version SoftwareVersion
+	for _, f := range fields {
+		if !f.isSet {
+			continue
+		}
+		if version.Major == 0 {
+			var err error
+			if version, err = c.GetSoftwareMajorMinorVersion(ctx); err != nil {
+				c.logDebug(ctx, "can't detect array version, %s is sent without version check: %s",
+					f.name, err.Error())
+				return nil
+			}
+		}
+		if !version.AtLeast(f.since.Major, f.since.Minor) {
+			return fmt.Errorf("%w: %s requires PowerStore %s or newer, array version is %s",
+				ErrNotSupported, f.name, f.since, version)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+// registerSoftwareVersion makes the mocked array report the release version, version detected
+// by previous tests is dropped
+func registerSoftwareVersion(release string) {
+	C.InvalidateCache()
+	httpmock.RegisterResponder("GET", softwareInstalledMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "1", "is_cluster": true, "release_version": "%s"}]`,
+			release)))
+}
+
+func TestClientIMPL_GetSoftwareMajorMinorVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("2.1.0.1")
+	version, err := C.GetSoftwareMajorMinorVersion(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, SoftwareVersion{Major: 2, Minor: 1}, version)
+
+	// detected version is reused until the cache is invalidated
+	httpmock.Reset()
+	version, err = C.GetSoftwareMajorMinorVersion(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, SoftwareVersion{Major: 2, Minor: 1}, version)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+
+	registerSoftwareVersion("3.10.0.0")
+	version, err = C.GetSoftwareMajorMinorVersion(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, SoftwareVersion{Major: 3, Minor: 10}, version)
+	assert.True(t, version.AtLeast(3, 9))
+	assert.False(t, version.AtLeast(4, 0))
+	assert.Equal(t, "3.10", version.String())
+
+	registerSoftwareVersion("unknown")
+	_, err = C.GetSoftwareMajorMinorVersion(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetCapabilities(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("2.1.0.0")
+	capabilities, err := C.GetCapabilities(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, capabilities.SupportsNVMeTCP())
+	assert.True(t, capabilities.SupportsAppType())
+	assert.False(t, capabilities.SupportsMetro())
+	assert.False(t, capabilities.SupportsVolumeMetadata())
+	assert.False(t, capabilities.SupportsNFSv4ACLs())
+
+	registerSoftwareVersion("3.0.0.0")
+	capabilities, err = C.GetCapabilities(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, capabilities.SupportsMetro())
+	assert.True(t, capabilities.SupportsIoLimitPolicies())
+}
+
+func TestClientIMPL_VersionedFields(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	defer C.InvalidateCache()
+	registerSoftwareVersion("2.0.0.0")
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		httpmock.NewStringResponder(201, fmt.Sprintf(`{"id": "%s"}`, volID)))
+
+	name := "new_volume"
+	size := int64(1048576)
+	_, err := C.CreateVolume(context.Background(), &VolumeCreate{Name: &name, Size: &size,
+		Metadata: &map[string]string{"k8s": "pvc-1"}})
+	assert.True(t, errors.Is(err, ErrNotSupported))
+	assert.Contains(t, err.Error(), "metadata requires PowerStore 3.0 or newer, array version is 2.0")
+
+	appType := AppTypeEnumRelationalDatabasesOracle
+	_, err = C.ModifyVolume(context.Background(), &VolumeModify{AppType: &appType}, volID)
+	assert.True(t, errors.Is(err, ErrNotSupported))
+
+	_, err = C.ConfigureMetroVolume(context.Background(), volID, &MetroConfig{})
+	assert.True(t, errors.Is(err, ErrNotSupported))
+
+	_, err = C.CreateVolume(context.Background(), &VolumeCreate{Name: &name, Size: &size})
+	assert.Nil(t, err)
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET "+softwareInstalledMockURL])
+	assert.Equal(t, 1, info["POST "+volumeMockURL])
+}
+
+type recordingLogger struct {
+	debug []string
+}
+
+func (l *recordingLogger) Info(ctx context.Context, format string, args ...interface{}) {}
+
+func (l *recordingLogger) Debug(ctx context.Context, format string, args ...interface{}) {
+	l.debug = append(l.debug, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Error(ctx context.Context, format string, args ...interface{}) {}
+
+func TestClientIMPL_VersionedFields_DetectionFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	c, err := NewClientWithArgs(APIMockURL, "admin", "password", NewClientOptions().SetRetryCount(0))
+	assert.Nil(t, err)
+	logger := &recordingLogger{}
+	c.SetLogger(logger)
+	httpmock.RegisterResponder("GET", softwareInstalledMockURL, httpmock.NewStringResponder(http.StatusForbidden, ""))
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		httpmock.NewStringResponder(201, fmt.Sprintf(`{"id": "%s"}`, volID)))
+
+	// request is sent as is when the version can't be detected
+	name := "new_volume"
+	size := int64(1048576)
+	_, err = c.CreateVolume(context.Background(), &VolumeCreate{Name: &name, Size: &size,
+		Metadata: &map[string]string{"k8s": "pvc-1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+volumeMockURL])
+	assert.Len(t, logger.debug, 1)
+	assert.Contains(t, logger.debug[0], "metadata is sent without version check")
+}
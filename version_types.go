@@ -0,0 +1,75 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import "fmt"
+
+// SoftwareVersion major and minor PowerStore OS version, e.g. 2.1
+type SoftwareVersion struct {
+	Major int
+	Minor int
+}
+
+// AtLeast returns true if the version is the same as or newer than major.minor
+func (v SoftwareVersion) AtLeast(major, minor int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	return v.Minor >= minor
+}
+
+func (v SoftwareVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Capabilities features of the array which depend on its PowerStore OS version
+type Capabilities struct {
+	// Major and minor version of the array, e.g. 2.1.
+	Version SoftwareVersion
+}
+
+// SupportsAppType returns true if application type of volumes can be set, PowerStore 2.1 and newer
+func (c Capabilities) SupportsAppType() bool {
+	return c.Version.AtLeast(2, 1)
+}
+
+// SupportsNVMeTCP returns true if hosts can be connected with NVMe over TCP, PowerStore 2.1 and newer
+func (c Capabilities) SupportsNVMeTCP() bool {
+	return c.Version.AtLeast(2, 1)
+}
+
+// SupportsMetro returns true if volumes can be stretched with metro replication, PowerStore 3.0 and newer
+func (c Capabilities) SupportsMetro() bool {
+	return c.Version.AtLeast(3, 0)
+}
+
+// SupportsVolumeMetadata returns true if volumes can have user defined metadata, PowerStore 3.0 and newer
+func (c Capabilities) SupportsVolumeMetadata() bool {
+	return c.Version.AtLeast(3, 0)
+}
+
+// SupportsIoLimitPolicies returns true if I/O limit policies can be assigned to volumes, PowerStore 3.0 and newer
+func (c Capabilities) SupportsIoLimitPolicies() bool {
+	return c.Version.AtLeast(3, 0)
+}
+
+// SupportsNFSv4ACLs returns true if NFSv4 ACLs of file systems can be managed, PowerStore 3.0 and newer
+func (c Capabilities) SupportsNFSv4ACLs() bool {
+	return c.Version.AtLeast(3, 0)
+}
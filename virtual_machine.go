@@ -0,0 +1,95 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const virtualMachineURL = "virtual_machine"
+
+func getVirtualMachineDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	vm := VirtualMachine{}
+	return c.APIClient().QueryParamsWithFields(&vm)
+}
+
+// GetVirtualMachine query and return specific virtual machine by id
+func (c *ClientIMPL) GetVirtualMachine(ctx context.Context, id string) (resp VirtualMachine, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    virtualMachineURL,
+			ID:          id,
+			QueryParams: getVirtualMachineDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetVirtualMachineByInstanceUUID query and return specific virtual machine by vSphere instance UUID,
+// e.g. to find the virtual machine of the virtual volume
+func (c *ClientIMPL) GetVirtualMachineByInstanceUUID(ctx context.Context,
+	instanceUUID string) (resp VirtualMachine, err error) {
+	var vmList []VirtualMachine
+	qp := getVirtualMachineDefaultQueryParams(c)
+	qp.RawArg("instance_uuid", fmt.Sprintf("eq.%s", instanceUUID))
+	qp.RawArg("type", fmt.Sprintf("eq.%s", VirtualMachineTypeEnumVM))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    virtualMachineURL,
+			QueryParams: qp},
+		&vmList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(vmList) != 1 {
+		return resp, notExistError()
+	}
+	return vmList[0], nil
+}
+
+// GetVirtualMachines returns all virtual machines, snapshots and templates of virtual machines included
+func (c *ClientIMPL) GetVirtualMachines(ctx context.Context) ([]VirtualMachine, error) {
+	result := []VirtualMachine{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []VirtualMachine
+		qp := getVirtualMachineDefaultQueryParams(c)
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    virtualMachineURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
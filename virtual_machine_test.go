@@ -0,0 +1,83 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const virtualMachineMockURL = APIMockURL + virtualMachineURL
+
+var (
+	vmID           = "vm-42"
+	vmInstanceUUID = "5012a4b1-7c3d-4e2f-8a9b-0c1d2e3f4a5b"
+)
+
+func TestClientIMPL_GetVirtualMachine(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "name": "db01", "instance_uuid": "%s", "type": "VM",
+"os_type": "Linux"}`, vmID, vmInstanceUUID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", virtualMachineMockURL, vmID),
+		httpmock.NewStringResponder(200, respData))
+	vm, err := C.GetVirtualMachine(context.Background(), vmID)
+	assert.Nil(t, err)
+	assert.Equal(t, "db01", vm.Name)
+	assert.Equal(t, vmInstanceUUID, vm.InstanceUUID)
+	assert.Equal(t, VirtualMachineTypeEnumVM, vm.Type)
+}
+
+func TestClientIMPL_GetVirtualMachineByInstanceUUID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var uuidFilter, typeFilter string
+	httpmock.RegisterResponder("GET", virtualMachineMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			uuidFilter = req.URL.Query().Get("instance_uuid")
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, vmID)), nil
+		})
+	vm, err := C.GetVirtualMachineByInstanceUUID(context.Background(), vmInstanceUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, vmID, vm.ID)
+	assert.Equal(t, "eq."+vmInstanceUUID, uuidFilter)
+	assert.Equal(t, "eq.VM", typeFilter)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", virtualMachineMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetVirtualMachineByInstanceUUID(context.Background(), vmInstanceUUID)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetVirtualMachines(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", virtualMachineMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "vm-43", "type": "Template"}]`, vmID)))
+	vms, err := C.GetVirtualMachines(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, vms, 2)
+	assert.Equal(t, VirtualMachineTypeEnumTemplate, vms[1].Type)
+}
@@ -0,0 +1,53 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// VirtualMachineTypeEnum type of the virtual machine object
+type VirtualMachineTypeEnum string
+
+const (
+	// VirtualMachineTypeEnumVM captures enum value "VM"
+	VirtualMachineTypeEnumVM VirtualMachineTypeEnum = "VM"
+	// VirtualMachineTypeEnumSnapshot captures enum value "Snapshot"
+	VirtualMachineTypeEnumSnapshot VirtualMachineTypeEnum = "Snapshot"
+	// VirtualMachineTypeEnumTemplate captures enum value "Template"
+	VirtualMachineTypeEnumTemplate VirtualMachineTypeEnum = "Template"
+)
+
+// VirtualMachine details about vSphere virtual machine which uses virtual volumes of the array
+type VirtualMachine struct {
+	// Unique identifier of the virtual machine.
+	ID string `json:"id,omitempty"`
+	// Name of the virtual machine.
+	Name string `json:"name,omitempty"`
+	// vSphere instance UUID of the virtual machine, virtual volumes refer to it
+	// with virtual_machine_uuid.
+	InstanceUUID string `json:"instance_uuid,omitempty"`
+	// Type of the virtual machine object.
+	Type VirtualMachineTypeEnum `json:"type,omitempty"`
+	// Guest operating system of the virtual machine.
+	OSType string `json:"os_type,omitempty"`
+	// Unique identifier of the virtual machine the snapshot or template was created from.
+	ParentID string `json:"parent_id,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (v *VirtualMachine) Fields() []string {
+	return []string{"id", "name", "instance_uuid", "type", "os_type", "parent_id"}
+}
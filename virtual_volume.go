@@ -0,0 +1,172 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const (
+	virtualVolumeURL            = "virtual_volume"
+	hostVirtualVolumeMappingURL = "host_virtual_volume_mapping"
+)
+
+func getVirtualVolumeDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	vvol := VirtualVolume{}
+	return c.APIClient().QueryParamsWithFields(&vvol)
+}
+
+func getHostVirtualVolumeMappingDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	mapping := HostVirtualVolumeMapping{}
+	return c.APIClient().QueryParamsWithFields(&mapping)
+}
+
+// GetVirtualVolume query and return specific virtual volume by id
+func (c *ClientIMPL) GetVirtualVolume(ctx context.Context, id string) (resp VirtualVolume, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    virtualVolumeURL,
+			ID:          id,
+			QueryParams: getVirtualVolumeDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetVirtualVolumeByName query and return specific virtual volume by name
+func (c *ClientIMPL) GetVirtualVolumeByName(ctx context.Context, name string) (resp VirtualVolume, err error) {
+	var vvolList []VirtualVolume
+	qp := getVirtualVolumeDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    virtualVolumeURL,
+			QueryParams: qp},
+		&vvolList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(vvolList) != 1 {
+		return resp, notExistError()
+	}
+	return vvolList[0], nil
+}
+
+// GetVirtualVolumes returns all virtual volumes, snapshots of virtual volumes are included
+func (c *ClientIMPL) GetVirtualVolumes(ctx context.Context) ([]VirtualVolume, error) {
+	return c.getVirtualVolumes(ctx, nil)
+}
+
+// GetVirtualVolumesByVirtualMachineUUID returns virtual volumes of the virtual machine
+// identified by its instance UUID, snapshots of virtual volumes are included
+func (c *ClientIMPL) GetVirtualVolumesByVirtualMachineUUID(ctx context.Context,
+	instanceUUID string) ([]VirtualVolume, error) {
+	return c.getVirtualVolumes(ctx, map[string]string{
+		"virtual_machine_uuid": fmt.Sprintf("eq.%s", instanceUUID),
+	})
+}
+
+// GetVirtualVolumeSnapshots returns snapshots of the virtual volume
+func (c *ClientIMPL) GetVirtualVolumeSnapshots(ctx context.Context, vvolID string) ([]VirtualVolume, error) {
+	return c.getVirtualVolumes(ctx, map[string]string{
+		"parent_id": fmt.Sprintf("eq.%s", vvolID),
+		"type":      fmt.Sprintf("eq.%s", VirtualVolumeTypeEnumSnapshot),
+	})
+}
+
+func (c *ClientIMPL) getVirtualVolumes(ctx context.Context, filters map[string]string) ([]VirtualVolume, error) {
+	result := []VirtualVolume{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []VirtualVolume
+		qp := getVirtualVolumeDefaultQueryParams(c)
+		for k, v := range filters {
+			qp.RawArg(k, v)
+		}
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    virtualVolumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetHostVirtualVolumeMappings returns all bindings of virtual volumes to hosts
+func (c *ClientIMPL) GetHostVirtualVolumeMappings(ctx context.Context) ([]HostVirtualVolumeMapping, error) {
+	return c.getHostVirtualVolumeMappings(ctx, nil)
+}
+
+// GetHostVirtualVolumeMappingsByHostID returns bindings of virtual volumes to the host
+func (c *ClientIMPL) GetHostVirtualVolumeMappingsByHostID(ctx context.Context,
+	hostID string) ([]HostVirtualVolumeMapping, error) {
+	return c.getHostVirtualVolumeMappings(ctx, map[string]string{
+		"host_id": fmt.Sprintf("eq.%s", hostID),
+	})
+}
+
+// GetHostVirtualVolumeMappingsByVirtualVolumeID returns bindings of the virtual volume to hosts
+func (c *ClientIMPL) GetHostVirtualVolumeMappingsByVirtualVolumeID(ctx context.Context,
+	vvolID string) ([]HostVirtualVolumeMapping, error) {
+	return c.getHostVirtualVolumeMappings(ctx, map[string]string{
+		"virtual_volume_id": fmt.Sprintf("eq.%s", vvolID),
+	})
+}
+
+func (c *ClientIMPL) getHostVirtualVolumeMappings(ctx context.Context,
+	filters map[string]string) ([]HostVirtualVolumeMapping, error) {
+	result := []HostVirtualVolumeMapping{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []HostVirtualVolumeMapping
+		qp := getHostVirtualVolumeMappingDefaultQueryParams(c)
+		for k, v := range filters {
+			qp.RawArg(k, v)
+		}
+		qp.Order("id")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    hostVirtualVolumeMappingURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
@@ -0,0 +1,140 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const virtualVolumeMockURL = APIMockURL + virtualVolumeURL
+
+var vvolID = "c6d2a8f1-3e9b-4a7c-b5d0-8f1e2a3b4c5d"
+
+func TestClientIMPL_GetVirtualVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "name": "vm1.vmdk", "type": "Primary", "usage_type": "Data",
+"storage_container_id": "%s"}`, vvolID, storageContainerID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", virtualVolumeMockURL, vvolID),
+		httpmock.NewStringResponder(200, respData))
+	vvol, err := C.GetVirtualVolume(context.Background(), vvolID)
+	assert.Nil(t, err)
+	assert.Equal(t, VirtualVolumeTypeEnumPrimary, vvol.Type)
+	assert.Equal(t, VirtualVolumeUsageTypeEnumData, vvol.UsageType)
+	assert.Equal(t, storageContainerID, vvol.StorageContainerID)
+}
+
+func TestClientIMPL_GetVirtualVolumeByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", virtualVolumeMockURL,
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[{"id": "%s", "name": "vm1.vmdk"}]`, vvolID)))
+	vvol, err := C.GetVirtualVolumeByName(context.Background(), "vm1.vmdk")
+	assert.Nil(t, err)
+	assert.Equal(t, vvolID, vvol.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", virtualVolumeMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetVirtualVolumeByName(context.Background(), "vm1.vmdk")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetVirtualVolumes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter string
+	httpmock.RegisterResponder("GET", virtualVolumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "vvol2"}]`, vvolID)), nil
+		})
+	vvols, err := C.GetVirtualVolumes(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, vvols, 2)
+	assert.Empty(t, typeFilter)
+}
+
+func TestClientIMPL_GetVirtualVolumeSnapshots(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var parentFilter, typeFilter string
+	httpmock.RegisterResponder("GET", virtualVolumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			parentFilter = req.URL.Query().Get("parent_id")
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "snap1", "type": "Snapshot", "parent_id": "%s"}]`, vvolID)), nil
+		})
+	snaps, err := C.GetVirtualVolumeSnapshots(context.Background(), vvolID)
+	assert.Nil(t, err)
+	assert.Len(t, snaps, 1)
+	assert.Equal(t, vvolID, snaps[0].ParentID)
+	assert.Equal(t, "eq."+vvolID, parentFilter)
+	assert.Equal(t, "eq.Snapshot", typeFilter)
+}
+
+func TestClientIMPL_GetVirtualVolumesByVirtualMachineUUID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var vmFilter string
+	httpmock.RegisterResponder("GET", virtualVolumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			vmFilter = req.URL.Query().Get("virtual_machine_uuid")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "%s", "virtual_machine_uuid": "%s"}]`, vvolID, vmInstanceUUID)), nil
+		})
+	vvols, err := C.GetVirtualVolumesByVirtualMachineUUID(context.Background(), vmInstanceUUID)
+	assert.Nil(t, err)
+	assert.Len(t, vvols, 1)
+	assert.Equal(t, vmInstanceUUID, vvols[0].VirtualMachineUUID)
+	assert.Equal(t, "eq."+vmInstanceUUID, vmFilter)
+}
+
+func TestClientIMPL_GetHostVirtualVolumeMappings(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var hostFilter, vvolFilter string
+	httpmock.RegisterResponder("GET", APIMockURL+hostVirtualVolumeMappingURL,
+		func(req *http.Request) (*http.Response, error) {
+			hostFilter = req.URL.Query().Get("host_id")
+			vvolFilter = req.URL.Query().Get("virtual_volume_id")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "m1", "host_id": "host1", "virtual_volume_id": "%s"}]`, vvolID)), nil
+		})
+	mappings, err := C.GetHostVirtualVolumeMappings(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, mappings, 1)
+	assert.Equal(t, "host1", mappings[0].HostID)
+	assert.Empty(t, hostFilter)
+
+	_, err = C.GetHostVirtualVolumeMappingsByHostID(context.Background(), "host1")
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.host1", hostFilter)
+
+	mappings, err = C.GetHostVirtualVolumeMappingsByVirtualVolumeID(context.Background(), vvolID)
+	assert.Nil(t, err)
+	assert.Equal(t, vvolID, mappings[0].VirtualVolumeID)
+	assert.Equal(t, "eq."+vvolID, vvolFilter)
+}
@@ -0,0 +1,96 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// VirtualVolumeTypeEnum type of the virtual volume
+type VirtualVolumeTypeEnum string
+
+const (
+	// VirtualVolumeTypeEnumPrimary captures enum value "Primary"
+	VirtualVolumeTypeEnumPrimary VirtualVolumeTypeEnum = "Primary"
+	// VirtualVolumeTypeEnumSnapshot captures enum value "Snapshot"
+	VirtualVolumeTypeEnumSnapshot VirtualVolumeTypeEnum = "Snapshot"
+	// VirtualVolumeTypeEnumFastClone captures enum value "Fast_Clone"
+	VirtualVolumeTypeEnumFastClone VirtualVolumeTypeEnum = "Fast_Clone"
+	// VirtualVolumeTypeEnumClone captures enum value "Clone"
+	VirtualVolumeTypeEnumClone VirtualVolumeTypeEnum = "Clone"
+)
+
+// VirtualVolumeUsageTypeEnum VMware usage of the virtual volume
+type VirtualVolumeUsageTypeEnum string
+
+const (
+	// VirtualVolumeUsageTypeEnumConfig captures enum value "Config"
+	VirtualVolumeUsageTypeEnumConfig VirtualVolumeUsageTypeEnum = "Config"
+	// VirtualVolumeUsageTypeEnumData captures enum value "Data"
+	VirtualVolumeUsageTypeEnumData VirtualVolumeUsageTypeEnum = "Data"
+	// VirtualVolumeUsageTypeEnumSwap captures enum value "Swap"
+	VirtualVolumeUsageTypeEnumSwap VirtualVolumeUsageTypeEnum = "Swap"
+	// VirtualVolumeUsageTypeEnumMemory captures enum value "Memory"
+	VirtualVolumeUsageTypeEnumMemory VirtualVolumeUsageTypeEnum = "Memory"
+	// VirtualVolumeUsageTypeEnumOther captures enum value "Other"
+	VirtualVolumeUsageTypeEnumOther VirtualVolumeUsageTypeEnum = "Other"
+)
+
+// VirtualVolume details about virtual volume (vVol) created by vSphere in a storage container
+type VirtualVolume struct {
+	// Unique identifier of the virtual volume.
+	ID string `json:"id,omitempty"`
+	// Name of the virtual volume.
+	Name string `json:"name,omitempty"`
+	// Size of the virtual volume in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Type of the virtual volume.
+	Type VirtualVolumeTypeEnum `json:"type,omitempty"`
+	// VMware usage of the virtual volume.
+	UsageType VirtualVolumeUsageTypeEnum `json:"usage_type,omitempty"`
+	// Unique identifier of the appliance the virtual volume is located on.
+	ApplianceID string `json:"appliance_id,omitempty"`
+	// Unique identifier of the storage container the virtual volume belongs to.
+	StorageContainerID string `json:"storage_container_id,omitempty"`
+	// Unique identifier of the virtual volume the snapshot or clone was created from.
+	ParentID string `json:"parent_id,omitempty"`
+	// Indicates whether the virtual volume is read-only.
+	IsReadonly bool `json:"is_readonly,omitempty"`
+	// Instance UUID of the virtual machine the virtual volume belongs to.
+	VirtualMachineUUID string `json:"virtual_machine_uuid,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (v *VirtualVolume) Fields() []string {
+	return []string{"id", "name", "size", "type", "usage_type", "appliance_id",
+		"storage_container_id", "parent_id", "is_readonly", "virtual_machine_uuid"}
+}
+
+// HostVirtualVolumeMapping details about binding of the virtual volume to the host
+type HostVirtualVolumeMapping struct {
+	// Unique identifier of the mapping.
+	ID string `json:"id,omitempty"`
+	// Unique identifier of the host the virtual volume is bound to.
+	HostID string `json:"host_id,omitempty"`
+	// Unique identifier of the host group the virtual volume is bound to.
+	HostGroupID string `json:"host_group_id,omitempty"`
+	// Unique identifier of the virtual volume.
+	VirtualVolumeID string `json:"virtual_volume_id,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (m *HostVirtualVolumeMapping) Fields() []string {
+	return []string{"id", "host_id", "host_group_id", "virtual_volume_id"}
+}
@@ -20,12 +20,17 @@ package gopowerstore
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
 	"github.com/dell/gopowerstore/api"
 	"fmt"
 )
 
 const (
-	volumeURL = "volume"
+	volumeURL            = "volume"
+	volumeListCmaViewURL = "volume_list_cma_view"
 )
 
 func getVolumeDefaultQueryParams(c Client) api.QueryParamsEncoder {
@@ -48,6 +53,11 @@ func (c *ClientIMPL) GetVolume(ctx context.Context, id string) (resp Volume, err
 
 // GetVolumeByName query and return specific volume by name
 func (c *ClientIMPL) GetVolumeByName(ctx context.Context, name string) (resp Volume, err error) {
+	key := cacheKey(volumeURL, "name", name)
+	if cached, ok := c.cache.get(key); ok {
+		return copyVolume(cached.(Volume)), nil
+	}
+	generation := c.cache.generation()
 	var volList []Volume
 	qp := getVolumeDefaultQueryParams(c)
 	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
@@ -65,12 +75,17 @@ func (c *ClientIMPL) GetVolumeByName(ctx context.Context, name string) (resp Vol
 	if len(volList) != 1 {
 		return resp, NewVolumeIsNotExistError()
 	}
+	c.cache.set(key, copyVolume(volList[0]), generation)
 	return volList[0], err
 }
 
-// GetVolumes returns a list of volumes
+// GetVolumes returns a list of all volumes, pages are requested until the full list is read.
+// Filter attached to the context by WithQueryFilter is applied to the request
 func (c *ClientIMPL) GetVolumes(ctx context.Context) ([]Volume, error) {
-	var result []Volume
+	if filter := queryFilterFromContext(ctx); filter != nil {
+		return c.getVolumesFiltered(ctx, fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot), filter)
+	}
+	result := []Volume{}
 	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
 		var page []Volume
 		qp := getVolumeDefaultQueryParams(c)
@@ -93,6 +108,197 @@ func (c *ClientIMPL) GetVolumes(ctx context.Context) ([]Volume, error) {
 	return result, err
 }
 
+// GetVolumesFiltered returns volumes and clones matching the filter, nil filter returns the same
+// result as GetVolumes. All matching volumes are read unless the filter limits the result
+func (c *ClientIMPL) GetVolumesFiltered(ctx context.Context, filter *QueryFilter) ([]Volume, error) {
+	if filter == nil {
+		return c.GetVolumes(ctx)
+	}
+	return c.getVolumesFiltered(ctx, fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot), filter)
+}
+
+// GetSnapshotsFiltered returns snapshots matching the filter, nil filter returns the same
+// result as GetSnapshots. All matching snapshots are read unless the filter limits the result
+func (c *ClientIMPL) GetSnapshotsFiltered(ctx context.Context, filter *QueryFilter) ([]Volume, error) {
+	if filter == nil {
+		return c.GetSnapshots(ctx)
+	}
+	return c.getVolumesFiltered(ctx, fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot), filter)
+}
+
+func (c *ClientIMPL) getVolumesFiltered(ctx context.Context,
+	typeFilter string, filter *QueryFilter) ([]Volume, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+	var vol Volume
+	result := []Volume{}
+	readPage := func(offset, limit int) (api.RespMeta, error) {
+		var page []Volume
+		qp := filter.queryParams(c, &vol)
+		qp.RawArg("type", typeFilter)
+		filter.applyTo(qp, "name")
+		qp.Offset(offset).Limit(limit)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	}
+	if filter.limit > 0 {
+		_, err := readPage(filter.offset, filter.limit)
+		return result, err
+	}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		// offsets of next pages are absolute, they already include offset of the filter
+		if offset == 0 {
+			offset = filter.offset
+		}
+		return readPage(offset, paginationDefaultPageSize)
+	})
+	return result, err
+}
+
+// GetVolumesWithPagination returns single page of volumes sorted by name together with
+// total count of volumes. Use GetVolumes to read all volumes
+func (c *ClientIMPL) GetVolumesWithPagination(ctx context.Context,
+	offset, limit int) ([]Volume, int, error) {
+	if offset < 0 || limit <= 0 || limit > paginationDefaultPageSize {
+		return nil, 0, fmt.Errorf("invalid page: offset must be non-negative and limit in range 1-%d",
+			paginationDefaultPageSize)
+	}
+	result := []Volume{}
+	qp := getVolumeDefaultQueryParams(c)
+	qp.RawArg("type", fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot))
+	qp.Order("name")
+	qp.Offset(offset).Limit(limit)
+	meta, err := c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			QueryParams: qp},
+		&result)
+	err = WrapErr(err)
+	if err != nil {
+		return nil, 0, err
+	}
+	if meta.Pagination.IsPaginate {
+		return result, meta.Pagination.Total, nil
+	}
+	// whole result set fits into the requested page
+	return result, offset + len(result), nil
+}
+
+// GetVolumesExceedingLogicalUsed returns volumes which logical used space is equal or above
+// thresholdPercent of their size. It filters the result of GetVolumes client-side, the threshold
+// is neither read from nor stored on the array and doesn't change space alerts raised by the array
+func (c *ClientIMPL) GetVolumesExceedingLogicalUsed(ctx context.Context,
+	thresholdPercent float64) ([]Volume, error) {
+	if thresholdPercent < 0 || thresholdPercent > 100 {
+		return nil, fmt.Errorf("threshold must be between 0 and 100, got %v", thresholdPercent)
+	}
+	volumes, err := c.GetVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []Volume{}
+	for _, v := range volumes {
+		if v.LogicalUsedPercent() >= thresholdPercent {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// GetTopSpaceConsumers returns n volumes with the lowest data reduction ratio, worst ratio first.
+// Ratios are current values reported by the array, sorting is done server-side
+func (c *ClientIMPL) GetTopSpaceConsumers(ctx context.Context, n int) (resp []Volume, err error) {
+	if n <= 0 || n > paginationDefaultPageSize {
+		return resp, fmt.Errorf("number of volumes must be in range 1-%d", paginationDefaultPageSize)
+	}
+	qp := c.APIClient().QueryParams()
+	qp.Select("id", "name", "size", "logical_used", "data_reduction_ratio", "compression_ratio", "dedup_ratio")
+	qp.RawArg("type", fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot))
+	qp.RawArg("data_reduction_ratio", "not.is.null")
+	qp.Order("data_reduction_ratio.asc")
+	qp.Limit(n)
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeListCmaViewURL,
+			QueryParams: qp},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetUnmanagedVolumes returns volumes and clones which metadata doesn't contain metadataKey,
+// i.e. volumes which were not created or adopted by the consumer using the key for ownership.
+// Volume metadata is supported by PowerStore 3.0 and newer
+func (c *ClientIMPL) GetUnmanagedVolumes(ctx context.Context, metadataKey string) (resp []Volume, err error) {
+	var vol Volume
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Volume
+		qp := c.APIClient().QueryParams().Select(append(vol.Fields(), "metadata")...)
+		qp.RawArg("type", fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot))
+		qp.RawArg(fmt.Sprintf("metadata->>%s", metadataKey), "is.null")
+		qp.Order("id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			resp = append(resp, page...)
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
+
+// GetVolumeByMetadata returns volumes and clones which metadata contains key with the value,
+// empty list is returned if there are no such volumes.
+// Volume metadata is supported by PowerStore 3.0 and newer
+func (c *ClientIMPL) GetVolumeByMetadata(ctx context.Context, key, value string) ([]Volume, error) {
+	var vol Volume
+	result := []Volume{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []Volume
+		qp := c.APIClient().QueryParams().Select(append(vol.Fields(), "metadata")...)
+		qp.RawArg("type", fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot))
+		qp.RawArg(fmt.Sprintf("metadata->>%s", key), fmt.Sprintf("eq.%s", value))
+		qp.Order("id")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
 // GetSnapshot query and return specific snapshot by it's id
 func (c *ClientIMPL) GetSnapshot(ctx context.Context, snapID string) (resVol Volume, err error) {
 	qp := getVolumeDefaultQueryParams(c)
@@ -108,9 +314,13 @@ func (c *ClientIMPL) GetSnapshot(ctx context.Context, snapID string) (resVol Vol
 	return resVol, WrapErr(err)
 }
 
-// GetSnapshots returns all snapshots
+// GetSnapshots returns all snapshots.
+// Filter attached to the context by WithQueryFilter is applied to the request
 func (c *ClientIMPL) GetSnapshots(ctx context.Context) ([]Volume, error) {
-	var result []Volume
+	if filter := queryFilterFromContext(ctx); filter != nil {
+		return c.getVolumesFiltered(ctx, fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot), filter)
+	}
+	result := []Volume{}
 	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
 		var page []Volume
 		qp := getVolumeDefaultQueryParams(c)
@@ -159,9 +369,26 @@ func (c *ClientIMPL) GetSnapshotsByVolumeID(ctx context.Context, volID string) (
 	return result, err
 }
 
-// CreateVolume creates new volume
+// CreateVolume creates new volume.
+// If client is configured with RequireProtectionPolicy option,
+// volume without ProtectionPolicyID is rejected before sending the request.
+// Fields the array doesn't support in its version, e.g. Metadata on PowerStore 2.x, are rejected with ErrNotSupported
 func (c *ClientIMPL) CreateVolume(ctx context.Context,
 	createParams *VolumeCreate) (resp CreateResponse, err error) {
+	if c.requireProtectionPolicy &&
+		(createParams.ProtectionPolicyID == nil || *createParams.ProtectionPolicyID == "") {
+		return resp, errors.New("protection policy is required by client options: ProtectionPolicyID is not set")
+	}
+	if err = validateVolumeCreate(createParams); err != nil {
+		return resp, err
+	}
+	if err = c.checkVersionedFields(ctx,
+		versionedField{name: "metadata", isSet: createParams.Metadata != nil, since: SoftwareVersion{3, 0}},
+		versionedField{name: "I/O limit policy", isSet: createParams.IoLimitPolicyID != nil, since: SoftwareVersion{3, 0}},
+		versionedField{name: "application type", isSet: createParams.AppType != nil, since: SoftwareVersion{2, 1}},
+	); err != nil {
+		return resp, err
+	}
 	_, err = c.APIClient().Query(
 		ctx,
 		RequestConfig{
@@ -172,6 +399,57 @@ func (c *ClientIMPL) CreateVolume(ctx context.Context,
 	return resp, WrapErr(err)
 }
 
+// validateVolumeCreate checks placement and format options of the volume before it is sent to the array
+func validateVolumeCreate(createParams *VolumeCreate) error {
+	if createParams.SectorSize != nil && *createParams.SectorSize != 512 && *createParams.SectorSize != 4096 {
+		return fmt.Errorf("unsupported sector size: %d, only 512 and 4096 are supported", *createParams.SectorSize)
+	}
+	if createParams.ApplianceID != nil && *createParams.ApplianceID == "" {
+		return errors.New("appliance id can't be empty")
+	}
+	if createParams.VolumeGroupID != nil && *createParams.VolumeGroupID == "" {
+		return errors.New("volume group id can't be empty")
+	}
+	return validateAppType(createParams.AppType, createParams.AppTypeOther)
+}
+
+// validateAppType checks application type description is set only for Other and *_Other types.
+// Application type itself is validated by the array, which supports more types than listed by AppTypeEnum
+func validateAppType(appType *AppTypeEnum, appTypeOther *string) error {
+	if appTypeOther == nil {
+		return nil
+	}
+	if appType == nil {
+		return errors.New("application type description requires application type")
+	}
+	if !strings.HasSuffix(string(*appType), string(AppTypeEnumOther)) {
+		return fmt.Errorf("application type description can't be set for application type %s", *appType)
+	}
+	return nil
+}
+
+// EnsureVolume creates the volume and returns it. If the create fails because volume with the same
+// name exists, the existing volume is returned when its size matches the requested one,
+// otherwise error matched by ErrNameInUse is returned
+func (c *ClientIMPL) EnsureVolume(ctx context.Context, createParams *VolumeCreate) (Volume, error) {
+	resp, err := c.CreateVolume(ctx, createParams)
+	if err == nil {
+		return c.GetVolume(ctx, resp.ID)
+	}
+	if !errors.Is(err, ErrNameInUse) || createParams.Name == nil {
+		return Volume{}, err
+	}
+	existing, getErr := c.GetVolumeByName(ctx, *createParams.Name)
+	if getErr != nil {
+		return Volume{}, err
+	}
+	if createParams.Size != nil && existing.Size != *createParams.Size {
+		return Volume{}, fmt.Errorf("%w: volume %s exists with size %d, requested size is %d",
+			ErrNameInUse, existing.Name, existing.Size, *createParams.Size)
+	}
+	return existing, nil
+}
+
 // CreateVolumeFromSnapshot creates a new volume by cloning a snapshot
 func (c *ClientIMPL) CreateVolumeFromSnapshot(ctx context.Context,
 	createParams *VolumeClone, snapID string) (resp CreateResponse, err error) {
@@ -188,6 +466,29 @@ func (c *ClientIMPL) CreateVolumeFromSnapshot(ctx context.Context,
 	return resp, WrapErr(err)
 }
 
+// CloneVolume creates a new volume by cloning the source volume directly, without intermediate snapshot.
+// The source may stay attached to hosts while it is cloned.
+// Clone of nonexistent source fails with error detectable by VolumeIsNotExist.
+// IoLimitPolicyID is rejected with ErrNotSupported by arrays older than PowerStore 3.0
+func (c *ClientIMPL) CloneVolume(ctx context.Context,
+	cloneParams *VolumeClone, sourceVolID string) (resp CreateResponse, err error) {
+	if err = c.checkVersionedFields(ctx,
+		versionedField{name: "I/O limit policy", isSet: cloneParams.IoLimitPolicyID != nil, since: SoftwareVersion{3, 0}},
+	); err != nil {
+		return resp, err
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeURL,
+			ID:       sourceVolID,
+			Action:   "clone",
+			Body:     cloneParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
 // CreateSnapshot creates a new snapshot
 func (c *ClientIMPL) CreateSnapshot(ctx context.Context,
 	createSnapParams *SnapshotCreate, id string) (resp CreateResponse, err error) {
@@ -203,6 +504,154 @@ func (c *ClientIMPL) CreateSnapshot(ctx context.Context,
 	return resp, WrapErr(err)
 }
 
+// RefreshVolume refreshes volume data from the specified snapshot or volume.
+// Only clone volumes can be refreshed, refresh of a base volume fails with error detectable by VolumeIsNotClone.
+// Operation runs asynchronously, the returned JobResponse holds id of the job, use WaitForJob to get the result
+func (c *ClientIMPL) RefreshVolume(ctx context.Context,
+	volID string, refreshParams *VolumeRefresh) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    volumeURL,
+		ID:          volID,
+		Action:      "refresh",
+		QueryParams: qp,
+		Body:        refreshParams})
+}
+
+// RestoreVolumeFromSnapshot restores volume data in place from one of its snapshots.
+// Optionally a backup snapshot of the current volume state is created before restore
+func (c *ClientIMPL) RestoreVolumeFromSnapshot(ctx context.Context,
+	volID string, restoreParams *VolumeRestore) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeURL,
+			ID:       volID,
+			Action:   "restore",
+			Body:     restoreParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// RestoreVolumeFromSnapshotAsync restores volume data in place from one of its snapshots.
+// Operation runs asynchronously, the returned JobResponse holds id of the job, use WaitForJob to get the result
+func (c *ClientIMPL) RestoreVolumeFromSnapshotAsync(ctx context.Context,
+	volID string, restoreParams *VolumeRestore) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    volumeURL,
+		ID:          volID,
+		Action:      "restore",
+		QueryParams: qp,
+		Body:        restoreParams})
+}
+
+// ConfigureMetroVolume stretches the volume to the remote system using synchronous active/active replication.
+// Metro volumes are supported by PowerStore 3.0 and newer, older arrays are rejected with ErrNotSupported
+func (c *ClientIMPL) ConfigureMetroVolume(ctx context.Context,
+	volID string, config *MetroConfig) (resp MetroSessionResponse, err error) {
+	if err = c.checkVersionedFields(ctx,
+		versionedField{name: "metro volume", isSet: true, since: SoftwareVersion{3, 0}},
+	); err != nil {
+		return resp, err
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeURL,
+			ID:       volID,
+			Action:   "configure_metro",
+			Body:     config},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// EndMetroVolume ends metro replication of the volume, the remote volume is deleted
+// only if DeleteRemoteVolume option is set
+func (c *ClientIMPL) EndMetroVolume(ctx context.Context,
+	volID string, options *EndMetroVolumeOptions) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeURL,
+			ID:       volID,
+			Action:   "end_metro",
+			Body:     options},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetMetroSessionByVolumeID returns metro replication session of the volume,
+// error detectable by NotFound is returned if the volume is not a metro volume
+func (c *ClientIMPL) GetMetroSessionByVolumeID(ctx context.Context, volID string) (resp ReplicationSession, err error) {
+	var vol struct {
+		MetroReplicationSessionID string `json:"metro_replication_session_id"`
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			ID:          volID,
+			QueryParams: c.APIClient().QueryParams().Select("metro_replication_session_id")},
+		&vol)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if vol.MetroReplicationSessionID == "" {
+		return resp, notExistError()
+	}
+	return c.GetReplicationSession(ctx, vol.MetroReplicationSessionID)
+}
+
+// ModifyVolume modifies existing volume.
+// Shrinking volume is rejected by the array, use VolumeSizeIsNotSupported to detect it.
+// Fields the array doesn't support in its version are rejected with ErrNotSupported
+func (c *ClientIMPL) ModifyVolume(ctx context.Context,
+	modifyParams *VolumeModify, volID string) (resp EmptyResponse, err error) {
+	if err = validateAppType(modifyParams.AppType, modifyParams.AppTypeOther); err != nil {
+		return resp, err
+	}
+	if err = c.checkVersionedFields(ctx,
+		versionedField{name: "metadata", isSet: modifyParams.Metadata != nil, since: SoftwareVersion{3, 0}},
+		versionedField{name: "I/O limit policy", isSet: modifyParams.IoLimitPolicyID != nil, since: SoftwareVersion{3, 0}},
+		versionedField{name: "application type", isSet: modifyParams.AppType != nil, since: SoftwareVersion{2, 1}},
+	); err != nil {
+		return resp, err
+	}
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: volumeURL,
+			ID:       volID,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifySnapshot modifies existing snapshot
+func (c *ClientIMPL) ModifySnapshot(ctx context.Context,
+	modifyParams *SnapshotModify, snapID string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: volumeURL,
+			ID:       snapID,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
 // DeleteVolume deletes existing volume
 func (c *ClientIMPL) DeleteVolume(ctx context.Context,
 	deleteParams *VolumeDelete, id string) (resp EmptyResponse, err error) {
@@ -222,3 +671,192 @@ func (c *ClientIMPL) DeleteSnapshot(ctx context.Context,
 	deleteParams *VolumeDelete, id string) (resp EmptyResponse, err error) {
 	return c.DeleteVolume(ctx, deleteParams, id)
 }
+
+// number of buckets returned by creation rate wrappers
+const creationRateBuckets = 24
+
+var creationRateIntervals = map[MetricsIntervalEnum]time.Duration{
+	MetricsIntervalEnumTwentySec: 20 * time.Second,
+	MetricsIntervalEnumFiveMins:  5 * time.Minute,
+	MetricsIntervalEnumOneHour:   time.Hour,
+	MetricsIntervalEnumOneDay:    24 * time.Hour,
+}
+
+func (c *ClientIMPL) getCreationRate(ctx context.Context, typeFilter string,
+	interval MetricsIntervalEnum) (resp CreationRate, err error) {
+	step, ok := creationRateIntervals[interval]
+	if !ok {
+		return resp, fmt.Errorf("unsupported creation rate interval: %s", interval)
+	}
+	start := time.Now().UTC().Truncate(step).Add(-step * (creationRateBuckets - 1))
+	resp.Interval = interval
+	for i := 0; i < creationRateBuckets; i++ {
+		resp.Buckets = append(resp.Buckets,
+			CreationRateBucket{Start: start.Add(step * time.Duration(i)).Format(time.RFC3339)})
+	}
+	err = c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []volumeCreation
+		qp := c.APIClient().QueryParams()
+		qp.Select("id", "creation_timestamp")
+		qp.RawArg("type", typeFilter)
+		qp.RawArg("creation_timestamp", fmt.Sprintf("gte.%s", start.Format(time.RFC3339)))
+		qp.Order("creation_timestamp")
+		qp.Limit(paginationDefaultPageSize)
+		qp.Offset(offset)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			for _, v := range page {
+				created, err := time.Parse(time.RFC3339, v.CreationTimestamp)
+				if err != nil || created.Before(start) {
+					continue
+				}
+				i := int(created.Sub(start) / step)
+				if i >= creationRateBuckets {
+					i = creationRateBuckets - 1
+				}
+				resp.Buckets[i].Count++
+				resp.Total++
+			}
+		}
+		return meta, err
+	})
+	return resp, WrapErr(err)
+}
+
+// GetSnapshotCreationRate returns number of snapshots created per interval over the last 24 intervals.
+// Rate is computed from creation time of existing snapshots, deleted snapshots are not counted
+func (c *ClientIMPL) GetSnapshotCreationRate(ctx context.Context, interval MetricsIntervalEnum) (CreationRate, error) {
+	return c.getCreationRate(ctx, fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot), interval)
+}
+
+// GetVolumeCreationRate returns number of volumes and clones created per interval over the last 24 intervals.
+// Rate is computed from creation time of existing volumes, deleted volumes are not counted
+func (c *ClientIMPL) GetVolumeCreationRate(ctx context.Context, interval MetricsIntervalEnum) (CreationRate, error) {
+	return c.getCreationRate(ctx, fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot), interval)
+}
+
+// maximum number of snapshots deleted in parallel by DeleteSnapshotsOlderThan and DeleteSnapshotsByVolumeID
+const snapshotDeleteConcurrency = 8
+
+// DeleteSnapshotsOlderThan deletes user created snapshots taken before cutoff. Snapshots created by
+// snapshot rules or replication are not deleted. Optional filter narrows the list, e.g. by
+// protection_data->>parent_id. Snapshots which have clones are skipped, errors for skipped and failed
+// snapshots are returned, snapshots which are already deleted are considered successfully deleted
+func (c *ClientIMPL) DeleteSnapshotsOlderThan(ctx context.Context, cutoff time.Time,
+	filter api.QueryParamsEncoder) []error {
+	var snapshots []resourceRef
+	qp := copyFilter(c, filter)
+	qp.Select("id", "name")
+	qp.RawArg("type", fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot))
+	qp.RawArg("protection_data->>creator_type", fmt.Sprintf("eq.%s", StorageCreatorTypeEnumUser))
+	qp.RawArg("creation_timestamp", fmt.Sprintf("lt.%s", cutoff.UTC().Format(time.RFC3339)))
+	qp.Order("id")
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []resourceRef
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			snapshots = append(snapshots, page...)
+		}
+		return meta, err
+	})
+	if err != nil {
+		return []error{WrapErr(err)}
+	}
+
+	var errs []error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, snapshotDeleteConcurrency)
+	for _, snap := range snapshots {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(snapID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := c.deleteSnapshotWithoutClones(ctx, snapID); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("can't delete snapshot %s: %s", snapID, err.Error()))
+				mu.Unlock()
+			}
+		}(snap.ID)
+	}
+	wg.Wait()
+	return errs
+}
+
+// DeleteSnapshotsByVolumeID deletes all snapshots of the volume in parallel. Failure to delete one snapshot
+// doesn't stop deletion of others, errors are reported per snapshot id in the result.
+// Snapshots which are already deleted are considered successfully deleted
+func (c *ClientIMPL) DeleteSnapshotsByVolumeID(ctx context.Context, volID string) (DeleteResult, error) {
+	result := DeleteResult{Errors: map[string]error{}}
+	snapshots, err := c.GetSnapshotsByVolumeID(ctx, volID)
+	if err != nil {
+		return result, err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, snapshotDeleteConcurrency)
+	for _, snap := range snapshots {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(snapID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			_, err := c.DeleteSnapshot(ctx, &VolumeDelete{}, snapID)
+			if apiError, ok := err.(APIError); ok && apiError.VolumeIsNotExist() {
+				err = nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Errors[snapID] = err
+				return
+			}
+			result.Deleted++
+		}(snap.ID)
+	}
+	wg.Wait()
+	return result, nil
+}
+
+func (c *ClientIMPL) deleteSnapshotWithoutClones(ctx context.Context, snapID string) error {
+	var clones []resourceRef
+	qp := c.APIClient().QueryParams().Select("id")
+	qp.RawArg("type", fmt.Sprintf("eq.%s", VolumeTypeEnumClone))
+	qp.RawArg("protection_data->>parent_id", fmt.Sprintf("eq.%s", snapID))
+	qp.Limit(1)
+	_, err := c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			QueryParams: qp},
+		&clones)
+	if err = WrapErr(err); err != nil {
+		return err
+	}
+	if len(clones) > 0 {
+		return fmt.Errorf("snapshot has clones")
+	}
+	_, err = c.DeleteSnapshot(ctx, &VolumeDelete{}, snapID)
+	if apiError, ok := err.(APIError); ok && apiError.VolumeIsNotExist() {
+		return nil
+	}
+	return err
+}
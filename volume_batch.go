@@ -0,0 +1,92 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"sync"
+)
+
+// number of requests sent in parallel by CreateVolumes and DeleteVolumes if concurrency is not set
+const volumeBatchDefaultConcurrency = 8
+
+// CreateVolumes creates volumes in parallel, at most concurrency requests are in flight at once,
+// non-positive concurrency selects the default. Failure of one volume doesn't stop creation of others,
+// result holds id or error of each volume in the order of createParams.
+// Volumes not started before the context is done fail with the context error
+func (c *ClientIMPL) CreateVolumes(ctx context.Context,
+	createParams []VolumeCreate, concurrency int) []BatchResult {
+	ids := make([]string, len(createParams))
+	errs := runBatch(ctx, len(createParams), concurrency, func(i int) error {
+		resp, err := c.CreateVolume(ctx, &createParams[i])
+		ids[i] = resp.ID
+		return err
+	})
+	return newBatchResults(ids, errs)
+}
+
+// DeleteVolumes deletes volumes in parallel, at most concurrency requests are in flight at once,
+// non-positive concurrency selects the default. Failure of one volume doesn't stop deletion of others,
+// result holds error of each volume in the order of ids.
+// Volumes which are already deleted are considered successfully deleted
+func (c *ClientIMPL) DeleteVolumes(ctx context.Context, ids []string, concurrency int) []BatchResult {
+	errs := runBatch(ctx, len(ids), concurrency, func(i int) error {
+		_, err := c.DeleteVolume(ctx, nil, ids[i])
+		if apiError, ok := err.(APIError); ok && apiError.VolumeIsNotExist() {
+			return nil
+		}
+		return err
+	})
+	return newBatchResults(ids, errs)
+}
+
+// runBatch calls op for indexes 0..n-1 with bounded parallelism and returns errors by index,
+// ops which are not started before the context is done get the context error
+func runBatch(ctx context.Context, n, concurrency int, op func(i int) error) []error {
+	if concurrency <= 0 {
+		concurrency = volumeBatchDefaultConcurrency
+	}
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = op(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func newBatchResults(ids []string, errs []error) []BatchResult {
+	result := make([]BatchResult, len(ids))
+	for i := range ids {
+		result[i] = BatchResult{ID: ids[i], Err: errs[i]}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClientIMPL_CreateVolumes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			var body VolumeCreate
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			if *body.Name == "vol-bad" {
+				return httpmock.NewStringResponse(422, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`,
+					VolumeNameAlreadyUseErrorCode)), nil
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "id-%s"}`, *body.Name)), nil
+		})
+
+	size := int64(1048576)
+	var params []VolumeCreate
+	for _, name := range []string{"vol-1", "vol-bad", "vol-3", "vol-4", "vol-5"} {
+		name := name
+		params = append(params, VolumeCreate{Name: &name, Size: &size})
+	}
+	result := C.CreateVolumes(context.Background(), params, 2)
+	assert.Len(t, result, 5)
+	assert.Equal(t, BatchResult{ID: "id-vol-1"}, result[0])
+	assert.Empty(t, result[1].ID)
+	apiError := result[1].Err.(APIError)
+	assert.True(t, apiError.VolumeNameIsAlreadyUse())
+	assert.Equal(t, "id-vol-5", result[4].ID)
+	assert.True(t, maxInFlight <= 2)
+}
+
+func TestClientIMPL_DeleteVolumes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(404, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`, UnknownVolumeErrorCode)))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, "attached"),
+		httpmock.NewStringResponder(422, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`,
+			VolumeAttachedToHost)))
+
+	result := C.DeleteVolumes(context.Background(), []string{volID, volID2, "attached"}, 0)
+	assert.Len(t, result, 3)
+	assert.Equal(t, BatchResult{ID: volID}, result[0])
+	// already deleted volume is not an error
+	assert.Equal(t, BatchResult{ID: volID2}, result[1])
+	assert.Equal(t, "attached", result[2].ID)
+	apiError := result[2].Err.(APIError)
+	assert.True(t, apiError.VolumeAttachedToHost())
+}
+
+func TestClientIMPL_DeleteVolumes_ContextDone(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := C.DeleteVolumes(ctx, []string{volID, volID2}, 1)
+	assert.Len(t, result, 2)
+	for _, r := range result {
+		assert.NotNil(t, r.Err)
+	}
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
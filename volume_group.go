@@ -0,0 +1,303 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dell/gopowerstore/api"
+)
+
+const volumeGroupURL = "volume_group"
+
+func getVolumeGroupDefaultQueryParams(c Client) api.QueryParamsEncoder {
+	vg := VolumeGroup{}
+	return c.APIClient().QueryParamsWithFields(&vg)
+}
+
+// GetVolumeGroup query and return specific volume group by id
+func (c *ClientIMPL) GetVolumeGroup(ctx context.Context, id string) (resp VolumeGroup, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeGroupURL,
+			ID:          id,
+			QueryParams: getVolumeGroupDefaultQueryParams(c)},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetVolumeGroupByName query and return specific volume group by name
+func (c *ClientIMPL) GetVolumeGroupByName(ctx context.Context, name string) (resp VolumeGroup, err error) {
+	var groupList []VolumeGroup
+	qp := getVolumeGroupDefaultQueryParams(c)
+	qp.RawArg("name", fmt.Sprintf("eq.%s", name))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeGroupURL,
+			QueryParams: qp},
+		&groupList)
+	err = WrapErr(err)
+	if err != nil {
+		return resp, err
+	}
+	if len(groupList) != 1 {
+		return resp, notExistError()
+	}
+	return groupList[0], nil
+}
+
+// GetVolumeGroups returns all volume groups, volume group snapshots are not included
+func (c *ClientIMPL) GetVolumeGroups(ctx context.Context) ([]VolumeGroup, error) {
+	result := []VolumeGroup{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []VolumeGroup
+		qp := getVolumeGroupDefaultQueryParams(c)
+		qp.RawArg("type", fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeGroupURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// GetVolumeGroupsByVolumeID returns volume groups the volume is a member of,
+// empty slice is returned if the volume doesn't belong to any group
+func (c *ClientIMPL) GetVolumeGroupsByVolumeID(ctx context.Context, volID string) ([]VolumeGroup, error) {
+	var vol struct {
+		VolumeGroups []resourceRef `json:"volume_groups"`
+	}
+	_, err := c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			ID:          volID,
+			QueryParams: c.APIClient().QueryParams().Select("volume_groups(id)")},
+		&vol)
+	err = WrapErr(err)
+	if err != nil {
+		return nil, err
+	}
+	result := []VolumeGroup{}
+	if len(vol.VolumeGroups) == 0 {
+		return result, nil
+	}
+	ids := make([]string, 0, len(vol.VolumeGroups))
+	for _, ref := range vol.VolumeGroups {
+		ids = append(ids, ref.ID)
+	}
+	qp := getVolumeGroupDefaultQueryParams(c)
+	qp.RawArg("id", fmt.Sprintf("in.(%s)", strings.Join(ids, ",")))
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeGroupURL,
+			QueryParams: qp},
+		&result)
+	return result, WrapErr(err)
+}
+
+// CreateVolumeGroup creates new volume group
+func (c *ClientIMPL) CreateVolumeGroup(ctx context.Context,
+	createParams *VolumeGroupCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeGroupURL,
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// ModifyVolumeGroup modifies existing volume group
+func (c *ClientIMPL) ModifyVolumeGroup(ctx context.Context,
+	modifyParams *VolumeGroupModify, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "PATCH",
+			Endpoint: volumeGroupURL,
+			ID:       id,
+			Body:     modifyParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// DeleteVolumeGroup deletes existing volume group, member volumes are not deleted
+func (c *ClientIMPL) DeleteVolumeGroup(ctx context.Context, id string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "DELETE",
+			Endpoint: volumeGroupURL,
+			ID:       id},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// AddMembersToVolumeGroup adds volumes to the volume group
+func (c *ClientIMPL) AddMembersToVolumeGroup(ctx context.Context,
+	groupID string, volumeIDs []string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeGroupURL,
+			ID:       groupID,
+			Action:   "add_members",
+			Body:     &volumeGroupMembers{VolumeIDs: volumeIDs}},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// RemoveMembersFromVolumeGroup removes volumes from the volume group
+func (c *ClientIMPL) RemoveMembersFromVolumeGroup(ctx context.Context,
+	groupID string, volumeIDs []string) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeGroupURL,
+			ID:       groupID,
+			Action:   "remove_members",
+			Body:     &volumeGroupMembers{VolumeIDs: volumeIDs}},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CreateVolumeGroupSnapshot creates crash consistent snapshot of all volume group members
+func (c *ClientIMPL) CreateVolumeGroupSnapshot(ctx context.Context,
+	groupID string, createParams *VolumeGroupSnapshotCreate) (resp CreateResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeGroupURL,
+			ID:       groupID,
+			Action:   "snapshot",
+			Body:     createParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// CloneVolumeGroup creates a new volume group with clones of all source group members.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) CloneVolumeGroup(ctx context.Context,
+	groupID string, cloneParams *VolumeGroupClone) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    volumeGroupURL,
+		ID:          groupID,
+		Action:      "clone",
+		QueryParams: qp,
+		Body:        cloneParams})
+}
+
+// RefreshVolumeGroup refreshes members of the volume group from the source group or group snapshot.
+// Operation runs asynchronously, the returned JobResponse holds id of the job
+func (c *ClientIMPL) RefreshVolumeGroup(ctx context.Context,
+	groupID string, refreshParams *VolumeGroupRefresh) (resp JobResponse, err error) {
+	qp := c.APIClient().QueryParams()
+	qp.Async(true)
+	return c.queryJob(ctx, RequestConfig{
+		Method:      "POST",
+		Endpoint:    volumeGroupURL,
+		ID:          groupID,
+		Action:      "refresh",
+		QueryParams: qp,
+		Body:        refreshParams})
+}
+
+// GetVolumeGroupSnapshots returns snapshots of the volume group
+func (c *ClientIMPL) GetVolumeGroupSnapshots(ctx context.Context, groupID string) ([]VolumeGroup, error) {
+	result := []VolumeGroup{}
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []VolumeGroup
+		qp := getVolumeGroupDefaultQueryParams(c)
+		qp.RawArg("type", fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot))
+		qp.RawArg("protection_data->>source_id", fmt.Sprintf("eq.%s", groupID))
+		qp.Order("name")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.APIClient().Query(
+			ctx,
+			RequestConfig{
+				Method:      "GET",
+				Endpoint:    volumeGroupURL,
+				QueryParams: qp},
+			&page)
+		err = WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
+
+// RestoreVolumeGroupFromSnapshot restores data of all volume group members in place from the group snapshot.
+// Optionally a backup snapshot of the current group state is created before restore
+func (c *ClientIMPL) RestoreVolumeGroupFromSnapshot(ctx context.Context,
+	groupID string, restoreParams *VolumeGroupRestore) (resp EmptyResponse, err error) {
+	_, err = c.APIClient().Query(
+		ctx,
+		RequestConfig{
+			Method:   "POST",
+			Endpoint: volumeGroupURL,
+			ID:       groupID,
+			Action:   "restore",
+			Body:     restoreParams},
+		&resp)
+	return resp, WrapErr(err)
+}
+
+// GetVolumeGroupCloneMapping returns map of source volume id to the id of its clone
+// for every member of the cloned volume group
+func (c *ClientIMPL) GetVolumeGroupCloneMapping(ctx context.Context,
+	cloneGroupID string) (map[string]string, error) {
+	group, err := c.GetVolumeGroup(ctx, cloneGroupID)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string, len(group.Volumes))
+	for _, v := range group.Volumes {
+		result[v.ProtectionData.SourceID] = v.ID
+	}
+	return result, nil
+}
@@ -0,0 +1,257 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+const (
+	volumeGroupMockURL = APIMockURL + volumeGroupURL
+)
+
+var volumeGroupID = "1f5a6a35-1a10-4d0c-8f3e-b1a8b5e3c9a7"
+var volumeGroupID2 = "7e2a4d0e-04c1-4a8f-a6d8-0b2c5b1a7f93"
+var jobID = "b2d7bc6d-9a6d-4e5c-9b9e-3b2a0b7bd2e1"
+
+func TestClientIMPL_GetVolumeGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "volumes": [{"id": "%s"}]}`, volumeGroupID, volID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeGroupMockURL, volumeGroupID),
+		httpmock.NewStringResponder(200, respData))
+	vg, err := C.GetVolumeGroup(context.Background(), volumeGroupID)
+	assert.Nil(t, err)
+	assert.Equal(t, volumeGroupID, vg.ID)
+	assert.Equal(t, volID, vg.Volumes[0].ID)
+}
+
+func TestClientIMPL_CloneVolumeGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s"}`, jobID)
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/clone", volumeGroupMockURL, volumeGroupID),
+		httpmock.NewStringResponder(202, respData))
+	name := "vg_clone"
+	resp, err := C.CloneVolumeGroup(context.Background(), volumeGroupID, &VolumeGroupClone{Name: &name})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+}
+
+func TestClientIMPL_RefreshVolumeGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s"}`, jobID)
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/refresh", volumeGroupMockURL, volumeGroupID2),
+		httpmock.NewStringResponder(202, respData))
+	resp, err := C.RefreshVolumeGroup(context.Background(), volumeGroupID2,
+		&VolumeGroupRefresh{FromObjectID: &volumeGroupID})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+}
+
+func TestClientIMPL_GetVolumeGroupCloneMapping(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s", "volumes": [{"id": "%s", "protection_data": {"source_id": "%s"}}]}`,
+		volumeGroupID2, volID2, volID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeGroupMockURL, volumeGroupID2),
+		httpmock.NewStringResponder(200, respData))
+	mapping, err := C.GetVolumeGroupCloneMapping(context.Background(), volumeGroupID2)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{volID: volID2}, mapping)
+}
+
+func TestClientIMPL_GetVolumeGroupByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "name": "vg"}]`, volumeGroupID)
+	httpmock.RegisterResponder("GET", volumeGroupMockURL,
+		httpmock.NewStringResponder(200, respData))
+	vg, err := C.GetVolumeGroupByName(context.Background(), "vg")
+	assert.Nil(t, err)
+	assert.Equal(t, volumeGroupID, vg.ID)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("GET", volumeGroupMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	_, err = C.GetVolumeGroupByName(context.Background(), "vg")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetVolumeGroups(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter string
+	httpmock.RegisterResponder("GET", volumeGroupMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "%s"}, {"id": "%s"}]`, volumeGroupID, volumeGroupID2)), nil
+		})
+	groups, err := C.GetVolumeGroups(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "not.eq.Snapshot", typeFilter)
+}
+
+func TestClientIMPL_GetVolumeGroupsByVolumeID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"volume_groups": [{"id": "%s"}]}`, volumeGroupID)))
+	var idFilter string
+	httpmock.RegisterResponder("GET", volumeGroupMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			idFilter = req.URL.Query().Get("id")
+			return httpmock.NewStringResponse(200,
+				fmt.Sprintf(`[{"id": "%s", "volumes": [{"id": "%s"}]}]`, volumeGroupID, volID)), nil
+		})
+	groups, err := C.GetVolumeGroupsByVolumeID(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, volumeGroupID, groups[0].ID)
+	assert.Equal(t, fmt.Sprintf("in.(%s)", volumeGroupID), idFilter)
+
+	// volume which doesn't belong to any group
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(200, `{"volume_groups": []}`))
+	groups, err = C.GetVolumeGroupsByVolumeID(context.Background(), volID2)
+	assert.Nil(t, err)
+	assert.Empty(t, groups)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET "+volumeGroupMockURL])
+}
+
+func TestClientIMPL_CreateVolumeGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", volumeGroupMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, volumeGroupID)), nil
+		})
+	name := "vg"
+	resp, err := C.CreateVolumeGroup(context.Background(),
+		&VolumeGroupCreate{Name: &name, VolumeIDs: []string{volID, volID2}})
+	assert.Nil(t, err)
+	assert.Equal(t, volumeGroupID, resp.ID)
+	assert.Len(t, reqBody["volume_ids"], 2)
+	assert.NotContains(t, reqBody, "protection_policy_id")
+}
+
+func TestClientIMPL_ModifyVolumeGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", volumeGroupMockURL, volumeGroupID),
+		httpmock.NewStringResponder(204, ""))
+	description := "databases"
+	_, err := C.ModifyVolumeGroup(context.Background(), &VolumeGroupModify{Description: &description}, volumeGroupID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_DeleteVolumeGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeGroupMockURL, volumeGroupID),
+		httpmock.NewStringResponder(204, ""))
+	_, err := C.DeleteVolumeGroup(context.Background(), volumeGroupID)
+	assert.Nil(t, err)
+}
+
+func TestClientIMPL_VolumeGroupMembers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string][]string
+	responder := func(req *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(204, ""), nil
+	}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/add_members", volumeGroupMockURL, volumeGroupID),
+		responder)
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/remove_members", volumeGroupMockURL, volumeGroupID),
+		responder)
+	_, err := C.AddMembersToVolumeGroup(context.Background(), volumeGroupID, []string{volID, volID2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{volID, volID2}, reqBody["volume_ids"])
+	_, err = C.RemoveMembersFromVolumeGroup(context.Background(), volumeGroupID, []string{volID2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{volID2}, reqBody["volume_ids"])
+}
+
+func TestClientIMPL_CreateVolumeGroupSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/snapshot", volumeGroupMockURL, volumeGroupID),
+		httpmock.NewStringResponder(201, fmt.Sprintf(`{"id": "%s"}`, volumeGroupID2)))
+	name := "vg_snap"
+	resp, err := C.CreateVolumeGroupSnapshot(context.Background(), volumeGroupID,
+		&VolumeGroupSnapshotCreate{Name: &name})
+	assert.Nil(t, err)
+	assert.Equal(t, volumeGroupID2, resp.ID)
+}
+
+func TestClientIMPL_GetVolumeGroupSnapshots(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var sourceFilter, typeFilter string
+	httpmock.RegisterResponder("GET", volumeGroupMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			sourceFilter = req.URL.Query().Get("protection_data->>source_id")
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(
+				`[{"id": "%s", "type": "Snapshot", "protection_data": {"source_id": "%s"}}]`,
+				volumeGroupID2, volumeGroupID)), nil
+		})
+	snaps, err := C.GetVolumeGroupSnapshots(context.Background(), volumeGroupID)
+	assert.Nil(t, err)
+	assert.Len(t, snaps, 1)
+	assert.Equal(t, volumeGroupID, snaps[0].ProtectionData.SourceID)
+	assert.Equal(t, "eq."+volumeGroupID, sourceFilter)
+	assert.Equal(t, "eq.Snapshot", typeFilter)
+}
+
+func TestClientIMPL_RestoreVolumeGroupFromSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/restore", volumeGroupMockURL, volumeGroupID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	createBackup := true
+	_, err := C.RestoreVolumeGroupFromSnapshot(context.Background(), volumeGroupID,
+		&VolumeGroupRestore{SnapshotID: &volumeGroupID2, CreateBackupSnap: &createBackup})
+	assert.Nil(t, err)
+	assert.Equal(t, volumeGroupID2, reqBody["from_snap_id"])
+	assert.Equal(t, true, reqBody["create_backup_snap"])
+}
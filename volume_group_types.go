@@ -0,0 +1,128 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// VolumeGroupCreate create volume group request
+type VolumeGroupCreate struct {
+	// Unique name for the volume group.
+	Name *string `json:"name"`
+	// Description for the volume group.
+	Description *string `json:"description,omitempty"`
+	// Volumes to add to the volume group.
+	VolumeIDs []string `json:"volume_ids,omitempty"`
+	// Unique identifier of the protection policy to assign to the volume group.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+	// Indicates whether snapshots of the group are write-order consistent.
+	IsWriteOrderConsistent *bool `json:"is_write_order_consistent,omitempty"`
+}
+
+// VolumeGroupModify modify volume group request, unset fields are not changed
+type VolumeGroupModify struct {
+	// Unique name for the volume group.
+	Name *string `json:"name,omitempty"`
+	// Description for the volume group.
+	Description *string `json:"description,omitempty"`
+	// Unique identifier of the protection policy to assign to the volume group.
+	// Empty string removes the protection policy.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+	// Indicates whether snapshots of the group are write-order consistent.
+	IsWriteOrderConsistent *bool `json:"is_write_order_consistent,omitempty"`
+}
+
+// volumeGroupMembers body of add_members and remove_members requests
+type volumeGroupMembers struct {
+	VolumeIDs []string `json:"volume_ids"`
+}
+
+// VolumeGroupSnapshotCreate create volume group snapshot request
+type VolumeGroupSnapshotCreate struct {
+	// Unique name for the volume group snapshot.
+	Name *string `json:"name,omitempty"`
+	// Description for the volume group snapshot.
+	Description *string `json:"description,omitempty"`
+	// Expiration timestamp of the volume group snapshot.
+	ExpirationTimestamp *string `json:"expiration_timestamp,omitempty"`
+}
+
+// VolumeGroupClone request for cloning volume group
+type VolumeGroupClone struct {
+	// Unique name for the volume group clone.
+	Name *string `json:"name"`
+	// Description for the volume group clone.
+	Description *string `json:"description,omitempty"`
+	// Unique identifier of the protection policy to assign to the volume group clone.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+}
+
+// BackupSnapProfile settings of backup snapshot created before refreshing or restoring a resource
+type BackupSnapProfile struct {
+	// Name of the backup snapshot.
+	Name *string `json:"name,omitempty"`
+	// Description of the backup snapshot.
+	Description *string `json:"description,omitempty"`
+	// Expiration timestamp of the backup snapshot.
+	ExpirationTimestamp *string `json:"expiration_timestamp,omitempty"`
+}
+
+// VolumeGroupRefresh request for refreshing volume group from another group or group snapshot
+type VolumeGroupRefresh struct {
+	// Unique identifier of the source volume group or volume group snapshot.
+	FromObjectID *string `json:"from_object_id"`
+	// Indicates whether a backup snapshot of the target volume group will be created before it is refreshed.
+	CreateBackupSnap *bool `json:"create_backup_snap,omitempty"`
+	// Backup snapshot settings.
+	BackupSnapProfile *BackupSnapProfile `json:"backup_snap_profile,omitempty"`
+}
+
+// VolumeGroupRestore request for restoring volume group from one of its snapshots
+type VolumeGroupRestore struct {
+	// Unique identifier of the snapshot of the volume group to restore from.
+	SnapshotID *string `json:"from_snap_id"`
+	// Indicates whether a backup snapshot of the volume group will be created before it is restored.
+	CreateBackupSnap *bool `json:"create_backup_snap,omitempty"`
+	// Backup snapshot settings.
+	BackupSnapProfile *BackupSnapProfile `json:"backup_snap_profile,omitempty"`
+}
+
+// VolumeGroup Details about a volume group.
+type VolumeGroup struct {
+	// Unique identifier of the volume group.
+	ID string `json:"id,omitempty"`
+	// Name of the volume group.
+	Name string `json:"name,omitempty"`
+	// Description for the volume group.
+	Description string `json:"description,omitempty"`
+	// Unique identifier of the protection policy assigned to the volume group.
+	ProtectionPolicyID string `json:"protection_policy_id,omitempty"`
+	// Indicates whether snapshots of the group are write-order consistent.
+	IsWriteOrderConsistent bool `json:"is_write_order_consistent,omitempty"`
+	// Type of the volume group.
+	Type VolumeTypeEnum `json:"type,omitempty"`
+	// Meta information about volume group creation.
+	ProtectionData ProtectionData `json:"protection_data,omitempty"`
+	// Volumes which are members of the group.
+	Volumes []Volume `json:"volumes,omitempty"`
+}
+
+// Fields returns fields which must be requested to fill struct
+func (v *VolumeGroup) Fields() []string {
+	return []string{"id", "name", "description", "protection_policy_id",
+		"is_write_order_consistent", "type", "protection_data",
+		"volumes(id,name,size,type,protection_data)"}
+}
@@ -0,0 +1,125 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+)
+
+// VolumeIterator reads volumes page by page, so only one page is kept in memory.
+// Iteration stops when all volumes are read, request fails or context is done, check Err after Next returns false
+type VolumeIterator struct {
+	ctx        context.Context
+	c          *ClientIMPL
+	typeFilter string
+	pageSize   int
+	page       []Volume
+	pos        int
+	offset     int
+	done       bool
+	err        error
+}
+
+// NewVolumeIterator returns iterator over volumes and clones ordered by name.
+// pageSize out of 1..1000 range is replaced by 1000
+func (c *ClientIMPL) NewVolumeIterator(ctx context.Context, pageSize int) *VolumeIterator {
+	return c.newVolumeIterator(ctx, fmt.Sprintf("not.eq.%s", VolumeTypeEnumSnapshot), pageSize)
+}
+
+// NewSnapshotIterator returns iterator over snapshots ordered by name.
+// pageSize out of 1..1000 range is replaced by 1000
+func (c *ClientIMPL) NewSnapshotIterator(ctx context.Context, pageSize int) *VolumeIterator {
+	return c.newVolumeIterator(ctx, fmt.Sprintf("eq.%s", VolumeTypeEnumSnapshot), pageSize)
+}
+
+func (c *ClientIMPL) newVolumeIterator(ctx context.Context, typeFilter string, pageSize int) *VolumeIterator {
+	if pageSize <= 0 || pageSize > paginationDefaultPageSize {
+		pageSize = paginationDefaultPageSize
+	}
+	return &VolumeIterator{ctx: ctx, c: c, typeFilter: typeFilter, pageSize: pageSize}
+}
+
+// Next advances iterator to the next volume, next page is requested when current one is exhausted.
+// Returns false when there are no more volumes or iteration failed
+func (it *VolumeIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if it.pos+1 < len(it.page) {
+		it.pos++
+		return true
+	}
+	it.page, it.pos = nil, 0
+	if it.done {
+		return false
+	}
+	if err := it.fetch(); err != nil {
+		it.err = err
+		return false
+	}
+	return len(it.page) > 0
+}
+
+// Value returns current volume, zero value is returned if Next was not called or returned false
+func (it *VolumeIterator) Value() Volume {
+	if it.pos < len(it.page) {
+		return it.page[it.pos]
+	}
+	return Volume{}
+}
+
+// Err returns error which stopped iteration, nil if all volumes were read
+func (it *VolumeIterator) Err() error {
+	return it.err
+}
+
+func (it *VolumeIterator) fetch() error {
+	qp := getVolumeDefaultQueryParams(it.c)
+	qp.RawArg("type", it.typeFilter)
+	qp.Order("name")
+	qp.Offset(it.offset).Limit(it.pageSize)
+	meta, err := it.c.APIClient().Query(
+		it.ctx,
+		RequestConfig{
+			Method:      "GET",
+			Endpoint:    volumeURL,
+			QueryParams: qp},
+		&it.page)
+	err = WrapErr(err)
+	if err != nil {
+		apiError, ok := err.(APIError)
+		if ok && it.offset > 0 && apiError.BadRange() {
+			// could happen if some instances was deleted during iteration
+			it.done = true
+			return nil
+		}
+		return err
+	}
+	nextOffset := meta.Pagination.Last + 1
+	if !meta.Pagination.IsPaginate || nextOffset >= meta.Pagination.Total || len(it.page) == 0 {
+		it.done = true
+	}
+	it.offset = nextOffset
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// registerVolumePages serves total volumes named vol-<index> respecting offset and limit
+func registerVolumePages(total int, offsets *[]int) {
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			*offsets = append(*offsets, offset)
+			last := offset + limit - 1
+			if last >= total {
+				last = total - 1
+			}
+			body := "["
+			for i := offset; i <= last; i++ {
+				if i > offset {
+					body += ","
+				}
+				body += fmt.Sprintf(`{"id": "vol-%d"}`, i)
+			}
+			body += "]"
+			return pageResponse(body, fmt.Sprintf("%d-%d/%d", offset, last, total)), nil
+		})
+}
+
+func TestClientIMPL_NewVolumeIterator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var offsets []int
+	registerVolumePages(5, &offsets)
+
+	it := C.NewVolumeIterator(context.Background(), 2)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"vol-0", "vol-1", "vol-2", "vol-3", "vol-4"}, ids)
+	assert.Equal(t, []int{0, 2, 4}, offsets)
+	assert.False(t, it.Next())
+	assert.Equal(t, Volume{}, it.Value())
+}
+
+func TestClientIMPL_NewSnapshotIterator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var typeFilter, limit string
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			limit = req.URL.Query().Get("limit")
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}]`, volID)), nil
+		})
+	it := C.NewSnapshotIterator(context.Background(), 0)
+	assert.True(t, it.Next())
+	assert.Equal(t, volID, it.Value().ID)
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+	assert.Equal(t, "eq.Snapshot", typeFilter)
+	assert.Equal(t, "1000", limit)
+}
+
+func TestClientIMPL_NewVolumeIterator_Error(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("offset") == "0" {
+				return pageResponse(`[{"id": "vol-0"}]`, "0-0/2"), nil
+			}
+			return httpmock.NewStringResponse(500, `{"messages": [{"message_l10n": "internal error"}]}`), nil
+		})
+	it := C.NewVolumeIterator(context.Background(), 1)
+	assert.True(t, it.Next())
+	assert.False(t, it.Next())
+	apiError, ok := it.Err().(APIError)
+	assert.True(t, ok)
+	assert.Equal(t, 500, apiError.Status())
+}
+
+func TestClientIMPL_NewVolumeIterator_Cancel(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var offsets []int
+	registerVolumePages(5, &offsets)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := C.NewVolumeIterator(ctx, 2)
+	assert.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+	assert.Equal(t, []int{0}, offsets)
+}
@@ -20,10 +20,19 @@ package gopowerstore
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 const (
@@ -45,6 +54,77 @@ func TestClientIMPL_GetVolumes(t *testing.T) {
 	assert.Equal(t, volID, vols[0].ID)
 }
 
+func TestClientIMPL_GetVolumes_Paginated(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	volID3 := "a9a4a0f2-0ad7-4a37-96c5-1d2a8a9c1b0e"
+	pages := map[string]*http.Response{
+		"0": pageResponse(fmt.Sprintf(`[{"id": "%s"}]`, volID), "0-0/3"),
+		"1": pageResponse(fmt.Sprintf(`[{"id": "%s"}]`, volID2), "1-1/3"),
+		"2": pageResponse(fmt.Sprintf(`[{"id": "%s"}]`, volID3), "2-2/3"),
+	}
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			return pages[req.URL.Query().Get("offset")], nil
+		})
+	vols, err := C.GetVolumes(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, vols, 3)
+	assert.Equal(t, volID, vols[0].ID)
+	assert.Equal(t, volID2, vols[1].ID)
+	assert.Equal(t, volID3, vols[2].ID)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
+func TestClientIMPL_GetSnapshots_Paginated(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	pages := map[string]*http.Response{
+		"0": pageResponse(fmt.Sprintf(`[{"id": "%s"}]`, volID), "0-0/2"),
+		"1": pageResponse(fmt.Sprintf(`[{"id": "%s"}]`, volID2), "1-1/2"),
+	}
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			return pages[req.URL.Query().Get("offset")], nil
+		})
+	snaps, err := C.GetSnapshots(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, snaps, 2)
+	assert.Equal(t, volID2, snaps[1].ID)
+}
+
+func TestClientIMPL_GetVolumes_Empty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, `[]`))
+	vols, err := C.GetVolumes(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, vols)
+	assert.Len(t, vols, 0)
+}
+
+func TestClientIMPL_GetVolumesWithPagination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var offset, limit string
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			offset = req.URL.Query().Get("offset")
+			limit = req.URL.Query().Get("limit")
+			return pageResponse(fmt.Sprintf(`[{"id": "%s"}, {"id": "%s"}]`, volID, volID2), "10-11/25"), nil
+		})
+	vols, total, err := C.GetVolumesWithPagination(context.Background(), 10, 2)
+	assert.Nil(t, err)
+	assert.Len(t, vols, 2)
+	assert.Equal(t, 25, total)
+	assert.Equal(t, "10", offset)
+	assert.Equal(t, "2", limit)
+
+	_, _, err = C.GetVolumesWithPagination(context.Background(), 0, 0)
+	assert.NotNil(t, err)
+}
+
 func TestClientIMPL_GetVolume(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -128,6 +208,81 @@ func TestClientIMPL_CreateVolume(t *testing.T) {
 	assert.Equal(t, volID, resp.ID)
 }
 
+func TestClientIMPL_CreateVolume_RequireProtectionPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		httpmock.NewStringResponder(201, fmt.Sprintf(`{"id": "%s"}`, volID)))
+	client, err := NewClientWithArgs(APIMockURL, "admin", "Password",
+		NewClientOptions().SetRequireProtectionPolicy(true))
+	assert.Nil(t, err)
+	name := "test_vol"
+	size := int64(11111111)
+	createReq := VolumeCreate{Name: &name, Size: &size}
+
+	_, err = client.CreateVolume(context.Background(), &createReq)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+
+	policyID := "2ee7dc3b-a1b5-4a2d-9c31-3a2e16c56f02"
+	createReq.ProtectionPolicyID = &policyID
+	resp, err := client.CreateVolume(context.Background(), &createReq)
+	assert.Nil(t, err)
+	assert.Equal(t, volID, resp.ID)
+}
+
+func TestClientIMPL_CreateVolume_Placement(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("3.0.0.0")
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			reqBody = nil
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, volID)), nil
+		})
+	name := "test_vol"
+	size := int64(8192)
+	sectorSize := int64(4096)
+	applianceID := "A2"
+	groupID := "c4b9e1f6-52a2-4d3c-9f1e-6b1a9f0e2d33"
+	appType := AppTypeEnumRelationalDatabasesOther
+	appTypeOther := "Db2"
+	createReq := VolumeCreate{Name: &name, Size: &size, SectorSize: &sectorSize, ApplianceID: &applianceID,
+		VolumeGroupID: &groupID, AppType: &appType, AppTypeOther: &appTypeOther}
+	resp, err := C.CreateVolume(context.Background(), &createReq)
+	assert.Nil(t, err)
+	assert.Equal(t, volID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "size": float64(size), "sector_size": float64(4096),
+		"appliance_id": applianceID, "volume_group_id": groupID, "app_type": string(appType),
+		"app_type_other": appTypeOther}, reqBody)
+
+	badSectorSize := int64(1024)
+	empty := ""
+	kubernetes := AppTypeEnumVirtualizationContainersKubernetes
+	for _, req := range []VolumeCreate{
+		{Name: &name, Size: &size, SectorSize: &badSectorSize},
+		{Name: &name, Size: &size, ApplianceID: &empty},
+		{Name: &name, Size: &size, VolumeGroupID: &empty},
+		{Name: &name, Size: &size, AppType: &kubernetes, AppTypeOther: &appTypeOther},
+		{Name: &name, Size: &size, AppTypeOther: &appTypeOther},
+	} {
+		req := req
+		_, err = C.CreateVolume(context.Background(), &req)
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST "+volumeMockURL])
+
+	// app types not listed by AppTypeEnum are passed to the array
+	db2 := AppTypeEnum("Relational_Databases_IBM_DB2")
+	_, err = C.CreateVolume(context.Background(), &VolumeCreate{Name: &name, Size: &size, AppType: &db2})
+	assert.Nil(t, err)
+	assert.Equal(t, string(db2), reqBody["app_type"])
+}
+
 func TestClientIMPL_CreateSnapshot(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -160,6 +315,88 @@ func TestClientIMPL_CreateVolumeFromSnapshot(t *testing.T) {
 	assert.Equal(t, volID2, resp.ID)
 }
 
+func TestClientIMPL_CloneVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/clone", volumeMockURL, volID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, volID2)), nil
+		})
+	name := "clone"
+	resp, err := C.CloneVolume(context.Background(),
+		&VolumeClone{Name: &name, ProtectionPolicyID: &protectionPolicyID}, volID)
+	assert.Nil(t, err)
+	assert.Equal(t, volID2, resp.ID)
+	assert.Equal(t, map[string]interface{}{"name": name, "protection_policy_id": protectionPolicyID}, reqBody)
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/clone", volumeMockURL, volID2),
+		httpmock.NewStringResponder(404, `{"messages": [{"code": "0xE04040020009"}]}`))
+	_, err = C.CloneVolume(context.Background(), &VolumeClone{Name: &name}, volID2)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.VolumeIsNotExist())
+}
+
+func TestClientIMPL_ModifyVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("3.0.0.0")
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	size := int64(2097152)
+	_, err := C.ModifyVolume(context.Background(), &VolumeModify{Size: &size}, volID)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(size), reqBody["size"])
+	assert.NotContains(t, reqBody, "name")
+	assert.NotContains(t, reqBody, "protection_policy_id")
+
+	// empty policy id unassigns the protection policy
+	noPolicy := ""
+	reqBody = nil
+	_, err = C.ModifyVolume(context.Background(), &VolumeModify{ProtectionPolicyID: &noPolicy}, volID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"protection_policy_id": ""}, reqBody)
+
+	name := "renamed"
+	policy := "default_high"
+	appType := AppTypeEnumVirtualizationContainersKubernetes
+	reqBody = nil
+	_, err = C.ModifyVolume(context.Background(),
+		&VolumeModify{Name: &name, PerformancePolicyID: &policy, AppType: &appType}, volID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": name, "performance_policy_id": policy,
+		"app_type": string(appType)}, reqBody)
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(422, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`,
+			VolumeSizeIsNotSupportedErrorCode)))
+	_, err = C.ModifyVolume(context.Background(), &VolumeModify{Size: &size}, volID)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.VolumeSizeIsNotSupported())
+}
+
+func TestClientIMPL_ModifySnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("PATCH", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(204, ""))
+	expiration := "2020-06-01T00:00:00Z"
+	_, err := C.ModifySnapshot(context.Background(), &SnapshotModify{ExpirationTimestamp: &expiration}, volID)
+	assert.Nil(t, err)
+}
+
 func TestClientIMPL_DeleteSnapshot(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -185,3 +422,413 @@ func TestClientIMPL_DeleteVolume(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, string(resp), 0)
 }
+
+func TestClientIMPL_RefreshVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`{"id": "%s"}`, jobID)
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/refresh", volumeMockURL, volID),
+		httpmock.NewStringResponder(202, respData))
+
+	refreshParams := VolumeRefresh{FromObjectID: &volID2}
+	resp, err := C.RefreshVolume(context.Background(), volID, &refreshParams)
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+}
+
+func TestClientIMPL_RefreshVolume_NotClone(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/refresh", volumeMockURL, volID),
+		httpmock.NewStringResponder(202, fmt.Sprintf(`{"id": "%s"}`, jobID)))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", jobMockURL, jobID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "state": "FAILED", "response_status": "422",
+"response_body": {"messages": [{"code": "%s"}]}}`, jobID, VolumeIsNotCloneErrorCode)))
+
+	resp, err := C.RefreshVolume(context.Background(), volID, &VolumeRefresh{FromObjectID: &volID2})
+	assert.Nil(t, err)
+	_, err = C.WaitForJob(context.Background(), resp.ID)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.VolumeIsNotClone())
+}
+
+func TestClientIMPL_RestoreVolumeFromSnapshotAsync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var async string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/restore", volumeMockURL, volID),
+		func(req *http.Request) (*http.Response, error) {
+			async = req.URL.Query().Get("is_async")
+			return httpmock.NewStringResponse(202, fmt.Sprintf(`{"id": "%s"}`, jobID)), nil
+		})
+	resp, err := C.RestoreVolumeFromSnapshotAsync(context.Background(), volID, &VolumeRestore{SnapshotID: &volID2})
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, resp.ID)
+	assert.Equal(t, "true", async)
+}
+
+func TestClientIMPL_RestoreVolumeFromSnapshot(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/restore", volumeMockURL, volID),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(204, ""), nil
+		})
+	backup := true
+	backupName := "before_restore"
+	_, err := C.RestoreVolumeFromSnapshot(context.Background(), volID, &VolumeRestore{
+		SnapshotID:        &volID2,
+		CreateBackupSnap:  &backup,
+		BackupSnapProfile: &BackupSnapProfile{Name: &backupName},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, volID2, reqBody["from_snap_id"])
+	assert.Equal(t, true, reqBody["create_backup_snap"])
+	assert.Equal(t, map[string]interface{}{"name": backupName}, reqBody["backup_snap_profile"])
+
+	httpmock.Reset()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/restore", volumeMockURL, volID),
+		httpmock.NewStringResponder(400, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`, SnapshotIsNotOfVolumeErrorCode)))
+	_, err = C.RestoreVolumeFromSnapshot(context.Background(), volID, &VolumeRestore{SnapshotID: &volID2})
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.SnapshotIsNotOfVolume())
+}
+
+func TestClientIMPL_GetTopSpaceConsumers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "data_reduction_ratio": 1.1, "compression_ratio": 1.05, "dedup_ratio": 1.05},
+{"id": "%s", "data_reduction_ratio": 2.4, "compression_ratio": 1.6, "dedup_ratio": 1.5}]`, volID, volID2)
+	var query url.Values
+	httpmock.RegisterResponder("GET", APIMockURL+volumeListCmaViewURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	vols, err := C.GetTopSpaceConsumers(context.Background(), 2)
+	assert.Nil(t, err)
+	assert.Len(t, vols, 2)
+	assert.Equal(t, 1.1, vols[0].DataReductionRatio)
+	assert.Equal(t, 1.6, vols[1].CompressionRatio)
+	assert.Equal(t, "data_reduction_ratio.asc", query.Get("order"))
+	assert.Equal(t, "2", query.Get("limit"))
+
+	_, err = C.GetTopSpaceConsumers(context.Background(), 0)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetUnmanagedVolumes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "metadata": {"owner": "backup"}}]`, volID)
+	var query url.Values
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	vols, err := C.GetUnmanagedVolumes(context.Background(), "csi.volume")
+	assert.Nil(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, "backup", vols[0].Metadata["owner"])
+	assert.Equal(t, "is.null", query.Get("metadata->>csi.volume"))
+	assert.Contains(t, query.Get("select"), "metadata")
+}
+
+func TestClientIMPL_GetVolumeByMetadata(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("3.0.0.0")
+	var reqBody map[string]interface{}
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, volID)), nil
+		})
+	var query url.Values
+	respData := fmt.Sprintf(`[{"id": "%s", "metadata": {"k8s.pvc": "pvc-1"}}]`, volID)
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+
+	name := "vol"
+	size := int64(1048576)
+	metadata := map[string]string{"k8s.pvc": "pvc-1"}
+	_, err := C.CreateVolume(context.Background(), &VolumeCreate{Name: &name, Size: &size, Metadata: &metadata})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"k8s.pvc": "pvc-1"}, reqBody["metadata"])
+
+	vols, err := C.GetVolumeByMetadata(context.Background(), "k8s.pvc", "pvc-1")
+	assert.Nil(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, volID, vols[0].ID)
+	assert.Equal(t, "pvc-1", vols[0].Metadata["k8s.pvc"])
+	assert.Equal(t, "eq.pvc-1", query.Get("metadata->>k8s.pvc"))
+
+	httpmock.RegisterResponder("GET", volumeMockURL, httpmock.NewStringResponder(200, `[]`))
+	vols, err = C.GetVolumeByMetadata(context.Background(), "k8s.pvc", "pvc-2")
+	assert.Nil(t, err)
+	assert.NotNil(t, vols)
+	assert.Len(t, vols, 0)
+}
+
+func TestClientIMPL_GetVolumesExceedingLogicalUsed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	respData := fmt.Sprintf(`[{"id": "%s", "size": 1048576, "logical_used": 943718},
+{"id": "%s", "size": 1048576, "logical_used": 8192}]`, volID, volID2)
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, respData))
+	vols, err := C.GetVolumesExceedingLogicalUsed(context.Background(), 85)
+	assert.Nil(t, err)
+	assert.Len(t, vols, 1)
+	assert.Equal(t, volID, vols[0].ID)
+	assert.InDelta(t, 90, vols[0].LogicalUsedPercent(), 0.1)
+
+	_, err = C.GetVolumesExceedingLogicalUsed(context.Background(), 120)
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_GetSnapshotCreationRate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	now := time.Now().UTC()
+	respData := fmt.Sprintf(`[{"id": "1", "creation_timestamp": "%s"},
+{"id": "2", "creation_timestamp": "%s"},
+{"id": "3", "creation_timestamp": "%s"}]`,
+		now.Add(-3*time.Hour).Format(time.RFC3339),
+		now.Format(time.RFC3339),
+		now.Format(time.RFC3339))
+	var typeFilter string
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			typeFilter = req.URL.Query().Get("type")
+			return httpmock.NewStringResponse(200, respData), nil
+		})
+	rate, err := C.GetSnapshotCreationRate(context.Background(), MetricsIntervalEnumOneHour)
+	assert.Nil(t, err)
+	assert.Equal(t, "eq.Snapshot", typeFilter)
+	assert.Len(t, rate.Buckets, 24)
+	assert.Equal(t, 3, rate.Total)
+	assert.Equal(t, 2, rate.Buckets[23].Count)
+	assert.Equal(t, 1, rate.Buckets[20].Count)
+
+	_, err = C.GetVolumeCreationRate(context.Background(), MetricsIntervalEnumOneDay)
+	assert.Nil(t, err)
+	assert.Equal(t, "not.eq.Snapshot", typeFilter)
+
+	_, err = C.GetVolumeCreationRate(context.Background(), "Five_Years")
+	assert.NotNil(t, err)
+}
+
+func TestClientIMPL_DeleteSnapshotsByVolumeID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	failedSnap := "b5f3a2e1-0c4d-4e5f-8a9b-1c2d3e4f5a6b"
+	goneSnap := "0d6c1e2f-3a4b-4c5d-9e8f-7a6b5c4d3e2f"
+	var listQuery url.Values
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			listQuery = req.URL.Query()
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "%s"}, {"id": "%s"}]`,
+				volID2, goneSnap, failedSnap)), nil
+		})
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, goneSnap),
+		httpmock.NewStringResponder(404, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`, InstanceWasNotFound)))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, failedSnap),
+		httpmock.NewStringResponder(422, `{"messages": [{"code": "0xE0A080020010"}]}`))
+
+	result, err := C.DeleteSnapshotsByVolumeID(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.Deleted)
+	assert.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors, failedSnap)
+	assert.Equal(t, "eq."+volID, listQuery.Get("protection_data->>source_id"))
+	assert.Equal(t, "eq.Snapshot", listQuery.Get("type"))
+}
+
+func TestClientIMPL_DeleteSnapshotsOlderThan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	snapWithClone := "b5f3a2e1-0c4d-4e5f-8a9b-1c2d3e4f5a6b"
+	var listQuery url.Values
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			if query.Get("type") == "eq.Clone" {
+				if query.Get("protection_data->>parent_id") == "eq."+snapWithClone {
+					return httpmock.NewStringResponse(200, `[{"id": "clone"}]`), nil
+				}
+				return httpmock.NewStringResponse(200, `[]`), nil
+			}
+			listQuery = query
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`[{"id": "%s"}, {"id": "%s"}, {"id": "%s"}]`,
+				volID, volID2, snapWithClone)), nil
+		})
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(204, ""))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(404, `{"messages": [{"code": "0xE04040020009"}]}`))
+
+	cutoff := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	filter := C.APIClient().QueryParams().RawArg("protection_data->>parent_id", "eq.parent")
+	errs := C.DeleteSnapshotsOlderThan(context.Background(), cutoff, filter)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), snapWithClone)
+	assert.Equal(t, "lt.2020-05-01T00:00:00Z", listQuery.Get("creation_timestamp"))
+	assert.Equal(t, "eq.User", listQuery.Get("protection_data->>creator_type"))
+	assert.Equal(t, "eq.parent", listQuery.Get("protection_data->>parent_id"))
+	assert.Equal(t, "protection_data-%3E%3Eparent_id=eq.parent", filter.Encode())
+}
+
+func TestClientIMPL_MetroVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	registerSoftwareVersion("3.0.0.0")
+	var reqBody map[string]interface{}
+	responder := func(status int, body string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&reqBody); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(status, body), nil
+		}
+	}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/configure_metro", volumeMockURL, volID),
+		responder(200, fmt.Sprintf(`{"metro_replication_session_id": "%s"}`, replicationSessionID)))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("%s/%s/end_metro", volumeMockURL, volID),
+		responder(204, ""))
+	remoteSystemID := "remote1"
+	resp, err := C.ConfigureMetroVolume(context.Background(), volID, &MetroConfig{RemoteSystemID: &remoteSystemID})
+	assert.Nil(t, err)
+	assert.Equal(t, replicationSessionID, resp.ID)
+	assert.Equal(t, map[string]interface{}{"remote_system_id": remoteSystemID}, reqBody)
+
+	reqBody = nil
+	deleteRemote := true
+	_, err = C.EndMetroVolume(context.Background(), volID, &EndMetroVolumeOptions{DeleteRemoteVolume: &deleteRemote})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"delete_remote_volume": true}, reqBody)
+}
+
+func TestClientIMPL_GetMetroSessionByVolumeID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"metro_replication_session_id": "%s"}`, replicationSessionID)))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", replicationSessionMockURL, replicationSessionID),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"id": "%s", "state": "OK", "local_resource_id": "%s"}`,
+			replicationSessionID, volID)))
+	session, err := C.GetMetroSessionByVolumeID(context.Background(), volID)
+	assert.Nil(t, err)
+	assert.Equal(t, replicationSessionID, session.ID)
+	assert.Equal(t, volID, session.LocalResourceID)
+
+	// volume is not a metro volume
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID2),
+		httpmock.NewStringResponder(200, `{"metro_replication_session_id": null}`))
+	_, err = C.GetMetroSessionByVolumeID(context.Background(), volID2)
+	assert.NotNil(t, err)
+	apiError := err.(APIError)
+	assert.True(t, apiError.NotFound())
+}
+
+func TestClientIMPL_EnsureVolume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	created := false
+	httpmock.RegisterResponder("POST", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			if created {
+				return httpmock.NewStringResponse(422, fmt.Sprintf(`{"messages": [{"code": "%s"}]}`,
+					VolumeNameAlreadyUseErrorCode)), nil
+			}
+			created = true
+			return httpmock.NewStringResponse(201, fmt.Sprintf(`{"id": "%s"}`, volID)), nil
+		})
+	volData := fmt.Sprintf(`{"id": "%s", "name": "vol1", "size": 1048576}`, volID)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("%s/%s", volumeMockURL, volID),
+		httpmock.NewStringResponder(200, volData))
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		httpmock.NewStringResponder(200, "["+volData+"]"))
+	name := "vol1"
+	size := int64(1048576)
+	createReq := &VolumeCreate{Name: &name, Size: &size}
+
+	vol, err := C.EnsureVolume(context.Background(), createReq)
+	assert.Nil(t, err)
+	assert.Equal(t, volID, vol.ID)
+	// the volume already exists
+	vol, err = C.EnsureVolume(context.Background(), createReq)
+	assert.Nil(t, err)
+	assert.Equal(t, volID, vol.ID)
+	assert.Equal(t, size, vol.Size)
+
+	otherSize := int64(2097152)
+	_, err = C.EnsureVolume(context.Background(), &VolumeCreate{Name: &name, Size: &otherSize})
+	assert.True(t, errors.Is(err, ErrNameInUse))
+	assert.Contains(t, err.Error(), "volume vol1 exists with size 1048576, requested size is 2097152")
+
+	// errors other than name in use are returned as is, even if volume with the name exists
+	for _, status := range []int{400, 500} {
+		httpmock.RegisterResponder("POST", volumeMockURL,
+			httpmock.NewStringResponder(status, `{"messages": [{"code": "0xE04040010001"}]}`))
+		_, err = C.EnsureVolume(context.Background(), createReq)
+		assert.NotNil(t, err)
+		assert.Equal(t, status, err.(APIError).StatusCode)
+	}
+}
+
+// BenchmarkClientIMPL_GetVolume_Parallel measures throughput of a single client shared by many
+// goroutines, conns metric is the number of TLS connections opened to the array
+func BenchmarkClientIMPL_GetVolume_Parallel(b *testing.B) {
+	var conns int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/login_session") {
+			w.Header().Set("DELL-EMC-TOKEN", "token")
+			http.SetCookie(w, &http.Cookie{Name: "auth_cookie", Value: "session"})
+		}
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "name": "vol", "size": 1048576}`, volID)
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&conns, 1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	for _, sessionAuth := range []bool{false, true} {
+		b.Run(fmt.Sprintf("SessionAuth=%t", sessionAuth), func(b *testing.B) {
+			c, err := NewClientWithArgs(server.URL+"/api/rest", "admin", "Password",
+				NewClientOptions().SetInsecure(true).SetSessionAuth(sessionAuth))
+			if err != nil {
+				b.Fatal(err)
+			}
+			atomic.StoreInt64(&conns, 0)
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := c.GetVolume(context.Background(), volID); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+			b.ReportMetric(float64(atomic.LoadInt64(&conns)), "conns")
+		})
+	}
+}
@@ -78,13 +78,87 @@ type VolumeCreate struct {
 	Size *int64 `json:"size"`
 	// Storage type. Valid values are:
 	StorageType *StorageTypeEnum `json:"storage_type,omitempty"`
+	// Unique identifier of the protection policy assigned to the volume.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+	// User defined key-value pairs, supported by PowerStore 3.0 and newer.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+	// Unique identifier of the performance policy assigned to the volume, e.g. default_medium.
+	PerformancePolicyID *string `json:"performance_policy_id,omitempty"`
+	// Unique identifier of the I/O limit policy assigned to the volume.
+	IoLimitPolicyID *string `json:"qos_performance_policy_id,omitempty"`
+	// Unique identifier of the appliance the volume is placed on, the array picks one when not set.
+	ApplianceID *string `json:"appliance_id,omitempty"`
+	// Unique identifier of the volume group the volume is added to.
+	VolumeGroupID *string `json:"volume_group_id,omitempty"`
+	// Application type of the volume, supported by PowerStore 2.1 and newer.
+	AppType *AppTypeEnum `json:"app_type,omitempty"`
+	// Free-form application type description, used when AppType is one of the *_Other values.
+	AppTypeOther *string `json:"app_type_other,omitempty"`
 }
 
+// VolumeModify modify volume request, unset fields are not changed
+type VolumeModify struct {
+	// Unique name of the volume.
+	Name *string `json:"name,omitempty"`
+	// Description of the volume.
+	Description *string `json:"description,omitempty"`
+	// New size of the volume in bytes. Volume can only grow, size must be a multiple of 8192.
+	Size *int64 `json:"size,omitempty"`
+	// User defined key-value pairs which replace existing metadata, supported by PowerStore 3.0 and newer.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+	// Unique identifier of the protection policy to assign to the volume.
+	// Empty string removes the protection policy.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
+	// Unique identifier of the performance policy to assign to the volume, e.g. default_high.
+	PerformancePolicyID *string `json:"performance_policy_id,omitempty"`
+	// Unique identifier of the I/O limit policy to assign to the volume.
+	// Empty string removes the I/O limit policy.
+	IoLimitPolicyID *string `json:"qos_performance_policy_id,omitempty"`
+	// Application type of the volume, supported by PowerStore 2.1 and newer.
+	AppType *AppTypeEnum `json:"app_type,omitempty"`
+	// Free-form application type description, used when AppType is one of the *_Other values.
+	AppTypeOther *string `json:"app_type_other,omitempty"`
+}
+
+// AppTypeEnum application type of the volume, used by the array to tune data placement and reporting
+type AppTypeEnum string
+
+const (
+	// AppTypeEnumRelationalDatabasesOther captures enum value "Relational_Databases_Other"
+	AppTypeEnumRelationalDatabasesOther AppTypeEnum = "Relational_Databases_Other"
+	// AppTypeEnumRelationalDatabasesOracle captures enum value "Relational_Databases_Oracle"
+	AppTypeEnumRelationalDatabasesOracle AppTypeEnum = "Relational_Databases_Oracle"
+	// AppTypeEnumRelationalDatabasesSQLServer captures enum value "Relational_Databases_SQL_Server"
+	AppTypeEnumRelationalDatabasesSQLServer AppTypeEnum = "Relational_Databases_SQL_Server"
+	// AppTypeEnumRelationalDatabasesPostgreSQL captures enum value "Relational_Databases_PostgreSQL"
+	AppTypeEnumRelationalDatabasesPostgreSQL AppTypeEnum = "Relational_Databases_PostgreSQL"
+	// AppTypeEnumRelationalDatabasesMySQL captures enum value "Relational_Databases_MySQL"
+	AppTypeEnumRelationalDatabasesMySQL AppTypeEnum = "Relational_Databases_MySQL"
+	// AppTypeEnumBigDataAnalyticsOther captures enum value "Big_Data_Analytics_Other"
+	AppTypeEnumBigDataAnalyticsOther AppTypeEnum = "Big_Data_Analytics_Other"
+	// AppTypeEnumBusinessApplicationsOther captures enum value "Business_Applications_Other"
+	AppTypeEnumBusinessApplicationsOther AppTypeEnum = "Business_Applications_Other"
+	// AppTypeEnumHealthcareOther captures enum value "Healthcare_Other"
+	AppTypeEnumHealthcareOther AppTypeEnum = "Healthcare_Other"
+	// AppTypeEnumVirtualizationOther captures enum value "Virtualization_Other"
+	AppTypeEnumVirtualizationOther AppTypeEnum = "Virtualization_Other"
+	// AppTypeEnumVirtualizationContainersKubernetes captures enum value "Virtualization_Containers_Kubernetes"
+	AppTypeEnumVirtualizationContainersKubernetes AppTypeEnum = "Virtualization_Containers_Kubernetes"
+	// AppTypeEnumOther captures enum value "Other"
+	AppTypeEnumOther AppTypeEnum = "Other"
+)
+
 // VolumeClone request for cloning snapshot/volume
 type VolumeClone struct {
 	// Unique name for the volume to be created.
 	Name        *string `json:"name"`
 	Description *string `json:"description,omitempty"`
+	// Unique identifier of the performance policy assigned to the clone.
+	PerformancePolicyID *string `json:"performance_policy_id,omitempty"`
+	// Unique identifier of the I/O limit policy assigned to the clone.
+	IoLimitPolicyID *string `json:"qos_performance_policy_id,omitempty"`
+	// Unique identifier of the protection policy assigned to the clone.
+	ProtectionPolicyID *string `json:"protection_policy_id,omitempty"`
 }
 
 // SnapshotCreate params for creating 'create snapshot' request
@@ -94,11 +168,37 @@ type SnapshotCreate struct {
 	Description *string `json:"description,omitempty"`
 }
 
+// SnapshotModify params for modifying existing snapshot
+type SnapshotModify struct {
+	// Name of the snapshot.
+	Name *string `json:"name,omitempty"`
+	// Description of the snapshot.
+	Description *string `json:"description,omitempty"`
+	// Time when the snapshot is deleted automatically, in RFC3339 format.
+	ExpirationTimestamp *string `json:"expiration_timestamp,omitempty"`
+}
+
 // VolumeDelete body for VolumeDelete request
 type VolumeDelete struct {
 	ForceInternal *bool `json:"force_internal,omitempty"`
 }
 
+// DeleteResult result of bulk delete operation
+type DeleteResult struct {
+	// Number of successfully deleted instances, including instances which were already deleted.
+	Deleted int
+	// Errors of failed deletions by instance id.
+	Errors map[string]error
+}
+
+// BatchResult result of one item of batch operation
+type BatchResult struct {
+	// Unique identifier of the instance, empty if creation failed.
+	ID string
+	// Error of the operation, nil on success.
+	Err error
+}
+
 // Volume Details about a volume, including snapshots and clones of volumes.
 type Volume struct {
 	Description string `json:"description,omitempty"`
@@ -108,6 +208,8 @@ type Volume struct {
 	//  Size of the volume in bytes. Minimum volume size is 1MB. Maximum volume size is 256TB.
 	//  Size must be a multiple of 8192.
 	Size int64 `json:"size,omitempty"`
+	// Amount of data in bytes written to the volume by hosts.
+	LogicalUsed int64 `json:"logical_used,omitempty"`
 	// state
 	State VolumeStateEnum `json:"state,omitempty"`
 	// Storage type. Valid values are:
@@ -117,17 +219,118 @@ type Volume struct {
 	// volume topology
 	// World wide name of the volume.
 	Wwn string `json:"wwn,omitempty"`
+	// Data reduction ratio of the volume, filled only by GetTopSpaceConsumers.
+	DataReductionRatio float64 `json:"data_reduction_ratio,omitempty"`
+	// Compression ratio of the volume, filled only by GetTopSpaceConsumers.
+	CompressionRatio float64 `json:"compression_ratio,omitempty"`
+	// Deduplication ratio of the volume, filled only by GetTopSpaceConsumers. Zero if not reported by the array.
+	DedupRatio float64 `json:"dedup_ratio,omitempty"`
+	// User defined key-value pairs, filled only by GetUnmanagedVolumes and GetVolumeByMetadata.
+	Metadata map[string]string `json:"metadata,omitempty"`
 
 	ProtectionData ProtectionData `json:"protection_data,omitempty"`
+	// Unique identifier of the protection policy assigned to the volume.
+	ProtectionPolicyID string `json:"protection_policy_id,omitempty"`
+}
+
+// VolumeRefresh request for refreshing volume data from another volume or snapshot
+type VolumeRefresh struct {
+	// Unique identifier of the source snapshot or volume used to refresh the target volume.
+	FromObjectID *string `json:"from_object_id"`
+	// Indicates whether a backup snapshot of the target volume will be created before it is refreshed.
+	CreateBackupSnap *bool `json:"create_backup_snap,omitempty"`
+	// Name of the backup snapshot to be created.
+	BackupSnapName *string `json:"backup_snap_name,omitempty"`
+	// Description of the backup snapshot to be created.
+	BackupSnapDescription *string `json:"backup_snap_description,omitempty"`
+}
+
+// VolumeRestore request for restoring volume data from its snapshot
+type VolumeRestore struct {
+	// Unique identifier of the snapshot of the volume to restore from.
+	SnapshotID *string `json:"from_snap_id"`
+	// Indicates whether a backup snapshot of the volume will be created before it is restored.
+	CreateBackupSnap *bool `json:"create_backup_snap,omitempty"`
+	// Backup snapshot settings.
+	BackupSnapProfile *BackupSnapProfile `json:"backup_snap_profile,omitempty"`
+}
+
+// MetroConfig request for configuring metro replication of the volume
+type MetroConfig struct {
+	// Unique identifier of the remote system the volume is stretched to.
+	RemoteSystemID *string `json:"remote_system_id"`
+	// Unique identifier of the storage container on the remote system, default container is used if not set.
+	RemoteStorageContainerID *string `json:"remote_storage_container_id,omitempty"`
+}
+
+// MetroSessionResponse response of configure_metro request
+type MetroSessionResponse struct {
+	// Unique identifier of the metro replication session.
+	ID string `json:"metro_replication_session_id,omitempty"`
+}
+
+// EndMetroVolumeOptions request for ending metro replication of the volume
+type EndMetroVolumeOptions struct {
+	// Indicates whether the volume on the remote system is deleted. By default the remote volume is kept.
+	DeleteRemoteVolume *bool `json:"delete_remote_volume,omitempty"`
 }
 
 // ProtectionData is a field that holds meta information about volume creation
 type ProtectionData struct {
+	// Unique identifier of the object from which the volume was created or last refreshed.
 	SourceID string `json:"source_id"`
+	// Unique identifier of the object from which the volume directly descends.
+	ParentID string `json:"parent_id,omitempty"`
+	// Unique identifier of the family the volume belongs to.
+	FamilyID string `json:"family_id,omitempty"`
+	// Timestamp of the source object at the moment the volume was created or last refreshed.
+	SourceTimestamp string `json:"source_timestamp,omitempty"`
+	// Creator type of the storage resource.
+	CreatorType StorageCreatorTypeEnum `json:"creator_type,omitempty"`
+	// Unique identifier of the copy signature of the source data.
+	CopySignature string `json:"copy_signature,omitempty"`
+	// Expiration timestamp of the snapshot.
+	ExpirationTimestamp string `json:"expiration_timestamp,omitempty"`
+	// Unique identifier of the snapshot rule that created the snapshot.
+	CreatedByRuleID string `json:"created_by_rule_id,omitempty"`
+	// Name of the snapshot rule that created the snapshot.
+	CreatedByRuleName string `json:"created_by_rule_name,omitempty"`
 }
 
 // Fields returns fields which must be requested to fill struct
 func (v *Volume) Fields() []string {
 	return []string{"description", "id", "name",
-		"size", "state", "storage_type", "type", "wwn", "protection_data"}
+		"size", "logical_used", "state", "storage_type", "type", "wwn", "protection_data", "protection_policy_id"}
+}
+
+// LogicalUsedPercent returns percentage of provisioned size written by hosts
+func (v *Volume) LogicalUsedPercent() float64 {
+	if v.Size == 0 {
+		return 0
+	}
+	return float64(v.LogicalUsed) / float64(v.Size) * 100
+}
+
+// volumeCreation holds volume creation time
+type volumeCreation struct {
+	ID                string `json:"id"`
+	CreationTimestamp string `json:"creation_timestamp"`
+}
+
+// CreationRateBucket number of objects created during one interval
+type CreationRateBucket struct {
+	// Start of the interval in RFC3339 format.
+	Start string
+	// Number of objects created during the interval.
+	Count int
+}
+
+// CreationRate time-bucketed series of created objects
+type CreationRate struct {
+	// Length of a single bucket.
+	Interval MetricsIntervalEnum
+	// Buckets sorted by time, the last one holds the current interval.
+	Buckets []CreationRateBucket
+	// Total number of objects created over all buckets.
+	Total int
 }
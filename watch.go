@@ -0,0 +1,169 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// validateWatchInterval checks interval of polling can be used by ticker
+func validateWatchInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid watch interval: %s, it must be positive", interval)
+	}
+	return nil
+}
+
+// watchCollection polls list every interval and calls emit for each difference from the previous poll.
+// Resources are compared by all fields, PowerStore has no modification timestamp to rely on.
+// It returns when ctx is done or emit returns false
+func watchCollection(ctx context.Context, interval time.Duration,
+	list func(ctx context.Context) (map[string]interface{}, error),
+	emit func(eventType WatchEventTypeEnum, item interface{}, err error) bool) {
+	known := map[string]interface{}{}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		current, err := list(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if !emit(WatchEventTypeEnumError, nil, err) {
+				return
+			}
+		} else {
+			for id, item := range current {
+				prev, ok := known[id]
+				switch {
+				case !ok:
+					if !emit(WatchEventTypeEnumAdded, item, nil) {
+						return
+					}
+				case !reflect.DeepEqual(prev, item):
+					if !emit(WatchEventTypeEnumUpdated, item, nil) {
+						return
+					}
+				}
+			}
+			for id, item := range known {
+				if _, ok := current[id]; !ok {
+					if !emit(WatchEventTypeEnumRemoved, item, nil) {
+						return
+					}
+				}
+			}
+			known = current
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// WatchVolumes polls volumes matching the filter every interval and emits added, updated and removed
+// volumes on returned channel. Filter is applied by the array, nil filter watches all volumes.
+// Every poll reads the full list of matching volumes and compares it with the previous one, polling is
+// not incremental. On arrays with many volumes narrow the list with filter conditions and select only
+// the watched fields with QueryFilter.Select, changes of other fields are not reported then.
+// Channel is closed when ctx is done, consumer must read it until then.
+// Error is returned and nothing is polled if interval is not positive
+func (c *ClientIMPL) WatchVolumes(ctx context.Context, interval time.Duration,
+	filter *QueryFilter) (<-chan VolumeEvent, error) {
+	if err := validateWatchInterval(interval); err != nil {
+		return nil, err
+	}
+	events := make(chan VolumeEvent)
+	go func() {
+		defer close(events)
+		watchCollection(ctx, interval,
+			func(ctx context.Context) (map[string]interface{}, error) {
+				volumes, err := c.GetVolumesFiltered(ctx, filter)
+				if err != nil {
+					return nil, err
+				}
+				result := make(map[string]interface{}, len(volumes))
+				for _, v := range volumes {
+					result[v.ID] = v
+				}
+				return result, nil
+			},
+			func(eventType WatchEventTypeEnum, item interface{}, err error) bool {
+				event := VolumeEvent{Type: eventType, Err: err}
+				if item != nil {
+					event.Volume = item.(Volume)
+				}
+				select {
+				case events <- event:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+	}()
+	return events, nil
+}
+
+// WatchHosts polls hosts every interval and emits added, updated and removed hosts on returned channel,
+// changes of initiators and their sessions are reported as updates.
+// Every poll reads the full list of hosts with GetHosts and compares it with the previous one, polling is
+// not incremental. When caching is enabled with ClientOptions.SetCacheTTL, GetHosts returns cached list
+// until ttl passes, so changes made by other clients are seen only after ttl and interval shorter than
+// ttl only repeats the same result.
+// Channel is closed when ctx is done, consumer must read it until then.
+// Error is returned and nothing is polled if interval is not positive
+func (c *ClientIMPL) WatchHosts(ctx context.Context, interval time.Duration) (<-chan HostEvent, error) {
+	if err := validateWatchInterval(interval); err != nil {
+		return nil, err
+	}
+	events := make(chan HostEvent)
+	go func() {
+		defer close(events)
+		watchCollection(ctx, interval,
+			func(ctx context.Context) (map[string]interface{}, error) {
+				hosts, err := c.GetHosts(ctx)
+				if err != nil {
+					return nil, err
+				}
+				result := make(map[string]interface{}, len(hosts))
+				for _, h := range hosts {
+					result[h.ID] = h
+				}
+				return result, nil
+			},
+			func(eventType WatchEventTypeEnum, item interface{}, err error) bool {
+				event := HostEvent{Type: eventType, Err: err}
+				if item != nil {
+					event.Host = item.(Host)
+				}
+				select {
+				case events <- event:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+	}()
+	return events, nil
+}
@@ -0,0 +1,141 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClientIMPL_WatchVolumes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	polls := []string{
+		`[{"id": "v1", "size": 1048576}, {"id": "v2"}]`,
+		`[{"id": "v1", "size": 2097152}, {"id": "v3"}]`,
+		"",
+		`[{"id": "v1", "size": 2097152}, {"id": "v3"}]`,
+	}
+	var mu sync.Mutex
+	var nameFilter string
+	poll := 0
+	httpmock.RegisterResponder("GET", volumeMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			nameFilter = req.URL.Query().Get("name")
+			body := polls[len(polls)-1]
+			if poll < len(polls) {
+				body = polls[poll]
+			}
+			poll++
+			if body == "" {
+				return httpmock.NewStringResponse(400, `{"messages": [{"code": "0xE04040010005"}]}`), nil
+			}
+			return httpmock.NewStringResponse(200, body), nil
+		})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := C.WatchVolumes(ctx, 10*time.Millisecond,
+		NewQueryFilter().Where("name", FilterOperatorEnumIlike, "csi-*"))
+	assert.Nil(t, err)
+
+	next := func() VolumeEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("no event")
+		}
+		return VolumeEvent{}
+	}
+	collect := func(n int) map[string]WatchEventTypeEnum {
+		result := make(map[string]WatchEventTypeEnum)
+		for i := 0; i < n; i++ {
+			event := next()
+			result[event.Volume.ID] = event.Type
+		}
+		return result
+	}
+	assert.Equal(t, map[string]WatchEventTypeEnum{
+		"v1": WatchEventTypeEnumAdded, "v2": WatchEventTypeEnumAdded}, collect(2))
+	mu.Lock()
+	assert.Equal(t, "ilike.csi-*", nameFilter)
+	mu.Unlock()
+	assert.Equal(t, map[string]WatchEventTypeEnum{
+		"v1": WatchEventTypeEnumUpdated, "v2": WatchEventTypeEnumRemoved, "v3": WatchEventTypeEnumAdded}, collect(3))
+	event := next()
+	assert.Equal(t, WatchEventTypeEnumError, event.Type)
+	assert.NotNil(t, event.Err)
+
+	// unchanged volumes produce no events
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	for range events {
+	}
+}
+
+func TestClientIMPL_WatchHosts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	poll := 0
+	httpmock.RegisterResponder("GET", hostMockURL,
+		func(req *http.Request) (*http.Response, error) {
+			poll++
+			sessions := ""
+			if poll > 1 {
+				sessions = `{"node_id": "N1", "port_name": "iqn.2015-10.com.dell:t1"}`
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(
+				`[{"id": "%s", "host_initiators": [{"port_name": "iqn.1994-05.com.redhat:a1", "active_sessions": [%s]}]}]`,
+				hostID, sessions)), nil
+		})
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := C.WatchHosts(ctx, 10*time.Millisecond)
+	assert.Nil(t, err)
+	event := <-events
+	assert.Equal(t, WatchEventTypeEnumAdded, event.Type)
+	assert.Empty(t, event.Host.Initiators[0].ActiveSessions)
+	event = <-events
+	assert.Equal(t, WatchEventTypeEnumUpdated, event.Type)
+	assert.Len(t, event.Host.Initiators[0].ActiveSessions, 1)
+	cancel()
+	for range events {
+	}
+}
+
+func TestClientIMPL_Watch_InvalidInterval(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	_, err := C.WatchVolumes(context.Background(), 0, nil)
+	assert.NotNil(t, err)
+	_, err = C.WatchHosts(context.Background(), -time.Second)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
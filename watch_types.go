@@ -0,0 +1,54 @@
+/*
+ *
+ * Copyright © 2020 Dell Inc. or its subsidiaries. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package gopowerstore
+
+// WatchEventTypeEnum kind of change detected by watch
+type WatchEventTypeEnum string
+
+const (
+	// WatchEventTypeEnumAdded - resource appeared since the previous poll, every resource is
+	// reported as added on the first poll
+	WatchEventTypeEnumAdded WatchEventTypeEnum = "Added"
+	// WatchEventTypeEnumUpdated - any of the requested fields of the resource changed since the previous poll
+	WatchEventTypeEnumUpdated WatchEventTypeEnum = "Updated"
+	// WatchEventTypeEnumRemoved - resource is gone or doesn't match the filter anymore
+	WatchEventTypeEnumRemoved WatchEventTypeEnum = "Removed"
+	// WatchEventTypeEnumError - poll failed, the watch goes on with the next poll
+	WatchEventTypeEnumError WatchEventTypeEnum = "Error"
+)
+
+// VolumeEvent is emitted by WatchVolumes when a change is detected
+type VolumeEvent struct {
+	// Kind of the change.
+	Type WatchEventTypeEnum
+	// Current state of the volume, the last known one for Removed events.
+	Volume Volume
+	// Error of the failed poll, set for Error events only.
+	Err error
+}
+
+// HostEvent is emitted by WatchHosts when a change is detected
+type HostEvent struct {
+	// Kind of the change.
+	Type WatchEventTypeEnum
+	// Current state of the host, the last known one for Removed events.
+	Host Host
+	// Error of the failed poll, set for Error events only.
+	Err error
+}